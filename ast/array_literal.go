@@ -7,6 +7,7 @@ import (
 
 type ArrayLiteral struct {
 	TokenAble
+	Span
 	Elements []Expression
 }
 
@@ -4,6 +4,7 @@ import "fmt"
 
 type AssignExpression struct {
 	TokenAble
+	Span
 	Name  *Identifier
 	Value Expression
 }
@@ -10,6 +10,30 @@ func (t TokenAble) T() token.Token {
 	return t.Token
 }
 
+// Span is the byte range a node covers in the parsed source. Start is the
+// offset of the node's first byte and Stop the offset just past its last.
+// The nodes of this package embed it to implement Spanned.
+type Span struct {
+	Start int
+	Stop  int
+}
+
+// Pos returns the offset of the first byte of the node.
+func (s Span) Pos() int {
+	return s.Start
+}
+
+// End returns the offset just past the last byte of the node.
+func (s Span) End() int {
+	return s.Stop
+}
+
+// SetSpan records the byte range of the node. It is used by the parser.
+func (s *Span) SetSpan(start, stop int) {
+	s.Start = start
+	s.Stop = stop
+}
+
 func (t TokenAble) TokenLiteral() string {
 	return t.Token.Literal
 }
@@ -25,6 +49,23 @@ type Node interface {
 	String() string
 }
 
+// Spanned is implemented by nodes that record the byte range they cover in
+// the parsed source. All nodes of this package do; nodes implemented
+// elsewhere don't have to.
+type Spanned interface {
+	Pos() int
+	End() int
+}
+
+// SpanOf returns the byte range n covers in the parsed source, or a zero
+// Span when n doesn't record one.
+func SpanOf(n Node) Span {
+	if s, ok := n.(Spanned); ok && !isNilNode(n) {
+		return Span{Start: s.Pos(), Stop: s.End()}
+	}
+	return Span{}
+}
+
 // All statement nodes implement this
 type Statement interface {
 	Node
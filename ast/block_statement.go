@@ -7,6 +7,7 @@ import (
 // BlockStatement is a list of statements grouped in a context surrounded by braces.
 type BlockStatement struct {
 	TokenAble
+	Span
 	Statements []Statement
 }
 
@@ -2,6 +2,7 @@ package ast
 
 type Boolean struct {
 	TokenAble
+	Span
 	Value bool
 }
 
@@ -2,6 +2,7 @@ package ast
 
 type BreakExpression struct {
 	TokenAble
+	Span
 }
 
 var _ Expression = &BreakExpression{}
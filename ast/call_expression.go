@@ -7,6 +7,7 @@ import (
 
 type CallExpression struct {
 	TokenAble
+	Span
 	Callee      Expression
 	ChainCallee Expression
 	Function    Expression
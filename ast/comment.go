@@ -0,0 +1,28 @@
+package ast
+
+// Comment is a `<%# %>` block comment or a `#` line comment kept as
+// trivia by parser.ParseWithOptions. Comments are not statements and
+// never affect rendering.
+type Comment struct {
+	TokenAble
+	Span
+	// Text is the comment without its `<%#`/`%>` or `#` delimiters.
+	Text string
+	// Block is true for `<%# %>` comments and false for `#` comments.
+	Block bool
+	// Node is the node the comment is attached to: the first node that
+	// starts after the comment or, when there is none, the last node that
+	// ends before it. It is nil for a program without statements.
+	Node Node
+	// Trailing is true when the comment follows Node instead of leading it.
+	Trailing bool
+}
+
+var _ Node = &Comment{}
+
+func (c *Comment) String() string {
+	if c.Block {
+		return "<%#" + c.Text + "%>"
+	}
+	return "#" + c.Text
+}
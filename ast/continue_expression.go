@@ -2,6 +2,7 @@ package ast
 
 type ContinueExpression struct {
 	TokenAble
+	Span
 }
 
 var _ Expression = &ContinueExpression{}
@@ -2,6 +2,7 @@ package ast
 
 type ExpressionStatement struct {
 	TokenAble
+	Span
 	Expression Expression
 }
 
@@ -2,6 +2,7 @@ package ast
 
 type FloatLiteral struct {
 	TokenAble
+	Span
 	Value float64
 }
 
@@ -6,6 +6,7 @@ import (
 
 type ForExpression struct {
 	TokenAble
+	Span
 	KeyName   string
 	ValueName string
 	Block     *BlockStatement
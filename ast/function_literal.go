@@ -7,6 +7,7 @@ import (
 
 type FunctionLiteral struct {
 	TokenAble
+	Span
 	Parameters []*Identifier
 	Block      *BlockStatement
 }
@@ -7,6 +7,7 @@ import (
 
 type HashLiteral struct {
 	TokenAble
+	Span
 	Order []Expression
 	Pairs map[Expression]Expression
 }
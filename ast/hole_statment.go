@@ -4,6 +4,7 @@ import "strings"
 
 type HoleStatement struct {
 	TokenAble
+	Span
 	Statements string
 }
 
@@ -2,6 +2,7 @@ package ast
 
 type HTMLLiteral struct {
 	TokenAble
+	Span
 	Value string
 }
 
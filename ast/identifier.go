@@ -6,6 +6,7 @@ import (
 
 type Identifier struct {
 	TokenAble
+	Span
 	Callee         *Identifier
 	Value          string
 	OriginalCallee *Identifier // So robot.Avatar.Name the OriginalCallee will be robot
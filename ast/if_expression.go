@@ -6,6 +6,7 @@ import (
 
 type IfExpression struct {
 	TokenAble
+	Span
 	Condition Expression
 	Block     *BlockStatement
 	ElseIf    []*ElseIfExpression
@@ -16,10 +17,29 @@ var _ Expression = &IfExpression{}
 
 type ElseIfExpression struct {
 	TokenAble
+	Span
 	Condition Expression
 	Block     *BlockStatement
 }
 
+var _ Node = &ElseIfExpression{}
+
+func (ei *ElseIfExpression) String() string {
+	var out bytes.Buffer
+
+	out.WriteString("else if (")
+	if ei.Condition != nil {
+		out.WriteString(ei.Condition.String())
+	}
+	out.WriteString(") { ")
+	if ei.Block != nil {
+		out.WriteString(ei.Block.String())
+	}
+	out.WriteString(" }")
+
+	return out.String()
+}
+
 func (ie *IfExpression) expressionNode() {}
 
 func (ie *IfExpression) String() string {
@@ -6,6 +6,7 @@ import (
 
 type IndexExpression struct {
 	TokenAble
+	Span
 	Left   Expression
 	Index  Expression
 	Value  Expression
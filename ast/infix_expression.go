@@ -6,6 +6,7 @@ import (
 
 type InfixExpression struct {
 	TokenAble
+	Span
 	Left     Expression
 	Operator string
 	Right    Expression
@@ -2,6 +2,7 @@ package ast
 
 type IntegerLiteral struct {
 	TokenAble
+	Span
 	Value int
 }
 
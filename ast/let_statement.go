@@ -6,6 +6,7 @@ import (
 
 type LetStatement struct {
 	TokenAble
+	Span
 	Name  *Identifier
 	Value Expression
 }
@@ -6,6 +6,7 @@ import (
 
 type PrefixExpression struct {
 	TokenAble
+	Span
 	Operator string
 	Right    Expression
 }
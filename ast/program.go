@@ -3,10 +3,53 @@ package ast
 import (
 	"bytes"
 	"strings"
+
+	"github.com/gobuffalo/plush/v5/token"
 )
 
 type Program struct {
 	Statements []Statement
+	// Comments holds the comments of the source in order. It is only
+	// populated by parser.ParseWithOptions when comments are requested.
+	Comments []*Comment
+}
+
+var _ Node = &Program{}
+
+// T returns the token of the first statement, so a Program can be
+// passed to Walk and Inspect like any other node.
+func (p *Program) T() token.Token {
+	if len(p.Statements) > 0 && p.Statements[0] != nil {
+		return p.Statements[0].T()
+	}
+	return token.Token{}
+}
+
+// Pos returns the offset of the first statement.
+func (p *Program) Pos() int {
+	if len(p.Statements) > 0 && p.Statements[0] != nil {
+		return SpanOf(p.Statements[0]).Start
+	}
+	return 0
+}
+
+// End returns the offset just past the last statement.
+func (p *Program) End() int {
+	if n := len(p.Statements); n > 0 && p.Statements[n-1] != nil {
+		return SpanOf(p.Statements[n-1]).Stop
+	}
+	return 0
+}
+
+// CommentsFor returns the comments attached to n.
+func (p *Program) CommentsFor(n Node) []*Comment {
+	var out []*Comment
+	for _, c := range p.Comments {
+		if c.Node == n {
+			out = append(out, c)
+		}
+	}
+	return out
 }
 
 func (p *Program) TokenLiteral() string {
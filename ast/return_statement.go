@@ -9,6 +9,7 @@ import (
 type ReturnStatement struct {
 	Type string
 	TokenAble
+	Span
 	ReturnValue Expression
 }
 
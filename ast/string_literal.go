@@ -2,6 +2,7 @@ package ast
 
 type StringLiteral struct {
 	TokenAble
+	Span
 	Value string
 }
 
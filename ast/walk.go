@@ -0,0 +1,406 @@
+package ast
+
+import "fmt"
+
+// A Visitor's Visit method is invoked for each node encountered by Walk.
+// If the result visitor w is not nil, Walk visits each of the children
+// of node with the visitor w, followed by a call of w.Visit(nil).
+type Visitor interface {
+	Visit(node Node) (w Visitor)
+}
+
+// Walk traverses an AST in depth-first order: It starts by calling
+// v.Visit(node); node must not be nil. If the visitor w returned by
+// v.Visit(node) is not nil, Walk is invoked recursively with visitor
+// w for each of the non-nil children of node, followed by a call of
+// w.Visit(nil).
+//
+// Children are visited in source order. Call blocks, else-if branches
+// and hash literal pairs are all visited, so a Walk reaches every node
+// the parser puts in the statements of a Program. Comments are trivia
+// kept in Program.Comments and are not visited.
+func Walk(v Visitor, node Node) {
+	if v = v.Visit(node); v == nil {
+		return
+	}
+
+	eachChild(node, func(child Node) {
+		Walk(v, child)
+	})
+
+	v.Visit(nil)
+}
+
+type inspector func(Node) bool
+
+func (f inspector) Visit(node Node) Visitor {
+	if f(node) {
+		return f
+	}
+	return nil
+}
+
+// Inspect traverses an AST in depth-first order: It starts by calling
+// f(node); node must not be nil. If f returns true, Inspect invokes f
+// recursively for each of the non-nil children of node, followed by a
+// call of f(nil).
+func Inspect(node Node, f func(Node) bool) {
+	Walk(inspector(f), node)
+}
+
+// RewriteFunc returns the replacement for node. Returning node itself
+// keeps it, returning nil removes it from its parent.
+type RewriteFunc func(node Node) Node
+
+// Rewrite traverses an AST in depth-first order and replaces every node
+// with the result of fn. Children are rewritten before their parent, so
+// fn always sees a node whose children have already been replaced.
+//
+// A nil replacement removes the node: it is dropped from statement,
+// argument and element lists and cleared from single-valued fields.
+// A replacement that does not fit the field it is stored in (for
+// example a Statement where an Expression is expected) panics.
+//
+// Rewrite modifies nodes in place and returns the replacement for the
+// root node.
+func Rewrite(node Node, fn RewriteFunc) Node {
+	if isNilNode(node) {
+		return nil
+	}
+
+	switch n := node.(type) {
+	case *Program:
+		n.Statements = rewriteStatements(n.Statements, fn)
+	case *BlockStatement:
+		n.Statements = rewriteStatements(n.Statements, fn)
+	case *ExpressionStatement:
+		n.Expression = rewriteExpression(n.Expression, fn)
+	case *LetStatement:
+		n.Name = rewriteIdentifier(n.Name, fn)
+		n.Value = rewriteExpression(n.Value, fn)
+	case *ReturnStatement:
+		n.ReturnValue = rewriteExpression(n.ReturnValue, fn)
+	case *Identifier:
+		n.Callee = rewriteIdentifier(n.Callee, fn)
+	case *AssignExpression:
+		n.Name = rewriteIdentifier(n.Name, fn)
+		n.Value = rewriteExpression(n.Value, fn)
+	case *PrefixExpression:
+		n.Right = rewriteExpression(n.Right, fn)
+	case *InfixExpression:
+		n.Left = rewriteExpression(n.Left, fn)
+		n.Right = rewriteExpression(n.Right, fn)
+	case *IfExpression:
+		n.Condition = rewriteExpression(n.Condition, fn)
+		n.Block = rewriteBlock(n.Block, fn)
+		elseIfs := n.ElseIf[:0]
+		for _, elseIf := range n.ElseIf {
+			if elseIf = rewriteElseIf(elseIf, fn); elseIf != nil {
+				elseIfs = append(elseIfs, elseIf)
+			}
+		}
+		n.ElseIf = elseIfs
+		n.ElseBlock = rewriteBlock(n.ElseBlock, fn)
+	case *ElseIfExpression:
+		n.Condition = rewriteExpression(n.Condition, fn)
+		n.Block = rewriteBlock(n.Block, fn)
+	case *ForExpression:
+		n.Iterable = rewriteExpression(n.Iterable, fn)
+		n.Block = rewriteBlock(n.Block, fn)
+	case *FunctionLiteral:
+		params := n.Parameters[:0]
+		for _, p := range n.Parameters {
+			if p = rewriteIdentifier(p, fn); p != nil {
+				params = append(params, p)
+			}
+		}
+		n.Parameters = params
+		n.Block = rewriteBlock(n.Block, fn)
+	case *CallExpression:
+		shared := sharesCallee(n)
+		if !shared {
+			n.Callee = rewriteExpression(n.Callee, fn)
+		}
+		n.Function = rewriteExpression(n.Function, fn)
+		if shared {
+			n.Callee = nil
+			if f, ok := n.Function.(*Identifier); ok && f.Callee != nil {
+				n.Callee = f.Callee
+			}
+		}
+		n.Arguments = rewriteExpressions(n.Arguments, fn)
+		n.Block = rewriteBlock(n.Block, fn)
+		n.ElseBlock = rewriteBlock(n.ElseBlock, fn)
+		n.ChainCallee = rewriteExpression(n.ChainCallee, fn)
+	case *ArrayLiteral:
+		n.Elements = rewriteExpressions(n.Elements, fn)
+	case *HashLiteral:
+		order := n.Order[:0]
+		pairs := make(map[Expression]Expression, len(n.Pairs))
+		for _, key := range n.Order {
+			value := n.Pairs[key]
+			newKey := rewriteExpression(key, fn)
+			if newKey == nil {
+				continue
+			}
+			order = append(order, newKey)
+			pairs[newKey] = rewriteExpression(value, fn)
+		}
+		n.Order = order
+		n.Pairs = pairs
+	case *IndexExpression:
+		n.Left = rewriteExpression(n.Left, fn)
+		n.Index = rewriteExpression(n.Index, fn)
+		n.Callee = rewriteExpression(n.Callee, fn)
+		n.Value = rewriteExpression(n.Value, fn)
+	}
+
+	return fn(node)
+}
+
+func rewriteStatements(list []Statement, fn RewriteFunc) []Statement {
+	out := list[:0]
+	for _, s := range list {
+		if s = rewriteStatement(s, fn); s != nil {
+			out = append(out, s)
+		}
+	}
+	return out
+}
+
+func rewriteExpressions(list []Expression, fn RewriteFunc) []Expression {
+	if list == nil {
+		return nil
+	}
+	out := list[:0]
+	for _, e := range list {
+		if e = rewriteExpression(e, fn); e != nil {
+			out = append(out, e)
+		}
+	}
+	return out
+}
+
+func rewriteStatement(s Statement, fn RewriteFunc) Statement {
+	if isNilNode(s) {
+		return nil
+	}
+	n := Rewrite(s, fn)
+	if n == nil {
+		return nil
+	}
+	stmt, ok := n.(Statement)
+	if !ok {
+		panic(fmt.Sprintf("ast.Rewrite: cannot replace statement %T with %T", s, n))
+	}
+	return stmt
+}
+
+func rewriteExpression(e Expression, fn RewriteFunc) Expression {
+	if isNilNode(e) {
+		return nil
+	}
+	n := Rewrite(e, fn)
+	if n == nil {
+		return nil
+	}
+	expr, ok := n.(Expression)
+	if !ok {
+		panic(fmt.Sprintf("ast.Rewrite: cannot replace expression %T with %T", e, n))
+	}
+	return expr
+}
+
+func rewriteIdentifier(i *Identifier, fn RewriteFunc) *Identifier {
+	if i == nil {
+		return nil
+	}
+	n := Rewrite(i, fn)
+	if n == nil {
+		return nil
+	}
+	ident, ok := n.(*Identifier)
+	if !ok {
+		panic(fmt.Sprintf("ast.Rewrite: cannot replace identifier with %T", n))
+	}
+	return ident
+}
+
+func rewriteBlock(b *BlockStatement, fn RewriteFunc) *BlockStatement {
+	if b == nil {
+		return nil
+	}
+	n := Rewrite(b, fn)
+	if n == nil {
+		return nil
+	}
+	block, ok := n.(*BlockStatement)
+	if !ok {
+		panic(fmt.Sprintf("ast.Rewrite: cannot replace block with %T", n))
+	}
+	return block
+}
+
+func rewriteElseIf(e *ElseIfExpression, fn RewriteFunc) *ElseIfExpression {
+	if e == nil {
+		return nil
+	}
+	n := Rewrite(e, fn)
+	if n == nil {
+		return nil
+	}
+	elseIf, ok := n.(*ElseIfExpression)
+	if !ok {
+		panic(fmt.Sprintf("ast.Rewrite: cannot replace else if with %T", n))
+	}
+	return elseIf
+}
+
+// eachChild calls f for every non-nil direct child of node in source order.
+func eachChild(node Node, f func(Node)) {
+	visit := func(n Node) {
+		if !isNilNode(n) {
+			f(n)
+		}
+	}
+
+	switch n := node.(type) {
+	case *Program:
+		for _, s := range n.Statements {
+			visit(s)
+		}
+	case *BlockStatement:
+		for _, s := range n.Statements {
+			visit(s)
+		}
+	case *ExpressionStatement:
+		visit(n.Expression)
+	case *LetStatement:
+		visit(n.Name)
+		visit(n.Value)
+	case *ReturnStatement:
+		visit(n.ReturnValue)
+	case *Identifier:
+		visit(n.Callee)
+	case *AssignExpression:
+		visit(n.Name)
+		visit(n.Value)
+	case *PrefixExpression:
+		visit(n.Right)
+	case *InfixExpression:
+		visit(n.Left)
+		visit(n.Right)
+	case *IfExpression:
+		visit(n.Condition)
+		visit(n.Block)
+		for _, elseIf := range n.ElseIf {
+			visit(elseIf)
+		}
+		visit(n.ElseBlock)
+	case *ElseIfExpression:
+		visit(n.Condition)
+		visit(n.Block)
+	case *ForExpression:
+		visit(n.Iterable)
+		visit(n.Block)
+	case *FunctionLiteral:
+		for _, p := range n.Parameters {
+			visit(p)
+		}
+		visit(n.Block)
+	case *CallExpression:
+		if !sharesCallee(n) {
+			visit(n.Callee)
+		}
+		visit(n.Function)
+		for _, a := range n.Arguments {
+			visit(a)
+		}
+		visit(n.Block)
+		visit(n.ElseBlock)
+		visit(n.ChainCallee)
+	case *ArrayLiteral:
+		for _, e := range n.Elements {
+			visit(e)
+		}
+	case *HashLiteral:
+		for _, key := range n.Order {
+			visit(key)
+			visit(n.Pairs[key])
+		}
+	case *IndexExpression:
+		visit(n.Left)
+		visit(n.Index)
+		visit(n.Callee)
+		visit(n.Value)
+	}
+}
+
+// sharesCallee reports whether the receiver of a method call is stored both
+// as the call's Callee and as the Callee of its Function, which is how the
+// parser represents `a.b()`. Such a receiver is visited only once.
+func sharesCallee(n *CallExpression) bool {
+	f, ok := n.Function.(*Identifier)
+	return ok && f != nil && f.Callee != nil && Expression(f.Callee) == n.Callee
+}
+
+// isNilNode reports whether n is nil or an interface holding a nil pointer.
+func isNilNode(n Node) bool {
+	switch n := n.(type) {
+	case nil:
+		return true
+	case *Program:
+		return n == nil
+	case *BlockStatement:
+		return n == nil
+	case *ExpressionStatement:
+		return n == nil
+	case *LetStatement:
+		return n == nil
+	case *ReturnStatement:
+		return n == nil
+	case *HoleStatement:
+		return n == nil
+	case *Identifier:
+		return n == nil
+	case *AssignExpression:
+		return n == nil
+	case *PrefixExpression:
+		return n == nil
+	case *InfixExpression:
+		return n == nil
+	case *IfExpression:
+		return n == nil
+	case *ElseIfExpression:
+		return n == nil
+	case *ForExpression:
+		return n == nil
+	case *FunctionLiteral:
+		return n == nil
+	case *CallExpression:
+		return n == nil
+	case *ArrayLiteral:
+		return n == nil
+	case *HashLiteral:
+		return n == nil
+	case *IndexExpression:
+		return n == nil
+	case *StringLiteral:
+		return n == nil
+	case *HTMLLiteral:
+		return n == nil
+	case *IntegerLiteral:
+		return n == nil
+	case *FloatLiteral:
+		return n == nil
+	case *Boolean:
+		return n == nil
+	case *BreakExpression:
+		return n == nil
+	case *ContinueExpression:
+		return n == nil
+	case *Comment:
+		return n == nil
+	}
+	return false
+}
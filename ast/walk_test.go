@@ -0,0 +1,190 @@
+package ast_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/gobuffalo/plush/v5/ast"
+	"github.com/gobuffalo/plush/v5/parser"
+	"github.com/gobuffalo/plush/v5/token"
+	"github.com/stretchr/testify/require"
+)
+
+const walkInput = `<p><%= title %></p>
+<%H partial("cached.html") %>
+<% let total = 0 %>
+<%= if (a > 1) { %>big<% } else if (!b) { %>small<% } else { %>none<% } %>
+<%= for (i, v) in list { total = total + v[0]; break } %>
+<%= greet(user.Name, {"k": 1.5}) { %><%= true %><% } %>
+<% let add = fn(x, y) { return x + y } %>
+<% for (v) in [1, 2] { continue } %>
+<% notify(title) %>`
+
+func nodeKinds(node ast.Node) map[string]int {
+	kinds := map[string]int{}
+	ast.Inspect(node, func(n ast.Node) bool {
+		if n != nil {
+			kinds[fmt.Sprintf("%T", n)]++
+		}
+		return true
+	})
+	return kinds
+}
+
+func Test_Inspect_Visits_Every_Node_Kind(t *testing.T) {
+	r := require.New(t)
+	program, err := parser.ParseWithOptions(walkInput+`<%# trivia %>`, parser.Options{Comments: true})
+	r.NoError(err)
+	r.Len(program.Comments, 1)
+
+	kinds := nodeKinds(program)
+	r.NotContains(kinds, "*ast.Comment", "comments are trivia, not children")
+	for _, kind := range []string{
+		"*ast.Program",
+		"*ast.ExpressionStatement",
+		"*ast.ArrayLiteral",
+		"*ast.ContinueExpression",
+		"*ast.HTMLLiteral",
+		"*ast.ReturnStatement",
+		"*ast.HoleStatement",
+		"*ast.LetStatement",
+		"*ast.IfExpression",
+		"*ast.ElseIfExpression",
+		"*ast.BlockStatement",
+		"*ast.InfixExpression",
+		"*ast.PrefixExpression",
+		"*ast.ForExpression",
+		"*ast.AssignExpression",
+		"*ast.IndexExpression",
+		"*ast.BreakExpression",
+		"*ast.CallExpression",
+		"*ast.HashLiteral",
+		"*ast.FloatLiteral",
+		"*ast.StringLiteral",
+		"*ast.Boolean",
+		"*ast.FunctionLiteral",
+		"*ast.IntegerLiteral",
+		"*ast.Identifier",
+	} {
+		r.Contains(kinds, kind)
+	}
+
+	rewritten := map[string]int{}
+	ast.Rewrite(program, func(n ast.Node) ast.Node {
+		rewritten[fmt.Sprintf("%T", n)]++
+		return n
+	})
+	r.Equal(kinds, rewritten)
+}
+
+func Test_Inspect_Skips_Children(t *testing.T) {
+	r := require.New(t)
+	program, err := parser.Parse(`<%= if (a) { %><%= inside %><% } %><%= outside %>`)
+	r.NoError(err)
+
+	var names []string
+	ast.Inspect(program, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.IfExpression:
+			return false
+		case *ast.Identifier:
+			names = append(names, n.Value)
+		}
+		return true
+	})
+	r.Equal([]string{"outside"}, names)
+}
+
+func Test_Inspect_Visits_Method_Receiver_Once(t *testing.T) {
+	r := require.New(t)
+	program, err := parser.Parse(`<%= user.greet(name) %>`)
+	r.NoError(err)
+
+	counts := map[string]int{}
+	ast.Inspect(program, func(n ast.Node) bool {
+		if id, ok := n.(*ast.Identifier); ok {
+			counts[id.String()]++
+		}
+		return true
+	})
+	r.Equal(1, counts["user"])
+	r.Equal(1, counts["name"])
+
+	seen := 0
+	ast.Rewrite(program, func(n ast.Node) ast.Node {
+		if id, ok := n.(*ast.Identifier); ok && id.Value == "user" {
+			seen++
+		}
+		return n
+	})
+	r.Equal(1, seen)
+	r.Equal(`<%= user.greet(name); %>`, program.String())
+}
+
+type depthVisitor struct {
+	depth *int
+	max   *int
+}
+
+func (v depthVisitor) Visit(n ast.Node) ast.Visitor {
+	if n == nil {
+		*v.depth--
+		return nil
+	}
+	*v.depth++
+	if *v.depth > *v.max {
+		*v.max = *v.depth
+	}
+	return v
+}
+
+func Test_Walk_Visits_Nil_After_Children(t *testing.T) {
+	r := require.New(t)
+	program, err := parser.Parse(`<%= a + (b * c) %>`)
+	r.NoError(err)
+
+	depth, max := 0, 0
+	ast.Walk(depthVisitor{depth: &depth, max: &max}, program)
+	r.Equal(0, depth)
+	r.Equal(5, max)
+}
+
+func Test_Rewrite_Replaces_And_Removes_Nodes(t *testing.T) {
+	r := require.New(t)
+	program, err := parser.Parse(`<% let unused = 1 %><%= greet(name, "x") %>`)
+	r.NoError(err)
+
+	out := ast.Rewrite(program, func(n ast.Node) ast.Node {
+		switch n := n.(type) {
+		case *ast.LetStatement:
+			return nil
+		case *ast.Identifier:
+			if n.Value == "name" {
+				return &ast.StringLiteral{
+					TokenAble: ast.TokenAble{Token: token.Token{Type: token.STRING, Literal: "Mark"}},
+					Value:     "Mark",
+				}
+			}
+		}
+		return n
+	})
+
+	r.Same(program, out)
+	r.Len(program.Statements, 1)
+	r.Equal(`<%= greet("Mark", "x"); %>`, program.String())
+}
+
+func Test_Rewrite_Panics_On_Mismatched_Replacement(t *testing.T) {
+	r := require.New(t)
+	program, err := parser.Parse(`<%= a + b %>`)
+	r.NoError(err)
+
+	r.Panics(func() {
+		ast.Rewrite(program, func(n ast.Node) ast.Node {
+			if _, ok := n.(*ast.Identifier); ok {
+				return &ast.BlockStatement{}
+			}
+			return n
+		})
+	})
+}
@@ -2,13 +2,62 @@ package plush
 
 import (
 	"errors"
+	"math"
 	"sync"
 	"sync/atomic"
+	"time"
 )
 
 // ErrBudgetExceeded is returned when a render exhausts its budget.
 var ErrBudgetExceeded = errors.New("render budget exceeded")
 
+// ErrRenderTimeout is returned when a render runs past the maximum duration
+// of its budget.
+var ErrRenderTimeout = errors.New("render time budget exceeded")
+
+// ErrOutputLimitExceeded is returned when a render writes more output than
+// the maximum output size of its budget.
+var ErrOutputLimitExceeded = errors.New("render output limit exceeded")
+
+// ErrCallDepthExceeded is returned when template functions call each
+// other deeper than the maximum call depth of the render.
+var ErrCallDepthExceeded = errors.New("call depth limit exceeded")
+
+// ErrPartialDepthExceeded is returned when partials render each other
+// deeper than the maximum partial nesting depth of the render.
+var ErrPartialDepthExceeded = errors.New("partial depth limit exceeded")
+
+// DefaultMaxCallDepth is the maximum depth of template function calls of a
+// render without a budget, or whose budget does not set one.
+const DefaultMaxCallDepth = 1000
+
+// DefaultMaxPartialDepth is the maximum nesting depth of partials of a
+// render without a budget, or whose budget does not set one.
+const DefaultMaxPartialDepth = 100
+
+// budgetCategory identifies the kind of operation charged at a checkpoint.
+type budgetCategory int32
+
+const (
+	categoryLoop budgetCategory = iota
+	categoryFunction
+	categoryFilter
+	categorySubRender
+	categoryCondition
+	categoryAssign
+	categoryTraversal
+	numBudgetCategories
+)
+
+// clockBase anchors clock so that it reads the monotonic clock.
+var clockBase = time.Now()
+
+// clock returns the nanoseconds since clockBase. It never returns 0, which
+// marks a budget whose clock has not started.
+func clock() int64 {
+	return int64(time.Since(clockBase)) + 1
+}
+
 // BudgetStats is a snapshot of work units consumed per operation category.
 // Retrieve it after rendering via b.Stats().
 type BudgetStats struct {
@@ -32,6 +81,26 @@ type BudgetStats struct {
 	// SpendFunctionCall. Functions without a FunctionCosts override appear
 	// here using the generic HelperCall cost.
 	ByFunction map[string]int64
+
+	// Elapsed is the time from when the budget was attached to the render
+	// to its last checkpoint. The time fields below split the time between
+	// checkpoints by category. They are only recorded by budgets with
+	// WithMaxDuration or WithTiming.
+	Elapsed time.Duration
+	// LoopTime is the time spent in loop iterations.
+	LoopTime time.Duration
+	// FunctionTime is the time spent in function/helper calls.
+	FunctionTime time.Duration
+	// FilterTime is the time spent in filter calls.
+	FilterTime time.Duration
+	// SubRenderTime is the time spent rendering partials and snippets.
+	SubRenderTime time.Duration
+	// ConditionTime is the time spent after if/unless evaluations.
+	ConditionTime time.Duration
+	// AssignmentTime is the time spent after variable assignments.
+	AssignmentTime time.Duration
+	// TraversalTime is the time spent after dot-notation traversals.
+	TraversalTime time.Duration
 }
 
 // Budget tracks render work units during template evaluation.
@@ -53,6 +122,20 @@ type Budget struct {
 	// per-function breakdown — mutex-protected plain map
 	statFuncsMu  sync.Mutex
 	statFuncsMap map[string]int64
+
+	// wall-clock tracking — the time between two checkpoints is charged to
+	// the category of the first one
+	maxDuration time.Duration
+	timing      bool
+	start       atomic.Int64
+	last        atomic.Int64
+	lastCat     atomic.Int32
+	statTime    [numBudgetCategories]atomic.Int64
+
+	maxOutput int64
+
+	maxCallDepth    int
+	maxPartialDepth int
 }
 
 // NewBudget creates a Budget with a limit and default costs.
@@ -64,6 +147,12 @@ func NewBudget(limit int64) *Budget {
 	}
 }
 
+// NewTimeBudget creates a Budget that only limits the render duration to d,
+// with no limit on work units.
+func NewTimeBudget(d time.Duration) *Budget {
+	return NewBudget(math.MaxInt64).WithMaxDuration(d)
+}
+
 // NewBudgetWithCosts creates a Budget with fully custom per-operation costs.
 func NewBudgetWithCosts(limit int64, costs BudgetCosts) *Budget {
 	return &Budget{
@@ -79,6 +168,120 @@ func (b *Budget) WithCosts(costs BudgetCosts) *Budget {
 	return b
 }
 
+// WithMaxDuration limits the wall-clock time of the render to d, counted
+// from when the budget is attached with Context.WithBudget, or from the
+// first checkpoint for contexts providing their budget otherwise. Once d
+// has passed, the next loop iteration,
+// call, partial or other checkpoint fails with ErrRenderTimeout. Partials
+// and punch holes share the budget, and so the deadline, of their render.
+// It also enables the time fields of Stats. Returns self for chaining.
+func (b *Budget) WithMaxDuration(d time.Duration) *Budget {
+	b.maxDuration = d
+	b.timing = true
+	return b
+}
+
+// WithTiming records the time fields of Stats without limiting the
+// duration of the render. Returns self for chaining.
+func (b *Budget) WithTiming() *Budget {
+	b.timing = true
+	return b
+}
+
+// MaxDuration returns the maximum render duration, or 0 when there is none.
+func (b *Budget) MaxDuration() time.Duration {
+	if b == nil {
+		return 0
+	}
+	return b.maxDuration
+}
+
+// Elapsed returns the time since the budget was attached to the render, or
+// 0 when the budget does not track time or its clock has not started.
+func (b *Budget) Elapsed() time.Duration {
+	if b == nil {
+		return 0
+	}
+	start := b.start.Load()
+	if start == 0 {
+		return 0
+	}
+	return time.Duration(clock() - start)
+}
+
+// CheckDeadline returns ErrRenderTimeout once the maximum duration has
+// passed, and nil otherwise. Slow helpers can call it between steps of
+// their own work.
+func (b *Budget) CheckDeadline() error {
+	if b == nil || b.maxDuration <= 0 {
+		return nil
+	}
+	if b.Elapsed() > b.maxDuration {
+		return ErrRenderTimeout
+	}
+	return nil
+}
+
+// WithMaxOutput limits the rendered output to n bytes. The size is checked
+// as output is written, so a render producing too much fails with
+// ErrOutputLimitExceeded before building the whole string. Returns self for
+// chaining.
+func (b *Budget) WithMaxOutput(n int64) *Budget {
+	b.maxOutput = n
+	return b
+}
+
+// MaxOutput returns the maximum output size in bytes, or 0 when there is
+// none.
+func (b *Budget) MaxOutput() int64 {
+	if b == nil {
+		return 0
+	}
+	return b.maxOutput
+}
+
+// CheckOutput returns ErrOutputLimitExceeded when an output of size bytes
+// is over the maximum output size, and nil otherwise.
+func (b *Budget) CheckOutput(size int) error {
+	if b == nil || b.maxOutput <= 0 || int64(size) <= b.maxOutput {
+		return nil
+	}
+	return ErrOutputLimitExceeded
+}
+
+// WithMaxCallDepth limits the depth of template function calls, such as a
+// recursive fn, to n. Deeper calls fail with ErrCallDepthExceeded. Returns
+// self for chaining.
+func (b *Budget) WithMaxCallDepth(n int) *Budget {
+	b.maxCallDepth = n
+	return b
+}
+
+// MaxCallDepth returns the maximum depth of template function calls,
+// DefaultMaxCallDepth unless set with WithMaxCallDepth.
+func (b *Budget) MaxCallDepth() int {
+	if b == nil || b.maxCallDepth <= 0 {
+		return DefaultMaxCallDepth
+	}
+	return b.maxCallDepth
+}
+
+// WithMaxPartialDepth limits the nesting of partials to n. Deeper partials
+// fail with ErrPartialDepthExceeded. Returns self for chaining.
+func (b *Budget) WithMaxPartialDepth(n int) *Budget {
+	b.maxPartialDepth = n
+	return b
+}
+
+// MaxPartialDepth returns the maximum nesting depth of partials,
+// DefaultMaxPartialDepth unless set with WithMaxPartialDepth.
+func (b *Budget) MaxPartialDepth() int {
+	if b == nil || b.maxPartialDepth <= 0 {
+		return DefaultMaxPartialDepth
+	}
+	return b.maxPartialDepth
+}
+
 // Costs returns the active cost configuration.
 func (b *Budget) Costs() BudgetCosts {
 	return b.costs
@@ -115,6 +318,16 @@ func (b *Budget) Stats() BudgetStats {
 		ObjectTraversals: b.statTraversal.Load(),
 		ByFunction:       make(map[string]int64),
 	}
+	if start, last := b.start.Load(), b.last.Load(); start != 0 && last != 0 {
+		s.Elapsed = time.Duration(last - start)
+		s.LoopTime = time.Duration(b.statTime[categoryLoop].Load())
+		s.FunctionTime = time.Duration(b.statTime[categoryFunction].Load())
+		s.FilterTime = time.Duration(b.statTime[categoryFilter].Load())
+		s.SubRenderTime = time.Duration(b.statTime[categorySubRender].Load())
+		s.ConditionTime = time.Duration(b.statTime[categoryCondition].Load())
+		s.AssignmentTime = time.Duration(b.statTime[categoryAssign].Load())
+		s.TraversalTime = time.Duration(b.statTime[categoryTraversal].Load())
+	}
 	b.statFuncsMu.Lock()
 	for k, v := range b.statFuncsMap {
 		s.ByFunction[k] = v
@@ -129,7 +342,7 @@ func (b *Budget) SpendLoop() error {
 		return nil
 	}
 	b.statLoop.Add(b.costs.LoopIteration)
-	return b.spend(b.costs.LoopIteration)
+	return b.spend(categoryLoop, b.costs.LoopIteration)
 }
 
 // SpendHelperCall spends the helper call cost.
@@ -138,7 +351,7 @@ func (b *Budget) SpendHelperCall() error {
 		return nil
 	}
 	b.statFunction.Add(b.costs.HelperCall)
-	return b.spend(b.costs.HelperCall)
+	return b.spend(categoryFunction, b.costs.HelperCall)
 }
 
 // SpendFilter spends the filter call cost.
@@ -147,7 +360,7 @@ func (b *Budget) SpendFilter() error {
 		return nil
 	}
 	b.statFilter.Add(b.costs.FilterCall)
-	return b.spend(b.costs.FilterCall)
+	return b.spend(categoryFilter, b.costs.FilterCall)
 }
 
 // SpendSubRender spends the sub-render cost.
@@ -156,7 +369,7 @@ func (b *Budget) SpendSubRender() error {
 		return nil
 	}
 	b.statSubRender.Add(b.costs.SubRender)
-	return b.spend(b.costs.SubRender)
+	return b.spend(categorySubRender, b.costs.SubRender)
 }
 
 // SpendCondition spends the condition check cost.
@@ -165,7 +378,7 @@ func (b *Budget) SpendCondition() error {
 		return nil
 	}
 	b.statCondition.Add(b.costs.ConditionCheck)
-	return b.spend(b.costs.ConditionCheck)
+	return b.spend(categoryCondition, b.costs.ConditionCheck)
 }
 
 // SpendAssignment spends the assignment cost.
@@ -174,7 +387,7 @@ func (b *Budget) SpendAssignment() error {
 		return nil
 	}
 	b.statAssign.Add(b.costs.Assignment)
-	return b.spend(b.costs.Assignment)
+	return b.spend(categoryAssign, b.costs.Assignment)
 }
 
 // SpendFunctionCall spends the cost for a named function call.
@@ -191,7 +404,7 @@ func (b *Budget) SpendFunctionCall(name string) error {
 	b.statFuncsMu.Lock()
 	b.statFuncsMap[name] += cost
 	b.statFuncsMu.Unlock()
-	return b.spend(cost)
+	return b.spend(categoryFunction, cost)
 }
 
 // SpendObjectTraversal spends ObjectTraversal * segments units.
@@ -202,12 +415,20 @@ func (b *Budget) SpendObjectTraversal(segments int) error {
 	}
 	units := b.costs.ObjectTraversal * int64(segments)
 	b.statTraversal.Add(units)
-	return b.spend(units)
+	return b.spend(categoryTraversal, units)
 }
 
 // spend is the internal hot path. Uses atomic add with no locks.
-func (b *Budget) spend(units int64) error {
-	if b == nil || units == 0 {
+func (b *Budget) spend(cat budgetCategory, units int64) error {
+	if b == nil {
+		return nil
+	}
+	if b.timing {
+		if err := b.tick(cat); err != nil {
+			return err
+		}
+	}
+	if units == 0 {
 		return nil
 	}
 	if b.counter.Add(units) > b.limit {
@@ -215,3 +436,32 @@ func (b *Budget) spend(units int64) error {
 	}
 	return nil
 }
+
+// startClock starts the clock of a budget tracking time, unless it runs
+// already.
+func (b *Budget) startClock() {
+	if b != nil && b.timing {
+		b.start.CompareAndSwap(0, clock())
+	}
+}
+
+// tick records a checkpoint of category cat: it starts the clock if no
+// render did, charges the time since the previous checkpoint to its
+// category and checks the maximum duration.
+func (b *Budget) tick(cat budgetCategory) error {
+	now := clock()
+	if b.start.CompareAndSwap(0, now) {
+		b.last.Store(now)
+		b.lastCat.Store(int32(cat))
+		return nil
+	}
+	prev := b.last.Swap(now)
+	prevCat := b.lastCat.Swap(int32(cat))
+	if prev != 0 && now > prev {
+		b.statTime[prevCat].Add(now - prev)
+	}
+	if b.maxDuration > 0 && now-b.start.Load() > int64(b.maxDuration) {
+		return ErrRenderTimeout
+	}
+	return nil
+}
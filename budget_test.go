@@ -2,7 +2,9 @@ package plush
 
 import (
 	"errors"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 )
@@ -283,3 +285,326 @@ func Test_Budget_Stats_Nil_Budget_Returns_Zero(t *testing.T) {
 	s := b.Stats()
 	r.Equal(BudgetStats{}, s)
 }
+
+// --- Wall-clock time budget ---
+
+func Test_Budget_Max_Duration_Exceeded(t *testing.T) {
+	r := require.New(t)
+	var calls int
+	b := NewTimeBudget(20 * time.Millisecond)
+	ctx := NewContext()
+	ctx.Set("items", make([]int, 100))
+	ctx.Set("slow", func() string {
+		calls++
+		time.Sleep(10 * time.Millisecond)
+		return "x"
+	})
+	ctx.WithBudget(b)
+
+	_, err := Render(`<%= for (i,v) in items { %><%= slow() %><% } %>`, ctx)
+	r.True(errors.Is(err, ErrRenderTimeout), "expected ErrRenderTimeout, got %v", err)
+	r.False(errors.Is(err, ErrBudgetExceeded))
+	r.Less(calls, 10)
+	r.Equal(20*time.Millisecond, b.MaxDuration())
+}
+
+func Test_Budget_Max_Duration_Within_Limit(t *testing.T) {
+	r := require.New(t)
+	ctx := NewContext()
+	ctx.Set("items", []int{1, 2, 3})
+	ctx.WithBudget(NewBudget(100).WithMaxDuration(time.Minute))
+
+	out, err := Render(`<%= for (i,v) in items { %><%= v %><% } %>`, ctx)
+	r.NoError(err)
+	r.Equal("123", out)
+}
+
+func Test_Budget_Max_Duration_Shared_By_Partials(t *testing.T) {
+	r := require.New(t)
+	ctx := NewContext()
+	ctx.Set("items", make([]int, 100))
+	ctx.Set("partialFeeder", func(string) (string, error) {
+		time.Sleep(10 * time.Millisecond)
+		return "<%= 1 %>", nil
+	})
+	ctx.WithBudget(NewTimeBudget(20 * time.Millisecond))
+
+	_, err := Render(`<%= for (i,v) in items { %><%= partial("row.html") %><% } %>`, ctx)
+	r.True(errors.Is(err, ErrRenderTimeout), "expected ErrRenderTimeout, got %v", err)
+}
+
+func Test_Budget_Check_Deadline(t *testing.T) {
+	r := require.New(t)
+	var b *Budget
+	r.NoError(b.CheckDeadline())
+
+	b = NewTimeBudget(time.Millisecond)
+	r.NoError(b.CheckDeadline(), "the clock starts when the budget is attached")
+	r.NoError(b.SpendLoop())
+	time.Sleep(5 * time.Millisecond)
+	r.True(errors.Is(b.CheckDeadline(), ErrRenderTimeout))
+	r.True(errors.Is(b.SpendAssignment(), ErrRenderTimeout), "zero-cost checkpoints check the deadline too")
+}
+
+func Test_Budget_Clock_Starts_When_Attached(t *testing.T) {
+	r := require.New(t)
+	b := NewTimeBudget(10 * time.Millisecond)
+	r.Zero(b.Elapsed())
+	ctx := NewContext().WithBudget(b)
+	time.Sleep(20 * time.Millisecond)
+	r.GreaterOrEqual(b.Elapsed(), 20*time.Millisecond)
+
+	_, err := Render(`<%= for (i) in range(1, 2) { %><%= i %><% } %>`, ctx)
+	r.True(errors.Is(err, ErrRenderTimeout), "expected ErrRenderTimeout, got %v", err)
+	r.GreaterOrEqual(b.Stats().Elapsed, 20*time.Millisecond)
+
+	// Budgets without timing keep no clock.
+	b = NewBudget(100)
+	NewContext().WithBudget(b)
+	r.Zero(b.Elapsed())
+}
+
+func Test_Budget_Stats_Time_By_Category(t *testing.T) {
+	r := require.New(t)
+	b := NewBudget(1_000).WithTiming()
+	ctx := NewContext()
+	ctx.Set("items", []int{1, 2, 3})
+	ctx.Set("slow", func() string {
+		time.Sleep(5 * time.Millisecond)
+		return "x"
+	})
+	ctx.WithBudget(b)
+
+	_, err := Render(`<%= for (i,v) in items { %><%= slow() %><% } %><%= if (true) { %>ok<% } %>`, ctx)
+	r.NoError(err)
+
+	s := b.Stats()
+	r.GreaterOrEqual(s.FunctionTime, 15*time.Millisecond)
+	r.Less(s.LoopTime, s.FunctionTime)
+	r.GreaterOrEqual(s.Elapsed, s.LoopTime+s.FunctionTime+s.FilterTime+s.SubRenderTime+s.ConditionTime+s.AssignmentTime+s.TraversalTime)
+	r.Equal(time.Duration(0), b.MaxDuration())
+}
+
+func Test_Budget_Stats_No_Time_Without_Timing(t *testing.T) {
+	r := require.New(t)
+	b := NewBudget(1_000)
+	ctx := NewContext()
+	ctx.Set("items", []int{1, 2, 3})
+	ctx.WithBudget(b)
+
+	_, err := Render(`<% for (i,v) in items { } %>`, ctx)
+	r.NoError(err)
+	s := b.Stats()
+	r.Equal(int64(3), s.LoopIterations)
+	r.Zero(s.Elapsed)
+	r.Zero(s.LoopTime)
+	r.Zero(b.Elapsed())
+}
+
+// --- Output size limit ---
+
+func Test_Budget_Max_Output_Exceeded(t *testing.T) {
+	r := require.New(t)
+	ctx := NewContext()
+	ctx.Set("items", make([]int, 1000))
+	ctx.WithBudget(NewBudget(1_000_000).WithMaxOutput(100))
+
+	_, err := RenderInterpreter(`<%= for (i,v) in items { %>0123456789<% } %>`, ctx)
+	r.True(errors.Is(err, ErrOutputLimitExceeded), "expected ErrOutputLimitExceeded, got %v", err)
+	r.Contains(err.Error(), "line 1")
+}
+
+func Test_Budget_Max_Output_Stops_Loop(t *testing.T) {
+	r := require.New(t)
+	var calls int
+	ctx := NewContext()
+	ctx.Set("items", make([]int, 1000))
+	ctx.Set("row", func() string {
+		calls++
+		return "0123456789"
+	})
+	ctx.WithBudget(NewBudget(1_000_000).WithMaxOutput(100))
+
+	_, err := RenderInterpreter(`<%= for (i,v) in items { %><p><%= row() %></p><% } %>`, ctx)
+	r.True(errors.Is(err, ErrOutputLimitExceeded), "expected ErrOutputLimitExceeded, got %v", err)
+	r.Equal(6, calls, "the loop stops at the iteration that passes the limit")
+}
+
+func Test_Budget_Max_Output_Within_Limit(t *testing.T) {
+	r := require.New(t)
+	ctx := NewContext()
+	ctx.Set("items", make([]int, 10))
+	ctx.WithBudget(NewBudget(1_000).WithMaxOutput(100))
+
+	out, err := RenderInterpreter(`<%= for (i,v) in items { %>0123456789<% } %>`, ctx)
+	r.NoError(err)
+	r.Len(out, 100)
+}
+
+func Test_Budget_Max_Output_Partial(t *testing.T) {
+	r := require.New(t)
+	ctx := NewContext()
+	ctx.Set("partialFeeder", func(string) (string, error) {
+		return `<%= big %>`, nil
+	})
+	ctx.Set("big", strings.Repeat("x", 200))
+	ctx.WithBudget(NewBudget(1_000).WithMaxOutput(100))
+
+	_, err := RenderInterpreter(`<%= partial("big.html") %>`, ctx)
+	r.True(errors.Is(err, ErrOutputLimitExceeded), "expected ErrOutputLimitExceeded, got %v", err)
+	r.Contains(err.Error(), "big.html")
+}
+
+func Test_Budget_Max_Output_Block(t *testing.T) {
+	r := require.New(t)
+	var blockErr error
+	ctx := NewContext()
+	ctx.Set("big", strings.Repeat("x", 200))
+	ctx.Set("wrap", func(help HelperContext) (string, error) {
+		s, err := help.Block()
+		blockErr = err
+		return s, err
+	})
+	ctx.WithBudget(NewBudget(1_000).WithMaxOutput(100))
+
+	_, err := RenderInterpreter(`<%= wrap() { %><%= big %><% } %>`, ctx)
+	r.True(errors.Is(err, ErrOutputLimitExceeded), "expected ErrOutputLimitExceeded, got %v", err)
+	r.True(errors.Is(blockErr, ErrOutputLimitExceeded), "the block returns the error, got %v", blockErr)
+}
+
+func Test_Budget_Check_Output(t *testing.T) {
+	r := require.New(t)
+	var b *Budget
+	r.NoError(b.CheckOutput(1 << 30))
+	r.Zero(b.MaxOutput())
+
+	b = NewBudget(10)
+	r.NoError(b.CheckOutput(1<<30), "no limit by default")
+	b.WithMaxOutput(10)
+	r.Equal(int64(10), b.MaxOutput())
+	r.NoError(b.CheckOutput(10))
+	r.True(errors.Is(b.CheckOutput(11), ErrOutputLimitExceeded))
+}
+
+func Test_Budget_Max_Output_Fill_Holes(t *testing.T) {
+	r := require.New(t)
+	marker := PunchHoleMarkerName(0)
+	holes := []HoleMarker{{marker_name: marker, start: 1, end: 1 + len(marker), content: "0123456789", maxOutput: 11}}
+
+	out, err := fillHoles("a"+marker+"b", holes)
+	r.True(errors.Is(err, ErrOutputLimitExceeded), "expected ErrOutputLimitExceeded, got %v", err)
+	r.Empty(out)
+
+	holes[0].maxOutput = 12
+	out, err = fillHoles("a"+marker+"b", holes)
+	r.NoError(err)
+	r.Equal("a0123456789b", out)
+}
+
+// --- Recursion limits ---
+
+func Test_Budget_Max_Call_Depth(t *testing.T) {
+	r := require.New(t)
+	tmpl := `<% let f = fn(n) { if (n > 0) { return f(n - 1) } return n } %><%= f(depth) %>`
+
+	ctx := NewContext()
+	ctx.Set("depth", 4)
+	ctx.WithBudget(NewBudget(1_000).WithMaxCallDepth(5))
+	out, err := RenderInterpreter(tmpl, ctx)
+	r.NoError(err)
+	r.Equal("0", out)
+
+	ctx = NewContext()
+	ctx.Set("depth", 5)
+	ctx.WithBudget(NewBudget(1_000).WithMaxCallDepth(5))
+	_, err = RenderInterpreter(tmpl, ctx)
+	r.True(errors.Is(err, ErrCallDepthExceeded), "expected ErrCallDepthExceeded, got %v", err)
+	r.Contains(err.Error(), "line 1")
+}
+
+func Test_Budget_Max_Call_Depth_Default(t *testing.T) {
+	r := require.New(t)
+	_, err := Render(`<% let f = fn(n) { return f(n + 1) } %><%= f(0) %>`, NewContext())
+	r.True(errors.Is(err, ErrCallDepthExceeded), "expected ErrCallDepthExceeded, got %v", err)
+	r.Contains(err.Error(), "(1000)")
+}
+
+func Test_Budget_Max_Partial_Depth(t *testing.T) {
+	r := require.New(t)
+	ctx := NewContext()
+	ctx.Set("number", 3)
+	ctx.Set("partialFeeder", func(string) (string, error) {
+		return `<%= if (number > 0) { %><% let number = number - 1 %><%= partial("index.plush") %><% } %>`, nil
+	})
+	ctx.WithBudget(NewBudget(1_000).WithMaxPartialDepth(4))
+	_, err := RenderInterpreter(`<%= partial("index.plush") %>`, ctx)
+	r.NoError(err)
+
+	ctx.Set("number", 4)
+	_, err = RenderInterpreter(`<%= partial("index.plush") %>`, ctx)
+	r.True(errors.Is(err, ErrPartialDepthExceeded), "expected ErrPartialDepthExceeded, got %v", err)
+}
+
+func Test_Budget_Partial_Cycle(t *testing.T) {
+	r := require.New(t)
+	ctx := NewContext()
+	ctx.Set("n", 0)
+	ctx.Set("partialFeeder", func(name string) (string, error) {
+		switch name {
+		case "a.plush":
+			return `<%= partial("b.plush") %>`, nil
+		case "b.plush":
+			return "\n" + `<%= partial("a.plush") %>`, nil
+		case "up.plush":
+			return `<%= partial("up.plush", {n: n + 1}) %>`, nil
+		}
+		return `<%= partial("self.plush") %>`, nil
+	})
+
+	ctx.WithBudget(NewBudget(1_000).WithMaxPartialDepth(4))
+
+	_, err := Render(`<%= partial("self.plush") %>`, ctx)
+	r.True(errors.Is(err, ErrPartialCycle), "expected ErrPartialCycle, got %v", err)
+	r.True(errors.Is(err, ErrPartialDepthExceeded), "expected ErrPartialDepthExceeded, got %v", err)
+	r.Contains(err.Error(), "partial cycle: self.plush -> self.plush: partial depth limit exceeded (4)")
+
+	_, err = Render(`<%= partial("a.plush") %>`, ctx)
+	r.True(errors.Is(err, ErrPartialCycle), "expected ErrPartialCycle, got %v", err)
+	r.Contains(err.Error(), "a.plush:1:b.plush:2:a.plush:1:b.plush:2:a.plush: partial cycle: a.plush -> b.plush -> a.plush")
+	var trace *TemplateTraceError
+	r.True(errors.As(err, &trace))
+
+	_, err = Render(`<%= partial("up.plush") %>`, ctx)
+	r.True(errors.Is(err, ErrPartialCycle), "expected ErrPartialCycle, got %v", err)
+	r.True(errors.Is(err, ErrPartialDepthExceeded), "expected ErrPartialDepthExceeded, got %v", err)
+	r.Contains(err.Error(), "partial cycle: up.plush -> up.plush: partial depth limit exceeded (4)")
+}
+
+type partialNode struct {
+	Name  string
+	Child *partialNode
+}
+
+func Test_Budget_Partial_Recursive_Data(t *testing.T) {
+	r := require.New(t)
+	list := &partialNode{Name: "a", Child: &partialNode{Name: "b", Child: &partialNode{Name: "c", Child: &partialNode{Name: "d"}}}}
+	ctx := NewContextWith(map[string]interface{}{"list": list})
+	ctx.Set("partialFeeder", func(string) (string, error) {
+		return `<%= node.Name %>;<%= if (node.Child) { %><%= partial("node.html", {"node": node.Child}) %><% } %>`, nil
+	})
+
+	out, err := Render(`<%= partial("node.html", {"node": list}) %>`, ctx)
+	r.NoError(err)
+	r.Equal("a;b;c;d;", out)
+}
+
+func Test_Budget_Depth_Defaults(t *testing.T) {
+	r := require.New(t)
+	var b *Budget
+	r.Equal(DefaultMaxCallDepth, b.MaxCallDepth())
+	r.Equal(DefaultMaxPartialDepth, b.MaxPartialDepth())
+
+	b = NewBudget(10).WithMaxCallDepth(7).WithMaxPartialDepth(3)
+	r.Equal(7, b.MaxCallDepth())
+	r.Equal(3, b.MaxPartialDepth())
+}
@@ -0,0 +1,54 @@
+package plush
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/gobuffalo/plush/v5/ast"
+	"github.com/gobuffalo/plush/v5/helpers/hctx"
+)
+
+// ErrNotFunction is returned by CallFunction when the name isn't bound to
+// a function defined by a template.
+var ErrNotFunction = errors.New("not a template function")
+
+// CallFunction calls the function a template or script bound to name with
+// let, such as `let fmtPrice = fn(p) { ... }`, after it rendered with ctx.
+// The arguments are Go values, and so is the value it returns. Each call
+// runs in its own child of ctx, so calls can run concurrently; budgets
+// attached to ctx are charged like for a render.
+//
+//	tpl.Exec(ctx)
+//	price, err := plush.CallFunction(ctx, "fmtPrice", 12.5)
+func CallFunction(ctx hctx.Context, name string, args ...interface{}) (interface{}, error) {
+	if ctx == nil {
+		return nil, fmt.Errorf("%s: %w", name, ErrNotFunction)
+	}
+	f, ok := ctx.Value(name).(*userFunction)
+	if !ok {
+		return nil, fmt.Errorf("%s: %w", name, ErrNotFunction)
+	}
+	if len(args) != len(f.Parameters) {
+		return nil, fmt.Errorf("%s: wrong number of arguments: want=%d, got=%d", name, len(f.Parameters), len(args))
+	}
+
+	c := &compiler{
+		ctx:     ctx.New(),
+		program: &ast.Program{},
+		depth:   1,
+	}
+	if err := CheckContext(ctx); err != nil {
+		return nil, err
+	}
+	if err := c.budget().SpendFunctionCall(name); err != nil {
+		return nil, err
+	}
+	for i, p := range f.Parameters {
+		c.ctx.Set(p.Value, args[i])
+	}
+	v, err := c.evalBlockStatement(f.Block)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", name, err)
+	}
+	return evalResult(v), nil
+}
@@ -0,0 +1,92 @@
+package plush_test
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/gobuffalo/plush/v5"
+	"github.com/stretchr/testify/require"
+)
+
+const callFunctionTemplate = `<%
+let currency = "$"
+let fmtPrice = fn(p) {
+	return currency + p
+}
+let row = fn(name) { %><b><%= name %></b><% }
+%><%= fmtPrice(3) %>`
+
+func Test_CallFunction(t *testing.T) {
+	r := require.New(t)
+	ctx := plush.NewContext()
+	s, err := plush.Render(callFunctionTemplate, ctx)
+	r.NoError(err)
+	r.Equal("$3", s)
+
+	v, err := plush.CallFunction(ctx, "fmtPrice", 12)
+	r.NoError(err)
+	r.Equal("$12", v)
+
+	v, err = plush.CallFunction(ctx, "row", "mark")
+	r.NoError(err)
+	s, err = plush.Render(`<%= v %>`, plush.NewContextWith(map[string]interface{}{"v": v}))
+	r.NoError(err)
+	r.Equal("<b>mark</b>", s)
+}
+
+func Test_CallFunction_Errors(t *testing.T) {
+	r := require.New(t)
+	ctx := plush.NewContext()
+	_, err := plush.Render(callFunctionTemplate, ctx)
+	r.NoError(err)
+
+	_, err = plush.CallFunction(ctx, "currency")
+	r.True(errors.Is(err, plush.ErrNotFunction), "got %v", err)
+	_, err = plush.CallFunction(ctx, "missing")
+	r.True(errors.Is(err, plush.ErrNotFunction), "got %v", err)
+	_, err = plush.CallFunction(nil, "fmtPrice", 1)
+	r.True(errors.Is(err, plush.ErrNotFunction), "got %v", err)
+
+	_, err = plush.CallFunction(ctx, "fmtPrice")
+	r.ErrorContains(err, "wrong number of arguments: want=1, got=0")
+	_, err = plush.CallFunction(ctx, "fmtPrice", nil)
+	r.ErrorContains(err, "fmtPrice: ")
+}
+
+func Test_CallFunction_Concurrent(t *testing.T) {
+	r := require.New(t)
+	ctx := plush.NewContext()
+	_, err := plush.Render(callFunctionTemplate, ctx)
+	r.NoError(err)
+
+	var wg sync.WaitGroup
+	errs := make([]error, 20)
+	for i := range errs {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			v, err := plush.CallFunction(ctx, "fmtPrice", i)
+			if err == nil && v != "$"+fmt.Sprint(i) {
+				err = fmt.Errorf("got %v", v)
+			}
+			errs[i] = err
+		}(i)
+	}
+	wg.Wait()
+	for _, err := range errs {
+		r.NoError(err)
+	}
+}
+
+func Test_CallFunction_Budget(t *testing.T) {
+	r := require.New(t)
+	ctx := plush.NewContext()
+	_, err := plush.Render(`<% let spin = fn(n) { for (i) in range(1, n) { upcase("x") } } %>`, ctx)
+	r.NoError(err)
+
+	ctx.WithBudget(plush.NewBudget(10))
+	_, err = plush.CallFunction(ctx, "spin", 100)
+	r.True(errors.Is(err, plush.ErrBudgetExceeded), "got %v", err)
+}
@@ -0,0 +1,33 @@
+package plush
+
+import (
+	"context"
+	"fmt"
+)
+
+// CheckContext returns an error wrapping ctx.Err() once ctx is cancelled
+// or past its deadline, and nil otherwise. Renders call it at every loop
+// iteration, helper call, partial and punch hole, so that a cancelled
+// request stops rendering.
+func CheckContext(ctx context.Context) error {
+	if ctx == nil {
+		return nil
+	}
+	// Skip the promoted method call for the common plush context.
+	if c, ok := ctx.(*Context); ok {
+		if c == nil || c.Context == nil {
+			return nil
+		}
+		ctx = c.Context
+	}
+	done := ctx.Done()
+	if done == nil {
+		return nil
+	}
+	select {
+	case <-done:
+		return fmt.Errorf("render cancelled: %w", ctx.Err())
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,132 @@
+package plush_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/gobuffalo/plush/v5"
+	"github.com/gobuffalo/plush/v5/helpers/hctx"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_CheckContext(t *testing.T) {
+	r := require.New(t)
+	r.NoError(plush.CheckContext(nil))
+	r.NoError(plush.CheckContext(plush.NewContext()))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	pctx := plush.NewContextWithContext(ctx)
+	child := pctx.New()
+	r.NoError(plush.CheckContext(child))
+	cancel()
+	err := plush.CheckContext(child)
+	r.True(errors.Is(err, context.Canceled), "got %v", err)
+	err = plush.CheckContext(plush.NewHelperContext(child, nil))
+	r.True(errors.Is(err, context.Canceled), "got %v", err)
+}
+
+func Test_NewContextWithOuter_Cancellation(t *testing.T) {
+	r := require.New(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	pctx := plush.NewContextWithContext(ctx)
+	child := plush.NewContextWithOuter(nil, pctx)
+	r.Equal(ctx, child.Context)
+	r.True(errors.Is(plush.CheckContext(child), context.Canceled))
+	_, err := plush.RenderInterpreter(`<%= for (i) in range(1, 3) { %><%= i %><% } %>`, pctx)
+	r.True(errors.Is(err, context.Canceled), "got %v", err)
+}
+
+func Test_Render_Cancelled_In_Loop(t *testing.T) {
+	r := require.New(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	rows := make([]int, 50000)
+	var rendered int
+	pctx := plush.NewContextWithContext(ctx)
+	pctx.Set("rows", rows)
+	pctx.Set("row", func(i int) int {
+		rendered++
+		if rendered == 10 {
+			cancel()
+		}
+		return i
+	})
+
+	_, err := plush.RenderInterpreter(`<%= for (i, v) in rows { %><%= row(i) %><% } %>`, pctx)
+	r.Error(err)
+	r.True(errors.Is(err, context.Canceled), "got %v", err)
+	r.Equal(10, rendered)
+}
+
+func Test_Render_Deadline_Exceeded(t *testing.T) {
+	r := require.New(t)
+	ctx, cancel := context.WithDeadline(context.Background(), time.Now().Add(-time.Second))
+	defer cancel()
+
+	pctx := plush.NewContextWithContext(ctx)
+	pctx.Set("f", func() string { return "x" })
+	for _, input := range []string{
+		`<%= for (i) in range(1, 3) { %><%= i %><% } %>`,
+		`<%= f() %>`,
+		`<% let g = fn() { return 1 } %><%= g() %>`,
+	} {
+		_, err := plush.RenderInterpreter(input, pctx)
+		r.True(errors.Is(err, context.DeadlineExceeded), "%s: got %v", input, err)
+	}
+
+	_, err := plush.CallFunction(pctx, "g")
+	r.True(errors.Is(err, context.DeadlineExceeded), "got %v", err)
+}
+
+func Test_Render_Cancelled_Partial(t *testing.T) {
+	r := require.New(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	pctx := plush.NewContextWithContext(ctx)
+	pctx.Set("partialFeeder", func(string) (string, error) {
+		return "partial", nil
+	})
+	_, err := plush.RenderInterpreter(`<%= partial("row.html") %>`, pctx)
+	r.True(errors.Is(err, context.Canceled), "got %v", err)
+}
+
+func Test_Render_Cancelled_Holes(t *testing.T) {
+	r := require.New(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	holes := []plush.HoleMarker{plush.NewHoleMarker(plush.PunchHoleMarkerName(0), "hole", 0, len(plush.PunchHoleMarkerName(0)))}
+	var calls int
+	holes = plush.RenderPunchHolesConcurrentlyWith(holes, plush.NewContextWithContext(ctx), func(input string, ctx hctx.Context) (string, error) {
+		calls++
+		return input, nil
+	})
+	r.Equal(0, calls)
+	_, err := plush.FillPunchHoles(plush.PunchHoleMarkerName(0), holes)
+	r.True(errors.Is(err, context.Canceled), "got %v", err)
+}
+
+func Test_Render_Timed_Out_Holes(t *testing.T) {
+	r := require.New(t)
+	b := plush.NewTimeBudget(time.Millisecond)
+	r.NoError(b.SpendLoop())
+	time.Sleep(5 * time.Millisecond)
+	ctx := plush.NewContext()
+	ctx.WithBudget(b)
+
+	holes := []plush.HoleMarker{plush.NewHoleMarker(plush.PunchHoleMarkerName(0), "hole", 0, len(plush.PunchHoleMarkerName(0)))}
+	var calls int
+	holes = plush.RenderPunchHolesConcurrentlyWith(holes, ctx, func(input string, ctx hctx.Context) (string, error) {
+		calls++
+		return input, nil
+	})
+	r.Equal(0, calls)
+	_, err := plush.FillPunchHoles(plush.PunchHoleMarkerName(0), holes)
+	r.True(errors.Is(err, plush.ErrRenderTimeout), "got %v", err)
+}
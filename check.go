@@ -0,0 +1,498 @@
+package plush
+
+import (
+	"fmt"
+	"html/template"
+	"reflect"
+	"strings"
+
+	"github.com/gobuffalo/plush/v5/ast"
+	"github.com/gobuffalo/plush/v5/helpers/hctx"
+	"github.com/gobuffalo/plush/v5/parser"
+)
+
+// Schema describes the values a template is rendered with so Check can
+// verify it without rendering.
+type Schema struct {
+	// Data maps context keys to the type of their value. A nil type
+	// declares the key without checking how it is used.
+	Data map[string]reflect.Type
+	// Helpers maps helper names to their function type. The global
+	// Helpers are always available and need not be listed.
+	Helpers map[string]reflect.Type
+	// Iterators maps types implementing Iterator to the type of the
+	// values returned by their Next method.
+	Iterators map[reflect.Type]reflect.Type
+}
+
+// SchemaOf returns a Schema with the types of the values in data, which
+// is typically the same map a template is rendered with. Zero values are
+// enough, as only their types are used.
+func SchemaOf(data map[string]interface{}) Schema {
+	s := Schema{Data: make(map[string]reflect.Type, len(data))}
+	for k, v := range data {
+		s.Data[k] = reflect.TypeOf(v)
+	}
+	return s
+}
+
+// CheckError is a problem found by Check.
+type CheckError struct {
+	Line    int
+	Column  int
+	Message string
+}
+
+func (e *CheckError) Error() string {
+	return fmt.Sprintf("line %d:%d: %s", e.Line, e.Column, e.Message)
+}
+
+// CheckErrors is the list of problems returned by Check, in source order.
+type CheckErrors []*CheckError
+
+func (e CheckErrors) Error() string {
+	lines := make([]string, len(e))
+	for i, err := range e {
+		lines[i] = err.Error()
+	}
+	return strings.Join(lines, "\n")
+}
+
+// Check type-checks the template input against schema without rendering
+// it. It reports unknown identifiers, fields, methods and helpers, and
+// helper calls with the wrong number or types of arguments. Loop
+// variables take the element types of the slices, maps and Iterators
+// they range over.
+//
+// Values whose type cannot be known statically, such as interface{}
+// values or the results of template functions, are not checked. The
+// returned error is a CheckErrors, or the parse error if input does not
+// parse.
+func Check(input string, schema Schema) error {
+	src := preprocessTrimTags(input)
+	program, err := parser.Parse(src)
+	if err != nil {
+		return err
+	}
+
+	ch := &checker{src: src, schema: schema, helpers: Helpers.Helpers()}
+	ch.statements(program.Statements, newCheckScope(nil))
+	if len(ch.errs) > 0 {
+		return ch.errs
+	}
+	return nil
+}
+
+var (
+	helperContextType   = reflect.TypeOf(HelperContext{})
+	hctxHelperContextIf = reflect.TypeOf((*hctx.HelperContext)(nil)).Elem()
+	emptyMapType        = reflect.TypeOf(map[string]interface{}{})
+	iteratorType        = reflect.TypeOf((*Iterator)(nil)).Elem()
+)
+
+// checkScope holds the variables declared by the template. A nil type
+// marks a variable whose type is unknown.
+type checkScope struct {
+	parent *checkScope
+	vars   map[string]reflect.Type
+	// open scopes are call blocks, where helpers may set variables the
+	// schema does not know about.
+	open bool
+}
+
+func newCheckScope(parent *checkScope) *checkScope {
+	s := &checkScope{parent: parent, vars: map[string]reflect.Type{}}
+	if parent != nil {
+		s.open = parent.open
+	}
+	return s
+}
+
+func (s *checkScope) lookup(name string) (reflect.Type, bool) {
+	for ; s != nil; s = s.parent {
+		if t, ok := s.vars[name]; ok {
+			return t, true
+		}
+	}
+	return nil, false
+}
+
+type checker struct {
+	src     string
+	schema  Schema
+	helpers map[string]interface{}
+	errs    CheckErrors
+
+	// inCondition is set while checking if conditions, where unknown
+	// identifiers are falsy instead of an error.
+	inCondition bool
+}
+
+func (ch *checker) errorf(n ast.Node, format string, args ...interface{}) {
+	line, column := n.T().LineNumber, n.T().Column
+	if ast.SpanOf(n).Stop > ast.SpanOf(n).Start && ast.SpanOf(n).Start <= len(ch.src) {
+		line, column = 1, 1
+		for _, r := range ch.src[:ast.SpanOf(n).Start] {
+			if r == '\n' {
+				line++
+				column = 1
+				continue
+			}
+			column++
+		}
+	}
+	ch.errs = append(ch.errs, &CheckError{Line: line, Column: column, Message: fmt.Sprintf(format, args...)})
+}
+
+func (ch *checker) statements(list []ast.Statement, scope *checkScope) {
+	for _, s := range list {
+		switch s := s.(type) {
+		case *ast.LetStatement:
+			t := ch.expr(s.Value, scope)
+			if s.Name != nil {
+				scope.vars[s.Name.Value] = t
+			}
+		case *ast.ReturnStatement:
+			ch.expr(s.ReturnValue, scope)
+		case *ast.ExpressionStatement:
+			ch.expr(s.Expression, scope)
+		}
+	}
+}
+
+func (ch *checker) block(b *ast.BlockStatement, scope *checkScope) {
+	if b != nil {
+		ch.statements(b.Statements, scope)
+	}
+}
+
+// expr checks e and returns its type, or nil when it is not known.
+func (ch *checker) expr(e ast.Expression, scope *checkScope) reflect.Type {
+	switch e := e.(type) {
+	case nil:
+		return nil
+	case *ast.IntegerLiteral:
+		return reflect.TypeOf(0)
+	case *ast.FloatLiteral:
+		return reflect.TypeOf(0.0)
+	case *ast.StringLiteral:
+		return reflect.TypeOf("")
+	case *ast.Boolean:
+		return reflect.TypeOf(true)
+	case *ast.HTMLLiteral:
+		return reflect.TypeOf(template.HTML(""))
+	case *ast.ArrayLiteral:
+		for _, el := range e.Elements {
+			ch.expr(el, scope)
+		}
+		return reflect.TypeOf([]interface{}{})
+	case *ast.HashLiteral:
+		for _, key := range e.Order {
+			ch.expr(e.Pairs[key], scope)
+		}
+		return emptyMapType
+	case *ast.Identifier:
+		return ch.identifier(e, scope)
+	case *ast.AssignExpression:
+		t := ch.expr(e.Value, scope)
+		if e.Name != nil {
+			if _, ok := scope.lookup(e.Name.Value); !ok {
+				scope.vars[e.Name.Value] = t
+			}
+		}
+		return nil
+	case *ast.PrefixExpression:
+		t := ch.expr(e.Right, scope)
+		if e.Operator == "!" {
+			return reflect.TypeOf(true)
+		}
+		return t
+	case *ast.InfixExpression:
+		l := ch.expr(e.Left, scope)
+		r := ch.expr(e.Right, scope)
+		switch e.Operator {
+		case "==", "!=", "<", ">", "<=", ">=", "&&", "||", "~=":
+			return reflect.TypeOf(true)
+		}
+		if l != nil && l == r {
+			return l
+		}
+		return nil
+	case *ast.IndexExpression:
+		t := ch.expr(e.Left, scope)
+		ch.expr(e.Index, scope)
+		ch.expr(e.Value, scope)
+		if t = derefType(t); t != nil {
+			switch t.Kind() {
+			case reflect.Slice, reflect.Array, reflect.Map:
+				return t.Elem()
+			}
+		}
+		return nil
+	case *ast.IfExpression:
+		ch.condition(e.Condition, scope)
+		ch.block(e.Block, newCheckScope(scope))
+		for _, ei := range e.ElseIf {
+			ch.condition(ei.Condition, scope)
+			ch.block(ei.Block, newCheckScope(scope))
+		}
+		ch.block(e.ElseBlock, newCheckScope(scope))
+		return nil
+	case *ast.ForExpression:
+		ch.forExpression(e, scope)
+		return nil
+	case *ast.FunctionLiteral:
+		inner := newCheckScope(scope)
+		for _, p := range e.Parameters {
+			inner.vars[p.Value] = nil
+		}
+		ch.block(e.Block, inner)
+		return nil
+	case *ast.CallExpression:
+		return ch.call(e, scope)
+	}
+	return nil
+}
+
+func (ch *checker) condition(e ast.Expression, scope *checkScope) {
+	prev := ch.inCondition
+	ch.inCondition = true
+	ch.expr(e, scope)
+	ch.inCondition = prev
+}
+
+func (ch *checker) identifier(id *ast.Identifier, scope *checkScope) reflect.Type {
+	if id.Callee == nil {
+		t, ok := ch.lookup(id.Value, scope)
+		if !ok && !ch.inCondition && !scope.open {
+			ch.errorf(id, "unknown identifier %q", id.Value)
+		}
+		return t
+	}
+
+	recv := ch.expr(id.Callee, scope)
+	t := derefType(recv)
+	if t == nil || t.Kind() == reflect.Interface || t.Kind() == reflect.Map {
+		return nil
+	}
+	if t.Kind() == reflect.Struct {
+		if f, ok := t.FieldByName(id.Value); ok {
+			if f.PkgPath != "" {
+				ch.errorf(id, "%s.%s is unexported", t, id.Value)
+				return nil
+			}
+			return derefType(f.Type)
+		}
+		if m, ok := reflect.PointerTo(t).MethodByName(id.Value); ok {
+			return methodFuncType(m.Type)
+		}
+	}
+	ch.errorf(id, "%s has no field or method %s", recv, id.Value)
+	return nil
+}
+
+// lookup resolves a plain identifier the way the context does.
+func (ch *checker) lookup(name string, scope *checkScope) (reflect.Type, bool) {
+	if t, ok := scope.lookup(name); ok {
+		return t, true
+	}
+	if t, ok := ch.schema.Data[name]; ok {
+		return t, true
+	}
+	if t, ok := ch.schema.Helpers[name]; ok {
+		return t, true
+	}
+	if h, ok := ch.helpers[name]; ok {
+		return reflect.TypeOf(h), true
+	}
+	return nil, name == "nil"
+}
+
+func (ch *checker) forExpression(e *ast.ForExpression, scope *checkScope) {
+	t := derefType(ch.expr(e.Iterable, scope))
+
+	var key, value reflect.Type
+	switch {
+	case t == nil:
+	case t.Kind() == reflect.Slice || t.Kind() == reflect.Array:
+		key, value = reflect.TypeOf(0), t.Elem()
+	case t.Kind() == reflect.Map:
+		key, value = t.Key(), t.Elem()
+	default:
+		if elem, ok := ch.iteratorElem(t); ok {
+			key, value = reflect.TypeOf(0), elem
+		}
+	}
+
+	inner := newCheckScope(scope)
+	inner.vars[e.KeyName] = key
+	inner.vars[e.ValueName] = value
+	ch.block(e.Block, inner)
+}
+
+// iteratorElem returns the element type declared in the schema for an
+// Iterator type.
+func (ch *checker) iteratorElem(t reflect.Type) (reflect.Type, bool) {
+	for _, candidate := range []reflect.Type{t, reflect.PointerTo(t)} {
+		if elem, ok := ch.schema.Iterators[candidate]; ok {
+			return elem, true
+		}
+	}
+	if t.Implements(iteratorType) || reflect.PointerTo(t).Implements(iteratorType) {
+		return nil, true
+	}
+	return nil, false
+}
+
+func (ch *checker) call(call *ast.CallExpression, scope *checkScope) reflect.Type {
+	args := make([]reflect.Type, len(call.Arguments))
+	for i, a := range call.Arguments {
+		args[i] = ch.expr(a, scope)
+	}
+
+	fn, name := ch.callee(call, scope)
+
+	if call.Block != nil {
+		inner := newCheckScope(scope)
+		inner.open = true
+		ch.block(call.Block, inner)
+	}
+	ch.block(call.ElseBlock, newCheckScope(scope))
+
+	var ret reflect.Type
+	if fn != nil && fn.Kind() == reflect.Func {
+		ch.checkArgs(call, name, fn, args)
+		if fn.NumOut() > 0 {
+			ret = derefType(fn.Out(0))
+		}
+	}
+
+	if call.ChainCallee != nil {
+		inner := newCheckScope(scope)
+		inner.vars[call.Function.String()] = ret
+		return ch.expr(call.ChainCallee, inner)
+	}
+	return ret
+}
+
+// callee returns the function type called by call, without the receiver
+// for methods, and its name for messages.
+func (ch *checker) callee(call *ast.CallExpression, scope *checkScope) (reflect.Type, string) {
+	id, ok := call.Function.(*ast.Identifier)
+	if !ok {
+		return ch.expr(call.Function, scope), call.Function.String()
+	}
+
+	if call.Callee == nil && id.Callee == nil {
+		t, ok := ch.lookup(id.Value, scope)
+		if !ok && !scope.open {
+			ch.errorf(call, "unknown helper %q", id.Value)
+		}
+		return t, id.Value
+	}
+
+	recvExpr := call.Callee
+	if recvExpr == nil {
+		recvExpr = id.Callee
+	}
+	recv := ch.expr(recvExpr, scope)
+	name := fmt.Sprintf("%s.%s", recv, id.Value)
+	t := recv
+	if t == nil || derefType(t).Kind() == reflect.Interface && derefType(t).NumMethod() == 0 {
+		return nil, name
+	}
+	if t.Kind() != reflect.Ptr && t.Kind() != reflect.Interface {
+		t = reflect.PointerTo(t)
+	}
+
+	m, ok := t.MethodByName(id.Value)
+	if !ok {
+		ch.errorf(call, "%s has no method %s", recv, id.Value)
+		return nil, name
+	}
+	if t.Kind() == reflect.Interface {
+		return m.Type, name
+	}
+	return methodFuncType(m.Type), name
+}
+
+// checkArgs checks the arguments of call against fn following the
+// rules the VM uses to build its call plans: trailing HelperContext and
+// map[string]interface{} parameters may be omitted.
+func (ch *checker) checkArgs(call *ast.CallExpression, name string, fn reflect.Type, args []reflect.Type) {
+	numIn := fn.NumIn()
+	given := len(call.Arguments)
+
+	if fn.IsVariadic() {
+		if given < numIn-1 {
+			ch.errorf(call, "%s: too few arguments (%d for %d)", name, given, numIn-1)
+			return
+		}
+	} else {
+		if given > numIn {
+			ch.errorf(call, "%s: too many arguments (%d for %d)", name, given, numIn)
+			return
+		}
+		if missing := numIn - given; missing > 0 && (missing > 2 || !hasOptionalHelperArg(fn, given)) {
+			ch.errorf(call, "%s: too few arguments (%d for %d)", name, given, numIn)
+			return
+		}
+	}
+
+	for pos, at := range args {
+		expected := fn.In(min(pos, numIn-1))
+		if fn.IsVariadic() && pos >= numIn-1 {
+			expected = expected.Elem()
+		}
+		if at != nil && !argAssignable(at, expected) {
+			ch.errorf(call.Arguments[pos], "%s: cannot use %s as %s in argument %d", name, at, expected, pos+1)
+		}
+	}
+}
+
+// hasOptionalHelperArg reports whether any parameter of fn from start on
+// is filled in automatically when omitted.
+func hasOptionalHelperArg(fn reflect.Type, start int) bool {
+	for pos := start; pos < fn.NumIn(); pos++ {
+		t := fn.In(pos)
+		if helperContextType.AssignableTo(t) || helperContextType.ConvertibleTo(t) || t.Implements(hctxHelperContextIf) ||
+			emptyMapType.AssignableTo(t) || emptyMapType.ConvertibleTo(t) {
+			return true
+		}
+	}
+	return false
+}
+
+// argAssignable mirrors the conversions applied to helper arguments.
+func argAssignable(arg, expected reflect.Type) bool {
+	if arg.AssignableTo(expected) || arg.ConvertibleTo(expected) {
+		return true
+	}
+	if arg.Kind() == reflect.Interface {
+		return true
+	}
+	if expected.Kind() == reflect.Ptr {
+		return arg.AssignableTo(expected.Elem()) || arg.ConvertibleTo(expected.Elem())
+	}
+	return false
+}
+
+// methodFuncType drops the receiver from the type of a method value.
+func methodFuncType(t reflect.Type) reflect.Type {
+	in := make([]reflect.Type, 0, t.NumIn()-1)
+	for i := 1; i < t.NumIn(); i++ {
+		in = append(in, t.In(i))
+	}
+	out := make([]reflect.Type, 0, t.NumOut())
+	for i := 0; i < t.NumOut(); i++ {
+		out = append(out, t.Out(i))
+	}
+	return reflect.FuncOf(in, out, t.IsVariadic())
+}
+
+func derefType(t reflect.Type) reflect.Type {
+	for t != nil && t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	return t
+}
@@ -0,0 +1,163 @@
+package plush_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/gobuffalo/plush/v5"
+	"github.com/stretchr/testify/require"
+)
+
+type checkAddress struct {
+	City string
+}
+
+type checkUser struct {
+	Name    string
+	Address *checkAddress
+	Tags    []string
+}
+
+func (u checkUser) Greeting(prefix string) string { return prefix + u.Name }
+
+func (u *checkUser) Initials() string { return u.Name[:1] }
+
+type checkUserIterator struct{ users []checkUser }
+
+func (i *checkUserIterator) Next() interface{} {
+	if len(i.users) == 0 {
+		return nil
+	}
+	u := i.users[0]
+	i.users = i.users[1:]
+	return u
+}
+
+func checkMessages(t *testing.T, input string, schema plush.Schema) []string {
+	t.Helper()
+	err := plush.Check(input, schema)
+	if err == nil {
+		return nil
+	}
+	errs, ok := err.(plush.CheckErrors)
+	require.True(t, ok, "unexpected error %v", err)
+
+	out := []string{}
+	for _, e := range errs {
+		out = append(out, e.Error())
+	}
+	return out
+}
+
+func Test_Check_Valid_Template(t *testing.T) {
+	r := require.New(t)
+	schema := plush.SchemaOf(map[string]interface{}{
+		"user":  checkUser{},
+		"users": []*checkUser{},
+		"byID":  map[int]checkUser{},
+	})
+
+	input := `<h1><%= user.Name %> <%= user.Address.City %></h1>
+<%= user.Greeting("hi ") %><%= user.Initials() %>
+<% let title = upcase(user.Name) %><%= title %>
+<%= for (i, u) in users { %><%= i %> <%= u.Name %><% } %>
+<%= for (id, u) in byID { %><%= id %> <%= u.Address.City %><% } %>
+<%= for (tag) in user.Tags { %><%= tag %><% } %>
+<%= if (missing) { %>ok<% } %>
+<%= partial("p.html", {name: user.Name}) %>`
+	r.Empty(checkMessages(t, input, schema))
+}
+
+func Test_Check_Unknown_Fields_And_Methods(t *testing.T) {
+	r := require.New(t)
+	schema := plush.SchemaOf(map[string]interface{}{"user": &checkUser{}})
+
+	input := `<%= user.Nmae %>
+<%= user.Address.Zip %>
+<%= user.Shout() %>`
+	r.Equal([]string{
+		"line 1:5: *plush_test.checkUser has no field or method Nmae",
+		"line 2:5: plush_test.checkAddress has no field or method Zip",
+		"line 3:5: *plush_test.checkUser has no method Shout",
+	}, checkMessages(t, input, schema))
+}
+
+func Test_Check_Unknown_Identifiers_And_Helpers(t *testing.T) {
+	r := require.New(t)
+
+	input := `<%= nope %><%= shout("x") %><%= form({}) { %><%= f.Anything %><% } %>`
+	r.Equal([]string{
+		`line 1:5: unknown identifier "nope"`,
+		`line 1:16: unknown helper "shout"`,
+	}, checkMessages(t, input, plush.Schema{
+		Helpers: map[string]reflect.Type{
+			"form": reflect.TypeOf(func(opts map[string]interface{}, help plush.HelperContext) string { return "" }),
+		},
+	}))
+}
+
+func Test_Check_Loop_Variable_Types(t *testing.T) {
+	r := require.New(t)
+	schema := plush.SchemaOf(map[string]interface{}{
+		"users": []checkUser{},
+		"byID":  map[string]*checkUser{},
+		"iter":  &checkUserIterator{},
+	})
+	schema.Iterators = map[reflect.Type]reflect.Type{
+		reflect.TypeOf(&checkUserIterator{}): reflect.TypeOf(checkUser{}),
+	}
+
+	input := `<%= for (u) in users { %><%= u.Email %><% } %>
+<%= for (k, u) in byID { %><%= k.Len %><%= u.Email %><% } %>
+<%= for (u) in iter { %><%= u.Email %><% } %>`
+	r.Equal([]string{
+		"line 1:30: plush_test.checkUser has no field or method Email",
+		"line 2:32: string has no field or method Len",
+		"line 2:44: *plush_test.checkUser has no field or method Email",
+		"line 3:29: plush_test.checkUser has no field or method Email",
+	}, checkMessages(t, input, schema))
+}
+
+func Test_Check_Helper_Arguments(t *testing.T) {
+	r := require.New(t)
+	schema := plush.Schema{
+		Data: map[string]reflect.Type{"user": reflect.TypeOf(checkUser{})},
+		Helpers: map[string]reflect.Type{
+			"price": reflect.TypeOf(func(cents int, currency string) string { return "" }),
+			"join":  reflect.TypeOf(func(sep string, parts ...string) string { return "" }),
+			"box":   reflect.TypeOf(func(title string, help plush.HelperContext) string { return "" }),
+		},
+	}
+
+	input := `<%= price(1) %>
+<%= price(1, "usd", 3) %>
+<%= price("1", "usd") %>
+<%= join() %><%= join(",", "a", 2.5) %>
+<%= box("t") %><%= user.Greeting(1, 2) %>`
+	r.Equal([]string{
+		"line 1:5: price: too few arguments (1 for 2)",
+		"line 2:5: price: too many arguments (3 for 2)",
+		"line 3:11: price: cannot use string as int in argument 1",
+		"line 4:5: join: too few arguments (0 for 1)",
+		"line 4:33: join: cannot use float64 as string in argument 3",
+		"line 5:20: plush_test.checkUser.Greeting: too many arguments (2 for 1)",
+	}, checkMessages(t, input, schema))
+}
+
+func Test_Check_Scopes(t *testing.T) {
+	r := require.New(t)
+
+	input := `<% let f = fn(a) { return a.Whatever } %><%= f(1) %>
+<%= if (true) { %><% let inner = 1 %><% } %><%= inner %>`
+	r.Equal([]string{
+		`line 2:49: unknown identifier "inner"`,
+	}, checkMessages(t, input, plush.Schema{}))
+}
+
+func Test_Check_Parse_Error(t *testing.T) {
+	r := require.New(t)
+	err := plush.Check(`<%= if { %>`, plush.Schema{})
+	r.Error(err)
+	_, ok := err.(plush.CheckErrors)
+	r.False(ok)
+}
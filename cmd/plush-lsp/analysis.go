@@ -0,0 +1,339 @@
+package main
+
+import (
+	"fmt"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/gobuffalo/plush/v5"
+	"github.com/gobuffalo/plush/v5/ast"
+	"github.com/gobuffalo/plush/v5/lint"
+	"github.com/gobuffalo/plush/v5/parser"
+)
+
+// diagnostics reports the syntax errors of d, or the lint findings when
+// it parses.
+func (d *document) diagnostics() []diagnostic {
+	diags := []diagnostic{}
+	if d.err != nil {
+		errs := parser.Errors(d.err)
+		if len(errs) == 0 {
+			errs = parser.ErrorList{{Line: 1, Column: 1, Msg: d.err.Error()}}
+		}
+		for _, e := range errs {
+			start := d.columnOffset(e.Line, e.Column)
+			diags = append(diags, diagnostic{
+				Range:    d.rangeOf(start, d.wordEnd(start)),
+				Severity: severityError,
+				Source:   "plush",
+				Message:  e.Msg,
+			})
+		}
+		return diags
+	}
+
+	findings, err := lint.Lint(d.uri, d.text, lint.Config{})
+	if err != nil {
+		return diags
+	}
+	for _, f := range findings {
+		start := d.columnOffset(f.Line, f.Column)
+		diags = append(diags, diagnostic{
+			Range:    d.rangeOf(start, d.wordEnd(start)),
+			Severity: severityWarning,
+			Code:     f.Rule,
+			Source:   "plush-lint",
+			Message:  f.Message,
+		})
+	}
+	return diags
+}
+
+// helperNames returns the names of the default helpers, sorted.
+func helperNames() []string {
+	names := make([]string, 0, len(plush.Helpers.Helpers()))
+	for name := range plush.Helpers.Helpers() {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// helperSignature formats the Go signature of the named helper, such as
+// `func upcase(string) string`. Parameter names are not known at run time
+// and are left out.
+func helperSignature(name string) (string, bool) {
+	h, ok := plush.Helpers.Helpers()[name]
+	if !ok || h == nil {
+		return "", false
+	}
+	t := reflect.TypeOf(h)
+	if t.Kind() != reflect.Func {
+		return fmt.Sprintf("var %s %s", name, t), true
+	}
+	return "func " + name + strings.TrimPrefix(t.String(), "func"), true
+}
+
+// completion lists the helpers and the variables in scope at offset. It
+// returns nothing outside of template tags.
+func (d *document) completion(offset int) []completionItem {
+	items := []completionItem{}
+	if !d.inCode(offset) {
+		return items
+	}
+
+	locals := d.scope(offset)
+	names := make([]string, 0, len(locals))
+	for name := range locals {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		items = append(items, completionItem{Label: name, Kind: completionVariable, Detail: locals[name]})
+	}
+
+	for _, name := range helperNames() {
+		if _, shadowed := locals[name]; shadowed {
+			continue
+		}
+		sig, _ := helperSignature(name)
+		items = append(items, completionItem{Label: name, Kind: completionFunction, Detail: sig})
+	}
+	return items
+}
+
+// scope returns the variables visible at offset with a short description
+// of where each was declared: `let` statements that end before offset in
+// the enclosing blocks, and the variables of enclosing for loops and
+// functions.
+func (d *document) scope(offset int) map[string]string {
+	vars := map[string]string{}
+	if d.program == nil {
+		return vars
+	}
+
+	var statements func([]ast.Statement)
+	statements = func(stmts []ast.Statement) {
+		for _, s := range stmts {
+			if s == nil || ast.SpanOf(s).Start > offset {
+				break
+			}
+			if let, ok := s.(*ast.LetStatement); ok && let.Name != nil && let.End() <= offset {
+				vars[let.Name.Value] = "let " + let.Name.Value
+			}
+			if !contains(s, offset) {
+				continue
+			}
+			ast.Inspect(s, func(n ast.Node) bool {
+				switch n := n.(type) {
+				case *ast.BlockStatement:
+					if n != nil && contains(n, offset) {
+						statements(n.Statements)
+					}
+					return false
+				case *ast.ForExpression:
+					if n.Block != nil && contains(n.Block, offset) {
+						for _, name := range []string{n.KeyName, n.ValueName} {
+							if name != "" && name != "_" {
+								vars[name] = "for " + name
+							}
+						}
+					}
+				case *ast.FunctionLiteral:
+					if n.Block != nil && contains(n.Block, offset) {
+						for _, p := range n.Parameters {
+							vars[p.Value] = "parameter " + p.Value
+						}
+					}
+				}
+				return true
+			})
+		}
+	}
+	statements(d.program.Statements)
+	return vars
+}
+
+// identifierAt returns the innermost plain identifier, one without a
+// receiver, whose span holds offset.
+func (d *document) identifierAt(offset int) *ast.Identifier {
+	var found *ast.Identifier
+	if d.program == nil {
+		return nil
+	}
+	ast.Inspect(d.program, func(n ast.Node) bool {
+		if id, ok := n.(*ast.Identifier); ok && id != nil && id.Callee == nil && contains(id, offset) {
+			found = id
+		}
+		return true
+	})
+	return found
+}
+
+// hover describes the helper under offset with its Go signature.
+func (d *document) hover(offset int) *hover {
+	id := d.identifierAt(offset)
+	if id == nil {
+		return nil
+	}
+	if _, local := d.scope(offset)[id.Value]; local {
+		return nil
+	}
+	sig, ok := helperSignature(id.Value)
+	if !ok {
+		return nil
+	}
+	r := d.rangeOf(id.Pos(), id.End())
+	return &hover{
+		Contents: markupContent{Kind: "markdown", Value: "```go\n" + sig + "\n```"},
+		Range:    &r,
+	}
+}
+
+// partialAt returns the name passed to the partial() call whose name
+// literal holds offset.
+func (d *document) partialAt(offset int) (string, bool) {
+	name, found := "", false
+	if d.program == nil {
+		return "", false
+	}
+	ast.Inspect(d.program, func(n ast.Node) bool {
+		call, ok := n.(*ast.CallExpression)
+		if !ok || call == nil || len(call.Arguments) == 0 {
+			return true
+		}
+		if fn, ok := call.Function.(*ast.Identifier); !ok || fn.Value != "partial" || fn.Callee != nil {
+			return true
+		}
+		if s, ok := call.Arguments[0].(*ast.StringLiteral); ok && contains(s, offset) {
+			name, found = s.Value, true
+		}
+		return true
+	})
+	return name, found
+}
+
+// partialExtensions are tried, in order, after a partial name that does
+// not name a file as-is.
+var partialExtensions = []string{".plush.html", ".plush", ".html"}
+
+// resolvePartial returns the files a partial name may refer to. Names are
+// looked up relative to the directory of the document and to root, both
+// as written and with the leading underscore partial files usually have.
+// A name such as `form.html` also matches `form.plush.html`.
+func resolvePartial(root, docPath, name string) []string {
+	name = filepath.FromSlash(name)
+	var names []string
+	for _, n := range []string{name, filepath.Join(filepath.Dir(name), "_"+filepath.Base(name))} {
+		if strings.HasPrefix(filepath.Base(name), "_") && n != name {
+			continue
+		}
+		names = append(names, n)
+		if ext := filepath.Ext(n); ext != "" && ext != ".plush" && !strings.HasSuffix(n, ".plush"+ext) {
+			names = append(names, strings.TrimSuffix(n, ext)+".plush"+ext)
+		}
+	}
+
+	var dirs []string
+	if docPath != "" {
+		dirs = append(dirs, filepath.Dir(docPath))
+	}
+	if root != "" {
+		dirs = append(dirs, root)
+	}
+
+	var files []string
+	seen := map[string]bool{}
+	for _, dir := range dirs {
+		for _, n := range names {
+			for _, ext := range append([]string{""}, partialExtensions...) {
+				p := filepath.Join(dir, n+ext)
+				if seen[p] {
+					continue
+				}
+				seen[p] = true
+				if info, err := os.Stat(p); err == nil && !info.IsDir() {
+					files = append(files, p)
+				}
+			}
+		}
+	}
+	return files
+}
+
+// symbols returns the `let` statements of d as a tree: those declared
+// inside a function are children of the `let` holding the function.
+func (d *document) symbols() []documentSymbol {
+	if d.program == nil {
+		return []documentSymbol{}
+	}
+	return d.symbolsIn(d.program)
+}
+
+func (d *document) symbolsIn(n ast.Node) []documentSymbol {
+	syms := []documentSymbol{}
+	ast.Inspect(n, func(c ast.Node) bool {
+		if c == n {
+			return true
+		}
+		let, ok := c.(*ast.LetStatement)
+		if !ok || let == nil || let.Name == nil {
+			return true
+		}
+
+		sym := documentSymbol{
+			Name:           let.Name.Value,
+			Detail:         "let",
+			Kind:           symbolVariable,
+			Range:          d.rangeOf(let.Pos(), let.End()),
+			SelectionRange: d.rangeOf(let.Name.Pos(), let.Name.End()),
+		}
+		if fn, ok := let.Value.(*ast.FunctionLiteral); ok && fn != nil {
+			params := make([]string, len(fn.Parameters))
+			for i, p := range fn.Parameters {
+				params[i] = p.Value
+			}
+			sym.Detail = "fn(" + strings.Join(params, ", ") + ")"
+			sym.Kind = symbolFunction
+			if fn.Block != nil {
+				sym.Children = d.symbolsIn(fn.Block)
+			}
+			syms = append(syms, sym)
+			return false
+		}
+		syms = append(syms, sym)
+		return true
+	})
+	return syms
+}
+
+// uriToPath returns the file path of a file:// URI, or "" for other URIs.
+func uriToPath(uri string) string {
+	const prefix = "file://"
+	if !strings.HasPrefix(uri, prefix) {
+		return ""
+	}
+	p, err := url.PathUnescape(strings.TrimPrefix(uri, prefix))
+	if err != nil {
+		return ""
+	}
+	// file:///C:/dir on Windows.
+	if len(p) > 2 && p[0] == '/' && p[2] == ':' {
+		p = p[1:]
+	}
+	return filepath.FromSlash(p)
+}
+
+func pathToURI(p string) string {
+	p = filepath.ToSlash(p)
+	if !strings.HasPrefix(p, "/") {
+		p = "/" + p
+	}
+	u := url.URL{Scheme: "file", Path: path.Clean(p)}
+	return u.String()
+}
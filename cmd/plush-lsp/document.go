@@ -0,0 +1,128 @@
+package main
+
+import (
+	"sort"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/gobuffalo/plush/v5/ast"
+	"github.com/gobuffalo/plush/v5/parser"
+)
+
+// document is an open text document and the result of parsing it.
+type document struct {
+	uri  string
+	text string
+	// lines holds the byte offset of the start of each line.
+	lines []int
+
+	program *ast.Program
+	err     error
+}
+
+func newDocument(uri, text string) *document {
+	d := &document{uri: uri, text: text, lines: []int{0}}
+	for i := 0; i < len(text); i++ {
+		if text[i] == '\n' {
+			d.lines = append(d.lines, i+1)
+		}
+	}
+	d.program, d.err = parser.Parse(text)
+	return d
+}
+
+// position converts a byte offset into an LSP position, whose character
+// counts UTF-16 code units.
+func (d *document) position(offset int) position {
+	offset = max(0, min(offset, len(d.text)))
+	line := sort.Search(len(d.lines), func(i int) bool { return d.lines[i] > offset }) - 1
+	return position{Line: line, Character: utf16Len(d.text[d.lines[line]:offset])}
+}
+
+func (d *document) rangeOf(start, end int) lspRange {
+	return lspRange{Start: d.position(start), End: d.position(end)}
+}
+
+// offset converts an LSP position into a byte offset.
+func (d *document) offset(p position) int {
+	if p.Line < 0 {
+		return 0
+	}
+	if p.Line >= len(d.lines) {
+		return len(d.text)
+	}
+	i := d.lines[p.Line]
+	for n := 0; n < p.Character && i < len(d.text) && d.text[i] != '\n'; {
+		r, size := utf8.DecodeRuneInString(d.text[i:])
+		n += utf16RuneLen(r)
+		i += size
+	}
+	return i
+}
+
+// columnOffset converts a 1-based line and rune column, as reported by the
+// lexer, into a byte offset.
+func (d *document) columnOffset(line, column int) int {
+	if line < 1 {
+		return 0
+	}
+	if line > len(d.lines) {
+		return len(d.text)
+	}
+	i := d.lines[line-1]
+	for n := 1; n < column && i < len(d.text) && d.text[i] != '\n'; n++ {
+		_, size := utf8.DecodeRuneInString(d.text[i:])
+		i += size
+	}
+	return i
+}
+
+// wordEnd returns the offset just past the identifier-like word starting
+// at offset, or past the rune at offset when there is no word.
+func (d *document) wordEnd(offset int) int {
+	i := offset
+	for i < len(d.text) {
+		r, size := utf8.DecodeRuneInString(d.text[i:])
+		if !isWordRune(r) {
+			break
+		}
+		i += size
+	}
+	if i == offset && i < len(d.text) && d.text[i] != '\n' {
+		_, size := utf8.DecodeRuneInString(d.text[i:])
+		i += size
+	}
+	return i
+}
+
+// inCode reports whether offset is inside a `<% %>` tag.
+func (d *document) inCode(offset int) bool {
+	before := d.text[:offset]
+	return strings.LastIndex(before, "<%") > strings.LastIndex(before, "%>")
+}
+
+func isWordRune(r rune) bool {
+	return r == '_' || r == '$' || r >= '0' && r <= '9' || r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= utf8.RuneSelf
+}
+
+func utf16Len(s string) int {
+	n := 0
+	for _, r := range s {
+		n += utf16RuneLen(r)
+	}
+	return n
+}
+
+func utf16RuneLen(r rune) int {
+	if r >= 0x10000 {
+		return 2
+	}
+	return 1
+}
+
+// contains reports whether offset is within the span of n, including the
+// offset just past its end so that a cursor after a word still hits it.
+func contains(n ast.Node, offset int) bool {
+	span := ast.SpanOf(n)
+	return span.Stop > span.Start && span.Start <= offset && offset <= span.Stop
+}
@@ -0,0 +1,39 @@
+// Command plush-lsp is a language server for Plush templates. It speaks
+// the Language Server Protocol over standard input and output and never
+// touches the network.
+//
+// It provides:
+//
+//   - diagnostics for syntax errors, with their line and column, and for
+//     the findings of the linter;
+//   - completion of the default helpers and of the variables in scope;
+//   - go to definition on the name of a partial;
+//   - hover with the Go signature of helpers;
+//   - document symbols for `let` statements and functions.
+//
+// Usage:
+//
+//	plush-lsp [-root dir]
+//
+// Partial names are resolved relative to the template being edited and
+// to the templates root: the -root flag, the `templatesRoot`
+// initialization option, or the `templates` directory of the workspace.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+)
+
+func main() {
+	fs := flag.NewFlagSet("plush-lsp", flag.ExitOnError)
+	root := fs.String("root", "", "directory partial names are resolved against")
+	fs.Usage = func() {
+		fmt.Fprintln(os.Stderr, "Usage: plush-lsp [-root dir]")
+		fs.PrintDefaults()
+	}
+	fs.Parse(os.Args[1:])
+
+	os.Exit(newServer(os.Stdin, os.Stdout, *root).serve())
+}
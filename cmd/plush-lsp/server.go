@@ -0,0 +1,266 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+// server answers the requests of a single client.
+type server struct {
+	conn *conn
+	// root is the directory partial names are resolved against. It is
+	// set from the -root flag, the templatesRoot initialization option or
+	// the workspace root, in that order.
+	root     string
+	rootFlag string
+
+	mu   sync.Mutex
+	docs map[string]*document
+
+	shutdown bool
+}
+
+func newServer(r io.Reader, w io.Writer, root string) *server {
+	return &server{
+		conn:     newConn(r, w),
+		rootFlag: root,
+		root:     root,
+		docs:     map[string]*document{},
+	}
+}
+
+// serve handles messages until the client exits or closes the input. It
+// returns the exit code the process should use.
+func (s *server) serve() int {
+	for {
+		msg, err := s.conn.read()
+		if err == io.EOF {
+			return 1
+		}
+		if rerr, ok := err.(*responseError); ok {
+			s.conn.write(&message{ID: &nullID, Error: rerr})
+			continue
+		}
+		if err != nil {
+			return 1
+		}
+
+		if msg.Method == "exit" {
+			if s.shutdown {
+				return 0
+			}
+			return 1
+		}
+		s.handle(msg)
+	}
+}
+
+var nullID = json.RawMessage("null")
+
+// handle dispatches msg. Requests, which have an ID, get a response;
+// unknown notifications are ignored.
+func (s *server) handle(msg *message) {
+	result, err := s.dispatch(msg.Method, msg.Params)
+	if msg.ID == nil {
+		return
+	}
+
+	resp := &message{ID: msg.ID}
+	if err != nil {
+		rerr, ok := err.(*responseError)
+		if !ok {
+			rerr = &responseError{Code: codeInternalError, Message: err.Error()}
+		}
+		resp.Error = rerr
+	} else {
+		b, err := json.Marshal(result)
+		if err != nil {
+			resp.Error = &responseError{Code: codeInternalError, Message: err.Error()}
+		} else {
+			raw := json.RawMessage(b)
+			resp.Result = &raw
+		}
+	}
+	s.conn.write(resp)
+}
+
+func (s *server) dispatch(method string, params json.RawMessage) (interface{}, error) {
+	switch method {
+	case "initialize":
+		var p initializeParams
+		if err := unmarshalParams(params, &p); err != nil {
+			return nil, err
+		}
+		return s.initialize(p), nil
+	case "initialized":
+		return nil, nil
+	case "shutdown":
+		s.shutdown = true
+		return nil, nil
+
+	case "textDocument/didOpen":
+		var p didOpenParams
+		if err := unmarshalParams(params, &p); err != nil {
+			return nil, err
+		}
+		s.update(p.TextDocument.URI, p.TextDocument.Text)
+		return nil, nil
+	case "textDocument/didChange":
+		var p didChangeParams
+		if err := unmarshalParams(params, &p); err != nil {
+			return nil, err
+		}
+		if n := len(p.ContentChanges); n > 0 {
+			s.update(p.TextDocument.URI, p.ContentChanges[n-1].Text)
+		}
+		return nil, nil
+	case "textDocument/didClose":
+		var p didCloseParams
+		if err := unmarshalParams(params, &p); err != nil {
+			return nil, err
+		}
+		s.mu.Lock()
+		delete(s.docs, p.TextDocument.URI)
+		s.mu.Unlock()
+		s.publish(p.TextDocument.URI, []diagnostic{})
+		return nil, nil
+
+	case "textDocument/completion":
+		return s.atPosition(params, func(d *document, offset int) interface{} {
+			return d.completion(offset)
+		})
+	case "textDocument/hover":
+		return s.atPosition(params, func(d *document, offset int) interface{} {
+			if h := d.hover(offset); h != nil {
+				return h
+			}
+			return nil
+		})
+	case "textDocument/definition":
+		return s.atPosition(params, func(d *document, offset int) interface{} {
+			return s.definition(d, offset)
+		})
+	case "textDocument/documentSymbol":
+		var p documentSymbolParams
+		if err := unmarshalParams(params, &p); err != nil {
+			return nil, err
+		}
+		d := s.document(p.TextDocument.URI)
+		if d == nil {
+			return []documentSymbol{}, nil
+		}
+		return d.symbols(), nil
+	}
+	return nil, &responseError{Code: codeMethodNotFound, Message: fmt.Sprintf("method %q not found", method)}
+}
+
+func unmarshalParams(params json.RawMessage, v interface{}) error {
+	if len(params) == 0 {
+		return nil
+	}
+	if err := json.Unmarshal(params, v); err != nil {
+		return &responseError{Code: codeInvalidParams, Message: err.Error()}
+	}
+	return nil
+}
+
+func (s *server) initialize(p initializeParams) interface{} {
+	switch {
+	case s.rootFlag != "":
+	case p.InitializationOptions.TemplatesRoot != "":
+		s.root = p.InitializationOptions.TemplatesRoot
+		if !filepath.IsAbs(s.root) {
+			if ws := workspaceRoot(p); ws != "" {
+				s.root = filepath.Join(ws, s.root)
+			}
+		}
+	default:
+		s.root = workspaceRoot(p)
+		// Buffalo applications keep their templates in ./templates.
+		if dir := filepath.Join(s.root, "templates"); s.root != "" && isDir(dir) {
+			s.root = dir
+		}
+	}
+
+	return map[string]interface{}{
+		"capabilities": map[string]interface{}{
+			"textDocumentSync":       syncFull,
+			"completionProvider":     map[string]interface{}{"triggerCharacters": []string{"%", " ", "("}},
+			"hoverProvider":          true,
+			"definitionProvider":     true,
+			"documentSymbolProvider": true,
+		},
+		"serverInfo": map[string]string{"name": "plush-lsp"},
+	}
+}
+
+func workspaceRoot(p initializeParams) string {
+	if p.RootURI != "" {
+		return uriToPath(p.RootURI)
+	}
+	return p.RootPath
+}
+
+func isDir(p string) bool {
+	info, err := os.Stat(p)
+	return err == nil && info.IsDir()
+}
+
+// update parses the new text of a document and publishes its diagnostics.
+func (s *server) update(uri, text string) {
+	d := newDocument(uri, text)
+	s.mu.Lock()
+	s.docs[uri] = d
+	s.mu.Unlock()
+	s.publish(uri, d.diagnostics())
+}
+
+func (s *server) publish(uri string, diags []diagnostic) {
+	b, err := json.Marshal(publishDiagnosticsParams{URI: uri, Diagnostics: diags})
+	if err != nil {
+		return
+	}
+	s.conn.write(&message{Method: "textDocument/publishDiagnostics", Params: b})
+}
+
+func (s *server) document(uri string) *document {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.docs[uri]
+}
+
+// atPosition decodes text document position params and calls fn with the
+// document and the byte offset they refer to. Requests on documents that
+// are not open get a null result.
+func (s *server) atPosition(params json.RawMessage, fn func(d *document, offset int) interface{}) (interface{}, error) {
+	var p textDocumentPositionParams
+	if err := unmarshalParams(params, &p); err != nil {
+		return nil, err
+	}
+	d := s.document(p.TextDocument.URI)
+	if d == nil {
+		return nil, nil
+	}
+	return fn(d, d.offset(p.Position)), nil
+}
+
+// definition returns the files of the partial named under offset.
+func (s *server) definition(d *document, offset int) interface{} {
+	name, ok := d.partialAt(offset)
+	if !ok {
+		return nil
+	}
+	files := resolvePartial(s.root, uriToPath(d.uri), name)
+	if len(files) == 0 {
+		return nil
+	}
+	locs := make([]location, len(files))
+	for i, f := range files {
+		locs[i] = location{URI: pathToURI(f)}
+	}
+	return locs
+}
@@ -0,0 +1,393 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// session scripts the messages a client sends and collects what the
+// server answers.
+type session struct {
+	t      *testing.T
+	in     bytes.Buffer
+	nextID int
+}
+
+func newSession(t *testing.T) *session {
+	s := &session{t: t}
+	s.request("initialize", map[string]interface{}{})
+	s.notify("initialized", map[string]interface{}{})
+	return s
+}
+
+func (s *session) send(msg map[string]interface{}) {
+	msg["jsonrpc"] = "2.0"
+	b, err := json.Marshal(msg)
+	require.NoError(s.t, err)
+	s.in.WriteString("Content-Length: " + strconv.Itoa(len(b)) + "\r\n\r\n")
+	s.in.Write(b)
+}
+
+func (s *session) request(method string, params interface{}) int {
+	s.nextID++
+	s.send(map[string]interface{}{"id": s.nextID, "method": method, "params": params})
+	return s.nextID
+}
+
+func (s *session) notify(method string, params interface{}) {
+	s.send(map[string]interface{}{"method": method, "params": params})
+}
+
+func (s *session) open(uri, text string) {
+	s.notify("textDocument/didOpen", map[string]interface{}{
+		"textDocument": map[string]interface{}{"uri": uri, "languageId": "plush", "version": 1, "text": text},
+	})
+}
+
+func (s *session) at(method, uri string, line, character int) int {
+	return s.request(method, map[string]interface{}{
+		"textDocument": map[string]interface{}{"uri": uri},
+		"position":     map[string]interface{}{"line": line, "character": character},
+	})
+}
+
+// results holds the responses by request ID and the notifications, in
+// the order they were sent.
+type results struct {
+	code          int
+	responses     map[int]*message
+	notifications []*message
+}
+
+func (s *session) run(root string) *results {
+	s.request("shutdown", nil)
+	s.notify("exit", nil)
+
+	var out bytes.Buffer
+	res := &results{responses: map[int]*message{}}
+	res.code = newServer(&s.in, &out, root).serve()
+
+	c := newConn(&out, io.Discard)
+	for {
+		msg, err := c.read()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(s.t, err)
+		if msg.ID == nil {
+			res.notifications = append(res.notifications, msg)
+			continue
+		}
+		var id int
+		require.NoError(s.t, json.Unmarshal(*msg.ID, &id))
+		res.responses[id] = msg
+	}
+	return res
+}
+
+func (r *results) result(t *testing.T, id int, v interface{}) {
+	t.Helper()
+	msg, ok := r.responses[id]
+	require.True(t, ok, "no response to request %d", id)
+	require.Nil(t, msg.Error)
+	require.NotNil(t, msg.Result)
+	require.NoError(t, json.Unmarshal(*msg.Result, v))
+}
+
+// null requires the response to request id to be a null result, which
+// decodes to a nil Result.
+func (r *results) null(t *testing.T, id int) {
+	t.Helper()
+	msg, ok := r.responses[id]
+	require.True(t, ok, "no response to request %d", id)
+	require.Nil(t, msg.Error)
+	require.Nil(t, msg.Result)
+}
+
+func (r *results) diagnostics(t *testing.T, uri string) []diagnostic {
+	t.Helper()
+	var last *publishDiagnosticsParams
+	for _, n := range r.notifications {
+		if n.Method != "textDocument/publishDiagnostics" {
+			continue
+		}
+		p := &publishDiagnosticsParams{}
+		require.NoError(t, json.Unmarshal(n.Params, p))
+		if p.URI == uri {
+			last = p
+		}
+	}
+	require.NotNil(t, last, "no diagnostics published for %s", uri)
+	return last.Diagnostics
+}
+
+func Test_Initialize(t *testing.T) {
+	r := require.New(t)
+	s := newSession(t)
+	res := s.run("")
+	r.Equal(0, res.code)
+
+	var init struct {
+		Capabilities map[string]interface{} `json:"capabilities"`
+	}
+	res.result(t, 1, &init)
+	r.Equal(float64(syncFull), init.Capabilities["textDocumentSync"])
+	r.Equal(true, init.Capabilities["hoverProvider"])
+	r.Equal(true, init.Capabilities["definitionProvider"])
+	r.Equal(true, init.Capabilities["documentSymbolProvider"])
+}
+
+func Test_Exit_Without_Shutdown(t *testing.T) {
+	r := require.New(t)
+	s := &session{t: t}
+	s.notify("exit", nil)
+	r.Equal(1, newServer(&s.in, io.Discard, "").serve())
+}
+
+func Test_Content_Length_Limit(t *testing.T) {
+	r := require.New(t)
+	c := newConn(bytes.NewBufferString("Content-Length: 1099511627776\r\n\r\n{}"), io.Discard)
+	_, err := c.read()
+	r.ErrorContains(err, "exceeds the limit")
+
+	var out bytes.Buffer
+	code := newServer(bytes.NewBufferString("Content-Length: 1099511627776\r\n\r\n{}"), &out, "").serve()
+	r.Equal(1, code)
+	r.Empty(out.String())
+}
+
+func Test_Unknown_Method(t *testing.T) {
+	r := require.New(t)
+	s := newSession(t)
+	id := s.request("workspace/symbol", map[string]interface{}{"query": "x"})
+	res := s.run("")
+
+	msg := res.responses[id]
+	r.NotNil(msg.Error)
+	r.Equal(codeMethodNotFound, msg.Error.Code)
+}
+
+func Test_Diagnostics_Syntax_Error(t *testing.T) {
+	r := require.New(t)
+	s := newSession(t)
+	s.open("file:///t/index.plush.html", "<p>héllo</p>\n<%= if (x) { %>\n  <p>a</p>\n<% } else %>")
+	res := s.run("")
+
+	diags := res.diagnostics(t, "file:///t/index.plush.html")
+	r.NotEmpty(diags)
+	d := diags[0]
+	r.Equal(severityError, d.Severity)
+	r.Equal("plush", d.Source)
+	r.Contains(d.Message, "line 4:")
+	r.Equal(3, d.Range.Start.Line)
+	r.Greater(d.Range.Start.Character, 0)
+}
+
+func Test_Diagnostics_Cleared(t *testing.T) {
+	r := require.New(t)
+	uri := "file:///t/a.plush"
+	s := newSession(t)
+	s.open(uri, "<%= if { %>")
+	s.notify("textDocument/didChange", map[string]interface{}{
+		"textDocument":   map[string]interface{}{"uri": uri, "version": 2},
+		"contentChanges": []map[string]interface{}{{"text": "<%= x %>"}},
+	})
+	res := s.run("")
+	r.Empty(res.diagnostics(t, uri))
+}
+
+func Test_Diagnostics_Lint(t *testing.T) {
+	r := require.New(t)
+	uri := "file:///t/a.plush"
+	s := newSession(t)
+	s.open(uri, "<% let unused = 1 %>\n<%= raw(x) %>")
+	res := s.run("")
+
+	diags := res.diagnostics(t, uri)
+	r.Len(diags, 2)
+	r.Equal("unused-let", diags[0].Code)
+	r.Equal(severityWarning, diags[0].Severity)
+	r.Equal(position{Line: 0, Character: 3}, diags[0].Range.Start)
+	r.Equal("raw-non-literal", diags[1].Code)
+	r.Equal(1, diags[1].Range.Start.Line)
+}
+
+func Test_Completion(t *testing.T) {
+	r := require.New(t)
+	uri := "file:///t/a.plush"
+	src := "<% let title = \"x\" %>\n" +
+		"<%= for (i, user) in users { %>\n" +
+		"  <%=  %>\n" +
+		"<% } %>\n" +
+		"<% let later = 1 %>"
+	s := newSession(t)
+	s.open(uri, src)
+	inLoop := s.at("textDocument/completion", uri, 2, 6)
+	outside := s.at("textDocument/completion", uri, 1, 0)
+	res := s.run("")
+
+	var items []completionItem
+	res.result(t, inLoop, &items)
+	labels := map[string]completionItem{}
+	for _, it := range items {
+		labels[it.Label] = it
+	}
+	r.Equal(completionVariable, labels["title"].Kind)
+	r.Equal(completionVariable, labels["user"].Kind)
+	r.Equal(completionVariable, labels["i"].Kind)
+	r.NotContains(labels, "later")
+	r.Equal(completionFunction, labels["partial"].Kind)
+	r.Equal(completionFunction, labels["contentFor"].Kind)
+	r.Contains(labels, "truncate")
+
+	res.result(t, outside, &items)
+	r.Empty(items)
+}
+
+func Test_Completion_Function_Scope(t *testing.T) {
+	r := require.New(t)
+	uri := "file:///t/a.plush"
+	src := "<% let greet = fn(name) { let msg = name\n return msg } %><%= x %>"
+	s := newSession(t)
+	s.open(uri, src)
+	inside := s.at("textDocument/completion", uri, 1, 8)
+	after := s.at("textDocument/completion", uri, 1, 21)
+	res := s.run("")
+
+	names := func(id int) map[string]bool {
+		var items []completionItem
+		res.result(t, id, &items)
+		m := map[string]bool{}
+		for _, it := range items {
+			if it.Kind == completionVariable {
+				m[it.Label] = true
+			}
+		}
+		return m
+	}
+	r.Equal(map[string]bool{"name": true, "msg": true}, names(inside))
+	r.Equal(map[string]bool{"greet": true}, names(after))
+}
+
+func Test_Hover(t *testing.T) {
+	r := require.New(t)
+	uri := "file:///t/a.plush"
+	s := newSession(t)
+	s.open(uri, "<p><%= upcase(name) %></p>")
+	helper := s.at("textDocument/hover", uri, 0, 8)
+	variable := s.at("textDocument/hover", uri, 0, 15)
+	res := s.run("")
+
+	var h hover
+	res.result(t, helper, &h)
+	r.Equal("markdown", h.Contents.Kind)
+	r.Equal("```go\nfunc upcase(string) string\n```", h.Contents.Value)
+	r.Equal(&lspRange{Start: position{0, 7}, End: position{0, 13}}, h.Range)
+
+	res.null(t, variable)
+}
+
+func Test_Definition(t *testing.T) {
+	r := require.New(t)
+	root := t.TempDir()
+	write := func(name string) string {
+		p := filepath.Join(root, filepath.FromSlash(name))
+		r.NoError(os.MkdirAll(filepath.Dir(p), 0o755))
+		r.NoError(os.WriteFile(p, []byte("<p></p>"), 0o644))
+		return p
+	}
+	form := write("users/_form.plush.html")
+	write("users/edit.plush.html")
+	shared := write("shared/_flash.plush.html")
+
+	uri := pathToURI(filepath.Join(root, "users", "edit.plush.html"))
+	s := newSession(t)
+	s.open(uri, "<%= partial(\"users/form.html\") %>\n<%= partial(\"shared/flash\") %>\n<%= partial(\"form\") %>")
+	byRoot := s.at("textDocument/definition", uri, 0, 15)
+	noExt := s.at("textDocument/definition", uri, 1, 15)
+	relative := s.at("textDocument/definition", uri, 2, 14)
+	none := s.at("textDocument/definition", uri, 0, 2)
+	res := s.run(root)
+
+	var locs []location
+	res.result(t, byRoot, &locs)
+	r.Equal([]location{{URI: pathToURI(form)}}, locs)
+
+	res.result(t, noExt, &locs)
+	r.Equal([]location{{URI: pathToURI(shared)}}, locs)
+
+	res.result(t, relative, &locs)
+	r.Equal([]location{{URI: pathToURI(form)}}, locs)
+
+	res.null(t, none)
+}
+
+func Test_Definition_Root_From_Initialization_Options(t *testing.T) {
+	r := require.New(t)
+	ws := t.TempDir()
+	p := filepath.Join(ws, "views", "_nav.html")
+	r.NoError(os.MkdirAll(filepath.Dir(p), 0o755))
+	r.NoError(os.WriteFile(p, []byte("<nav></nav>"), 0o644))
+
+	s := &session{t: t}
+	s.request("initialize", map[string]interface{}{
+		"rootUri":               pathToURI(ws),
+		"initializationOptions": map[string]interface{}{"templatesRoot": "views"},
+	})
+	uri := "untitled:Untitled-1"
+	s.open(uri, "<%= partial(\"nav\") %>")
+	id := s.at("textDocument/definition", uri, 0, 14)
+	res := s.run("")
+
+	var locs []location
+	res.result(t, id, &locs)
+	r.Equal([]location{{URI: pathToURI(p)}}, locs)
+}
+
+func Test_Document_Symbols(t *testing.T) {
+	r := require.New(t)
+	uri := "file:///t/a.plush"
+	src := "<% let title = \"x\" %>\n<% let row = fn(u, i) {\n  let label = u.Name\n  return label\n} %>\n<%= if (a) { %><% let inner = 2 %><% } %>"
+	s := newSession(t)
+	s.open(uri, src)
+	id := s.request("textDocument/documentSymbol", map[string]interface{}{
+		"textDocument": map[string]interface{}{"uri": uri},
+	})
+	res := s.run("")
+
+	var syms []documentSymbol
+	res.result(t, id, &syms)
+	r.Len(syms, 3)
+
+	r.Equal("title", syms[0].Name)
+	r.Equal(symbolVariable, syms[0].Kind)
+	r.Equal(lspRange{Start: position{0, 3}, End: position{0, 18}}, syms[0].Range)
+	r.Equal(lspRange{Start: position{0, 7}, End: position{0, 12}}, syms[0].SelectionRange)
+
+	r.Equal("row", syms[1].Name)
+	r.Equal(symbolFunction, syms[1].Kind)
+	r.Equal("fn(u, i)", syms[1].Detail)
+	r.Len(syms[1].Children, 1)
+	r.Equal("label", syms[1].Children[0].Name)
+	r.Equal(2, syms[1].Children[0].Range.Start.Line)
+
+	r.Equal("inner", syms[2].Name)
+}
+
+func Test_Document_Positions(t *testing.T) {
+	r := require.New(t)
+	d := newDocument("x", "a😀b\nçd")
+	r.Equal(position{0, 3}, d.position(len("a😀")))
+	r.Equal(len("a😀"), d.offset(position{0, 3}))
+	r.Equal(position{1, 1}, d.position(len("a😀b\nç")))
+	r.Equal(len("a😀b\nç"), d.offset(position{1, 1}))
+	r.Equal(len("a😀b\nç"), d.columnOffset(2, 2))
+	r.Equal(len("a😀b"), d.offset(position{0, 99}))
+}
@@ -0,0 +1,117 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/gobuffalo/plush/v5"
+	vmplush "github.com/gobuffalo/plush/v5/vm/plush"
+)
+
+func init() {
+	register(&command{
+		name:  "compile",
+		short: "compile templates to a bytecode bundle",
+		run:   runCompile,
+	})
+}
+
+// runCompile writes a bundle holding the VM bytecode of templates, which
+// applications load with vmplush.LoadBundle at startup.
+func runCompile(e *env, args []string) error {
+	fs := newFlagSet(e, "compile", "[-o bundle] path ...")
+	out := fs.String("o", "", "write the bundle to `file` instead of standard output")
+	paths, err := parseInterspersed(fs, args)
+	if err != nil {
+		return err
+	}
+	if len(paths) == 0 {
+		fs.Usage()
+		return errUsage
+	}
+
+	templates, err := bundleTemplates(paths)
+	if err != nil {
+		return err
+	}
+	var buf bytes.Buffer
+	if err := vmplush.WriteBundle(&buf, templates); err != nil {
+		return err
+	}
+	if *out == "" {
+		_, err = e.stdout.Write(buf.Bytes())
+		return err
+	}
+	return os.WriteFile(*out, buf.Bytes(), 0o644)
+}
+
+// bundleTemplates reads the templates in paths. Templates found in a
+// directory are named relative to it, the way Buffalo names templates
+// relative to its templates root. Partials such as `users/_form.plush.html`
+// are also stored under the name partial() resolves, `users/form.html`.
+// Templates the cache never looks up, such as `.plush.md` files, are
+// skipped.
+func bundleTemplates(paths []string) ([]vmplush.BundleTemplate, error) {
+	names := map[string]string{}
+	for _, path := range paths {
+		files, err := templateFiles([]string{path})
+		if err != nil {
+			return nil, err
+		}
+		for _, file := range files {
+			name := file
+			if rel, err := filepath.Rel(path, file); err == nil && rel != "." {
+				name = rel
+			}
+			name = filepath.ToSlash(name)
+			if file != path && !plush.IsVMBytecodeCacheableTemplateFile(name) {
+				continue
+			}
+			b, err := os.ReadFile(file)
+			if err != nil {
+				return nil, err
+			}
+			if other, ok := names[name]; ok && other != string(b) {
+				return nil, fmt.Errorf("two different templates are named %s", name)
+			}
+			names[name] = string(b)
+			if alias, ok := partialName(name); ok && plush.IsVMBytecodeCacheableTemplateFile(alias) {
+				if _, ok := names[alias]; !ok {
+					names[alias] = string(b)
+				}
+			}
+		}
+	}
+
+	sorted := make([]string, 0, len(names))
+	for name := range names {
+		sorted = append(sorted, name)
+	}
+	sort.Strings(sorted)
+	templates := make([]vmplush.BundleTemplate, 0, len(sorted))
+	for _, name := range sorted {
+		templates = append(templates, vmplush.BundleTemplate{Name: name, Source: names[name]})
+	}
+	return templates, nil
+}
+
+// partialName returns the name partials are requested by for a partial
+// file name, undoing what partialCandidates adds.
+func partialName(name string) (string, bool) {
+	dir, base := "", name
+	if i := strings.LastIndex(name, "/"); i >= 0 {
+		dir, base = name[:i+1], name[i+1:]
+	}
+	if !strings.HasPrefix(base, "_") {
+		return "", false
+	}
+	base = strings.TrimPrefix(base, "_")
+	if ext := filepath.Ext(base); ext != ".plush" {
+		base = strings.Replace(base, ".plush"+ext, ext, 1)
+	}
+	return dir + base, true
+}
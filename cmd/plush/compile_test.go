@@ -0,0 +1,70 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/gobuffalo/plush/v5"
+	"github.com/gobuffalo/plush/v5/templatecache/inmemory"
+	vmplush "github.com/gobuffalo/plush/v5/vm/plush"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Compile(t *testing.T) {
+	r := require.New(t)
+	root := t.TempDir()
+	writeTemplate(t, root, "users/index.plush.html", `<main><%= partial("users/form.html") %></main>`)
+	writeTemplate(t, root, "users/_form.plush.html", `<form><%= name %></form>`)
+	writeTemplate(t, root, "notes.plush.md", `# <%= name %>`)
+	bundle := filepath.Join(t.TempDir(), "templates.bundle")
+
+	code, out, errOut := runCmd("", "compile", root, "-o", bundle)
+	r.Equal(0, code, errOut)
+	r.Empty(out)
+
+	plush.PlushCacheSetup(inmemory.NewMemoryCache())
+	defer func() {
+		plush.ClearTemplateCache()
+		plush.PlushCacheSetup(nil)
+	}()
+	f, err := os.Open(bundle)
+	r.NoError(err)
+	defer f.Close()
+	n, err := vmplush.LoadBundle(f)
+	r.NoError(err)
+	r.Equal(3, n)
+
+	for _, name := range []string{"users/index.plush.html", "users/_form.plush.html", "users/form.html"} {
+		_, ok := plush.CachedVMBytecodeForFilename(name)
+		r.True(ok, name)
+	}
+}
+
+func Test_Compile_Errors(t *testing.T) {
+	r := require.New(t)
+
+	code, _, errOut := runCmd("", "compile")
+	r.Equal(2, code)
+	r.Contains(errOut, "Usage: plush compile")
+
+	path := writeTemplate(t, t.TempDir(), "a.plush", "<%= if { %>")
+	code, _, errOut = runCmd("", "compile", path)
+	r.Equal(1, code)
+	r.Contains(errOut, filepath.ToSlash(path)+":")
+}
+
+func Test_Partial_Name(t *testing.T) {
+	r := require.New(t)
+	for name, want := range map[string]string{
+		"users/_form.plush.html": "users/form.html",
+		"_form.html":             "form.html",
+		"_row.plush":             "row.plush",
+	} {
+		got, ok := partialName(name)
+		r.True(ok, name)
+		r.Equal(want, got)
+	}
+	_, ok := partialName("users/index.plush.html")
+	r.False(ok)
+}
@@ -0,0 +1,55 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/gobuffalo/plush/v5/js"
+)
+
+func init() {
+	register(&command{
+		name:  "compile-js",
+		short: "compile a template to a JavaScript render function",
+		run:   runCompileJS,
+	})
+}
+
+// runCompileJS compiles a template, usually a partial, to a JavaScript
+// function rendering it in the browser.
+func runCompileJS(e *env, args []string) error {
+	fs := newFlagSet(e, "compile-js", "[-name render] [-helpers a,b] [-export] [-o file.js] file")
+	name := fs.String("name", "render", "name of the render `function`")
+	helpers := fs.String("helpers", "", "comma-separated `names` of the JavaScript helpers the template calls")
+	export := fs.Bool("export", false, "declare the function with an ES module export")
+	out := fs.String("o", "", "write the JavaScript to `file` instead of standard output")
+	files, err := parseInterspersed(fs, args)
+	if err != nil {
+		return err
+	}
+	if len(files) != 1 {
+		fs.Usage()
+		return errUsage
+	}
+
+	src, err := os.ReadFile(files[0])
+	if err != nil {
+		return err
+	}
+	opts := js.Options{Name: *name, Export: *export}
+	for _, h := range strings.Split(*helpers, ",") {
+		if h = strings.TrimSpace(h); h != "" {
+			opts.Helpers = append(opts.Helpers, h)
+		}
+	}
+	code, err := js.CompileString(string(src), opts)
+	if err != nil {
+		return fmt.Errorf("%s: %w", files[0], err)
+	}
+	if *out == "" {
+		_, err = fmt.Fprint(e.stdout, code)
+		return err
+	}
+	return os.WriteFile(*out, []byte(code), 0o644)
+}
@@ -0,0 +1,35 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Compile_JS(t *testing.T) {
+	r := require.New(t)
+	root := t.TempDir()
+	path := writeTemplate(t, root, "_row.plush.html", `<li><%= shout(user["name"]) %></li>`)
+
+	code, _, errOut := runCmd("", "compile-js", path)
+	r.Equal(1, code)
+	r.Contains(errOut, `line 1: call to "shout": unknown helper`)
+
+	code, stdout, errOut := runCmd("", "compile-js", "-name", "userRow", "-helpers", "shout", "-export", path)
+	r.Equal(0, code, errOut)
+	r.True(strings.HasPrefix(stdout, "export var userRow = (function () {"))
+
+	out := filepath.Join(root, "row.js")
+	code, stdout, errOut = runCmd("", "compile-js", "-helpers", "shout", "-o", out, path)
+	r.Equal(0, code, errOut)
+	r.Empty(stdout)
+	b, err := os.ReadFile(out)
+	r.NoError(err)
+	r.Contains(string(b), "return function render(data, helpers) {")
+
+	code, _, _ = runCmd("", "compile-js")
+	r.Equal(2, code)
+}
@@ -0,0 +1,112 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/gobuffalo/plush/v5/convert"
+)
+
+func init() {
+	register(&command{
+		name:  "convert",
+		short: "convert a Go html/template or ERB template to Plush",
+		run:   runConvert,
+	})
+}
+
+// runConvert converts a template and prints what it couldn't translate.
+// The result goes to standard output, or with -o to a directory along
+// with the partials made of the template's {{define}}s.
+func runConvert(e *env, args []string) error {
+	fs := newFlagSet(e, "convert", "[-from go|erb] [-delims '{{ }}'] [-o dir] file")
+	from := fs.String("from", "", "template language, `go` or `erb`; defaults to erb for .erb files and go otherwise")
+	delims := fs.String("delims", "", "Go template action `delimiters`, separated by a space")
+	outDir := fs.String("o", "", "write the template and its partials to `dir`")
+	files, err := parseInterspersed(fs, args)
+	if err != nil {
+		return err
+	}
+	if len(files) != 1 {
+		fs.Usage()
+		return errUsage
+	}
+
+	file := filepath.Clean(files[0])
+	src, err := os.ReadFile(file)
+	if err != nil {
+		return err
+	}
+	name := filepath.ToSlash(file)
+
+	lang := *from
+	if lang == "" {
+		lang = "go"
+		if filepath.Ext(file) == ".erb" {
+			lang = "erb"
+		}
+	}
+	var res *convert.Result
+	switch lang {
+	case "go":
+		opts := convert.GoTemplateOptions{}
+		if *delims != "" {
+			left, right, ok := strings.Cut(*delims, " ")
+			if !ok {
+				return fmt.Errorf("-delims: want two delimiters separated by a space, got %q", *delims)
+			}
+			opts.LeftDelim, opts.RightDelim = left, right
+		}
+		res, err = convert.GoTemplate(name, string(src), opts)
+	case "erb":
+		res, err = convert.ERB(name, string(src))
+	default:
+		return fmt.Errorf("-from: unknown template language %q", lang)
+	}
+	if err != nil {
+		return err
+	}
+
+	if *outDir == "" {
+		if len(res.Partials) > 0 {
+			return fmt.Errorf("%s defines %d partials; use -o to write them", name, len(res.Partials))
+		}
+		if _, err := fmt.Fprint(e.stdout, res.Source); err != nil {
+			return err
+		}
+	} else {
+		out := filepath.Join(*outDir, convertedName(file))
+		if err := writeConverted(out, res.Source); err != nil {
+			return err
+		}
+		for _, p := range res.PartialNames() {
+			if err := writeConverted(filepath.Join(*outDir, filepath.FromSlash(convert.PartialFile(p))), res.Partials[p]); err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, issue := range res.Issues {
+		fmt.Fprintln(e.stderr, issue)
+	}
+	if len(res.Issues) > 0 {
+		return errSilent
+	}
+	return nil
+}
+
+// convertedName names the Plush version of file: index.html.erb,
+// index.gohtml and index.tmpl all become index.plush.html.
+func convertedName(file string) string {
+	base := strings.TrimSuffix(filepath.Base(file), ".erb")
+	return strings.TrimSuffix(base, filepath.Ext(base)) + ".plush.html"
+}
+
+func writeConverted(path, src string) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+	return os.WriteFile(path, []byte(src), 0o644)
+}
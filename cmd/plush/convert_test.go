@@ -0,0 +1,53 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Convert(t *testing.T) {
+	r := require.New(t)
+	root := t.TempDir()
+	path := writeTemplate(t, root, "index.gohtml", `{{define "users/row"}}<li>{{.Name}}</li>{{end}}<ul>{{range .Users}}{{template "users/row" .}}{{end}}</ul>`)
+
+	code, _, errOut := runCmd("", "convert", path)
+	r.Equal(1, code)
+	r.Contains(errOut, "defines 1 partials; use -o to write them")
+
+	out := filepath.Join(root, "out")
+	code, stdout, errOut := runCmd("", "convert", "-o", out, path)
+	r.Equal(0, code, errOut)
+	r.Empty(stdout)
+	b, err := os.ReadFile(filepath.Join(out, "index.plush.html"))
+	r.NoError(err)
+	r.Equal(`<ul><%= for (user) in Users { %><%= partial("users/row.html", {Name: user.Name}) %><% } %></ul>`, string(b))
+	b, err = os.ReadFile(filepath.Join(out, "users", "_row.plush.html"))
+	r.NoError(err)
+	r.Equal(`<li><%= Name %></li>`, string(b))
+}
+
+func Test_Convert_Issues(t *testing.T) {
+	r := require.New(t)
+	root := t.TempDir()
+	path := writeTemplate(t, root, "show.html.erb", "<h1><%= @title %></h1>\n<%= @admin ? 1 : 2 %>")
+
+	code, stdout, errOut := runCmd("", "convert", path)
+	r.Equal(1, code)
+	r.Equal("<h1><%= title %></h1>\n<%# unconverted: <%= @admin ? 1 : 2 % > %>", stdout)
+	r.Equal(filepath.ToSlash(path)+":2: operator ? has no Plush equivalent: <%= @admin ? 1 : 2 %>\n", errOut)
+
+	path = writeTemplate(t, root, "page.tmpl", `[[.Title]]`)
+	code, stdout, errOut = runCmd("", "convert", "-from", "go", "-delims", "[[ ]]", path)
+	r.Equal(0, code, errOut)
+	r.Equal("<%= Title %>", stdout)
+
+	code, _, errOut = runCmd("", "convert", "-from", "haml", path)
+	r.Equal(1, code)
+	r.Contains(errOut, `unknown template language "haml"`)
+
+	code, _, _ = runCmd("", "convert")
+	r.Equal(2, code)
+}
@@ -0,0 +1,147 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/gobuffalo/plush/v5"
+)
+
+func init() {
+	register(&command{
+		name:  "cover",
+		short: "merge coverage profiles and report them as text, HTML or LCOV",
+		run:   runCover,
+	})
+}
+
+// runCover merges the coverage profiles written by `plush render
+// -coverprofile` or by plush.Coverage, and prints a summary of each
+// template or writes the merged profile and its reports.
+func runCover(e *env, args []string) error {
+	fs := newFlagSet(e, "cover", "[-html out.html] [-lcov out.info] [-o merged.json] [-root dir] profile.json...")
+	htmlOut := fs.String("html", "", "write an annotated HTML report to `file`")
+	lcovOut := fs.String("lcov", "", "write an LCOV tracefile to `file`")
+	mergedOut := fs.String("o", "", "write the merged profile to `file`")
+	root := fs.String("root", ".", "`dir`ectory the HTML report reads templates and partials from")
+	files, err := parseInterspersed(fs, args)
+	if err != nil {
+		return err
+	}
+	if len(files) == 0 {
+		fs.Usage()
+		return errUsage
+	}
+
+	merged := plush.MergeCoverageProfiles()
+	for _, file := range files {
+		p, err := readCoverProfile(file)
+		if err != nil {
+			return err
+		}
+		merged.Merge(p)
+	}
+
+	wrote := false
+	if *mergedOut != "" {
+		if err := writeCoverProfile(*mergedOut, merged); err != nil {
+			return err
+		}
+		wrote = true
+	}
+	if *lcovOut != "" {
+		if err := writeCoverReport(*lcovOut, merged.WriteLCOV); err != nil {
+			return err
+		}
+		wrote = true
+	}
+	if *htmlOut != "" {
+		source := coverSource(*root)
+		err := writeCoverReport(*htmlOut, func(w io.Writer) error {
+			return merged.WriteHTML(w, source)
+		})
+		if err != nil {
+			return err
+		}
+		wrote = true
+	}
+	if wrote {
+		return nil
+	}
+
+	tw := tabwriter.NewWriter(e.stdout, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(tw, "TEMPLATE\tLINES\tBRANCHES\tUNCOVERED LINES")
+	for _, name := range merged.FileNames() {
+		f := merged.Files[name]
+		lines, linesHit, branches, branchesHit := f.Summary()
+		var uncovered []int
+		for line, hits := range f.Lines {
+			if hits == 0 {
+				uncovered = append(uncovered, line)
+			}
+		}
+		sort.Ints(uncovered)
+		missed := make([]string, len(uncovered))
+		for i, line := range uncovered {
+			missed[i] = fmt.Sprint(line)
+		}
+		fmt.Fprintf(tw, "%s\t%d/%d\t%d/%d\t%s\n", coverName(name), linesHit, lines, branchesHit, branches, strings.Join(missed, ","))
+	}
+	return tw.Flush()
+}
+
+func readCoverProfile(path string) (*plush.CoverageProfile, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	p, err := plush.ReadCoverageProfile(f)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return p, nil
+}
+
+func writeCoverReport(path string, write func(io.Writer) error) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	if err := write(f); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// coverSource reads the templates of a profile. Rendered templates are
+// named by their path; partials are named after the partials directory
+// and looked up in root the way `plush render` finds them.
+func coverSource(root string) func(string) (string, error) {
+	partials := partialFeeder(root)
+	return func(name string) (string, error) {
+		if name == "" {
+			return "", fmt.Errorf("the template was rendered without a file name")
+		}
+		if b, err := os.ReadFile(filepath.FromSlash(name)); err == nil {
+			return string(b), nil
+		}
+		if rel, err := filepath.Rel(root, filepath.FromSlash(name)); err == nil && !strings.HasPrefix(rel, "..") {
+			return partials(filepath.ToSlash(rel))
+		}
+		return partials(name)
+	}
+}
+
+func coverName(name string) string {
+	if name == "" {
+		return "(unnamed template)"
+	}
+	return name
+}
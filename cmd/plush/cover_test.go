@@ -0,0 +1,76 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/gobuffalo/plush/v5"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Cover(t *testing.T) {
+	r := require.New(t)
+	root := t.TempDir()
+	path := writeTemplate(t, root, "users/index.plush.html", "<h1><%= title %></h1>\n<%= for (u) in users { %>\n<li><%= partial(\"users/row.html\", {who: u}) %></li><% } %>\n<%= if (len(users) == 0) { %><p><%= \"none\" %></p><% } %>")
+	writeTemplate(t, root, "users/_row.plush.html", "<%= if (who == \"mark\") { %>\n<em><%= who %></em>\n<% } else { %>\n<%= who %>\n<% } %>")
+	full := writeTemplate(t, root, "full.json", `{"title": "T", "users": ["mark"]}`)
+	empty := writeTemplate(t, root, "empty.json", `{"title": "T", "users": []}`)
+
+	fullCov := filepath.Join(root, "full.cov")
+	code, out, errOut := runCmd("", "render", "-partials", root, "-data", full, "-coverprofile", fullCov, path)
+	r.Equal(0, code, errOut)
+	r.Contains(out, "<em>mark</em>")
+	emptyCov := filepath.Join(root, "empty.cov")
+	code, _, errOut = runCmd("", "render", "-engine", "vm", "-partials", root, "-data", empty, "-coverprofile", emptyCov, path)
+	r.Equal(0, code, errOut)
+
+	code, out, errOut = runCmd("", "cover", fullCov, emptyCov)
+	r.Equal(0, code, errOut)
+	index := filepath.ToSlash(path)
+	row := filepath.ToSlash(root) + "/users/row.html"
+	r.Equal("TEMPLATE"+strings.Repeat(" ", len(index)-8)+"  LINES  BRANCHES  UNCOVERED LINES\n"+
+		index+"  4/4    3/3       \n"+
+		row+strings.Repeat(" ", len(index)-len(row))+"  2/3    1/2       4\n", out)
+
+	merged := filepath.Join(root, "merged.cov")
+	lcov := filepath.Join(root, "cover.info")
+	html := filepath.Join(root, "cover.html")
+	code, out, errOut = runCmd("", "cover", "-o", merged, "-lcov", lcov, "-html", html, "-root", root, fullCov, emptyCov)
+	r.Equal(0, code, errOut)
+	r.Empty(out)
+
+	f, err := os.Open(merged)
+	r.NoError(err)
+	defer f.Close()
+	p, err := plush.ReadCoverageProfile(f)
+	r.NoError(err)
+	r.Equal(map[int]int{1: 1, 2: 1, 4: 0}, p.Files[row].Lines)
+
+	b, err := os.ReadFile(lcov)
+	r.NoError(err)
+	r.Contains(string(b), "SF:"+row+"\nBRDA:1,5,0,1\nBRDA:1,5,1,-\n")
+
+	b, err = os.ReadFile(html)
+	r.NoError(err)
+	r.Contains(string(b), `<td class="code">&lt;em&gt;&lt;%= who %&gt;&lt;/em&gt;</td>`)
+	r.NotContains(string(b), "source unavailable")
+}
+
+func Test_Cover_Errors(t *testing.T) {
+	r := require.New(t)
+	code, _, errOut := runCmd("", "cover")
+	r.Equal(2, code)
+	r.Contains(errOut, "Usage: plush cover")
+
+	dir := t.TempDir()
+	code, _, errOut = runCmd("", "cover", filepath.Join(dir, "missing.cov"))
+	r.Equal(1, code)
+	r.Contains(errOut, "missing.cov")
+
+	bad := writeTemplate(t, dir, "bad.cov", "not json")
+	code, _, errOut = runCmd("", "cover", bad)
+	r.Equal(1, code)
+	r.Contains(errOut, "reading coverage profile")
+}
@@ -0,0 +1,259 @@
+package main
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/gobuffalo/plush/v5"
+)
+
+func init() {
+	register(&command{
+		name:  "debug",
+		short: "render a template in a step debugger",
+		run:   runDebug,
+	})
+}
+
+// errDebugQuit stops a render from the debugger prompt.
+var errDebugQuit = errors.New("quit from the debugger")
+
+const debugHelp = `commands:
+  s, step              run to the next line
+  c, continue          run to the next breakpoint
+  b, break [file:]line set a breakpoint; without an argument, list them
+  clear [file:]line    remove a breakpoint
+  p, print name        print a variable
+  vars                 print every visible variable
+  l, list              show the source around the current line
+  bt, stack            show the partial stack
+  q, quit              stop rendering
+`
+
+// runDebug renders a template like `plush render`, stopping at its first
+// line, or at the breakpoints given with -b, to read debugger commands
+// from standard input. The prompt goes to standard error and the rendered
+// output to standard output.
+func runDebug(e *env, args []string) error {
+	fs := newFlagSet(e, "debug", "[-data file.json] [-partials dir] [-engine vm|interpreter] [-b [file:]line ...] file")
+	data := fs.String("data", "", "JSON `file` holding an object whose keys are set on the context")
+	partials := fs.String("partials", "", "`dir`ectory partial names are resolved against")
+	engine := fs.String("engine", "interpreter", "rendering engine: vm or interpreter")
+	var breakpoints []plush.Breakpoint
+	fs.Func("b", "stop at `[file:]line`; may be repeated", func(s string) error {
+		b, err := plush.ParseBreakpoint(s)
+		if err != nil {
+			return err
+		}
+		breakpoints = append(breakpoints, b)
+		return nil
+	})
+	files, err := parseInterspersed(fs, args)
+	if err != nil {
+		return err
+	}
+	if len(files) != 1 {
+		fs.Usage()
+		return errUsage
+	}
+	render, ok := engines[*engine]
+	if !ok {
+		return fmt.Errorf("unknown engine %q", *engine)
+	}
+	if *data == "-" {
+		return fmt.Errorf("-data cannot read standard input, which holds the debugger commands")
+	}
+
+	file := filepath.Clean(files[0])
+	src, err := os.ReadFile(file)
+	if err != nil {
+		return err
+	}
+	ctx, root, err := renderContext(e, file, *data, *partials)
+	if err != nil {
+		return err
+	}
+
+	r := &debugREPL{
+		in:      bufio.NewScanner(e.stdin),
+		out:     e.stderr,
+		file:    filepath.ToSlash(file),
+		root:    root,
+		sources: map[string][]string{filepath.ToSlash(file): strings.Split(string(src), "\n")},
+	}
+	r.debugger = plush.NewBreakpointDebugger(r.stop)
+	for _, b := range breakpoints {
+		r.debugger.SetBreakpoint(b)
+	}
+	if len(breakpoints) == 0 {
+		r.debugger.StepNext()
+	}
+	ctx.WithDebugger(r.debugger)
+
+	out, err := render(string(src), ctx)
+	if errors.Is(err, errDebugQuit) {
+		return nil
+	}
+	if err != nil {
+		fmt.Fprintln(e.stderr, plush.WrapTemplateError(r.file, err))
+		return errSilent
+	}
+	_, err = io.WriteString(e.stdout, out)
+	return err
+}
+
+// debugREPL reads debugger commands each time the render stops.
+type debugREPL struct {
+	in       *bufio.Scanner
+	out      io.Writer
+	debugger *plush.BreakpointDebugger
+	file     string
+	root     string
+	sources  map[string][]string
+	// done is set once the commands run out; the render then runs to the
+	// end without stopping.
+	done bool
+}
+
+func (r *debugREPL) stop(s *plush.DebugState) error {
+	if r.done {
+		return nil
+	}
+	fmt.Fprintf(r.out, "%s\n", r.location(s.File, s.Line))
+	r.printLines(s, s.Line, s.Line)
+
+	for {
+		fmt.Fprint(r.out, "(plush) ")
+		if !r.in.Scan() {
+			fmt.Fprintln(r.out)
+			r.done = true
+			return nil
+		}
+		fields := strings.Fields(r.in.Text())
+		if len(fields) == 0 {
+			continue
+		}
+		arg := strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(r.in.Text()), fields[0]))
+
+		switch fields[0] {
+		case "s", "step":
+			r.debugger.StepNext()
+			return nil
+		case "c", "continue":
+			return nil
+		case "q", "quit":
+			return errDebugQuit
+		case "b", "break":
+			if arg == "" {
+				for _, b := range r.debugger.Breakpoints() {
+					fmt.Fprintf(r.out, "  %s\n", b)
+				}
+				continue
+			}
+			b, err := plush.ParseBreakpoint(arg)
+			if err != nil {
+				fmt.Fprintln(r.out, err)
+				continue
+			}
+			r.debugger.SetBreakpoint(b)
+			fmt.Fprintf(r.out, "breakpoint at %s\n", b)
+		case "clear":
+			b, err := plush.ParseBreakpoint(arg)
+			if err != nil {
+				fmt.Fprintln(r.out, err)
+				continue
+			}
+			if !r.debugger.ClearBreakpoint(b) {
+				fmt.Fprintf(r.out, "no breakpoint at %s\n", b)
+			}
+		case "p", "print":
+			vars := s.Variables()
+			v, ok := vars[arg]
+			if !ok {
+				fmt.Fprintf(r.out, "%q is not defined\n", arg)
+				continue
+			}
+			fmt.Fprintf(r.out, "%s = %s\n", arg, debugValue(v))
+		case "vars":
+			vars := s.Variables()
+			names := make([]string, 0, len(vars))
+			for name := range vars {
+				names = append(names, name)
+			}
+			sort.Strings(names)
+			for _, name := range names {
+				fmt.Fprintf(r.out, "  %s = %s\n", name, debugValue(vars[name]))
+			}
+		case "l", "list":
+			r.printLines(s, s.Line-3, s.Line+3)
+		case "bt", "stack":
+			fmt.Fprintf(r.out, "  %s\n", r.location(s.File, s.Line))
+			for i := len(s.Partials) - 1; i >= 0; i-- {
+				fmt.Fprintf(r.out, "  %s\n", r.location(s.Partials[i].CallerFile, s.Partials[i].CallerLine))
+			}
+		case "h", "help":
+			fmt.Fprint(r.out, debugHelp)
+		default:
+			fmt.Fprintf(r.out, "unknown command %q; type help for the commands\n", fields[0])
+		}
+	}
+}
+
+func (r *debugREPL) location(file string, line int) string {
+	if file == "" {
+		return fmt.Sprintf("line %d", line)
+	}
+	return fmt.Sprintf("%s:%d", file, line)
+}
+
+// printLines prints lines from through to of the template being
+// rendered, marking the current line.
+func (r *debugREPL) printLines(s *plush.DebugState, from, to int) {
+	lines := r.source(s.File)
+	if from < 1 {
+		from = 1
+	}
+	for n := from; n <= to && n <= len(lines); n++ {
+		mark := " "
+		if n == s.Line {
+			mark = ">"
+		}
+		fmt.Fprintf(r.out, "%s%4d | %s\n", mark, n, lines[n-1])
+	}
+}
+
+// source returns the lines of a template, reading partials from the
+// partials directory the way the render does.
+func (r *debugREPL) source(file string) []string {
+	if lines, ok := r.sources[file]; ok {
+		return lines
+	}
+	var lines []string
+	if rel, err := filepath.Rel(r.root, filepath.FromSlash(file)); err == nil && !strings.HasPrefix(rel, "..") {
+		if src, err := partialFeeder(r.root)(filepath.ToSlash(rel)); err == nil {
+			lines = strings.Split(src, "\n")
+		}
+	}
+	r.sources[file] = lines
+	return lines
+}
+
+func debugValue(v interface{}) string {
+	switch v := v.(type) {
+	case string:
+		return fmt.Sprintf("%q", v)
+	case error:
+		return v.Error()
+	}
+	if rv := reflect.ValueOf(v); rv.Kind() == reflect.Func {
+		return rv.Type().String()
+	}
+	return fmt.Sprintf("%+v", v)
+}
@@ -0,0 +1,73 @@
+package main
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Debug(t *testing.T) {
+	r := require.New(t)
+	root := t.TempDir()
+	path := writeTemplate(t, root, "index.plush.html", "<h1><%= title %></h1>\n<% let n = 2 %>\n<%= partial(\"row.html\", {x: n}) %>")
+	writeTemplate(t, root, "_row.plush.html", "<p>\n<%= x * 10 %></p>")
+	data := writeTemplate(t, root, "data.json", `{"title": "Users"}`)
+	file := filepath.ToSlash(path)
+	row := filepath.ToSlash(filepath.Join(root, "row.html"))
+
+	for _, engine := range []string{"interpreter", "vm"} {
+		code, out, errOut := runCmd("p title\ns\nvars\nb row.html:2\nb\nc\nbt\np x\nl\nc\n", "debug", "-data", data, "-engine", engine, path)
+		r.Equal(0, code, errOut)
+		r.Equal("<h1>Users</h1>\n\n<p>\n20</p>", out)
+
+		r.Contains(errOut, file+":1\n>   1 | <h1><%= title %></h1>\n(plush) title = \"Users\"\n")
+		r.Contains(errOut, "(plush) "+file+":2\n>   2 | <% let n = 2 %>\n")
+		r.Contains(errOut, "  partialFeeder = func(string) (string, error)\n  title = \"Users\"\n")
+		r.Contains(errOut, "(plush) breakpoint at row.html:2\n(plush)   row.html:2\n")
+		r.Contains(errOut, "(plush) "+row+":2\n>   2 | <%= x * 10 %></p>\n")
+		r.Contains(errOut, "(plush)   "+row+":2\n  "+file+":3\n")
+		r.Contains(errOut, "(plush) x = 2\n(plush)     1 | <p>\n>   2 | <%= x * 10 %></p>\n")
+	}
+}
+
+func Test_Debug_Breakpoints(t *testing.T) {
+	r := require.New(t)
+	path := writeTemplate(t, t.TempDir(), "a.plush", "a\n<%= for (i) in [1, 2] { %>\n<%= i %>\n<% } %>")
+
+	code, out, errOut := runCmd("c\nclear 3\nc\n", "debug", "-b", "3", path)
+	r.Equal(0, code, errOut)
+	r.Equal("a\n\n1\n\n2\n", out)
+	r.Contains(errOut, ":3\n>   3 | <%= i %>\n(plush) ")
+	r.NotContains(errOut, ":1\n")
+
+	code, out, errOut = runCmd("q\n", "debug", path)
+	r.Equal(0, code, errOut)
+	r.Empty(out)
+
+	code, out, _ = runCmd("", "debug", path)
+	r.Equal(0, code)
+	r.Equal("a\n\n1\n\n2\n", out)
+}
+
+func Test_Debug_Errors(t *testing.T) {
+	r := require.New(t)
+
+	code, _, errOut := runCmd("", "debug")
+	r.Equal(2, code)
+	r.Contains(errOut, "Usage: plush debug")
+
+	path := writeTemplate(t, t.TempDir(), "a.plush", "<%= nope %>")
+	code, _, errOut = runCmd("", "debug", "-b", "x", path)
+	r.Equal(2, code)
+	r.Contains(errOut, `invalid breakpoint "x"`)
+
+	code, _, errOut = runCmd("", "debug", "-data", "-", path)
+	r.Equal(1, code)
+	r.Contains(errOut, "-data cannot read standard input")
+
+	code, _, errOut = runCmd("c\n", "debug", path)
+	r.Equal(1, code)
+	r.Contains(errOut, filepath.ToSlash(path)+":1")
+	r.Contains(errOut, "nope")
+}
@@ -0,0 +1,45 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/gobuffalo/plush/v5"
+	vmplush "github.com/gobuffalo/plush/v5/vm/plush"
+)
+
+func init() {
+	register(&command{
+		name:  "disasm",
+		short: "show the VM bytecode and fast render plan of a template",
+		run:   runDisasm,
+	})
+}
+
+// runDisasm prints the compiled form of a template and explains which
+// path the VM renders it with.
+func runDisasm(e *env, args []string) error {
+	fs := newFlagSet(e, "disasm", "[-fallback] file")
+	fallback := fs.Bool("fallback", false, "explain the paths taken with plush.SetVMGenericFallback(true)")
+	files, err := parseInterspersed(fs, args)
+	if err != nil {
+		return err
+	}
+	if len(files) != 1 {
+		fs.Usage()
+		return errUsage
+	}
+
+	src, err := os.ReadFile(files[0])
+	if err != nil {
+		return err
+	}
+	previous := plush.SetVMGenericFallback(*fallback)
+	defer plush.SetVMGenericFallback(previous)
+
+	if err := vmplush.Disassemble(e.stdout, string(src)); err != nil {
+		return fmt.Errorf("%s: %w", filepath.ToSlash(files[0]), err)
+	}
+	return nil
+}
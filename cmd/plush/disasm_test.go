@@ -0,0 +1,35 @@
+package main
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Disasm(t *testing.T) {
+	r := require.New(t)
+	path := writeTemplate(t, t.TempDir(), "a.plush", "<p>\n<% let f = fn() { return 1 } %><%= f() %></p>")
+
+	code, out, errOut := runCmd("", "disasm", path)
+	r.Equal(0, code, errOut)
+	r.Contains(out, "path: generic\n  line 2: let value: function literals are not fast-planned\n")
+	r.Contains(out, "\nbytecode:\n")
+
+	code, out, errOut = runCmd("", "disasm", "-fallback", path)
+	r.Equal(0, code, errOut)
+	r.Contains(out, "path: generic\n")
+}
+
+func Test_Disasm_Errors(t *testing.T) {
+	r := require.New(t)
+
+	code, _, errOut := runCmd("", "disasm")
+	r.Equal(2, code)
+	r.Contains(errOut, "Usage: plush disasm")
+
+	path := writeTemplate(t, t.TempDir(), "a.plush", "<%= if { %>")
+	code, _, errOut = runCmd("", "disasm", path)
+	r.Equal(1, code)
+	r.Contains(errOut, filepath.ToSlash(path)+":")
+}
@@ -0,0 +1,52 @@
+package main
+
+import (
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// isTemplate reports whether name looks like a Plush template, such as
+// `index.plush` or `index.plush.html`.
+func isTemplate(name string) bool {
+	base := filepath.Base(name)
+	return strings.HasSuffix(base, ".plush") || strings.Contains(base, ".plush.")
+}
+
+// templateFiles expands paths into the list of template files to process.
+// Files named explicitly are always included; directories are walked for
+// files that look like templates, skipping hidden directories.
+func templateFiles(paths []string) ([]string, error) {
+	var files []string
+	for _, path := range paths {
+		info, err := os.Stat(path)
+		if err != nil {
+			return nil, err
+		}
+		if !info.IsDir() {
+			files = append(files, path)
+			continue
+		}
+
+		err = filepath.WalkDir(path, func(p string, d fs.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+			if d.IsDir() {
+				if p != path && strings.HasPrefix(d.Name(), ".") {
+					return filepath.SkipDir
+				}
+				return nil
+			}
+			if isTemplate(p) {
+				files = append(files, p)
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return files, nil
+}
@@ -0,0 +1,89 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/gobuffalo/plush/v5/formatter"
+)
+
+func init() {
+	register(&command{
+		name:  "fmt",
+		short: "format the code inside template tags",
+		run:   runFmt,
+	})
+}
+
+// runFmt formats templates like gofmt. Without paths it formats
+// standard input.
+func runFmt(e *env, args []string) error {
+	fs := newFlagSet(e, "fmt", "[-l] [-d] [-w] [path ...]")
+	list := fs.Bool("l", false, "list files whose formatting differs from plush fmt's")
+	diff := fs.Bool("d", false, "display diffs instead of rewriting files")
+	write := fs.Bool("w", false, "write result to (source) file instead of stdout")
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+
+	if fs.NArg() == 0 {
+		if *write {
+			return fmt.Errorf("cannot use -w with standard input")
+		}
+		src, err := io.ReadAll(e.stdin)
+		if err != nil {
+			return err
+		}
+		return fmtFile(e, "<standard input>", string(src), *list, *diff, false)
+	}
+
+	files, err := templateFiles(fs.Args())
+	if err != nil {
+		return err
+	}
+
+	failed := false
+	for _, path := range files {
+		src, err := os.ReadFile(path)
+		if err == nil {
+			err = fmtFile(e, path, string(src), *list, *diff, *write)
+		}
+		if err != nil {
+			fmt.Fprintf(e.stderr, "%s: %s\n", path, err)
+			failed = true
+		}
+	}
+	if failed {
+		return errSilent
+	}
+	return nil
+}
+
+func fmtFile(e *env, path, src string, list, diff, write bool) error {
+	out, err := formatter.Format(src)
+	if err != nil {
+		return err
+	}
+
+	changed := out != src
+	if list && changed {
+		fmt.Fprintln(e.stdout, path)
+	}
+	if diff {
+		writeDiff(e.stdout, path, src, out)
+	}
+	if write && changed {
+		info, err := os.Stat(path)
+		if err != nil {
+			return err
+		}
+		if err := os.WriteFile(path, []byte(out), info.Mode().Perm()); err != nil {
+			return err
+		}
+	}
+	if !list && !diff && !write {
+		_, err = io.WriteString(e.stdout, out)
+	}
+	return err
+}
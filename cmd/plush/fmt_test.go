@@ -0,0 +1,85 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// runCmd runs the plush command with args and returns its exit code and
+// output streams.
+func runCmd(stdin string, args ...string) (int, string, string) {
+	var stdout, stderr bytes.Buffer
+	code := run(&env{stdin: strings.NewReader(stdin), stdout: &stdout, stderr: &stderr}, args)
+	return code, stdout.String(), stderr.String()
+}
+
+func writeTemplate(t *testing.T, dir, name, src string) string {
+	t.Helper()
+	path := filepath.Join(dir, name)
+	require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+	require.NoError(t, os.WriteFile(path, []byte(src), 0o644))
+	return path
+}
+
+func Test_Fmt_Stdin(t *testing.T) {
+	r := require.New(t)
+	code, out, _ := runCmd("<p><%=x%></p>\n", "fmt")
+	r.Equal(0, code)
+	r.Equal("<p><%= x %></p>\n", out)
+}
+
+func Test_Fmt_List(t *testing.T) {
+	r := require.New(t)
+	dir := t.TempDir()
+	bad := writeTemplate(t, dir, "a.plush.html", "<%=x%>\n")
+	writeTemplate(t, dir, "b.plush.html", "<%= x %>\n")
+	writeTemplate(t, dir, "c.txt", "<%=x%>\n")
+	writeTemplate(t, dir, ".hidden/d.plush", "<%=x%>\n")
+
+	code, out, _ := runCmd("", "fmt", "-l", dir)
+	r.Equal(0, code)
+	r.Equal(bad+"\n", out)
+}
+
+func Test_Fmt_Diff(t *testing.T) {
+	r := require.New(t)
+	path := writeTemplate(t, t.TempDir(), "a.plush", "<h1>\n<%=x%>\n</h1>\n")
+
+	code, out, _ := runCmd("", "fmt", "-d", path)
+	r.Equal(0, code)
+	r.Equal("--- "+path+".orig\n+++ "+path+"\n@@ -1,3 +1,3 @@\n <h1>\n-<%=x%>\n+<%= x %>\n </h1>\n", out)
+}
+
+func Test_Fmt_Write(t *testing.T) {
+	r := require.New(t)
+	path := writeTemplate(t, t.TempDir(), "a.plush", "<%=x%>")
+
+	code, out, _ := runCmd("", "fmt", "-w", path)
+	r.Equal(0, code)
+	r.Empty(out)
+
+	b, err := os.ReadFile(path)
+	r.NoError(err)
+	r.Equal("<%= x %>", string(b))
+}
+
+func Test_Fmt_Parse_Error(t *testing.T) {
+	r := require.New(t)
+	path := writeTemplate(t, t.TempDir(), "a.plush", "<%= if { %>")
+
+	code, _, errOut := runCmd("", "fmt", "-l", path)
+	r.Equal(1, code)
+	r.Contains(errOut, path+": ")
+}
+
+func Test_Unknown_Command(t *testing.T) {
+	r := require.New(t)
+	code, _, errOut := runCmd("", "nope")
+	r.Equal(2, code)
+	r.Contains(errOut, `unknown command "nope"`)
+}
@@ -0,0 +1,86 @@
+package main
+
+import (
+	"fmt"
+	"go/types"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/gobuffalo/plush/v5/vm/gen"
+)
+
+func init() {
+	register(&command{
+		name:  "gen",
+		short: "compile a template to Go source",
+		run:   runGen,
+	})
+}
+
+// runGen compiles a template to a Go file exposing a render function.
+// Context values declared with -type are read directly by the generated
+// code; everything else is left to the VM.
+func runGen(e *env, args []string) error {
+	fs := newFlagSet(e, "gen", "[-package name] [-func Render] [-type name=type ...] [-o file.go] file")
+	pkg := fs.String("package", "", "package `name` of the generated file; defaults to the output directory's name")
+	fn := fs.String("func", "Render", "`name` of the generated render function")
+	out := fs.String("o", "", "write the generated code to `file` instead of standard output")
+	decl := map[string]string{}
+	fs.Func("type", "declare the Go type of a context value, as `name=type` with the type's full import path, e.g. user=*example.com/models.User", func(s string) error {
+		name, typ, ok := strings.Cut(s, "=")
+		if !ok || name == "" {
+			return fmt.Errorf("want name=type, got %q", s)
+		}
+		decl[name] = typ
+		return nil
+	})
+	files, err := parseInterspersed(fs, args)
+	if err != nil {
+		return err
+	}
+	if len(files) != 1 {
+		fs.Usage()
+		return errUsage
+	}
+
+	file := filepath.Clean(files[0])
+	src, err := os.ReadFile(file)
+	if err != nil {
+		return err
+	}
+
+	name := *pkg
+	if name == "" {
+		dir, err := filepath.Abs(filepath.Dir(*out))
+		if err != nil {
+			return err
+		}
+		name = strings.ReplaceAll(filepath.Base(dir), "-", "_")
+	}
+
+	cfg := gen.Config{
+		Package: name,
+		Func:    *fn,
+		File:    filepath.ToSlash(file),
+		Types:   map[string]types.Type{},
+	}
+	loader := gen.NewTypeLoader("")
+	for name, expr := range decl {
+		typ, err := loader.Load(expr)
+		if err != nil {
+			return fmt.Errorf("-type %s: %w", name, err)
+		}
+		cfg.Types[name] = typ
+	}
+
+	code, err := gen.Generate(string(src), cfg)
+	if err != nil {
+		return fmt.Errorf("%s: %w", filepath.ToSlash(file), err)
+	}
+	if *out == "" {
+		_, err = e.stdout.Write(code)
+		return err
+	}
+	return os.WriteFile(*out, code, 0o644)
+}
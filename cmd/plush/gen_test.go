@@ -0,0 +1,58 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Gen(t *testing.T) {
+	r := require.New(t)
+	path := writeTemplate(t, t.TempDir(), "show.plush.html", `<h1><%= title %></h1>`)
+
+	code, out, errOut := runCmd("", "gen", path, "-package", "views", "-func", "Show", "-type", "title=string")
+	r.Equal(0, code, errOut)
+	r.Contains(out, "package views")
+	r.Contains(out, "func Show(w io.Writer, ctx hctx.Context) error {")
+	r.Contains(out, `v_title, ok := ctx.Value("title").(string)`)
+	r.Contains(out, "b.WriteString(template.HTMLEscapeString(v_title))")
+}
+
+func Test_Gen_Output_File(t *testing.T) {
+	r := require.New(t)
+	dir := filepath.Join(t.TempDir(), "views")
+	path := writeTemplate(t, dir, "index.plush", `<p><%= name %></p>`)
+	file := filepath.Join(dir, "index.go")
+
+	code, out, errOut := runCmd("", "gen", "-o", file, path)
+	r.Equal(0, code, errOut)
+	r.Empty(out)
+
+	b, err := os.ReadFile(file)
+	r.NoError(err)
+	r.Contains(string(b), "package views")
+	r.Contains(string(b), "func Render(w io.Writer, ctx hctx.Context) error {")
+}
+
+func Test_Gen_Errors(t *testing.T) {
+	r := require.New(t)
+	path := writeTemplate(t, t.TempDir(), "a.plush", "<%= if { %>")
+
+	code, _, errOut := runCmd("", "gen")
+	r.Equal(2, code)
+	r.Contains(errOut, "Usage: plush gen")
+
+	code, _, errOut = runCmd("", "gen", "-package", "views", path)
+	r.Equal(1, code)
+	r.Contains(errOut, filepath.ToSlash(path)+":")
+
+	code, _, errOut = runCmd("", "gen", "-package", "views", "-type", "user=User", path)
+	r.Equal(1, code)
+	r.Contains(errOut, "-type user:")
+
+	code, _, errOut = runCmd("", "gen", "-package", "views", "-type", "user", path)
+	r.Equal(2, code)
+	r.Contains(errOut, "want name=type")
+}
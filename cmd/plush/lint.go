@@ -0,0 +1,101 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/gobuffalo/plush/v5/lint"
+)
+
+func init() {
+	register(&command{
+		name:  "lint",
+		short: "report likely bugs in templates",
+		run:   runLint,
+	})
+}
+
+// runLint lints templates and exits non-zero when anything is found.
+// Without paths it lints standard input.
+func runLint(e *env, args []string) error {
+	fs := newFlagSet(e, "lint", "[-disable rule,...] [-enable rule,...] [-rules] [path ...]")
+	disable := fs.String("disable", "", "comma separated rules to skip")
+	enable := fs.String("enable", "", "comma separated rules to run instead of all rules")
+	list := fs.Bool("rules", false, "list the available rules")
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+
+	if *list {
+		for _, r := range lint.Rules {
+			fmt.Fprintf(e.stdout, "%-20s %s\n", r.Name, r.Doc)
+		}
+		return nil
+	}
+
+	cfg := lint.Config{Disable: splitList(*disable)}
+	for _, name := range append(splitList(*enable), cfg.Disable...) {
+		if _, ok := lint.Lookup(name); !ok {
+			return fmt.Errorf("unknown rule %q", name)
+		}
+	}
+	if *enable != "" {
+		cfg.Rules = []*lint.Rule{}
+		for _, name := range splitList(*enable) {
+			r, _ := lint.Lookup(name)
+			cfg.Rules = append(cfg.Rules, r)
+		}
+	}
+
+	type source struct{ name, src string }
+	var sources []source
+	if fs.NArg() == 0 {
+		b, err := io.ReadAll(e.stdin)
+		if err != nil {
+			return err
+		}
+		sources = append(sources, source{"<standard input>", string(b)})
+	} else {
+		files, err := templateFiles(fs.Args())
+		if err != nil {
+			return err
+		}
+		for _, path := range files {
+			b, err := os.ReadFile(path)
+			if err != nil {
+				return err
+			}
+			sources = append(sources, source{path, string(b)})
+		}
+	}
+
+	failed := false
+	for _, s := range sources {
+		findings, err := lint.Lint(s.name, s.src, cfg)
+		if err != nil {
+			fmt.Fprintf(e.stderr, "%s: %s\n", s.name, err)
+			failed = true
+			continue
+		}
+		for _, f := range findings {
+			fmt.Fprintln(e.stdout, f)
+			failed = true
+		}
+	}
+	if failed {
+		return errSilent
+	}
+	return nil
+}
+
+func splitList(s string) []string {
+	var out []string
+	for _, part := range strings.Split(s, ",") {
+		if part = strings.TrimSpace(part); part != "" {
+			out = append(out, part)
+		}
+	}
+	return out
+}
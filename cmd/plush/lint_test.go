@@ -0,0 +1,44 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Lint_Files(t *testing.T) {
+	r := require.New(t)
+	dir := t.TempDir()
+	bad := writeTemplate(t, dir, "a.plush.html", "<% let x = 1 %>\n<%= raw(y) %>\n")
+	writeTemplate(t, dir, "b.plush.html", "<%= y %>\n")
+
+	code, out, _ := runCmd("", "lint", dir)
+	r.Equal(1, code)
+	r.Equal(bad+":1:4: x is declared but never used (unused-let)\n"+
+		bad+":2:5: raw() applied to a non-literal value may render unescaped input (raw-non-literal)\n", out)
+}
+
+func Test_Lint_Toggle_Rules(t *testing.T) {
+	r := require.New(t)
+	src := "<% let x = 1 %><%= raw(y) %>"
+
+	code, out, _ := runCmd(src, "lint", "-disable", "unused-let")
+	r.Equal(1, code)
+	r.Equal("<standard input>:1:20: raw() applied to a non-literal value may render unescaped input (raw-non-literal)\n", out)
+
+	code, out, _ = runCmd(src, "lint", "-enable", "dynamic-partial,deprecated-helper")
+	r.Equal(0, code)
+	r.Empty(out)
+
+	code, _, errOut := runCmd(src, "lint", "-disable", "nope")
+	r.Equal(1, code)
+	r.Contains(errOut, `unknown rule "nope"`)
+}
+
+func Test_Lint_List_Rules(t *testing.T) {
+	r := require.New(t)
+	code, out, _ := runCmd("", "lint", "-rules")
+	r.Equal(0, code)
+	r.Contains(out, "unused-let")
+	r.Contains(out, "deprecated-helper")
+}
@@ -0,0 +1,146 @@
+// Command plush is a set of tools for working with Plush templates.
+//
+// Usage:
+//
+//	plush <command> [arguments]
+//
+// Run `plush help` for the list of commands.
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+
+	"github.com/gobuffalo/plush/v5"
+)
+
+// command is a plush subcommand.
+type command struct {
+	name  string
+	short string
+	run   func(env *env, args []string) error
+}
+
+// commands holds every subcommand by name. Each command registers
+// itself from an init function in its own file.
+var commands = map[string]*command{}
+
+func register(c *command) {
+	commands[c.name] = c
+}
+
+// env holds the standard streams so commands can be run from tests.
+type env struct {
+	stdin  io.Reader
+	stdout io.Writer
+	stderr io.Writer
+}
+
+var (
+	// errSilent reports a failure that the command already printed.
+	errSilent = errors.New("plush: command failed")
+	// errUsage reports bad arguments; the usage has already been printed.
+	errUsage = errors.New("plush: bad usage")
+)
+
+func main() {
+	os.Exit(run(&env{stdin: os.Stdin, stdout: os.Stdout, stderr: os.Stderr}, os.Args[1:]))
+}
+
+// run executes the command named by args[0] and returns the exit code.
+func run(e *env, args []string) int {
+	if len(args) == 0 || args[0] == "help" || args[0] == "-h" || args[0] == "--help" {
+		usage(e.stderr)
+		if len(args) == 0 {
+			return 2
+		}
+		return 0
+	}
+
+	c, ok := commands[args[0]]
+	if !ok {
+		fmt.Fprintf(e.stderr, "plush: unknown command %q\n", args[0])
+		usage(e.stderr)
+		return 2
+	}
+
+	var exit *plush.ExitError
+	switch err := c.run(e, args[1:]); {
+	case err == nil:
+		return 0
+	case errors.As(err, &exit):
+		return exit.Code
+	case errors.Is(err, flag.ErrHelp):
+		return 0
+	case errors.Is(err, errSilent):
+		return 1
+	case errors.Is(err, errUsage):
+		return 2
+	default:
+		fmt.Fprintf(e.stderr, "plush %s: %s\n", c.name, err)
+		return 1
+	}
+}
+
+func usage(w io.Writer) {
+	fmt.Fprintln(w, "Usage: plush <command> [arguments]")
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "Commands:")
+
+	names := make([]string, 0, len(commands))
+	for name := range commands {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		fmt.Fprintf(w, "  %-10s %s\n", name, commands[name].short)
+	}
+}
+
+// parseFlags parses args into fs. Errors have already been printed by
+// fs, so they are reported as errUsage.
+func parseFlags(fs *flag.FlagSet, args []string) error {
+	if err := fs.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return err
+		}
+		return errUsage
+	}
+	return nil
+}
+
+// parseInterspersed parses args into fs like parseFlags, but also accepts
+// flags after positional arguments, which it returns.
+func parseInterspersed(fs *flag.FlagSet, args []string) ([]string, error) {
+	var positional []string
+	for {
+		if err := parseFlags(fs, args); err != nil {
+			return nil, err
+		}
+		if fs.NArg() == 0 {
+			return positional, nil
+		}
+		args = fs.Args()
+		if args[0] == "--" && len(positional) == 0 {
+			return args[1:], nil
+		}
+		positional = append(positional, args[0])
+		args = args[1:]
+	}
+}
+
+// newFlagSet returns a flag set for the named command that reports
+// errors to e.stderr instead of exiting.
+func newFlagSet(e *env, name, args string) *flag.FlagSet {
+	fs := flag.NewFlagSet("plush "+name, flag.ContinueOnError)
+	fs.SetOutput(e.stderr)
+	fs.Usage = func() {
+		fmt.Fprintf(e.stderr, "Usage: plush %s %s\n", name, args)
+		fs.PrintDefaults()
+	}
+	return fs
+}
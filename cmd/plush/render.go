@@ -0,0 +1,212 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/gobuffalo/plush/v5"
+	"github.com/gobuffalo/plush/v5/helpers/meta"
+	vmplush "github.com/gobuffalo/plush/v5/vm/plush"
+)
+
+func init() {
+	register(&command{
+		name:  "render",
+		short: "render a template to standard output",
+		run:   runRender,
+	})
+}
+
+// engines maps the -engine flag to a render function.
+var engines = map[string]plush.RenderFunc{
+	"interpreter": plush.Render,
+	"vm":          vmplush.Render,
+}
+
+// runRender renders a single template with data read from a JSON file.
+// Partials are read from the -partials directory, which defaults to the
+// directory of the template.
+func runRender(e *env, args []string) error {
+	fs := newFlagSet(e, "render", "[-data file.json] [-partials dir] [-engine vm|interpreter] [-coverprofile file] file")
+	data := fs.String("data", "", "JSON `file` holding an object whose keys are set on the context; - reads standard input")
+	partials := fs.String("partials", "", "`dir`ectory partial names are resolved against")
+	engine := fs.String("engine", "interpreter", "rendering engine: vm or interpreter")
+	coverProfile := fs.String("coverprofile", "", "write the line and branch coverage of the render to `file`, for plush cover")
+	files, err := parseInterspersed(fs, args)
+	if err != nil {
+		return err
+	}
+	if len(files) != 1 {
+		fs.Usage()
+		return errUsage
+	}
+	render, ok := engines[*engine]
+	if !ok {
+		return fmt.Errorf("unknown engine %q", *engine)
+	}
+
+	file := filepath.Clean(files[0])
+	src, err := os.ReadFile(file)
+	if err != nil {
+		return err
+	}
+	ctx, _, err := renderContext(e, file, *data, *partials)
+	if err != nil {
+		return err
+	}
+	var cov *plush.Coverage
+	if *coverProfile != "" {
+		cov = plush.NewCoverage()
+		ctx.WithCoverage(cov)
+	}
+
+	out, err := render(string(src), ctx)
+	if cov != nil {
+		// A failed render still covered the lines it ran.
+		if err := writeCoverProfile(*coverProfile, cov.Profile()); err != nil {
+			return err
+		}
+	}
+	if err != nil {
+		fmt.Fprintln(e.stderr, plush.WrapTemplateError(filepath.ToSlash(file), err))
+		return errSilent
+	}
+	_, err = io.WriteString(e.stdout, out)
+	return err
+}
+
+func writeCoverProfile(path string, p *plush.CoverageProfile) error {
+	b, err := json.MarshalIndent(p, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, append(b, '\n'), 0644)
+}
+
+// renderContext returns the context file is rendered with, holding the
+// values of the JSON data file and a feeder reading partials from the
+// partials directory, which defaults to the directory of file. It also
+// returns that directory.
+func renderContext(e *env, file, data, partials string) (*plush.Context, string, error) {
+	root := partials
+	if root == "" {
+		root = filepath.Dir(file)
+	}
+
+	ctx := plush.NewContext()
+	if data != "" {
+		values, err := readData(e, data)
+		if err != nil {
+			return nil, "", err
+		}
+		for k, v := range values {
+			ctx.Set(k, v)
+		}
+	}
+	setTemplateFile(ctx, root, file)
+	ctx.Set("partialFeeder", partialFeeder(root))
+	return ctx, root, nil
+}
+
+// setTemplateFile records file as the template being rendered, the way
+// Buffalo does: the base name is relative to the templates root, so that
+// PartialHelper names nested partials after the root in error traces.
+func setTemplateFile(ctx *plush.Context, root, file string) {
+	base := filepath.Base(file)
+	if rel, err := filepath.Rel(root, file); err == nil && !strings.HasPrefix(rel, "..") {
+		base = rel
+	}
+	ext := filepath.Ext(base)
+
+	ctx.Set(meta.TemplateFileKey, filepath.ToSlash(file))
+	ctx.Set(meta.TemplateBaseFileNameKey, filepath.ToSlash(strings.TrimSuffix(base, ext)))
+	ctx.Set(meta.TemplateExtensionKey, strings.TrimPrefix(ext, "."))
+}
+
+// readData decodes the JSON object in path. Whole numbers become ints so
+// that they behave like Go ints in templates.
+func readData(e *env, path string) (map[string]interface{}, error) {
+	var b []byte
+	var err error
+	if path == "-" {
+		b, err = io.ReadAll(e.stdin)
+	} else {
+		b, err = os.ReadFile(path)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.UseNumber()
+	var values map[string]interface{}
+	if err := dec.Decode(&values); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	for k, v := range values {
+		values[k] = jsonValue(v)
+	}
+	return values, nil
+}
+
+func jsonValue(v interface{}) interface{} {
+	switch v := v.(type) {
+	case json.Number:
+		if i, err := v.Int64(); err == nil {
+			return int(i)
+		}
+		f, _ := v.Float64()
+		return f
+	case map[string]interface{}:
+		for k, e := range v {
+			v[k] = jsonValue(e)
+		}
+	case []interface{}:
+		for i, e := range v {
+			v[i] = jsonValue(e)
+		}
+	}
+	return v
+}
+
+// partialFeeder reads partials from root. A name such as `users/form.html`
+// is looked up as written, with the leading underscore partial files
+// usually have, and with `.plush` before its extension, so it finds
+// `users/_form.plush.html`.
+func partialFeeder(root string) func(string) (string, error) {
+	return func(name string) (string, error) {
+		for _, p := range partialCandidates(name) {
+			b, err := os.ReadFile(filepath.Join(root, p))
+			if err == nil {
+				return string(b), nil
+			}
+			if !os.IsNotExist(err) {
+				return "", err
+			}
+		}
+		return "", fmt.Errorf("could not find partial %q in %s", name, root)
+	}
+}
+
+func partialCandidates(name string) []string {
+	name = filepath.FromSlash(name)
+	dir, base := filepath.Split(name)
+	bases := []string{base}
+	if !strings.HasPrefix(base, "_") {
+		bases = append(bases, "_"+base)
+	}
+
+	var names []string
+	for _, b := range bases {
+		names = append(names, filepath.Join(dir, b))
+		if ext := filepath.Ext(b); ext != "" && ext != ".plush" && !strings.HasSuffix(b, ".plush"+ext) {
+			names = append(names, filepath.Join(dir, strings.TrimSuffix(b, ext)+".plush"+ext))
+		}
+	}
+	return names
+}
@@ -0,0 +1,94 @@
+package main
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Render_Data(t *testing.T) {
+	r := require.New(t)
+	dir := t.TempDir()
+	path := writeTemplate(t, dir, "index.plush.html", `<h1><%= title %></h1><%= for (u) in users { %><%= u["name"] %>:<%= u["age"] + 1 %> <% } %>`)
+	data := writeTemplate(t, dir, "data.json", `{"title": "Users", "users": [{"name": "mark", "age": 41}, {"name": "ringo", "age": 1.5}]}`)
+
+	for _, engine := range []string{"interpreter", "vm"} {
+		code, out, errOut := runCmd("", "render", path, "--data", data, "--engine", engine)
+		r.Equal(0, code, errOut)
+		r.Equal("<h1>Users</h1>mark:42 ringo:2.5 ", out)
+	}
+}
+
+func Test_Render_Data_Stdin(t *testing.T) {
+	r := require.New(t)
+	path := writeTemplate(t, t.TempDir(), "a.plush", `<%= name %>`)
+
+	code, out, _ := runCmd(`{"name": "paul"}`, "render", "-data", "-", path)
+	r.Equal(0, code)
+	r.Equal("paul", out)
+}
+
+func Test_Render_Partials(t *testing.T) {
+	r := require.New(t)
+	root := t.TempDir()
+	path := writeTemplate(t, root, "users/index.plush.html", `<main><%= partial("users/form.html", {name: "john"}) %></main>`)
+	writeTemplate(t, root, "users/_form.plush.html", `<form><%= partial("shared/field.html") %></form>`)
+	writeTemplate(t, root, "shared/_field.html", `<input value="<%= name %>">`)
+
+	for _, engine := range []string{"interpreter", "vm"} {
+		code, out, errOut := runCmd("", "render", "-partials", root, "-engine", engine, path)
+		r.Equal(0, code, errOut)
+		r.Equal(`<main><form><input value="john"></form></main>`, out)
+	}
+}
+
+func Test_Render_Error_Trace(t *testing.T) {
+	r := require.New(t)
+	root := t.TempDir()
+	path := writeTemplate(t, root, "users/index.plush.html", "<main>\n<%= partial(\"users/form.html\") %></main>")
+	writeTemplate(t, root, "users/_form.plush.html", "<form>\n\n<%= missing %></form>")
+
+	base := filepath.ToSlash(root)
+	for _, engine := range []string{"interpreter", "vm"} {
+		code, out, errOut := runCmd("", "render", path, "-partials", root, "-engine", engine)
+		r.Equal(1, code)
+		r.Empty(out)
+		r.Equal(base+"/users/index.plush.html:2:"+base+"/users/form.html:3: \"missing\": unknown identifier\n", errOut)
+	}
+}
+
+func Test_Render_Error_Top_Level(t *testing.T) {
+	r := require.New(t)
+	path := writeTemplate(t, t.TempDir(), "a.plush", "<p>\n<%= missing %></p>")
+
+	code, _, errOut := runCmd("", "render", path)
+	r.Equal(1, code)
+	r.Equal(filepath.ToSlash(path)+":2: \"missing\": unknown identifier\n", errOut)
+}
+
+func Test_Render_Missing_Partial(t *testing.T) {
+	r := require.New(t)
+	path := writeTemplate(t, t.TempDir(), "a.plush", `<%= partial("nope.html") %>`)
+
+	code, _, errOut := runCmd("", "render", path)
+	r.Equal(1, code)
+	r.Contains(errOut, `could not find partial "nope.html"`)
+}
+
+func Test_Render_Usage(t *testing.T) {
+	r := require.New(t)
+
+	code, _, errOut := runCmd("", "render")
+	r.Equal(2, code)
+	r.Contains(errOut, "Usage: plush render")
+
+	path := writeTemplate(t, t.TempDir(), "a.plush", "x")
+	code, _, errOut = runCmd("", "render", "-engine", "jit", path)
+	r.Equal(1, code)
+	r.Contains(errOut, `unknown engine "jit"`)
+
+	code, _, errOut = runCmd("", "render", "-data", filepath.Join(t.TempDir(), "missing.json"), path)
+	r.Equal(1, code)
+	r.Contains(errOut, "missing.json")
+}
@@ -0,0 +1,315 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/gobuffalo/plush/v5"
+	"github.com/gobuffalo/plush/v5/ast"
+	"github.com/gobuffalo/plush/v5/helpers"
+	"github.com/gobuffalo/plush/v5/parser"
+	vmplush "github.com/gobuffalo/plush/v5/vm/plush"
+)
+
+func init() {
+	register(&command{
+		name:  "repl",
+		short: "evaluate Plush expressions interactively",
+		run:   runREPL,
+	})
+}
+
+const replHelp = `Enter Plush expressions and let statements; blocks may span lines.
+commands:
+  :engine [vm|interpreter]  show or set the engine evaluating the input
+  :budget [n]               show or set the budget of each input; 0 is unlimited
+  :load file.json           set the keys of a JSON object on the context
+  :complete prefix          list the helpers and variables starting with prefix
+  :help                     show this help
+  :quit                     leave the REPL
+A line ending in a tab also completes its last word.
+`
+
+// replEvaluators maps the -engine flag to the function evaluating a
+// single expression.
+var replEvaluators = map[string]func(string, *plush.Context) (interface{}, error){
+	"interpreter": func(expr string, ctx *plush.Context) (interface{}, error) {
+		return plush.Eval(expr, ctx)
+	},
+	"vm": func(expr string, ctx *plush.Context) (interface{}, error) {
+		e, err := vmplush.CompileExpr(expr)
+		if err != nil {
+			return nil, err
+		}
+		return e.Eval(ctx)
+	},
+}
+
+// runREPL reads Plush input from standard input and prints the Go value
+// of each expression. Values set with let stay on the context for the
+// following inputs. Prompts and errors go to standard error.
+func runREPL(e *env, args []string) error {
+	fs := newFlagSet(e, "repl", "[-engine vm|interpreter] [-budget n] [-data file.json]")
+	engine := fs.String("engine", "interpreter", "evaluation engine: vm or interpreter")
+	budget := fs.Int64("budget", 0, "maximum number of evaluation steps of each input; 0 is unlimited")
+	data := fs.String("data", "", "JSON `file` holding an object whose keys are set on the context")
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+	if fs.NArg() != 0 {
+		fs.Usage()
+		return errUsage
+	}
+	if _, ok := replEvaluators[*engine]; !ok {
+		return fmt.Errorf("unknown engine %q", *engine)
+	}
+	if *data == "-" {
+		return fmt.Errorf("-data cannot read standard input, which holds the input of the REPL")
+	}
+
+	r := &repl{
+		e:      e,
+		ctx:    plush.NewContext(),
+		engine: *engine,
+		budget: *budget,
+		names:  map[string]bool{},
+	}
+	if *data != "" {
+		if err := r.load(*data); err != nil {
+			return err
+		}
+	}
+	r.run()
+	return nil
+}
+
+// repl holds the state of a REPL session.
+type repl struct {
+	e      *env
+	ctx    *plush.Context
+	engine string
+	budget int64
+	// names are the variables set by let statements and :load, which are
+	// completed along with the helpers.
+	names map[string]bool
+}
+
+func (r *repl) run() {
+	in := bufio.NewScanner(r.e.stdin)
+	var input strings.Builder
+	for {
+		if input.Len() == 0 {
+			fmt.Fprint(r.e.stderr, ">> ")
+		} else {
+			fmt.Fprint(r.e.stderr, ".. ")
+		}
+		if !in.Scan() {
+			fmt.Fprintln(r.e.stderr)
+			return
+		}
+		line := in.Text()
+
+		if strings.HasSuffix(line, "\t") {
+			words := strings.FieldsFunc(line, func(r rune) bool {
+				return !(r == '_' || r >= '0' && r <= '9' || r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z')
+			})
+			if len(words) > 0 {
+				r.complete(words[len(words)-1])
+			}
+			continue
+		}
+		if input.Len() == 0 && strings.HasPrefix(strings.TrimSpace(line), ":") {
+			if !r.command(strings.TrimSpace(line)) {
+				return
+			}
+			continue
+		}
+
+		input.WriteString(line)
+		input.WriteString("\n")
+		if replOpen(input.String()) {
+			continue
+		}
+		src := strings.TrimSpace(input.String())
+		input.Reset()
+		if src != "" {
+			r.eval(src)
+		}
+	}
+}
+
+// command runs a : command and reports whether the session goes on.
+func (r *repl) command(line string) bool {
+	name, arg, _ := strings.Cut(line, " ")
+	arg = strings.TrimSpace(arg)
+	switch name {
+	case ":q", ":quit":
+		return false
+	case ":h", ":help":
+		fmt.Fprint(r.e.stderr, replHelp)
+	case ":engine":
+		if arg == "" {
+			fmt.Fprintln(r.e.stderr, r.engine)
+			break
+		}
+		if _, ok := replEvaluators[arg]; !ok {
+			fmt.Fprintf(r.e.stderr, "unknown engine %q\n", arg)
+			break
+		}
+		r.engine = arg
+	case ":budget":
+		if arg == "" {
+			fmt.Fprintln(r.e.stderr, r.budget)
+			break
+		}
+		n, err := strconv.ParseInt(arg, 10, 64)
+		if err != nil || n < 0 {
+			fmt.Fprintf(r.e.stderr, "invalid budget %q\n", arg)
+			break
+		}
+		r.budget = n
+	case ":load":
+		if err := r.load(arg); err != nil {
+			fmt.Fprintln(r.e.stderr, err)
+		}
+	case ":complete":
+		r.complete(arg)
+	default:
+		fmt.Fprintf(r.e.stderr, "unknown command %q; type :help for the commands\n", name)
+	}
+	return true
+}
+
+func (r *repl) load(path string) error {
+	values, err := readData(r.e, path)
+	if err != nil {
+		return err
+	}
+	for k, v := range values {
+		r.ctx.Set(k, v)
+		r.names[k] = true
+	}
+	return nil
+}
+
+// complete prints the helpers and variables starting with prefix.
+func (r *repl) complete(prefix string) {
+	var names []string
+	for name := range helpers.Base {
+		if strings.HasPrefix(name, prefix) && !r.names[name] {
+			names = append(names, name)
+		}
+	}
+	for name := range r.names {
+		if strings.HasPrefix(name, prefix) {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	fmt.Fprintln(r.e.stderr, strings.Join(names, "  "))
+}
+
+// eval evaluates each statement of src and prints the value of the
+// expressions.
+func (r *repl) eval(src string) {
+	const open = "<% "
+	program, err := parser.Parse(open + src + " %>")
+	if err != nil {
+		fmt.Fprintln(r.e.stderr, err)
+		return
+	}
+	if r.budget > 0 {
+		r.ctx.WithBudget(plush.NewBudget(r.budget))
+	} else {
+		r.ctx.WithBudget(nil)
+	}
+	text := func(n ast.Node) string {
+		span := ast.SpanOf(n)
+		return src[span.Start-len(open) : span.Stop-len(open)]
+	}
+	eval := replEvaluators[r.engine]
+
+	for _, stmt := range program.Statements {
+		var expr ast.Expression
+		switch s := stmt.(type) {
+		case *ast.LetStatement:
+			v, err := eval(text(s.Value), r.ctx)
+			if err != nil {
+				fmt.Fprintln(r.e.stderr, err)
+				return
+			}
+			r.ctx.Set(s.Name.Value, v)
+			r.names[s.Name.Value] = true
+			continue
+		case *ast.ExpressionStatement:
+			expr = s.Expression
+		case *ast.ReturnStatement:
+			expr = s.ReturnValue
+		}
+		if expr == nil {
+			continue
+		}
+		v, err := eval(text(expr), r.ctx)
+		if err != nil {
+			fmt.Fprintln(r.e.stderr, err)
+			return
+		}
+		if _, ok := expr.(*ast.AssignExpression); !ok {
+			fmt.Fprintln(r.e.stdout, replValue(v))
+		}
+	}
+}
+
+// replValue formats v with its Go type.
+func replValue(v interface{}) string {
+	if v == nil {
+		return "nil"
+	}
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Func:
+		return rv.Type().String()
+	case reflect.Bool, reflect.String,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return fmt.Sprintf("%#v (%T)", v, v)
+	case reflect.Ptr:
+		// Template functions only make sense as their type.
+		if rv.Elem().Kind() == reflect.Struct && strings.HasPrefix(rv.Type().Elem().PkgPath(), "github.com/gobuffalo/plush/") {
+			return rv.Type().String()
+		}
+	}
+	return fmt.Sprintf("%#v", v)
+}
+
+// replOpen reports whether src has unclosed brackets or strings, so the
+// input goes on on the next line.
+func replOpen(src string) bool {
+	depth := 0
+	var quote rune
+	escaped := false
+	for _, c := range src {
+		switch {
+		case escaped:
+			escaped = false
+		case quote != 0:
+			if c == '\\' && quote == '"' {
+				escaped = true
+			} else if c == quote {
+				quote = 0
+			}
+		case c == '"' || c == '`':
+			quote = c
+		case c == '(' || c == '[' || c == '{':
+			depth++
+		case c == ')' || c == ']' || c == '}':
+			depth--
+		}
+	}
+	return depth > 0 || quote != 0
+}
@@ -0,0 +1,70 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_REPL(t *testing.T) {
+	r := require.New(t)
+	input := `let double = fn(x) {
+	return x * 2
+}
+double(4)
+upcase("mark") + "!"
+[1, "a"]
+let n = 3; n = n + 1; n
+n > 3
+`
+	code, out, errOut := runCmd(input, "repl")
+	r.Equal(0, code, errOut)
+	r.Equal("8 (int)\n\"MARK!\" (string)\n[]interface {}{1, \"a\"}\n4 (int)\ntrue (bool)\n", out)
+	r.Contains(errOut, ">> .. .. >> ")
+
+	code, out, errOut = runCmd(input, "repl", "-engine", "vm")
+	r.Equal(0, code, errOut)
+	r.Equal("8 (int)\n\"MARK!\" (string)\n[]interface {}{1, \"a\"}\n4 (int)\ntrue (bool)\n", out)
+}
+
+func Test_REPL_Commands(t *testing.T) {
+	r := require.New(t)
+	data := writeTemplate(t, t.TempDir(), "data.json", `{"user": {"name": "mark"}, "upper": true}`)
+	input := ":load " + data + `
+user["name"]
+:engine vm
+:engine
+7 / 2.0
+:budget 5
+for (i) in range(1, 10) { upcase("x") }
+:budget 0
+for (i) in range(1, 10) { upcase("x") }
+:complete up
+camel` + "\t" + `
+:engine js
+:nope
+missing
+:quit
+1
+`
+	code, out, errOut := runCmd(input, "repl")
+	r.Equal(0, code, errOut)
+	r.Equal("\"mark\" (string)\n3.5 (float64)\n[]interface {}{}\n", out)
+	r.Contains(errOut, ">> vm\n")
+	r.Contains(errOut, "budget exceeded")
+	r.Contains(errOut, "upcase  upper\n")
+	r.Contains(errOut, "camelize  camelize_down_first\n")
+	r.Contains(errOut, `unknown engine "js"`)
+	r.Contains(errOut, `unknown command ":nope"`)
+	r.Contains(errOut, `"missing": unknown identifier`)
+}
+
+func Test_REPL_Usage(t *testing.T) {
+	r := require.New(t)
+	code, _, _ := runCmd("", "repl", "extra")
+	r.Equal(2, code)
+
+	code, _, errOut := runCmd("", "repl", "-engine", "js")
+	r.Equal(1, code)
+	r.Contains(errOut, `unknown engine "js"`)
+}
@@ -0,0 +1,72 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/gobuffalo/plush/v5"
+)
+
+func init() {
+	register(&command{
+		name:  "run",
+		short: "run a script with sandboxed file access",
+		run:   runScript,
+	})
+}
+
+// runScript runs a Plush script with the helpers of plush.RunScriptWith.
+// Arguments after the script are passed to it as args, and its file
+// helpers are limited to the -root directory.
+func runScript(e *env, args []string) error {
+	fs := newFlagSet(e, "run", "[-root dir] [-budget n] [-data file.json] script.plush [args ...]")
+	root := fs.String("root", ".", "`dir`ectory the file helpers of the script are limited to")
+	budget := fs.Int64("budget", 0, "maximum number of evaluation steps; 0 is unlimited")
+	data := fs.String("data", "", "JSON `file` holding an object whose keys are set on the context")
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+	if fs.NArg() == 0 {
+		fs.Usage()
+		return errUsage
+	}
+	if *data == "-" {
+		return fmt.Errorf("-data cannot read standard input, which is read by the script")
+	}
+
+	file := filepath.Clean(fs.Arg(0))
+	src, err := os.ReadFile(file)
+	if err != nil {
+		return err
+	}
+	ctx := plush.NewContext()
+	if *data != "" {
+		values, err := readData(e, *data)
+		if err != nil {
+			return err
+		}
+		for k, v := range values {
+			ctx.Set(k, v)
+		}
+	}
+	env := plush.ScriptEnv{
+		Stdin:  e.stdin,
+		Stdout: e.stdout,
+		Args:   fs.Args()[1:],
+		Root:   *root,
+	}
+	if *budget > 0 {
+		env.Budget = plush.NewBudget(*budget)
+	}
+
+	err = plush.RunScriptWith(string(src), ctx, env)
+	if _, ok := err.(*plush.ExitError); ok {
+		return err
+	}
+	if err != nil {
+		fmt.Fprintln(e.stderr, plush.WrapTemplateError(filepath.ToSlash(file), err))
+		return errSilent
+	}
+	return nil
+}
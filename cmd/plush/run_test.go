@@ -0,0 +1,63 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Run(t *testing.T) {
+	r := require.New(t)
+	dir := t.TempDir()
+	writeTemplate(t, dir, "names.txt", "mark")
+	path := writeTemplate(t, dir, "greet.plush", `let name = readFile("names.txt")
+for (a) in args {
+	println(a + " " + name + " " + readStdin())
+}
+writeFile("out/count.txt", len(args))`)
+
+	code, out, errOut := runCmd("!", "run", "-root", dir, path, "hello", "-bye")
+	r.Equal(0, code, errOut)
+	r.Equal("hello mark !\n-bye mark \n", out)
+	b, err := os.ReadFile(filepath.Join(dir, "out", "count.txt"))
+	r.NoError(err)
+	r.Equal("2", string(b))
+}
+
+func Test_Run_Exit(t *testing.T) {
+	r := require.New(t)
+	path := writeTemplate(t, t.TempDir(), "exit.plush", `print(greeting)
+if (len(args) == 0) { exit(3) }
+exit(0)`)
+	data := writeTemplate(t, t.TempDir(), "data.json", `{"greeting": "hi"}`)
+
+	code, out, errOut := runCmd("", "run", "-data", data, path)
+	r.Equal(3, code)
+	r.Equal("hi", out)
+	r.Empty(errOut)
+
+	code, _, errOut = runCmd("", "run", "-data", data, path, "x")
+	r.Equal(0, code, errOut)
+}
+
+func Test_Run_Errors(t *testing.T) {
+	r := require.New(t)
+	dir := t.TempDir()
+	path := writeTemplate(t, filepath.Join(dir, "scripts"), "read.plush", `print(readFile("../secret.txt"))`)
+	writeTemplate(t, dir, "secret.txt", "s3cr3t")
+
+	code, out, errOut := runCmd("", "run", "-root", filepath.Dir(path), path)
+	r.Equal(1, code)
+	r.Empty(out)
+	r.Contains(errOut, "path is outside of the script root")
+
+	loop := writeTemplate(t, dir, "loop.plush", `for (i) in range(1, 100) { print(i) }`)
+	code, _, errOut = runCmd("", "run", "-budget", "10", loop)
+	r.Equal(1, code)
+	r.Contains(errOut, "budget exceeded")
+
+	code, _, _ = runCmd("", "run")
+	r.Equal(2, code)
+}
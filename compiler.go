@@ -40,6 +40,11 @@ type compiler struct {
 	program           *ast.Program
 	curStmt           ast.Statement
 	positionStartEnds []HoleMarker
+	sourceMap         *SourceMapBuilder
+	debugger          Debugger
+	debugOutput       *strings.Builder
+	coverage          *TemplateCoverage
+	depth             int
 }
 
 // budget returns the active Budget from the current context, or nil if unlimited.
@@ -50,15 +55,46 @@ func (c *compiler) budget() *Budget {
 	return nil
 }
 
+// spendLoop stops the render once its context is cancelled and spends a
+// loop iteration on the budget.
+func (c *compiler) spendLoop() error {
+	if err := CheckContext(c.ctx); err != nil {
+		return err
+	}
+	return c.budget().SpendLoop()
+}
+
+// coverStatement records that node ran, unless it only writes HTML.
+func (c *compiler) coverStatement(node ast.Statement) {
+	if _, html := htmlStatement(node); !html {
+		c.coverage.HitLine(node.T().LineNumber)
+	}
+}
+
+// debugStep calls the attached debugger before node is executed.
+func (c *compiler) debugStep(node ast.Statement) error {
+	return DebugStep(c.debugger, c.ctx, node.T().LineNumber, c.debugOutput.Len(), nil)
+}
+
 func (c *compiler) compile() (string, error) {
 	bb := builderPool.Get().(*strings.Builder)
 	bb.Reset()
 	defer builderPool.Put(bb)
+	c.debugOutput = bb
 
 	for _, stmt := range c.program.Statements {
 		var res interface{}
 		var err error
 
+		if c.debugger != nil {
+			if err := c.debugStep(stmt); err != nil {
+				return "", fmt.Errorf("line %d: %w", stmt.T().LineNumber, err)
+			}
+		}
+		if c.coverage != nil {
+			c.coverStatement(stmt)
+		}
+
 		switch node := stmt.(type) {
 		case *ast.HoleStatement:
 			res, err = c.evalHoleStatement(node)
@@ -100,7 +136,9 @@ func (c *compiler) compile() (string, error) {
 			return "", fmt.Errorf("line %d: %w", s.T().LineNumber, err)
 		}
 
-		c.write(bb, res)
+		if err := c.writeSource(bb, statementSourceLine(stmt, res)); err != nil {
+			return "", fmt.Errorf("line %d: %w", stmt.T().LineNumber, err)
+		}
 	}
 
 	content := bb.String()
@@ -108,8 +146,24 @@ func (c *compiler) compile() (string, error) {
 	return content, nil
 }
 
-func (c *compiler) write(bb *strings.Builder, i interface{}) {
+// writeSource writes the value of a statement, mapping its output to the
+// statement line when a source map is recorded.
+func (c *compiler) writeSource(bb *strings.Builder, sl sourceLine) error {
+	if c.sourceMap == nil {
+		return c.write(bb, sl.value)
+	}
+	start, from := bb.Len(), c.sourceMap.Len()
+	err := c.write(bb, sl.value)
+	c.sourceMap.Fill(bb.String(), start, bb.Len(), from, sl.line, sl.html)
+	return err
+}
+
+// write writes i to bb, failing once the output grows past the maximum
+// output size of the budget.
+func (c *compiler) write(bb *strings.Builder, i interface{}) error {
 	switch t := i.(type) {
+	case sourceLine:
+		return c.writeSource(bb, t)
 	case *ast.HoleStatement:
 		res, _ := c.evalHoleStatement(t)
 		getString := string(res)
@@ -124,17 +178,16 @@ func (c *compiler) write(bb *strings.Builder, i interface{}) {
 		}
 		c.positionStartEnds = append(c.positionStartEnds, st)
 		bb.WriteString(hh)
-		return
 	case time.Time:
 		if dtf, ok := c.ctx.Value("TIME_FORMAT").(string); ok {
 			bb.Write(unsafeGetBytes(t.Format(dtf)))
-			return
+			break
 		}
 		bb.Write(unsafeGetBytes(t.Format(DefaultTimeFormat)))
 	case *time.Time:
-		c.write(bb, *t)
+		return c.write(bb, *t)
 	case interfaceable:
-		c.write(bb, t.Interface())
+		return c.write(bb, t.Interface())
 	case string, ast.Printable, bool:
 		bb.Write(unsafeGetBytes(template.HTMLEscaper(t)))
 	case template.HTML:
@@ -147,17 +200,27 @@ func (c *compiler) write(bb *strings.Builder, i interface{}) {
 		bb.Write(unsafeGetBytes(t.String()))
 	case []string:
 		for _, ii := range t {
-			c.write(bb, ii)
+			if err := c.write(bb, ii); err != nil {
+				return err
+			}
 		}
+		return nil
 	case []interface{}:
 		for _, ii := range t {
-			c.write(bb, ii)
+			if err := c.write(bb, ii); err != nil {
+				return err
+			}
 		}
+		return nil
 	case returnObject:
 		for _, ii := range t.Value {
-			c.write(bb, ii)
+			if err := c.write(bb, ii); err != nil {
+				return err
+			}
 		}
+		return nil
 	}
+	return c.budget().CheckOutput(bb.Len())
 }
 
 func (c *compiler) evalHoleStatement(node *ast.HoleStatement) (template.HTML, error) {
@@ -228,6 +291,12 @@ func (c *compiler) evalAssignExpression(node *ast.AssignExpression) (interface{}
 }
 
 func (c *compiler) evalUserFunction(node *userFunction, args []ast.Expression) (interface{}, error) {
+	if max := c.budget().MaxCallDepth(); c.depth >= max {
+		return nil, fmt.Errorf("%w (%d)", ErrCallDepthExceeded, max)
+	}
+	c.depth++
+	defer func() { c.depth-- }()
+
 	octx := c.ctx
 	defer func() { c.ctx = octx }()
 
@@ -299,6 +368,7 @@ func (c *compiler) evalIfExpression(node *ast.IfExpression) (interface{}, error)
 	}
 
 	if c.isTruthy(con) {
+		c.coverage.HitBranch(node.Token.LineNumber, node.Token.Column, 0)
 		return c.evalBlockStatement(node.Block)
 	}
 
@@ -307,7 +377,7 @@ func (c *compiler) evalIfExpression(node *ast.IfExpression) (interface{}, error)
 
 func (c *compiler) evalElseAndElseIfExpressions(node *ast.IfExpression) (interface{}, error) {
 	var r interface{}
-	for _, eiNode := range node.ElseIf {
+	for i, eiNode := range node.ElseIf {
 		eiCon, err := c.evalExpression(eiNode.Condition)
 		if err != nil {
 			if _, ok := err.(*ErrUnknownIdentifier); !ok {
@@ -316,10 +386,12 @@ func (c *compiler) evalElseAndElseIfExpressions(node *ast.IfExpression) (interfa
 		}
 
 		if c.isTruthy(eiCon) {
+			c.coverage.HitBranch(node.Token.LineNumber, node.Token.Column, i+1)
 			return c.evalBlockStatement(eiNode.Block)
 		}
 	}
 
+	c.coverage.HitBranch(node.Token.LineNumber, node.Token.Column, len(node.ElseIf)+1)
 	if node.ElseBlock != nil {
 		return c.evalBlockStatement(node.ElseBlock)
 	}
@@ -751,6 +823,9 @@ func (c *compiler) evalCallExpression(node *ast.CallExpression) (interface{}, er
 	if i, ok := node.Function.(*ast.Identifier); ok {
 		funcName = i.Value
 	}
+	if err := CheckContext(c.ctx); err != nil {
+		return nil, err
+	}
 	if err := c.budget().SpendFunctionCall(funcName); err != nil {
 		return nil, err
 	}
@@ -1000,11 +1075,14 @@ func (c *compiler) evalForExpression(node *ast.ForExpression) (interface{}, erro
 	}
 
 	ret := []interface{}{}
+	// out measures the output of the iterations so far, which is only
+	// written once the loop ends.
+	out := &strings.Builder{}
 	switch riter.Kind() {
 	case reflect.Map:
 		keys := riter.MapKeys()
 		for i := 0; i < len(keys); i++ {
-			if err := c.budget().SpendLoop(); err != nil {
+			if err := c.spendLoop(); err != nil {
 				return nil, err
 			}
 			k := keys[i]
@@ -1012,6 +1090,7 @@ func (c *compiler) evalForExpression(node *ast.ForExpression) (interface{}, erro
 			c.ctx.Set(node.KeyName, k.Interface())
 			c.ctx.Set(node.ValueName, v.Interface())
 
+			c.coverage.HitBranch(node.Token.LineNumber, node.Token.Column, 0)
 			res, err := c.evalBlockStatement(node.Block)
 			if err != nil {
 				return nil, err
@@ -1028,6 +1107,9 @@ func (c *compiler) evalForExpression(node *ast.ForExpression) (interface{}, erro
 
 			if res != nil {
 				ret = append(ret, res)
+				if err := c.checkLoopOutput(out, res); err != nil {
+					return nil, err
+				}
 			}
 
 			if breakLoop {
@@ -1036,13 +1118,14 @@ func (c *compiler) evalForExpression(node *ast.ForExpression) (interface{}, erro
 		}
 	case reflect.Slice, reflect.Array:
 		for i := 0; i < riter.Len(); i++ {
-			if err := c.budget().SpendLoop(); err != nil {
+			if err := c.spendLoop(); err != nil {
 				return nil, err
 			}
 			v := riter.Index(i)
 			c.ctx.Set(node.KeyName, i)
 			c.ctx.Set(node.ValueName, v.Interface())
 
+			c.coverage.HitBranch(node.Token.LineNumber, node.Token.Column, 0)
 			res, err := c.evalBlockStatement(node.Block)
 			if err != nil {
 				return nil, err
@@ -1059,6 +1142,9 @@ func (c *compiler) evalForExpression(node *ast.ForExpression) (interface{}, erro
 
 			if res != nil {
 				ret = append(ret, res)
+				if err := c.checkLoopOutput(out, res); err != nil {
+					return nil, err
+				}
 			}
 
 			if breakLoop {
@@ -1073,12 +1159,13 @@ func (c *compiler) evalForExpression(node *ast.ForExpression) (interface{}, erro
 			i := 0
 			ii := it.Next()
 			for ii != nil {
-				if err := c.budget().SpendLoop(); err != nil {
+				if err := c.spendLoop(); err != nil {
 					return nil, err
 				}
 				c.ctx.Set(node.KeyName, i)
 				c.ctx.Set(node.ValueName, ii)
 
+				c.coverage.HitBranch(node.Token.LineNumber, node.Token.Column, 0)
 				res, err := c.evalBlockStatement(node.Block)
 				if err != nil {
 					return nil, err
@@ -1095,6 +1182,9 @@ func (c *compiler) evalForExpression(node *ast.ForExpression) (interface{}, erro
 
 				if res != nil {
 					ret = append(ret, res)
+					if err := c.checkLoopOutput(out, res); err != nil {
+						return nil, err
+					}
 				}
 
 				if breakLoop {
@@ -1111,6 +1201,23 @@ func (c *compiler) evalForExpression(node *ast.ForExpression) (interface{}, erro
 	return ret, nil
 }
 
+// checkLoopOutput writes the result of a loop iteration to out, which
+// holds the output of the iterations before it, so that the loop stops as
+// soon as write finds its output too large. The loop result is written
+// again by the statement holding it: out records neither source map
+// segments nor punch holes.
+func (c *compiler) checkLoopOutput(out *strings.Builder, res interface{}) error {
+	if c.budget().MaxOutput() <= 0 {
+		return nil
+	}
+	sm, holes := c.sourceMap, len(c.positionStartEnds)
+	c.sourceMap = nil
+	defer func() {
+		c.sourceMap, c.positionStartEnds = sm, c.positionStartEnds[:holes]
+	}()
+	return c.write(out, res)
+}
+
 func (c *compiler) evalBlockStatement(node *ast.BlockStatement) (interface{}, error) {
 	res := []interface{}{}
 	for _, s := range node.Statements {
@@ -1122,6 +1229,9 @@ func (c *compiler) evalBlockStatement(node *ast.BlockStatement) (interface{}, er
 		val, exitBlock := i.(exitBlockStatment)
 		if !exitBlock {
 			if i != nil {
+				if c.sourceMap != nil {
+					i = statementSourceLine(s, i)
+				}
 				res = append(res, i)
 			}
 		} else {
@@ -1148,6 +1258,14 @@ func (c *compiler) evalBlockStatement(node *ast.BlockStatement) (interface{}, er
 
 func (c *compiler) evalStatement(node ast.Statement) (interface{}, error) {
 	c.curStmt = node
+	if c.debugger != nil {
+		if err := c.debugStep(node); err != nil {
+			return nil, err
+		}
+	}
+	if c.coverage != nil {
+		c.coverStatement(node)
+	}
 
 	switch t := node.(type) {
 	case *ast.ExpressionStatement:
@@ -19,9 +19,11 @@ type Context struct {
 	budget  *Budget
 }
 
-// WithBudget attaches a Budget to this context. Returns self for chaining.
+// WithBudget attaches a Budget to this context and starts its clock when
+// it tracks time. Returns self for chaining.
 func (c *Context) WithBudget(b *Budget) *Context {
 	c.budget = b
+	b.startClock()
 	return c
 }
 
@@ -183,7 +185,7 @@ func NewContextWith(data map[string]interface{}) *Context {
 // seccond argument.
 func NewContextWithOuter(data map[string]interface{}, out *Context) *Context {
 	c := &Context{
-		Context: context.Background(),
+		Context: out.Context,
 		data:    newScopeWithCapacity(out.data, len(data)),
 		helpers: out.helpers,
 		outer:   out,
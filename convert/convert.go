@@ -0,0 +1,114 @@
+// Package convert translates templates written for Go's html/template
+// and text/template, or for ERB, into Plush.
+//
+// Conversion is best effort. Everything that has a Plush equivalent is
+// translated; every construct that hasn't is kept in the output as a
+// Plush comment and reported as an Issue, so that the result always
+// parses and nothing is dropped silently.
+package convert
+
+import (
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+)
+
+// Issue is a construct that could not be translated.
+type Issue struct {
+	File    string
+	Line    int
+	Source  string
+	Message string
+}
+
+// String formats the issue as `file:line: message: source`.
+func (i Issue) String() string {
+	return fmt.Sprintf("%s:%d: %s: %s", i.File, i.Line, i.Message, i.Source)
+}
+
+// Result is a converted template.
+type Result struct {
+	// Source is the Plush source of the template.
+	Source string
+	// Partials holds the Plush source of the templates the converted one
+	// defines, keyed by the name Source calls them with through partial().
+	// Use PartialFile for the file each one should be saved as.
+	Partials map[string]string
+	// Issues lists the constructs that were not translated, in the order
+	// they appear in the input.
+	Issues []Issue
+}
+
+// PartialNames returns the names of the partials, sorted.
+func (r *Result) PartialNames() []string {
+	names := make([]string, 0, len(r.Partials))
+	for name := range r.Partials {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// PartialFile returns the file a partial called with name is looked up
+// in by Buffalo and `plush render`: `users/row.html` is saved as
+// `users/_row.plush.html`.
+func PartialFile(name string) string {
+	dir, base := path.Split(name)
+	if !strings.HasPrefix(base, "_") {
+		base = "_" + base
+	}
+	if ext := path.Ext(base); ext != "" && !strings.HasSuffix(base, ".plush"+ext) {
+		base = strings.TrimSuffix(base, ext) + ".plush" + ext
+	}
+	return dir + base
+}
+
+// issues collects the issues of a conversion.
+type issues struct {
+	file string
+	list []Issue
+}
+
+// unconverted reports source as untranslatable and returns the Plush
+// comment that keeps it in the output.
+func (is *issues) unconverted(line int, source, format string, args ...interface{}) string {
+	is.list = append(is.list, Issue{
+		File:    is.file,
+		Line:    line,
+		Source:  source,
+		Message: fmt.Sprintf(format, args...),
+	})
+	return "<%# unconverted: " + strings.ReplaceAll(source, "%>", "% >") + " %>"
+}
+
+// escapeText escapes the sequences Plush would read as the start of a tag
+// in literal text.
+func escapeText(s string) string {
+	return strings.ReplaceAll(s, "<%", "\\<%")
+}
+
+// lineAt returns the 1-based line of byte offset pos in src.
+func lineAt(src string, pos int) int {
+	if pos > len(src) {
+		pos = len(src)
+	}
+	return strings.Count(src[:pos], "\n") + 1
+}
+
+// uniqueName returns base, or base followed by a number, whichever is not
+// in used yet, and marks it used.
+func uniqueName(used map[string]bool, base string) string {
+	name := base
+	for i := 2; used[name] || plushKeywords[name]; i++ {
+		name = fmt.Sprintf("%s%d", base, i)
+	}
+	used[name] = true
+	return name
+}
+
+var plushKeywords = map[string]bool{
+	"fn": true, "func": true, "let": true, "true": true, "false": true,
+	"if": true, "else": true, "return": true, "for": true, "in": true,
+	"continue": true, "break": true, "nil": true,
+}
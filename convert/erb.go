@@ -0,0 +1,492 @@
+package convert
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// ERB converts an ERB template, the subset of embedded Ruby that views
+// usually hold: <%= expression %> tags, if/elsif/else/unless, each,
+// each_with_index, each_pair and for loops, assignments, comments and
+// render calls. file names the template in issues.
+//
+// Instance variables become context variables (@user is user), symbols
+// become strings, and and/or/not become &&, || and !. A few common
+// methods become helpers: size, length and count are len, nil?, empty?,
+// any? and present? are comparisons, and upcase, downcase, capitalize,
+// pluralize, singularize and camelize are the inflection helpers.
+// Other methods are kept as method calls, and render "users/row" is
+// partial("users/row.html").
+//
+// Anything else, blocks other than loops, ternaries, string interpolation
+// or method calls without parentheses, is reported and kept as a comment.
+func ERB(file, src string) (*Result, error) {
+	c := &erbConverter{
+		issues: issues{file: file},
+		scopes: []map[string]bool{{}},
+	}
+	var out strings.Builder
+	pos := 0
+	for {
+		i := strings.Index(src[pos:], "<%")
+		if i < 0 {
+			out.WriteString(erbText(src[pos:]))
+			break
+		}
+		start := pos + i
+		out.WriteString(erbText(src[pos:start]))
+		if strings.HasPrefix(src[start:], "<%%") {
+			// <%% is a literal <% in ERB.
+			out.WriteString("\\<%")
+			pos = start + 3
+			continue
+		}
+		end := strings.Index(src[start:], "%>")
+		if end < 0 {
+			return nil, fmt.Errorf("%s:%d: unclosed <%%", file, lineAt(src, start))
+		}
+		end += start
+		pos = end + 2
+
+		body := src[start+2 : end]
+		trim := strings.HasSuffix(body, "-")
+		body = strings.TrimSuffix(body, "-")
+		if trim && strings.HasPrefix(src[pos:], "\n") {
+			// -%> swallows the newline that follows the tag.
+			pos++
+		}
+		out.WriteString(c.tag(lineAt(src, start), src[start:end+2], body))
+	}
+	if len(c.blocks) > 0 {
+		return nil, fmt.Errorf("%s: %d blocks are not closed with end", file, len(c.blocks))
+	}
+	return &Result{Source: out.String(), Partials: map[string]string{}, Issues: c.list}, nil
+}
+
+// erbText converts literal text, where ERB reads %%> as %>.
+func erbText(s string) string {
+	return escapeText(strings.ReplaceAll(s, "%%>", "%>"))
+}
+
+type erbConverter struct {
+	issues
+	// blocks are the kinds of the blocks open: "if", "loop", or "" for a
+	// block that was not converted.
+	blocks []string
+	// scopes are the variables declared in each open block.
+	scopes []map[string]bool
+}
+
+var (
+	erbIf       = regexp.MustCompile(`^if\s+(.+?)(?:\s+then)?$`)
+	erbUnless   = regexp.MustCompile(`^unless\s+(.+?)(?:\s+then)?$`)
+	erbElsif    = regexp.MustCompile(`^elsif\s+(.+?)(?:\s+then)?$`)
+	erbEach     = regexp.MustCompile(`^(.+?)\.(each|each_with_index|each_pair)\s+do\s*\|\s*(\w+)(?:\s*,\s*(\w+))?\s*\|$`)
+	erbFor      = regexp.MustCompile(`^for\s+(\w+)(?:\s*,\s*(\w+))?\s+in\s+(.+?)(?:\s+do)?$`)
+	erbAssign   = regexp.MustCompile(`^@?([a-z_]\w*)\s*=([^=~].*)$`)
+	erbBlock    = regexp.MustCompile(`\s(do|\{)\s*(\|[^|]*\|)?$`)
+	erbRender   = regexp.MustCompile(`^render\s*\(?\s*(?:partial:\s*)?("[^"]+"|'[^']+')\s*(?:,\s*(.*?))?\s*\)?$`)
+	erbLocalsKw = regexp.MustCompile(`^locals:\s*`)
+)
+
+func (c *erbConverter) tag(line int, source, body string) string {
+	switch {
+	case strings.HasPrefix(body, "#"):
+		return "<%# " + strings.ReplaceAll(strings.TrimSpace(body[1:]), "%>", "% >") + " %>"
+	case strings.HasPrefix(body, "="):
+		return c.output(line, source, strings.TrimSpace(body[1:]))
+	}
+	code := strings.TrimSpace(strings.TrimPrefix(body, "-"))
+	if code == "" {
+		return ""
+	}
+	if strings.ContainsAny(code, "\n;") {
+		return c.unconverted(line, source, "several statements in one tag are not converted")
+	}
+
+	if m := erbIf.FindStringSubmatch(code); m != nil {
+		return c.open(line, source, "if", "if (%s)", m[1])
+	}
+	if m := erbUnless.FindStringSubmatch(code); m != nil {
+		return c.open(line, source, "if", "if (!(%s))", m[1])
+	}
+	if m := erbElsif.FindStringSubmatch(code); m != nil {
+		if c.top() != "if" {
+			return c.unconverted(line, source, "elsif outside of if")
+		}
+		cond, err := erbExpr(m[1])
+		if err != nil {
+			return c.unconverted(line, source, "%s", err)
+		}
+		c.closeScope()
+		c.openScope()
+		return "<% } else if (" + cond + ") { %>"
+	}
+	if code == "else" {
+		if c.top() != "if" {
+			return c.unconverted(line, source, "else outside of if")
+		}
+		c.closeScope()
+		c.openScope()
+		return "<% } else { %>"
+	}
+	if code == "end" {
+		if len(c.blocks) == 0 {
+			return c.unconverted(line, source, "end without a block")
+		}
+		kind := c.top()
+		c.blocks = c.blocks[:len(c.blocks)-1]
+		c.closeScope()
+		if kind == "" {
+			return "<%# end of an unconverted block %>"
+		}
+		return "<% } %>"
+	}
+	if m := erbEach.FindStringSubmatch(code); m != nil {
+		vars := m[3]
+		switch {
+		case m[4] != "" && m[2] == "each_with_index":
+			vars = m[4] + ", " + m[3]
+		case m[4] != "":
+			vars = m[3] + ", " + m[4]
+		}
+		return c.loop(line, source, vars, m[1])
+	}
+	if m := erbFor.FindStringSubmatch(code); m != nil {
+		vars := m[1]
+		if m[2] != "" {
+			vars += ", " + m[2]
+		}
+		return c.loop(line, source, vars, m[3])
+	}
+	if m := erbAssign.FindStringSubmatch(code); m != nil {
+		value, err := erbExpr(m[2])
+		if err != nil {
+			return c.unconverted(line, source, "%s", err)
+		}
+		if c.declared(m[1]) {
+			return "<% " + m[1] + " = " + value + " %>"
+		}
+		c.scopes[len(c.scopes)-1][m[1]] = true
+		return "<% let " + m[1] + " = " + value + " %>"
+	}
+	if erbBlock.MatchString(code) {
+		c.push("")
+		return c.unconverted(line, source, "blocks other than loops are not converted")
+	}
+	return c.unconverted(line, source, "unsupported statement")
+}
+
+// output converts the expression of a <%= %> tag.
+func (c *erbConverter) output(line int, source, code string) string {
+	if m := erbRender.FindStringSubmatch(code); m != nil {
+		return c.render(line, source, m[1], m[2])
+	}
+	if erbBlock.MatchString(code) {
+		c.push("")
+		return c.unconverted(line, source, "blocks other than loops are not converted")
+	}
+	e, err := erbExpr(code)
+	if err != nil {
+		return c.unconverted(line, source, "%s", err)
+	}
+	return "<%= " + e + " %>"
+}
+
+// render converts a render call of the partial name, a quoted Ruby
+// string, with the locals in args.
+func (c *erbConverter) render(line int, source, name, args string) string {
+	name = strings.Trim(name, `"'`) + ".html"
+	if args == "" {
+		return "<%= partial(" + strconv.Quote(name) + ") %>"
+	}
+	args = strings.TrimSpace(erbLocalsKw.ReplaceAllString(args, ""))
+	if !strings.HasPrefix(args, "{") {
+		args = "{" + args + "}"
+	}
+	locals, err := erbExpr(args)
+	if err != nil {
+		return c.unconverted(line, source, "%s", err)
+	}
+	return "<%= partial(" + strconv.Quote(name) + ", " + locals + ") %>"
+}
+
+// open converts the condition cond of a block and formats it with format
+// to make the head of the block.
+func (c *erbConverter) open(line int, source, kind, format, cond string) string {
+	e, err := erbExpr(cond)
+	if err != nil {
+		c.push("")
+		return c.unconverted(line, source, "%s", err)
+	}
+	c.push(kind)
+	return "<%= " + fmt.Sprintf(format, e) + " { %>"
+}
+
+func (c *erbConverter) loop(line int, source, vars, iter string) string {
+	e, err := erbExpr(iter)
+	if err != nil {
+		c.push("")
+		return c.unconverted(line, source, "%s", err)
+	}
+	c.push("loop")
+	for _, v := range strings.Split(vars, ", ") {
+		c.scopes[len(c.scopes)-1][v] = true
+	}
+	return "<%= for (" + vars + ") in " + e + " { %>"
+}
+
+func (c *erbConverter) top() string {
+	if len(c.blocks) == 0 {
+		return "none"
+	}
+	return c.blocks[len(c.blocks)-1]
+}
+
+func (c *erbConverter) push(kind string) {
+	c.blocks = append(c.blocks, kind)
+	c.openScope()
+}
+
+func (c *erbConverter) openScope() {
+	c.scopes = append(c.scopes, map[string]bool{})
+}
+
+func (c *erbConverter) closeScope() {
+	c.scopes = c.scopes[:len(c.scopes)-1]
+}
+
+func (c *erbConverter) declared(name string) bool {
+	for _, scope := range c.scopes {
+		if scope[name] {
+			return true
+		}
+	}
+	return false
+}
+
+// rubyToken is a token of a Ruby expression. kind is 'i' for identifiers
+// and instance variables, 'v' for literals, 'l' for hash labels and 'o'
+// for operators and punctuation.
+type rubyToken struct {
+	kind byte
+	text string
+}
+
+func isRubyWord(ch byte) bool {
+	return ch == '_' || ch >= 'a' && ch <= 'z' || ch >= 'A' && ch <= 'Z' || ch >= '0' && ch <= '9'
+}
+
+func scanRuby(s string) ([]rubyToken, error) {
+	var toks []rubyToken
+	for i := 0; i < len(s); {
+		ch := s[i]
+		switch {
+		case ch == ' ' || ch == '\t' || ch == '\n' || ch == '\r':
+			i++
+		case ch == '@':
+			j := i + 1
+			for j < len(s) && isRubyWord(s[j]) {
+				j++
+			}
+			if j == i+1 || strings.HasPrefix(s[j:], "@") {
+				return nil, fmt.Errorf("class variables have no Plush equivalent")
+			}
+			toks = append(toks, rubyToken{'i', s[i+1 : j]})
+			i = j
+		case isRubyWord(ch) && (ch < '0' || ch > '9'):
+			j := i
+			for j < len(s) && isRubyWord(s[j]) {
+				j++
+			}
+			if j < len(s) && (s[j] == '?' || s[j] == '!') && !strings.HasPrefix(s[j+1:], "=") {
+				j++
+			}
+			if j < len(s) && s[j] == ':' && !strings.HasPrefix(s[j+1:], ":") {
+				toks = append(toks, rubyToken{'l', s[i:j]})
+				i = j + 1
+				continue
+			}
+			toks = append(toks, rubyToken{'i', s[i:j]})
+			i = j
+		case ch >= '0' && ch <= '9':
+			j := i
+			for j < len(s) && (s[j] >= '0' && s[j] <= '9' || s[j] == '_' ||
+				s[j] == '.' && j+1 < len(s) && s[j+1] >= '0' && s[j+1] <= '9') {
+				j++
+			}
+			toks = append(toks, rubyToken{'v', strings.ReplaceAll(s[i:j], "_", "")})
+			i = j
+		case ch == '"' || ch == '\'':
+			j := i + 1
+			for j < len(s) && s[j] != ch {
+				if s[j] == '\\' {
+					j++
+				}
+				j++
+			}
+			if j >= len(s) {
+				return nil, fmt.Errorf("unterminated string")
+			}
+			text := s[i+1 : j]
+			if ch == '"' {
+				if strings.Contains(text, "#{") {
+					return nil, fmt.Errorf("string interpolation has no Plush equivalent")
+				}
+				toks = append(toks, rubyToken{'v', s[i : j+1]})
+			} else {
+				text = strings.NewReplacer(`\'`, `'`, `\\`, `\`).Replace(text)
+				toks = append(toks, rubyToken{'v', strconv.Quote(text)})
+			}
+			i = j + 1
+		case ch == ':' && i+1 < len(s) && isRubyWord(s[i+1]):
+			j := i + 1
+			for j < len(s) && isRubyWord(s[j]) {
+				j++
+			}
+			toks = append(toks, rubyToken{'v', strconv.Quote(s[i+1 : j])})
+			i = j
+		default:
+			op := s[i : i+1]
+			if i+1 < len(s) {
+				switch two := s[i : i+2]; two {
+				case "==", "!=", "<=", ">=", "&&", "||", "=>", "..", "::", "<<", "**", "&.":
+					op = two
+				}
+			}
+			toks = append(toks, rubyToken{'o', op})
+			i += len(op)
+		}
+	}
+	return toks, nil
+}
+
+// erbMethods are the Ruby methods without arguments converted to Plush
+// expressions of their receiver.
+var erbMethods = map[string]string{
+	"size":        "len(%s)",
+	"length":      "len(%s)",
+	"count":       "len(%s)",
+	"nil?":        "(%s == nil)",
+	"empty?":      "(len(%s) == 0)",
+	"any?":        "(len(%s) > 0)",
+	"present?":    "(len(%s) > 0)",
+	"first":       "%s[0]",
+	"to_s":        "%s",
+	"html_safe":   "raw(%s)",
+	"upcase":      "upcase(%s)",
+	"downcase":    "downcase(%s)",
+	"capitalize":  "capitalize(%s)",
+	"pluralize":   "pluralize(%s)",
+	"singularize": "singularize(%s)",
+	"camelize":    "camelize(%s)",
+}
+
+var rubyWordOperators = map[string]string{
+	"and": " && ", "or": " || ", "not": "!",
+}
+
+var rubyUnsupported = map[string]bool{
+	"do": true, "if": true, "unless": true, "then": true, "end": true,
+	"yield": true, "lambda": true, "proc": true, "while": true, "case": true,
+}
+
+// erbExpr converts a Ruby expression.
+func erbExpr(s string) (string, error) {
+	toks, err := scanRuby(s)
+	if err != nil {
+		return "", err
+	}
+	var out []string
+	// primary is where the operand being built starts in out, the
+	// receiver of the methods rewritten around it.
+	primary := 0
+	var groups []int
+	value := false
+	for i := 0; i < len(toks); i++ {
+		t := toks[i]
+		switch t.kind {
+		case 'i', 'v':
+			if t.kind == 'i' {
+				switch op := rubyWordOperators[t.text]; {
+				case op != "":
+					out = append(out, op)
+					value = false
+					continue
+				case t.text == "h" && !value && i+1 < len(toks) && toks[i+1].text == "(":
+					// Plush escapes output already.
+					continue
+				case rubyUnsupported[t.text]:
+					return "", fmt.Errorf("%s has no Plush equivalent in an expression", t.text)
+				}
+			}
+			if value {
+				return "", fmt.Errorf("method calls without parentheses have no Plush equivalent")
+			}
+			primary = len(out)
+			out = append(out, t.text)
+			value = true
+		case 'l':
+			out = append(out, t.text+": ")
+			value = false
+		default:
+			switch t.text {
+			case "(", "[", "{":
+				if value && t.text == "{" {
+					return "", fmt.Errorf("blocks have no Plush equivalent")
+				}
+				if value {
+					groups = append(groups, primary)
+				} else {
+					groups = append(groups, len(out))
+				}
+				out = append(out, t.text)
+				value = false
+			case ")", "]", "}":
+				if len(groups) == 0 {
+					return "", fmt.Errorf("unbalanced %s", t.text)
+				}
+				primary = groups[len(groups)-1]
+				groups = groups[:len(groups)-1]
+				out = append(out, t.text)
+				value = true
+			case ".", "&.":
+				if !value || i+1 >= len(toks) || toks[i+1].kind != 'i' {
+					return "", fmt.Errorf("unexpected %s", t.text)
+				}
+				i++
+				name := toks[i].text
+				call := i+1 < len(toks) && toks[i+1].text == "("
+				if format, ok := erbMethods[name]; ok && !call {
+					receiver := strings.Join(out[primary:], "")
+					out = append(out[:primary], fmt.Sprintf(format, receiver))
+					continue
+				}
+				if strings.HasSuffix(name, "?") || strings.HasSuffix(name, "!") {
+					return "", fmt.Errorf("method %s has no Plush equivalent", name)
+				}
+				out = append(out, "."+name)
+			case ",":
+				out = append(out, ", ")
+				value = false
+			case "!":
+				out = append(out, "!")
+				value = false
+			case "+", "-", "*", "/", "%", "==", "!=", "<", ">", "<=", ">=", "&&", "||":
+				out = append(out, " "+t.text+" ")
+				value = false
+			default:
+				return "", fmt.Errorf("operator %s has no Plush equivalent", t.text)
+			}
+		}
+	}
+	if len(groups) > 0 {
+		return "", fmt.Errorf("unbalanced parentheses")
+	}
+	if len(out) == 0 {
+		return "", fmt.Errorf("empty expression")
+	}
+	return strings.Join(out, ""), nil
+}
@@ -0,0 +1,88 @@
+package convert_test
+
+import (
+	"testing"
+
+	"github.com/gobuffalo/plush/v5/convert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ERB(t *testing.T) {
+	r := require.New(t)
+	res, err := convert.ERB("index.html.erb", `<h1><%= @title.upcase %></h1>
+<%# the users -%>
+<% if @users.empty? %>
+none
+<% elsif @users.size > 1 and not @admin %>
+<%= @users.length %> users
+<% else %>
+one
+<% end %>
+<% @users.each_with_index do |u, i| -%>
+<% total = i + 1 %><%= total %>. <%= h(u) %> <%= render "users/row", who: u %>
+<% end %>
+<% for name in @names %><%= name.nil? %>|<%= :sym %>|<%= 'it\'s' %><% end %>
+<%% literal %%>`)
+	r.NoError(err)
+	r.Empty(res.Issues)
+	r.Equal(`<h1><%= upcase(title) %></h1>
+<%# the users %><%= if ((len(users) == 0)) { %>
+none
+<% } else if (len(users) > 1 && !admin) { %>
+<%= len(users) %> users
+<% } else { %>
+one
+<% } %>
+<%= for (i, u) in users { %><% let total = i + 1 %><%= total %>. <%= (u) %> <%= partial("users/row.html", {who: u}) %>
+<% } %>
+<%= for (name) in names { %><%= (name == nil) %>|<%= "sym" %>|<%= "it's" %><% } %>
+\<% literal %>`, res.Source)
+}
+
+func Test_ERB_Renders(t *testing.T) {
+	r := require.New(t)
+	res, err := convert.ERB("index.html.erb", `<% count = 0 %><% @users.each do |u| %><% count = count + 1 %><%= u.upcase %> <% end %><%= count %>
+<% unless @admin %><%= render partial: "row", locals: { who: "ann" } %><% end %>`)
+	r.NoError(err)
+	r.Empty(res.Issues)
+	res.Partials["row.html"] = `<b><%= who %></b>`
+	out := renderPlush(t, res, map[string]interface{}{
+		"users": []string{"mark", "paul"},
+		"admin": false,
+	})
+	r.Equal("MARK PAUL 2\n<b>ann</b>", out)
+}
+
+func Test_ERB_Issues(t *testing.T) {
+	r := require.New(t)
+	res, err := convert.ERB("index.html.erb", `<%= form_for @user do |f| %>
+<%= f.text_field :name %>
+<% end %>
+<%= @admin ? "yes" : "no" %>
+<%= "hi #{@name}" %>
+<% x = 1; y = 2 %>`)
+	r.NoError(err)
+	r.Equal(`<%# unconverted: <%= form_for @user do |f| % > %>
+<%# unconverted: <%= f.text_field :name % > %>
+<%# end of an unconverted block %>
+<%# unconverted: <%= @admin ? "yes" : "no" % > %>
+<%# unconverted: <%= "hi #{@name}" % > %>
+<%# unconverted: <% x = 1; y = 2 % > %>`, res.Source)
+
+	var messages []string
+	for _, issue := range res.Issues {
+		messages = append(messages, issue.String())
+	}
+	r.Equal([]string{
+		`index.html.erb:1: blocks other than loops are not converted: <%= form_for @user do |f| %>`,
+		`index.html.erb:2: method calls without parentheses have no Plush equivalent: <%= f.text_field :name %>`,
+		`index.html.erb:4: operator ? has no Plush equivalent: <%= @admin ? "yes" : "no" %>`,
+		`index.html.erb:5: string interpolation has no Plush equivalent: <%= "hi #{@name}" %>`,
+		`index.html.erb:6: several statements in one tag are not converted: <% x = 1; y = 2 %>`,
+	}, messages)
+
+	_, err = convert.ERB("index.html.erb", `<% if @a %>`)
+	r.Error(err)
+	_, err = convert.ERB("index.html.erb", `<%= a`)
+	r.Error(err)
+}
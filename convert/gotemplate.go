@@ -0,0 +1,715 @@
+package convert
+
+import (
+	"fmt"
+	"path"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"text/template/parse"
+)
+
+// GoTemplateOptions configures GoTemplate.
+type GoTemplateOptions struct {
+	// PartialExt is added to the names of the templates called with
+	// {{template}} or {{block}} to make the names of their partials, so
+	// that "header" is called as partial("header.html"). Defaults to
+	// ".html"; names that already have an extension are kept.
+	PartialExt string
+	// LeftDelim and RightDelim are the action delimiters, when they are
+	// not {{ and }}.
+	LeftDelim  string
+	RightDelim string
+}
+
+// GoTemplate converts a template written for html/template or
+// text/template. file names the template in issues.
+//
+// The fields of the data the template is executed with become variables
+// of the context: {{.User.Name}} is converted to <%= User.Name %>, so the
+// values set with ctx.Set take the place of the data. Inside range and
+// with, dot is the loop variable or the value tested. Functions other than
+// the builtins are called as helpers of the same name, which have to be
+// registered with Plush.
+//
+// Templates defined with {{define}} or {{block}} become partials. A
+// {{template}} call with another dot than the template's own passes the
+// fields the partial uses: {{template "row" .User}} is converted to
+// partial("row.html", {Name: User.Name}) when "row" only reads .Name.
+//
+// A few things differ at run time and are not reported: Plush treats
+// empty slices and maps and zero numbers as true, ranges over maps in
+// random order, and escapes output the same way in every context where
+// html/template escapes JavaScript, CSS and URLs contextually. Fields
+// are read as properties, so niladic methods, which Go templates call
+// implicitly, need parentheses added by hand.
+func GoTemplate(file, src string, opts GoTemplateOptions) (*Result, error) {
+	if opts.PartialExt == "" {
+		opts.PartialExt = ".html"
+	}
+	t := parse.New(file)
+	t.Mode = parse.ParseComments | parse.SkipFuncCheck
+	trees := map[string]*parse.Tree{}
+	if _, err := t.Parse(src, opts.LeftDelim, opts.RightDelim, trees); err != nil {
+		return nil, err
+	}
+
+	c := &goConverter{
+		src:    src,
+		opts:   opts,
+		trees:  trees,
+		issues: issues{file: file},
+		uses:   map[string]*rootUse{},
+	}
+	res := &Result{Partials: map[string]string{}}
+	res.Source = c.template(t)
+	names := make([]string, 0, len(trees))
+	for name := range trees {
+		if name != file {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		res.Partials[c.partialName(name)] = c.template(trees[name])
+	}
+	sort.SliceStable(c.list, func(i, j int) bool {
+		return c.list[i].Line < c.list[j].Line
+	})
+	res.Issues = c.list
+	return res, nil
+}
+
+type goConverter struct {
+	src   string
+	opts  GoTemplateOptions
+	trees map[string]*parse.Tree
+	issues
+	uses map[string]*rootUse
+
+	// The state of the template being converted.
+	out  *strings.Builder
+	dots []string
+	used map[string]bool
+}
+
+// expr is a converted Plush expression. compound expressions are wrapped
+// in parentheses when used as operands.
+type expr struct {
+	s        string
+	compound bool
+}
+
+func (e expr) operand() string {
+	if e.compound {
+		return "(" + e.s + ")"
+	}
+	return e.s
+}
+
+// convertError is a construct with no Plush equivalent.
+type convertError struct {
+	msg string
+}
+
+func (e *convertError) Error() string { return e.msg }
+
+func unsupported(format string, args ...interface{}) error {
+	return &convertError{msg: fmt.Sprintf(format, args...)}
+}
+
+func (c *goConverter) template(t *parse.Tree) string {
+	c.out = &strings.Builder{}
+	c.dots = []string{""}
+	c.used = map[string]bool{}
+	if t.Root == nil {
+		return ""
+	}
+	walkGoNodes(t.Root, func(n parse.Node) {
+		switch n := n.(type) {
+		case *parse.VariableNode:
+			c.used[c.variable(n.Ident[0])] = true
+		case *parse.FieldNode:
+			c.used[n.Ident[0]] = true
+		}
+	})
+	c.node(t.Root)
+	return c.out.String()
+}
+
+// dot returns the Plush expression dot stands for, or "" at the top level
+// of a template, where the fields of dot are context variables.
+func (c *goConverter) dot() string {
+	return c.dots[len(c.dots)-1]
+}
+
+func (c *goConverter) partialName(name string) string {
+	if path.Ext(name) != "" {
+		return name
+	}
+	return name + c.opts.PartialExt
+}
+
+// variable returns the Plush name of the Go template variable $name.
+func (c *goConverter) variable(name string) string {
+	name = strings.TrimPrefix(name, "$")
+	if name == "" {
+		return ""
+	}
+	if plushKeywords[name] {
+		return name + "_"
+	}
+	return name
+}
+
+func (c *goConverter) unconvertedNode(n parse.Node, err error) string {
+	return c.unconverted(lineAt(c.src, int(n.Position())), n.String(), "%s", err)
+}
+
+func (c *goConverter) node(n parse.Node) {
+	switch n := n.(type) {
+	case *parse.ListNode:
+		for _, child := range n.Nodes {
+			c.node(child)
+		}
+	case *parse.TextNode:
+		c.out.WriteString(escapeText(string(n.Text)))
+	case *parse.CommentNode:
+		text := strings.TrimSuffix(strings.TrimPrefix(n.Text, "/*"), "*/")
+		c.out.WriteString("<%# " + strings.ReplaceAll(strings.TrimSpace(text), "%>", "% >") + " %>")
+	case *parse.ActionNode:
+		c.action(n)
+	case *parse.IfNode:
+		c.ifChain(n)
+	case *parse.RangeNode:
+		c.rangeNode(n)
+	case *parse.WithNode:
+		c.withNode(n)
+	case *parse.TemplateNode:
+		c.templateNode(n)
+	case *parse.BreakNode:
+		c.out.WriteString("<% break %>")
+	case *parse.ContinueNode:
+		c.out.WriteString("<% continue %>")
+	default:
+		c.out.WriteString(c.unconvertedNode(n, unsupported("unsupported construct")))
+	}
+}
+
+func (c *goConverter) action(n *parse.ActionNode) {
+	e, err := c.pipeline(n.Pipe)
+	if err != nil {
+		c.out.WriteString(c.unconvertedNode(n, err))
+		return
+	}
+	if len(n.Pipe.Decl) == 0 {
+		c.out.WriteString("<%= " + e.s + " %>")
+		return
+	}
+	name := c.variable(n.Pipe.Decl[0].Ident[0])
+	if n.Pipe.IsAssign {
+		c.out.WriteString("<% " + name + " = " + e.s + " %>")
+		return
+	}
+	c.out.WriteString("<% let " + name + " = " + e.s + " %>")
+}
+
+// condition converts the pipeline of an if, range or with. Variables it
+// declares are bound with let statements written before the construct.
+// A pipeline that can't be converted is reported and replaced with nil.
+func (c *goConverter) condition(n parse.Node, pipe *parse.PipeNode) expr {
+	e, err := c.pipeline(pipe)
+	if err != nil {
+		c.out.WriteString(c.unconverted(lineAt(c.src, int(n.Position())), pipe.String(), "%s; replaced with nil", err))
+		return expr{s: "nil"}
+	}
+	return e
+}
+
+func (c *goConverter) ifChain(n *parse.IfNode) {
+	cond := c.condition(n, n.Pipe)
+	if len(n.Pipe.Decl) > 0 {
+		cond = expr{s: c.bindDecl(n.Pipe, cond)}
+	}
+	c.out.WriteString("<%= if (" + cond.s + ") { %>")
+	c.node(n.List)
+	for n.ElseList != nil {
+		if len(n.ElseList.Nodes) == 1 {
+			if elseIf, ok := n.ElseList.Nodes[0].(*parse.IfNode); ok && len(elseIf.Pipe.Decl) == 0 {
+				cond := c.condition(elseIf, elseIf.Pipe)
+				c.out.WriteString("<% } else if (" + cond.s + ") { %>")
+				c.node(elseIf.List)
+				n = elseIf
+				continue
+			}
+		}
+		c.out.WriteString("<% } else { %>")
+		c.node(n.ElseList)
+		break
+	}
+	c.out.WriteString("<% } %>")
+}
+
+// bindDecl writes the let statement binding a variable declared by the
+// pipeline of an if or with, and returns its Plush name.
+func (c *goConverter) bindDecl(pipe *parse.PipeNode, value expr) string {
+	name := c.variable(pipe.Decl[0].Ident[0])
+	c.out.WriteString("<% let " + name + " = " + value.s + " %>")
+	return name
+}
+
+func (c *goConverter) rangeNode(n *parse.RangeNode) {
+	iter := c.condition(n, n.Pipe)
+	key, value := "", ""
+	switch len(n.Pipe.Decl) {
+	case 0:
+		value = uniqueName(c.used, elementName(iter.s))
+	case 1:
+		value = c.variable(n.Pipe.Decl[0].Ident[0])
+	default:
+		key = c.variable(n.Pipe.Decl[0].Ident[0])
+		value = c.variable(n.Pipe.Decl[1].Ident[0])
+	}
+	loop := "for (" + value + ") in " + iter.operand() + " { %>"
+	if key != "" {
+		loop = "for (" + key + ", " + value + ") in " + iter.operand() + " { %>"
+	}
+
+	if n.ElseList != nil {
+		c.out.WriteString("<%= if (len(" + iter.s + ") > 0) { %>")
+	}
+	c.out.WriteString("<%= " + loop)
+	c.dots = append(c.dots, value)
+	c.node(n.List)
+	c.dots = c.dots[:len(c.dots)-1]
+	c.out.WriteString("<% } %>")
+	if n.ElseList != nil {
+		c.out.WriteString("<% } else { %>")
+		c.node(n.ElseList)
+		c.out.WriteString("<% } %>")
+	}
+}
+
+var pathExpr = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*(\.[A-Za-z_][A-Za-z0-9_]*)*$`)
+
+func (c *goConverter) withNode(n *parse.WithNode) {
+	value := c.condition(n, n.Pipe)
+	dot := value.s
+	switch {
+	case len(n.Pipe.Decl) > 0:
+		dot = c.bindDecl(n.Pipe, value)
+	case !pathExpr.MatchString(dot) || plushKeywords[dot]:
+		dot = uniqueName(c.used, "with")
+		c.out.WriteString("<% let " + dot + " = " + value.s + " %>")
+	}
+
+	c.out.WriteString("<%= if (" + dot + ") { %>")
+	c.dots = append(c.dots, dot)
+	c.node(n.List)
+	c.dots = c.dots[:len(c.dots)-1]
+	if n.ElseList != nil {
+		c.out.WriteString("<% } else { %>")
+		c.node(n.ElseList)
+	}
+	c.out.WriteString("<% } %>")
+}
+
+func (c *goConverter) templateNode(n *parse.TemplateNode) {
+	name := strconv.Quote(c.partialName(n.Name))
+	if n.Pipe == nil || c.isRootDot(n.Pipe) {
+		c.out.WriteString("<%= partial(" + name + ") %>")
+		return
+	}
+	if _, ok := c.trees[n.Name]; !ok {
+		c.out.WriteString(c.unconvertedNode(n, unsupported("template %q is not defined in this file, so the fields to pass it are unknown", n.Name)))
+		return
+	}
+	use := c.rootUse(n.Name)
+	if use.whole {
+		c.out.WriteString(c.unconvertedNode(n, unsupported("template %q uses its whole data, which can only be passed to a partial as fields", n.Name)))
+		return
+	}
+	e, err := c.pipeline(n.Pipe)
+	if err != nil {
+		c.out.WriteString(c.unconvertedNode(n, err))
+		return
+	}
+	if len(use.fields) == 0 {
+		c.out.WriteString("<%= partial(" + name + ") %>")
+		return
+	}
+	fields := make([]string, len(use.fields))
+	for i, f := range use.fields {
+		fields[i] = f + ": " + e.operand() + "." + f
+	}
+	c.out.WriteString("<%= partial(" + name + ", {" + strings.Join(fields, ", ") + "}) %>")
+}
+
+// isRootDot reports whether pipe is the data of the template at its top
+// level: `.` outside of range and with, or `$`.
+func (c *goConverter) isRootDot(pipe *parse.PipeNode) bool {
+	if len(pipe.Decl) > 0 || len(pipe.Cmds) != 1 || len(pipe.Cmds[0].Args) != 1 {
+		return false
+	}
+	switch arg := pipe.Cmds[0].Args[0].(type) {
+	case *parse.DotNode:
+		return c.dot() == ""
+	case *parse.VariableNode:
+		return len(arg.Ident) == 1 && arg.Ident[0] == "$"
+	}
+	return false
+}
+
+func (c *goConverter) pipeline(pipe *parse.PipeNode) (expr, error) {
+	var prev *expr
+	for _, cmd := range pipe.Cmds {
+		e, err := c.command(cmd, prev)
+		if err != nil {
+			return expr{}, err
+		}
+		prev = &e
+	}
+	if prev == nil {
+		return expr{}, unsupported("empty pipeline")
+	}
+	return *prev, nil
+}
+
+// command converts cmd; final is the result of the previous command of
+// the pipeline, which Go passes as the last argument.
+func (c *goConverter) command(cmd *parse.CommandNode, final *expr) (expr, error) {
+	first, rest := cmd.Args[0], cmd.Args[1:]
+	args := make([]expr, 0, len(rest)+1)
+	for _, arg := range rest {
+		e, err := c.operand(arg)
+		if err != nil {
+			return expr{}, err
+		}
+		args = append(args, e)
+	}
+	if final != nil {
+		args = append(args, *final)
+	}
+
+	switch f := first.(type) {
+	case *parse.IdentifierNode:
+		return c.function(f.Ident, args)
+	case *parse.FieldNode, *parse.ChainNode, *parse.VariableNode:
+		recv, err := c.operand(first)
+		if err != nil || len(args) == 0 {
+			return recv, err
+		}
+		return expr{s: recv.s + "(" + joinOperands(args, ", ") + ")"}, nil
+	}
+	if len(args) > 0 {
+		return expr{}, unsupported("%s is not a function and can't take arguments", first)
+	}
+	return c.operand(first)
+}
+
+func (c *goConverter) operand(n parse.Node) (expr, error) {
+	switch n := n.(type) {
+	case *parse.FieldNode:
+		if c.dot() == "" {
+			return expr{s: strings.Join(n.Ident, ".")}, nil
+		}
+		return expr{s: c.dot() + "." + strings.Join(n.Ident, ".")}, nil
+	case *parse.VariableNode:
+		if n.Ident[0] == "$" {
+			if len(n.Ident) == 1 {
+				return expr{}, unsupported("the whole data of the template ($) has no Plush equivalent; its fields are context variables")
+			}
+			return expr{s: strings.Join(n.Ident[1:], ".")}, nil
+		}
+		return expr{s: strings.Join(append([]string{c.variable(n.Ident[0])}, n.Ident[1:]...), ".")}, nil
+	case *parse.DotNode:
+		if c.dot() == "" {
+			return expr{}, unsupported("the whole data of the template (.) has no Plush equivalent; its fields are context variables")
+		}
+		return expr{s: c.dot()}, nil
+	case *parse.ChainNode:
+		var inner expr
+		var err error
+		if pipe, ok := n.Node.(*parse.PipeNode); ok {
+			// (index .Users 0).Name reads a field of a call or index.
+			inner, err = c.pipeline(pipe)
+		} else {
+			inner, err = c.operand(n.Node)
+		}
+		if err != nil {
+			return expr{}, err
+		}
+		if inner.compound {
+			return expr{}, unsupported("fields of a parenthesized pipeline have no Plush equivalent; bind it with a variable first")
+		}
+		return expr{s: inner.s + "." + strings.Join(n.Field, ".")}, nil
+	case *parse.PipeNode:
+		e, err := c.pipeline(n)
+		if err != nil {
+			return expr{}, err
+		}
+		e.compound = true
+		return e, nil
+	case *parse.IdentifierNode:
+		return c.function(n.Ident, nil)
+	case *parse.StringNode:
+		return expr{s: strconv.Quote(n.Text)}, nil
+	case *parse.NumberNode:
+		switch {
+		case n.IsInt:
+			return expr{s: strconv.FormatInt(n.Int64, 10)}, nil
+		case n.IsUint:
+			return expr{s: strconv.FormatUint(n.Uint64, 10)}, nil
+		case n.IsFloat:
+			return expr{s: strconv.FormatFloat(n.Float64, 'g', -1, 64)}, nil
+		}
+		return expr{}, unsupported("complex number %s has no Plush equivalent", n.Text)
+	case *parse.BoolNode:
+		return expr{s: strconv.FormatBool(n.True)}, nil
+	case *parse.NilNode:
+		return expr{s: "nil"}, nil
+	}
+	return expr{}, unsupported("%s has no Plush equivalent", n)
+}
+
+var comparisons = map[string]string{
+	"ne": "!=", "lt": "<", "le": "<=", "gt": ">", "ge": ">=",
+}
+
+// function converts a call of the template function name. The builtins
+// become operators or the matching Plush helpers; other functions are
+// called as helpers of the same name.
+func (c *goConverter) function(name string, args []expr) (expr, error) {
+	switch name {
+	case "and", "or":
+		if len(args) == 0 {
+			return expr{}, unsupported("%s needs arguments", name)
+		}
+		if len(args) == 1 {
+			return args[0], nil
+		}
+		op := " && "
+		if name == "or" {
+			op = " || "
+		}
+		return expr{s: joinOperands(args, op), compound: true}, nil
+	case "not":
+		if len(args) != 1 {
+			return expr{}, unsupported("not takes one argument")
+		}
+		return expr{s: "!" + args[0].operand(), compound: true}, nil
+	case "eq":
+		if len(args) < 2 {
+			return expr{}, unsupported("eq takes at least two arguments")
+		}
+		tests := make([]string, 0, len(args)-1)
+		for _, arg := range args[1:] {
+			tests = append(tests, args[0].operand()+" == "+arg.operand())
+		}
+		return expr{s: strings.Join(tests, " || "), compound: true}, nil
+	case "ne", "lt", "le", "gt", "ge":
+		if len(args) != 2 {
+			return expr{}, unsupported("%s takes two arguments", name)
+		}
+		return expr{s: args[0].operand() + " " + comparisons[name] + " " + args[1].operand(), compound: true}, nil
+	case "index":
+		if len(args) == 0 {
+			return expr{}, unsupported("index needs arguments")
+		}
+		if args[0].compound {
+			return expr{}, unsupported("indexing a parenthesized pipeline has no Plush equivalent; bind it with a variable first")
+		}
+		s := args[0].s
+		for _, key := range args[1:] {
+			s += "[" + key.s + "]"
+		}
+		return expr{s: s}, nil
+	case "call":
+		if len(args) == 0 {
+			return expr{}, unsupported("call needs a function")
+		}
+		return expr{s: args[0].operand() + "(" + joinOperands(args[1:], ", ") + ")"}, nil
+	case "print", "html":
+		if len(args) != 1 {
+			return expr{}, unsupported("%s with %d arguments has no Plush equivalent", name, len(args))
+		}
+		// Plush escapes what it writes already.
+		return args[0], nil
+	case "js":
+		name = "jsEscape"
+	case "len":
+	case "printf", "println", "urlquery", "slice":
+		return expr{}, unsupported("%s has no Plush helper", name)
+	}
+	return expr{s: name + "(" + joinOperands(args, ", ") + ")"}, nil
+}
+
+func joinOperands(args []expr, sep string) string {
+	s := make([]string, len(args))
+	for i, arg := range args {
+		s[i] = arg.operand()
+		if sep == ", " {
+			s[i] = arg.s
+		}
+	}
+	return strings.Join(s, sep)
+}
+
+// elementName picks the name of the loop variable of a range over iter:
+// the singular of its last field, or "item".
+func elementName(iter string) string {
+	name := iter[strings.LastIndex(iter, ".")+1:]
+	if !pathExpr.MatchString(name) || len(name) < 3 {
+		return "item"
+	}
+	switch {
+	case strings.HasSuffix(name, "ies"):
+		name = strings.TrimSuffix(name, "ies") + "y"
+	case strings.HasSuffix(name, "s") && !strings.HasSuffix(name, "ss"):
+		name = strings.TrimSuffix(name, "s")
+	default:
+		return "item"
+	}
+	return strings.ToLower(name[:1]) + name[1:]
+}
+
+// rootUse is how a defined template uses the data it is called with.
+type rootUse struct {
+	// fields are the fields of dot read at the top level of the template
+	// or through $, sorted.
+	fields []string
+	// whole is set when dot itself is used, which a partial can't receive.
+	whole bool
+}
+
+// rootUse returns how the template defined as name uses its data,
+// following the templates it calls with its own data.
+func (c *goConverter) rootUse(name string) *rootUse {
+	if use, ok := c.uses[name]; ok {
+		return use
+	}
+	use := &rootUse{}
+	// Stored first so that recursive templates end the walk.
+	c.uses[name] = use
+	fields := map[string]bool{}
+	if t, ok := c.trees[name]; ok && t.Root != nil {
+		c.collectRootUse(t.Root, true, fields, use)
+	}
+	for f := range fields {
+		use.fields = append(use.fields, f)
+	}
+	sort.Strings(use.fields)
+	return use
+}
+
+func (c *goConverter) collectRootUse(n parse.Node, atRoot bool, fields map[string]bool, use *rootUse) {
+	switch n := n.(type) {
+	case *parse.ListNode:
+		if n == nil {
+			return
+		}
+		for _, child := range n.Nodes {
+			c.collectRootUse(child, atRoot, fields, use)
+		}
+	case *parse.ActionNode:
+		c.collectRootUse(n.Pipe, atRoot, fields, use)
+	case *parse.PipeNode:
+		if n == nil {
+			return
+		}
+		for _, cmd := range n.Cmds {
+			for _, arg := range cmd.Args {
+				c.collectRootUse(arg, atRoot, fields, use)
+			}
+		}
+	case *parse.ChainNode:
+		c.collectRootUse(n.Node, atRoot, fields, use)
+	case *parse.FieldNode:
+		if atRoot {
+			fields[n.Ident[0]] = true
+		}
+	case *parse.DotNode:
+		if atRoot {
+			use.whole = true
+		}
+	case *parse.VariableNode:
+		if n.Ident[0] == "$" {
+			if len(n.Ident) == 1 {
+				use.whole = true
+			} else {
+				fields[n.Ident[1]] = true
+			}
+		}
+	case *parse.IfNode:
+		c.collectRootUse(n.Pipe, atRoot, fields, use)
+		c.collectRootUse(n.List, atRoot, fields, use)
+		c.collectRootUse(n.ElseList, atRoot, fields, use)
+	case *parse.RangeNode:
+		c.collectRootUse(n.Pipe, atRoot, fields, use)
+		c.collectRootUse(n.List, false, fields, use)
+		c.collectRootUse(n.ElseList, atRoot, fields, use)
+	case *parse.WithNode:
+		c.collectRootUse(n.Pipe, atRoot, fields, use)
+		c.collectRootUse(n.List, false, fields, use)
+		c.collectRootUse(n.ElseList, atRoot, fields, use)
+	case *parse.TemplateNode:
+		if n.Pipe == nil {
+			return
+		}
+		if _, ok := n.Pipe.Cmds[0].Args[0].(*parse.DotNode); ok && atRoot && len(n.Pipe.Cmds) == 1 && len(n.Pipe.Cmds[0].Args) == 1 {
+			// The template called gets this data: its uses are ours.
+			callee := c.rootUse(n.Name)
+			for _, f := range callee.fields {
+				fields[f] = true
+			}
+			use.whole = use.whole || callee.whole
+			return
+		}
+		c.collectRootUse(n.Pipe, atRoot, fields, use)
+	}
+}
+
+// walkGoNodes calls fn for n and every node below it.
+func walkGoNodes(n parse.Node, fn func(parse.Node)) {
+	fn(n)
+	switch n := n.(type) {
+	case *parse.ListNode:
+		for _, child := range n.Nodes {
+			walkGoNodes(child, fn)
+		}
+	case *parse.ActionNode:
+		walkGoNodes(n.Pipe, fn)
+	case *parse.PipeNode:
+		for _, decl := range n.Decl {
+			walkGoNodes(decl, fn)
+		}
+		for _, cmd := range n.Cmds {
+			walkGoNodes(cmd, fn)
+		}
+	case *parse.CommandNode:
+		for _, arg := range n.Args {
+			walkGoNodes(arg, fn)
+		}
+	case *parse.ChainNode:
+		walkGoNodes(n.Node, fn)
+	case *parse.IfNode:
+		walkGoBranch(&n.BranchNode, fn)
+	case *parse.RangeNode:
+		walkGoBranch(&n.BranchNode, fn)
+	case *parse.WithNode:
+		walkGoBranch(&n.BranchNode, fn)
+	case *parse.TemplateNode:
+		if n.Pipe != nil {
+			walkGoNodes(n.Pipe, fn)
+		}
+	}
+}
+
+func walkGoBranch(b *parse.BranchNode, fn func(parse.Node)) {
+	walkGoNodes(b.Pipe, fn)
+	walkGoNodes(b.List, fn)
+	if b.ElseList != nil {
+		walkGoNodes(b.ElseList, fn)
+	}
+}
@@ -0,0 +1,157 @@
+package convert_test
+
+import (
+	"fmt"
+	"html/template"
+	"strings"
+	"testing"
+
+	"github.com/gobuffalo/plush/v5"
+	"github.com/gobuffalo/plush/v5/convert"
+	"github.com/stretchr/testify/require"
+)
+
+type user struct {
+	Name  string
+	Admin bool
+	Tags  []string
+}
+
+var goData = map[string]interface{}{
+	"Title": "Users",
+	"Users": []user{
+		{Name: "mark", Admin: true, Tags: []string{"a", "b"}},
+		{Name: "paul"},
+	},
+	"Empty":  []user{},
+	"Owner":  user{Name: "ann"},
+	"Count":  3,
+	"Counts": map[string]int{"a": 1, "b": 2},
+}
+
+// renderPlush renders a converted template with the data of goData as
+// context variables and its partials fed from the result.
+func renderPlush(t *testing.T, res *convert.Result, data map[string]interface{}) string {
+	t.Helper()
+	ctx := plush.NewContextWith(data)
+	ctx.Set("partialFeeder", func(name string) (string, error) {
+		if src, ok := res.Partials[name]; ok {
+			return src, nil
+		}
+		return "", fmt.Errorf("no partial %s", name)
+	})
+	out, err := plush.Render(res.Source, ctx)
+	require.NoError(t, err, res.Source)
+	return out
+}
+
+func executeGo(t *testing.T, src string, data interface{}) string {
+	t.Helper()
+	tmpl, err := template.New("index.html").Parse(src)
+	require.NoError(t, err)
+	var buf strings.Builder
+	require.NoError(t, tmpl.Execute(&buf, data))
+	return buf.String()
+}
+
+func Test_GoTemplate_Renders_Like_Go(t *testing.T) {
+	tests := []struct {
+		name string
+		src  string
+	}{
+		{"fields", `<h1>{{.Title}}</h1>{{ .Owner.Name }}`},
+		{"if", `{{if .Count}}some{{else}}none{{end}}`},
+		{"else if", `{{if gt .Count 5}}many{{else if eq .Count 3 4}}few{{else}}one{{end}}`},
+		{"range", `<ul>{{range .Users}}<li>{{.Name}}{{if .Admin}}!{{end}}</li>{{end}}</ul>`},
+		{"range vars", `{{range $i, $u := .Users}}{{$i}}:{{$u.Name}} {{end}}`},
+		{"range else", `{{range .Empty}}{{.Name}}{{else}}nobody{{end}}`},
+		{"nested range", `{{range .Users}}{{range .Tags}}[{{.}}]{{end}}{{end}}`},
+		{"root in range", `{{range .Users}}{{$.Title}}/{{.Name}} {{end}}`},
+		{"with", `{{with .Owner}}{{.Name}}{{end}}`},
+		{"with var", `{{with $o := .Owner}}{{$o.Name}}{{end}}`},
+		{"variables", `{{$n := .Count}}{{$n = 4}}{{$n}}`},
+		{"logic", `{{if and .Count (not .Owner.Admin)}}yes{{end}}{{if or false .Title}}{{.Title}}{{end}}`},
+		{"builtins", `{{len .Users}} {{(index .Users 1).Admin | print}} {{index .Counts "b"}} {{.Title | len}}`},
+		{"break", `{{range .Users}}{{if .Admin}}{{continue}}{{end}}{{.Name}}{{break}}{{end}}`},
+		{"comment", `a{{/* note */}}b`},
+		{"define", `{{define "row"}}<td>{{.Name}}</td>{{end}}{{range .Users}}{{template "row" .}}{{end}}`},
+		{"block", `{{block "head" .}}<title>{{.Title}}</title>{{end}}`},
+		{"escaping", `{{"<b>"}}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := require.New(t)
+			res, err := convert.GoTemplate("index.html", tt.src, convert.GoTemplateOptions{})
+			r.NoError(err)
+			r.Empty(res.Issues)
+			want := executeGo(t, tt.src, goData)
+			r.Equal(want, renderPlush(t, res, goData), res.Source)
+		})
+	}
+}
+
+func Test_GoTemplate_Source(t *testing.T) {
+	r := require.New(t)
+	res, err := convert.GoTemplate("index.html", `{{define "row"}}{{.Name}} {{$.Site}}{{end}}
+{{range .Users}}{{template "row" .}}{{else}}-{{end}}
+{{with .Owner.Name | upcase}}{{.}}{{end}}
+{{if eq .A "x" "y"}}{{js .B}}{{end}}
+{{if $n := len .Users}}{{$n}} <%= users %>{{end}}`, convert.GoTemplateOptions{})
+	r.NoError(err)
+	r.Equal(`
+<%= if (len(Users) > 0) { %><%= for (user) in Users { %><%= partial("row.html", {Name: user.Name, Site: user.Site}) %><% } %><% } else { %>-<% } %>
+<% let with = upcase(Owner.Name) %><%= if (with) { %><%= with %><% } %>
+<%= if (A == "x" || A == "y") { %><%= jsEscape(B) %><% } %>
+<% let n = len(Users) %><%= if (n) { %><%= n %> \<%= users %><% } %>`, res.Source)
+	r.Equal(`<%= Name %> <%= Site %>`, res.Partials["row.html"])
+	r.Equal([]string{"row.html"}, res.PartialNames())
+}
+
+func Test_GoTemplate_Issues(t *testing.T) {
+	r := require.New(t)
+	res, err := convert.GoTemplate("index.html", `{{define "all"}}{{.}}{{end}}
+{{printf "%d users" .Count}}
+{{range .Users}}{{template "all" .}}{{template "missing" .}}{{end}}
+{{if slice .Title 1}}x{{end}}`, convert.GoTemplateOptions{})
+	r.NoError(err)
+	r.Equal(`
+<%# unconverted: {{printf "%d users" .Count}} %>
+<%= for (user) in Users { %><%# unconverted: {{template "all" .}} %><%# unconverted: {{template "missing" .}} %><% } %>
+<%# unconverted: slice .Title 1 %><%= if (nil) { %>x<% } %>`, res.Source)
+	r.Equal(`<%# unconverted: {{.}} %>`, res.Partials["all.html"])
+
+	var messages []string
+	for _, issue := range res.Issues {
+		messages = append(messages, issue.String())
+	}
+	r.Equal([]string{
+		`index.html:1: the whole data of the template (.) has no Plush equivalent; its fields are context variables: {{.}}`,
+		`index.html:2: printf has no Plush helper: {{printf "%d users" .Count}}`,
+		`index.html:3: template "all" uses its whole data, which can only be passed to a partial as fields: {{template "all" .}}`,
+		`index.html:3: template "missing" is not defined in this file, so the fields to pass it are unknown: {{template "missing" .}}`,
+		`index.html:4: slice has no Plush helper; replaced with nil: slice .Title 1`,
+	}, messages)
+}
+
+func Test_GoTemplate_Options(t *testing.T) {
+	r := require.New(t)
+	res, err := convert.GoTemplate("index.html", `[[template "header"]][[template "footer.tmpl"]]{{.}}`, convert.GoTemplateOptions{
+		PartialExt: ".plush.html",
+		LeftDelim:  "[[",
+		RightDelim: "]]",
+	})
+	r.NoError(err)
+	r.Equal(`<%= partial("header.plush.html") %><%= partial("footer.tmpl") %>{{.}}`, res.Source)
+}
+
+func Test_GoTemplate_Parse_Error(t *testing.T) {
+	_, err := convert.GoTemplate("index.html", `{{if .A}}`, convert.GoTemplateOptions{})
+	require.Error(t, err)
+}
+
+func Test_PartialFile(t *testing.T) {
+	r := require.New(t)
+	r.Equal("users/_row.plush.html", convert.PartialFile("users/row.html"))
+	r.Equal("_row.plush.html", convert.PartialFile("_row.plush.html"))
+	r.Equal("_header", convert.PartialFile("header"))
+}
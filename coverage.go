@@ -0,0 +1,506 @@
+package plush
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"html/template"
+	"io"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gobuffalo/plush/v5/ast"
+	"github.com/gobuffalo/plush/v5/helpers/hctx"
+)
+
+var coverageKey = "__plush_internal_coverage_" + fmt.Sprintf("%d", time.Now().UnixNano()) + "__"
+
+// Branch kinds of a BranchCoverage.
+const (
+	BranchIf     = "if"
+	BranchElseIf = "else if"
+	BranchElse   = "else"
+	BranchFor    = "for"
+)
+
+// Coverage collects which lines and branches of templates ran, across any
+// number of renders. Attach it to a context with Context.WithCoverage;
+// both engines and the partials they render record into it. It is safe to
+// share between concurrent renders.
+//
+// A line is covered when a `<%= %>` or `<% %>` tag starting on it ran.
+// Branches are the bodies of if, else if, else and for: an if without an
+// else still has an else branch, taken when no condition held, and a for
+// body counts one hit per iteration. Lines holding only HTML are not
+// tracked. Punch holes are recorded as the line of their tag; what they
+// render is not.
+type Coverage struct {
+	mu    sync.Mutex
+	files map[string]*TemplateCoverage
+}
+
+// NewCoverage returns an empty Coverage.
+func NewCoverage() *Coverage {
+	return &Coverage{files: map[string]*TemplateCoverage{}}
+}
+
+// WithCoverage attaches cov to this context and every context made from
+// it. Returns self for chaining.
+func (c *Context) WithCoverage(cov *Coverage) *Context {
+	c.Set(coverageKey, cov)
+	return c
+}
+
+// CoverageFrom returns the coverage attached to ctx, or nil.
+func CoverageFrom(ctx hctx.Context) *Coverage {
+	if ctx == nil {
+		return nil
+	}
+	cov, _ := ctx.Value(coverageKey).(*Coverage)
+	return cov
+}
+
+// Template returns the recorder for the template file parsed as program,
+// registering the lines and branches of program it doesn't know yet.
+// Engines call it once per render.
+func (cov *Coverage) Template(file string, program *ast.Program) *TemplateCoverage {
+	if cov == nil || program == nil {
+		return nil
+	}
+	cov.mu.Lock()
+	defer cov.mu.Unlock()
+	t, ok := cov.files[file]
+	if !ok {
+		t = &TemplateCoverage{
+			mu:       &cov.mu,
+			file:     &FileCoverage{Lines: map[int]int{}},
+			branches: map[branchKey]int{},
+		}
+		cov.files[file] = t
+	}
+	t.register(program)
+	return t
+}
+
+// TemplateCoverageFor returns the recorder for a render of program with
+// ctx, or nil when ctx has no coverage attached. Punch holes, which are
+// rendered as templates of their own, are not recorded.
+func TemplateCoverageFor(ctx hctx.Context, program *ast.Program) *TemplateCoverage {
+	cov := CoverageFrom(ctx)
+	if cov == nil || isHole(ctx) {
+		return nil
+	}
+	return cov.Template(TemplateFilenameForError(ctx), program)
+}
+
+// Profile returns a snapshot of the coverage collected so far.
+func (cov *Coverage) Profile() *CoverageProfile {
+	p := &CoverageProfile{Files: map[string]*FileCoverage{}}
+	if cov == nil {
+		return p
+	}
+	cov.mu.Lock()
+	defer cov.mu.Unlock()
+	for name, t := range cov.files {
+		p.Files[name] = t.file.clone()
+	}
+	return p
+}
+
+type branchKey struct {
+	line, column, branch int
+}
+
+// TemplateCoverage records the lines and branches of one template file
+// that run. All methods are safe to call on a nil TemplateCoverage, which
+// records nothing.
+type TemplateCoverage struct {
+	mu       *sync.Mutex
+	file     *FileCoverage
+	branches map[branchKey]int
+}
+
+func (t *TemplateCoverage) register(program *ast.Program) {
+	known := len(t.file.Branches)
+	ast.Inspect(program, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.BlockStatement, nil:
+		case ast.Statement:
+			if _, html := htmlStatement(n); !html && n.T().LineNumber > 0 {
+				if _, ok := t.file.Lines[n.T().LineNumber]; !ok {
+					t.file.Lines[n.T().LineNumber] = 0
+				}
+			}
+		case *ast.IfExpression:
+			t.addBranch(n.Token.LineNumber, n.Token.Column, 0, BranchIf)
+			for i := range n.ElseIf {
+				t.addBranch(n.Token.LineNumber, n.Token.Column, i+1, BranchElseIf)
+			}
+			t.addBranch(n.Token.LineNumber, n.Token.Column, len(n.ElseIf)+1, BranchElse)
+		case *ast.ForExpression:
+			t.addBranch(n.Token.LineNumber, n.Token.Column, 0, BranchFor)
+		}
+		return true
+	})
+	if len(t.file.Branches) == known {
+		return
+	}
+	t.file.sortBranches()
+	for i, b := range t.file.Branches {
+		t.branches[branchKey{b.Line, b.Column, b.Branch}] = i
+	}
+}
+
+func (t *TemplateCoverage) addBranch(line, column, branch int, kind string) {
+	if _, ok := t.branches[branchKey{line, column, branch}]; ok {
+		return
+	}
+	t.branches[branchKey{line, column, branch}] = len(t.file.Branches)
+	t.file.Branches = append(t.file.Branches, BranchCoverage{Line: line, Column: column, Branch: branch, Kind: kind})
+}
+
+// HitLine records that a statement starting on line ran.
+func (t *TemplateCoverage) HitLine(line int) {
+	if t == nil {
+		return
+	}
+	t.mu.Lock()
+	if _, ok := t.file.Lines[line]; ok {
+		t.file.Lines[line]++
+	}
+	t.mu.Unlock()
+}
+
+// HitBranch records that a branch of the if or for expression whose
+// keyword is at line and column ran, numbered as in BranchCoverage.
+func (t *TemplateCoverage) HitBranch(line, column, branch int) {
+	if t == nil {
+		return
+	}
+	t.mu.Lock()
+	if i, ok := t.branches[branchKey{line, column, branch}]; ok {
+		t.file.Branches[i].Hits++
+	}
+	t.mu.Unlock()
+}
+
+// CoverageProfile is a snapshot of Coverage keyed by template file name;
+// templates rendered without a name are kept under "". It can be saved and
+// loaded as JSON with encoding/json and merged with the profiles of other
+// runs.
+type CoverageProfile struct {
+	Files map[string]*FileCoverage `json:"files"`
+}
+
+// FileCoverage is the coverage of a single template file.
+type FileCoverage struct {
+	// Lines maps every line holding a tag to the number of times it ran.
+	// How often a line counts as run differs between engines: the
+	// interpreter counts every statement, the VM every time it enters the
+	// line. Whether a line ran at all is the same in both.
+	Lines map[int]int `json:"lines"`
+	// Branches are sorted by line, column and branch.
+	Branches []BranchCoverage `json:"branches"`
+}
+
+// BranchCoverage is a branch of an if or for expression. Line and Column
+// locate its if or for keyword. Branch numbers the bodies of an if from
+// 0: the if body, then each else if, then the else.
+type BranchCoverage struct {
+	Line   int    `json:"line"`
+	Column int    `json:"column"`
+	Branch int    `json:"branch"`
+	Kind   string `json:"kind"`
+	Hits   int    `json:"hits"`
+}
+
+// ReadCoverageProfile reads a profile saved as JSON.
+func ReadCoverageProfile(r io.Reader) (*CoverageProfile, error) {
+	p := &CoverageProfile{}
+	if err := json.NewDecoder(r).Decode(p); err != nil {
+		return nil, fmt.Errorf("reading coverage profile: %w", err)
+	}
+	if p.Files == nil {
+		p.Files = map[string]*FileCoverage{}
+	}
+	for name, f := range p.Files {
+		if f == nil {
+			p.Files[name] = &FileCoverage{Lines: map[int]int{}}
+			continue
+		}
+		if f.Lines == nil {
+			f.Lines = map[int]int{}
+		}
+		f.sortBranches()
+	}
+	return p, nil
+}
+
+// Merge adds the hits of other to p. Lines and branches only one of them
+// knows about are kept.
+func (p *CoverageProfile) Merge(other *CoverageProfile) {
+	if other == nil {
+		return
+	}
+	if p.Files == nil {
+		p.Files = map[string]*FileCoverage{}
+	}
+	for name, f := range other.Files {
+		if f == nil {
+			continue
+		}
+		mine, ok := p.Files[name]
+		if !ok {
+			p.Files[name] = f.clone()
+			continue
+		}
+		mine.merge(f)
+	}
+}
+
+// MergeCoverageProfiles returns the sum of profiles.
+func MergeCoverageProfiles(profiles ...*CoverageProfile) *CoverageProfile {
+	out := &CoverageProfile{Files: map[string]*FileCoverage{}}
+	for _, p := range profiles {
+		out.Merge(p)
+	}
+	return out
+}
+
+// FileNames returns the names of the files in p, sorted.
+func (p *CoverageProfile) FileNames() []string {
+	names := make([]string, 0, len(p.Files))
+	for name := range p.Files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func (f *FileCoverage) clone() *FileCoverage {
+	out := &FileCoverage{Lines: make(map[int]int, len(f.Lines))}
+	for line, hits := range f.Lines {
+		out.Lines[line] = hits
+	}
+	out.Branches = append([]BranchCoverage(nil), f.Branches...)
+	return out
+}
+
+func (f *FileCoverage) merge(other *FileCoverage) {
+	if f.Lines == nil {
+		f.Lines = map[int]int{}
+	}
+	for line, hits := range other.Lines {
+		f.Lines[line] += hits
+	}
+	index := make(map[branchKey]int, len(f.Branches))
+	for i, b := range f.Branches {
+		index[branchKey{b.Line, b.Column, b.Branch}] = i
+	}
+	for _, b := range other.Branches {
+		if i, ok := index[branchKey{b.Line, b.Column, b.Branch}]; ok {
+			f.Branches[i].Hits += b.Hits
+			continue
+		}
+		f.Branches = append(f.Branches, b)
+	}
+	f.sortBranches()
+}
+
+func (f *FileCoverage) sortBranches() {
+	sort.SliceStable(f.Branches, func(i, j int) bool {
+		a, b := f.Branches[i], f.Branches[j]
+		if a.Line != b.Line {
+			return a.Line < b.Line
+		}
+		if a.Column != b.Column {
+			return a.Column < b.Column
+		}
+		return a.Branch < b.Branch
+	})
+}
+
+// Summary returns the number of lines and branches of f and how many of
+// them ran.
+func (f *FileCoverage) Summary() (lines, linesHit, branches, branchesHit int) {
+	for _, hits := range f.Lines {
+		lines++
+		if hits > 0 {
+			linesHit++
+		}
+	}
+	for _, b := range f.Branches {
+		branches++
+		if b.Hits > 0 {
+			branchesHit++
+		}
+	}
+	return lines, linesHit, branches, branchesHit
+}
+
+// WriteLCOV writes p in the LCOV tracefile format read by genhtml and
+// most coverage services. A branch's block number is the column of its
+// if or for keyword.
+func (p *CoverageProfile) WriteLCOV(w io.Writer) error {
+	bw := bufio.NewWriter(w)
+	for _, name := range p.FileNames() {
+		f := p.Files[name]
+		fmt.Fprintf(bw, "TN:\nSF:%s\n", name)
+		for _, b := range f.Branches {
+			taken := "-"
+			if b.Hits > 0 {
+				taken = fmt.Sprint(b.Hits)
+			}
+			fmt.Fprintf(bw, "BRDA:%d,%d,%d,%s\n", b.Line, b.Column, b.Branch, taken)
+		}
+		lines, linesHit, branches, branchesHit := f.Summary()
+		fmt.Fprintf(bw, "BRF:%d\nBRH:%d\n", branches, branchesHit)
+		for _, line := range sortedLines(f.Lines) {
+			fmt.Fprintf(bw, "DA:%d,%d\n", line, f.Lines[line])
+		}
+		fmt.Fprintf(bw, "LF:%d\nLH:%d\nend_of_record\n", lines, linesHit)
+	}
+	return bw.Flush()
+}
+
+func sortedLines(m map[int]int) []int {
+	lines := make([]int, 0, len(m))
+	for line := range m {
+		lines = append(lines, line)
+	}
+	sort.Ints(lines)
+	return lines
+}
+
+// WriteHTML writes p as a standalone HTML page showing the source of each
+// template with the lines that ran, the lines that didn't, and the
+// branches that were never taken. source returns the content of a file
+// of the profile; files it fails to read are listed without their source.
+func (p *CoverageProfile) WriteHTML(w io.Writer, source func(file string) (string, error)) error {
+	page := coverageReport{}
+	for _, name := range p.FileNames() {
+		f := p.Files[name]
+		lines, linesHit, branches, branchesHit := f.Summary()
+		file := coverageReportFile{
+			Name:     name,
+			Lines:    fmt.Sprintf("%d/%d", linesHit, lines),
+			Branches: fmt.Sprintf("%d/%d", branchesHit, branches),
+			Percent:  coveragePercent(linesHit+branchesHit, lines+branches),
+		}
+		if name == "" {
+			file.Name = "(unnamed template)"
+		}
+
+		missed := map[int][]string{}
+		for _, b := range f.Branches {
+			if b.Hits == 0 {
+				missed[b.Line] = append(missed[b.Line], b.Kind)
+			}
+		}
+		var src string
+		var err error
+		if source != nil {
+			src, err = source(name)
+		}
+		if source == nil || err != nil {
+			if err != nil {
+				file.Error = err.Error()
+			}
+			for _, line := range sortedLines(f.Lines) {
+				file.Source = append(file.Source, coverageReportLine(line, "", f, missed))
+			}
+		} else {
+			for i, text := range strings.Split(src, "\n") {
+				file.Source = append(file.Source, coverageReportLine(i+1, text, f, missed))
+			}
+		}
+		page.Files = append(page.Files, file)
+	}
+	return coverageTemplate.Execute(w, page)
+}
+
+func coverageReportLine(n int, text string, f *FileCoverage, missed map[int][]string) coverageLine {
+	line := coverageLine{Number: n, Text: text}
+	hits, ok := f.Lines[n]
+	switch {
+	case !ok:
+	case hits == 0:
+		line.Class, line.Hits = "miss", "0"
+	case len(missed[n]) > 0:
+		line.Class, line.Hits = "partial", fmt.Sprint(hits)
+	default:
+		line.Class, line.Hits = "hit", fmt.Sprint(hits)
+	}
+	if len(missed[n]) > 0 {
+		line.Missed = "not taken: " + strings.Join(missed[n], ", ")
+		if line.Class == "" {
+			line.Class = "partial"
+		}
+	}
+	return line
+}
+
+func coveragePercent(hit, total int) string {
+	if total == 0 {
+		return "-"
+	}
+	return fmt.Sprintf("%.1f%%", float64(hit)*100/float64(total))
+}
+
+type coverageReport struct {
+	Files []coverageReportFile
+}
+
+type coverageReportFile struct {
+	Name     string
+	Lines    string
+	Branches string
+	Percent  string
+	Error    string
+	Source   []coverageLine
+}
+
+type coverageLine struct {
+	Number int
+	Text   string
+	Class  string
+	Hits   string
+	Missed string
+}
+
+var coverageTemplate = template.Must(template.New("coverage").Parse(`<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>plush coverage</title>
+<style>
+body { font-family: sans-serif; margin: 2em; }
+table.summary td, table.summary th { padding: 0.2em 1em; text-align: left; }
+pre { margin: 0; }
+table.source { border-collapse: collapse; font-family: monospace; width: 100%; }
+table.source td { padding: 0 0.5em; white-space: pre; vertical-align: top; }
+td.num, td.hits { color: #888; text-align: right; width: 1%; }
+tr.hit td.code { background: #dfd; }
+tr.miss td.code { background: #fdd; }
+tr.partial td.code { background: #ffd; }
+td.missed { color: #a60; font-family: sans-serif; font-size: 0.9em; }
+</style>
+</head>
+<body>
+<h1>plush coverage</h1>
+<table class="summary">
+<tr><th>Template</th><th>Lines</th><th>Branches</th><th>Covered</th></tr>
+{{range $i, $f := .Files}}<tr><td><a href="#file{{$i}}">{{$f.Name}}</a></td><td>{{$f.Lines}}</td><td>{{$f.Branches}}</td><td>{{$f.Percent}}</td></tr>
+{{end}}</table>
+{{range $i, $f := .Files}}
+<h2 id="file{{$i}}">{{$f.Name}}</h2>
+{{if $f.Error}}<p>source unavailable: {{$f.Error}}</p>
+{{end}}<table class="source">
+{{range $f.Source}}<tr class="{{.Class}}"><td class="num">{{.Number}}</td><td class="hits">{{.Hits}}</td><td class="code">{{.Text}}</td><td class="missed">{{.Missed}}</td></tr>
+{{end}}</table>
+{{end}}
+</body>
+</html>
+`))
@@ -0,0 +1,180 @@
+package plush_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/gobuffalo/plush/v5"
+	"github.com/gobuffalo/plush/v5/helpers/meta"
+	"github.com/stretchr/testify/require"
+)
+
+const coverageTemplate = `<h1><%= title %></h1>
+<%= if (admin) { %>
+  <b><%= "admin" %></b>
+<% } else if (len(users) > 1) { %>
+  <i><%= len(users) %></i>
+<% } %>
+<%= for (u) in users { %>
+<li><%= partial("users/row.html", {who: u}) %></li><% } %>
+<%= if (false) { %><%= "never" %><% } %>`
+
+const coverageRow = `<%= if (who == "mark") { %>
+<em><%= who %></em>
+<% } else { %>
+<%= who %>
+<% } %>`
+
+func coverageContext(cov *plush.Coverage, users ...string) *plush.Context {
+	ctx := plush.NewContextWith(map[string]interface{}{
+		"title": "Users",
+		"admin": false,
+		"users": users,
+	})
+	ctx.Set(meta.TemplateFileKey, "users/index.html")
+	ctx.Set("partialFeeder", func(name string) (string, error) {
+		return coverageRow, nil
+	})
+	return ctx.WithCoverage(cov)
+}
+
+func Test_Coverage_Interpreter(t *testing.T) {
+	r := require.New(t)
+	cov := plush.NewCoverage()
+	_, err := plush.Render(coverageTemplate, coverageContext(cov, "mark", "paul"))
+	r.NoError(err)
+
+	p := cov.Profile()
+	r.Equal([]string{"users/index.html", "users/row.html"}, p.FileNames())
+
+	index := p.Files["users/index.html"]
+	r.Equal(map[int]int{1: 1, 2: 1, 3: 0, 5: 1, 7: 1, 8: 2, 9: 1}, index.Lines)
+	r.Equal([]plush.BranchCoverage{
+		{Line: 2, Column: 5, Branch: 0, Kind: plush.BranchIf, Hits: 0},
+		{Line: 2, Column: 5, Branch: 1, Kind: plush.BranchElseIf, Hits: 1},
+		{Line: 2, Column: 5, Branch: 2, Kind: plush.BranchElse, Hits: 0},
+		{Line: 7, Column: 5, Branch: 0, Kind: plush.BranchFor, Hits: 2},
+		{Line: 9, Column: 5, Branch: 0, Kind: plush.BranchIf, Hits: 0},
+		{Line: 9, Column: 5, Branch: 1, Kind: plush.BranchElse, Hits: 1},
+	}, index.Branches)
+
+	row := p.Files["users/row.html"]
+	r.Equal(map[int]int{1: 2, 2: 1, 4: 1}, row.Lines)
+	r.Equal([]plush.BranchCoverage{
+		{Line: 1, Column: 5, Branch: 0, Kind: plush.BranchIf, Hits: 1},
+		{Line: 1, Column: 5, Branch: 1, Kind: plush.BranchElse, Hits: 1},
+	}, row.Branches)
+}
+
+func Test_Coverage_Accumulates_And_Merges(t *testing.T) {
+	r := require.New(t)
+	first := plush.NewCoverage()
+	second := plush.NewCoverage()
+	_, err := plush.Render(coverageTemplate, coverageContext(first, "mark"))
+	r.NoError(err)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := plush.Render(coverageTemplate, coverageContext(second, "paul", "john"))
+			require.NoError(t, err)
+		}()
+	}
+	wg.Wait()
+	row := second.Profile().Files["users/row.html"]
+	r.Equal(map[int]int{1: 8, 2: 0, 4: 8}, row.Lines)
+
+	var buf bytes.Buffer
+	r.NoError(json.NewEncoder(&buf).Encode(first.Profile()))
+	loaded, err := plush.ReadCoverageProfile(&buf)
+	r.NoError(err)
+	r.Equal(first.Profile(), loaded)
+
+	merged := plush.MergeCoverageProfiles(loaded, second.Profile())
+	index := merged.Files["users/index.html"]
+	r.Equal(map[int]int{1: 5, 2: 5, 3: 0, 5: 4, 7: 5, 8: 9, 9: 5}, index.Lines)
+	lines, linesHit, branches, branchesHit := index.Summary()
+	r.Equal([]int{7, 6, 6, 4}, []int{lines, linesHit, branches, branchesHit})
+
+	row = merged.Files["users/row.html"]
+	r.Equal(map[int]int{1: 9, 2: 1, 4: 8}, row.Lines)
+	r.Equal(1, row.Branches[0].Hits)
+	r.Equal(8, row.Branches[1].Hits)
+
+	// Merging keeps what only one side knows about.
+	merged.Merge(&plush.CoverageProfile{Files: map[string]*plush.FileCoverage{
+		"other.html": {Lines: map[int]int{1: 1}},
+		"users/row.html": {Lines: map[int]int{7: 0}, Branches: []plush.BranchCoverage{
+			{Line: 7, Column: 1, Kind: plush.BranchFor},
+		}},
+	}})
+	r.Equal([]string{"other.html", "users/index.html", "users/row.html"}, merged.FileNames())
+	r.Equal(map[int]int{1: 9, 2: 1, 4: 8, 7: 0}, merged.Files["users/row.html"].Lines)
+	r.Len(merged.Files["users/row.html"].Branches, 3)
+}
+
+func Test_Coverage_Without_Collector(t *testing.T) {
+	r := require.New(t)
+	r.Nil(plush.CoverageFrom(plush.NewContext()))
+	r.Nil(plush.CoverageFrom(nil))
+	r.Empty(((*plush.Coverage)(nil)).Profile().Files)
+
+	var tc *plush.TemplateCoverage
+	tc.HitLine(1)
+	tc.HitBranch(1, 1, 0)
+}
+
+func Test_Coverage_WriteLCOV(t *testing.T) {
+	r := require.New(t)
+	cov := plush.NewCoverage()
+	ctx := coverageContext(cov)
+	ctx.Set("who", "paul")
+	_, err := plush.Render(coverageRow, ctx)
+	r.NoError(err)
+
+	var buf bytes.Buffer
+	r.NoError(cov.Profile().WriteLCOV(&buf))
+	r.Equal(`TN:
+SF:users/index.html
+BRDA:1,5,0,-
+BRDA:1,5,1,1
+BRF:2
+BRH:1
+DA:1,1
+DA:2,0
+DA:4,1
+LF:3
+LH:2
+end_of_record
+`, buf.String())
+}
+
+func Test_Coverage_WriteHTML(t *testing.T) {
+	r := require.New(t)
+	cov := plush.NewCoverage()
+	_, err := plush.Render(coverageTemplate, coverageContext(cov, "mark"))
+	r.NoError(err)
+
+	var buf bytes.Buffer
+	err = cov.Profile().WriteHTML(&buf, func(file string) (string, error) {
+		if file == "users/index.html" {
+			return coverageTemplate, nil
+		}
+		return "", fmt.Errorf("no source for %s", file)
+	})
+	r.NoError(err)
+	out := buf.String()
+	r.Contains(out, `<a href="#file0">users/index.html</a></td><td>5/7</td><td>3/6</td>`)
+	r.Contains(out, `<tr class="miss"><td class="num">3</td><td class="hits">0</td><td class="code">  &lt;b&gt;&lt;%= &#34;admin&#34; %&gt;&lt;/b&gt;</td>`)
+	r.Contains(out, `<tr class="partial"><td class="num">2</td><td class="hits">1</td>`)
+	r.Contains(out, `not taken: if, else if`)
+	r.Contains(out, `<tr class=""><td class="num">4</td>`)
+	r.Contains(out, `source unavailable: no source for users/row.html`)
+	r.True(strings.HasSuffix(strings.TrimSpace(out), "</html>"))
+}
@@ -0,0 +1,262 @@
+package plush
+
+import (
+	"fmt"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gobuffalo/plush/v5/helpers/hctx"
+)
+
+var debuggerKey = "__plush_internal_debugger_" + fmt.Sprintf("%d", time.Now().UnixNano()) + "__"
+var debugPartialsKey = "__plush_internal_debug_partials_" + fmt.Sprintf("%d", time.Now().UnixNano()) + "__"
+
+// Debugger follows a render statement by statement. Attach one to a
+// context with Context.WithDebugger; it is called by both engines and
+// by the partials they render. Renders without a debugger don't pay for
+// the hooks beyond a nil check.
+type Debugger interface {
+	// Step is called before the interpreter executes each statement, and
+	// each time the VM reaches a new line. Returning an error stops the
+	// render with that error.
+	Step(*DebugState) error
+}
+
+// DebugState describes the point a render has reached. It is only valid
+// during the call to Debugger.Step.
+type DebugState struct {
+	// File is the template being rendered, if it has a name.
+	File string
+	Line int
+	// Partials are the partials being rendered, outermost first.
+	Partials []DebugPartial
+	// OutputLen is the number of bytes the template has written so far.
+	// Output produced inside a block, such as a loop body, is counted
+	// once the statement holding the block finishes.
+	OutputLen int
+
+	ctx    hctx.Context
+	locals func(map[string]interface{})
+}
+
+// DebugPartial is a partial being rendered and the line that called it.
+type DebugPartial struct {
+	File       string
+	CallerFile string
+	CallerLine int
+}
+
+// WithDebugger attaches d to this context and every context made from
+// it. Returns self for chaining.
+func (c *Context) WithDebugger(d Debugger) *Context {
+	c.Set(debuggerKey, d)
+	return c
+}
+
+// DebuggerFrom returns the debugger attached to ctx, or nil.
+func DebuggerFrom(ctx hctx.Context) Debugger {
+	if ctx == nil {
+		return nil
+	}
+	d, _ := ctx.Value(debuggerKey).(Debugger)
+	return d
+}
+
+// DebugStep calls d for a render of ctx that reached line. It is used by
+// rendering engines; locals, if not nil, adds the variables the engine
+// keeps outside of ctx.
+func DebugStep(d Debugger, ctx hctx.Context, line, outputLen int, locals func(map[string]interface{})) error {
+	partials, _ := ctx.Value(debugPartialsKey).([]DebugPartial)
+	return d.Step(&DebugState{
+		File:      TemplateFilenameForError(ctx),
+		Line:      line,
+		Partials:  partials,
+		OutputLen: outputLen,
+		ctx:       ctx,
+		locals:    locals,
+	})
+}
+
+// Variables returns the variables visible at this point of the render:
+// context values, let bindings and loop variables. Helpers are left out.
+func (s *DebugState) Variables() map[string]interface{} {
+	vars := map[string]interface{}{}
+	if c, ok := s.ctx.(*Context); ok {
+		var scopes []*SymbolTable
+		for scope := c.data; scope != nil; scope = scope.parent {
+			scopes = append(scopes, scope)
+		}
+		c.moot.RLock()
+		for i := len(scopes) - 1; i >= 0; i-- {
+			for id, value := range scopes[i].vars {
+				if name, ok := scopes[i].SymbolName(id); ok {
+					vars[name] = value
+				}
+			}
+		}
+		c.moot.RUnlock()
+	}
+	if s.locals != nil {
+		s.locals(vars)
+	}
+	for name := range vars {
+		if strings.HasPrefix(name, "__plush_") {
+			delete(vars, name)
+		}
+	}
+	return vars
+}
+
+// debugPartial records the partial being rendered with help, so that the
+// debugger sees it in DebugState.Partials.
+func debugPartial(help HelperContext, p DebugPartial) {
+	parents, _ := help.Value(debugPartialsKey).([]DebugPartial)
+	partials := make([]DebugPartial, len(parents), len(parents)+1)
+	copy(partials, parents)
+	help.Set(debugPartialsKey, append(partials, p))
+}
+
+// Breakpoint is a template line a BreakpointDebugger stops at. An empty
+// File matches every template.
+type Breakpoint struct {
+	File string
+	Line int
+}
+
+// ParseBreakpoint parses a breakpoint written as file:line, or as a line
+// number alone.
+func ParseBreakpoint(s string) (Breakpoint, error) {
+	file, line := "", s
+	if i := strings.LastIndex(s, ":"); i >= 0 {
+		file, line = s[:i], s[i+1:]
+	}
+	n, err := strconv.Atoi(line)
+	if err != nil || n < 1 {
+		return Breakpoint{}, fmt.Errorf("invalid breakpoint %q: want file:line or line", s)
+	}
+	return Breakpoint{File: file, Line: n}, nil
+}
+
+func (b Breakpoint) String() string {
+	if b.File == "" {
+		return strconv.Itoa(b.Line)
+	}
+	return fmt.Sprintf("%s:%d", b.File, b.Line)
+}
+
+// matches reports whether b is at line of file. A breakpoint file given
+// as a relative path matches templates whose name ends with it.
+func (b Breakpoint) matches(file string, line int) bool {
+	if b.Line != line {
+		return false
+	}
+	if b.File == "" {
+		return true
+	}
+	want, got := path.Clean(b.File), path.Clean(file)
+	return got == want || strings.HasSuffix(got, "/"+strings.TrimPrefix(want, "./"))
+}
+
+// BreakpointDebugger is a Debugger that calls Break when a render reaches
+// one of its breakpoints, or the next line after StepNext. A line is
+// reached once however many statements or loop iterations it holds; the
+// render has to move to another line before it stops there again.
+// Calls to Break are serialized, so it is safe to share between renders.
+type BreakpointDebugger struct {
+	// Break is called when the render stops. Returning an error stops
+	// the render with that error.
+	Break func(*DebugState) error
+
+	breakMu     sync.Mutex
+	mu          sync.Mutex
+	breakpoints []Breakpoint
+	stepping    bool
+	last        Breakpoint
+}
+
+// NewBreakpointDebugger returns a BreakpointDebugger calling brk.
+func NewBreakpointDebugger(brk func(*DebugState) error) *BreakpointDebugger {
+	return &BreakpointDebugger{Break: brk}
+}
+
+// SetBreakpoint adds a breakpoint.
+func (d *BreakpointDebugger) SetBreakpoint(b Breakpoint) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	for _, existing := range d.breakpoints {
+		if existing == b {
+			return
+		}
+	}
+	d.breakpoints = append(d.breakpoints, b)
+}
+
+// ClearBreakpoint removes a breakpoint, reporting whether it was set.
+func (d *BreakpointDebugger) ClearBreakpoint(b Breakpoint) bool {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	for i, existing := range d.breakpoints {
+		if existing == b {
+			d.breakpoints = append(d.breakpoints[:i], d.breakpoints[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+// Breakpoints returns the breakpoints, sorted by file and line.
+func (d *BreakpointDebugger) Breakpoints() []Breakpoint {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	out := append([]Breakpoint(nil), d.breakpoints...)
+	sort.Slice(out, func(i, j int) bool {
+		if out[i].File != out[j].File {
+			return out[i].File < out[j].File
+		}
+		return out[i].Line < out[j].Line
+	})
+	return out
+}
+
+// StepNext makes the debugger stop at the next line the render reaches.
+func (d *BreakpointDebugger) StepNext() {
+	d.mu.Lock()
+	d.stepping = true
+	d.mu.Unlock()
+}
+
+// Step implements Debugger.
+func (d *BreakpointDebugger) Step(s *DebugState) error {
+	d.breakMu.Lock()
+	defer d.breakMu.Unlock()
+	if !d.shouldBreak(s) || d.Break == nil {
+		return nil
+	}
+	return d.Break(s)
+}
+
+func (d *BreakpointDebugger) shouldBreak(s *DebugState) bool {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	here := Breakpoint{File: s.File, Line: s.Line}
+	if here == d.last {
+		return false
+	}
+	d.last = here
+
+	stop := d.stepping
+	for _, b := range d.breakpoints {
+		if b.matches(s.File, s.Line) {
+			stop = true
+			break
+		}
+	}
+	if stop {
+		d.stepping = false
+	}
+	return stop
+}
@@ -0,0 +1,157 @@
+package plush_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/gobuffalo/plush/v5"
+	"github.com/gobuffalo/plush/v5/helpers/meta"
+	"github.com/stretchr/testify/require"
+)
+
+// stepRecorder records every step of a render as "file:line".
+type stepRecorder struct {
+	steps  []string
+	states []plush.DebugState
+	vars   []map[string]interface{}
+	err    error
+}
+
+func (s *stepRecorder) Step(state *plush.DebugState) error {
+	s.steps = append(s.steps, fmt.Sprintf("%s:%d", state.File, state.Line))
+	s.states = append(s.states, *state)
+	s.vars = append(s.vars, state.Variables())
+	return s.err
+}
+
+func debugContext(d plush.Debugger) *plush.Context {
+	ctx := plush.NewContextWith(map[string]interface{}{
+		"title": "Users",
+		"users": []string{"mark", "paul"},
+	})
+	ctx.Set(meta.TemplateFileKey, "users/index.html")
+	ctx.Set("partialFeeder", func(name string) (string, error) {
+		return "<p>\n<%= who %></p>", nil
+	})
+	return ctx.WithDebugger(d)
+}
+
+const debugTemplate = `<h1><%= title %></h1>
+<% let n = 2 %>
+<%= for (u) in users { %>
+<li><%= u %></li><% } %>
+<%= partial("users/row.html", {who: n}) %>`
+
+func Test_Debugger_Steps(t *testing.T) {
+	r := require.New(t)
+	rec := &stepRecorder{}
+	out, err := plush.Render(debugTemplate, debugContext(rec))
+	r.NoError(err)
+	r.Equal("<h1>Users</h1>\n\n\n<li>mark</li>\n<li>paul</li>\n<p>\n2</p>", out)
+
+	r.Equal([]string{
+		"users/index.html:1", "users/index.html:1", "users/index.html:1",
+		"users/index.html:2", "users/index.html:3", "users/index.html:3",
+		"users/index.html:4", "users/index.html:4", "users/index.html:4",
+		"users/index.html:4", "users/index.html:4", "users/index.html:4",
+		"users/index.html:5", "users/index.html:5",
+		"users/row.html:1", "users/row.html:2", "users/row.html:2",
+	}, rec.steps)
+
+	r.Equal(0, rec.states[0].OutputLen)
+	r.Equal(4, rec.states[1].OutputLen)
+	r.Nil(rec.states[0].Partials)
+
+	r.Equal("Users", rec.vars[0]["title"])
+	r.NotContains(rec.vars[0], "n")
+	r.Equal(2, rec.vars[4]["n"])
+	r.Equal("mark", rec.vars[6]["u"])
+	r.Equal("paul", rec.vars[9]["u"])
+	r.NotContains(rec.vars[12], "u")
+	for name := range rec.vars[0] {
+		r.NotContains(name, "__plush", "internal values are hidden")
+	}
+
+	row := rec.states[len(rec.states)-1]
+	r.Equal([]plush.DebugPartial{{File: "users/row.html", CallerFile: "users/index.html", CallerLine: 5}}, row.Partials)
+	r.Equal(2, rec.vars[len(rec.vars)-1]["who"])
+}
+
+func Test_Debugger_Error_Stops_Render(t *testing.T) {
+	r := require.New(t)
+	stop := errors.New("stop")
+	rec := &stepRecorder{err: stop}
+	_, err := plush.Render(debugTemplate, debugContext(rec))
+	r.Error(err)
+	r.True(errors.Is(err, stop))
+	r.Contains(err.Error(), "line 1")
+	r.Len(rec.steps, 1)
+}
+
+func Test_Debugger_Not_Attached(t *testing.T) {
+	r := require.New(t)
+	r.Nil(plush.DebuggerFrom(plush.NewContext()))
+	r.Nil(plush.DebuggerFrom(nil))
+	rec := &stepRecorder{}
+	r.Equal(rec, plush.DebuggerFrom(plush.NewContext().WithDebugger(rec).New()))
+}
+
+func Test_BreakpointDebugger(t *testing.T) {
+	r := require.New(t)
+	var stops []string
+	d := plush.NewBreakpointDebugger(func(s *plush.DebugState) error {
+		stops = append(stops, fmt.Sprintf("%s:%d", s.File, s.Line))
+		return nil
+	})
+	d.SetBreakpoint(plush.Breakpoint{Line: 4})
+	d.SetBreakpoint(plush.Breakpoint{File: "row.html", Line: 2})
+	d.SetBreakpoint(plush.Breakpoint{File: "other.html", Line: 1})
+	d.SetBreakpoint(plush.Breakpoint{Line: 4})
+	r.Equal([]plush.Breakpoint{{Line: 4}, {File: "other.html", Line: 1}, {File: "row.html", Line: 2}}, d.Breakpoints())
+
+	_, err := plush.Render(debugTemplate, debugContext(d))
+	r.NoError(err)
+	r.Equal([]string{"users/index.html:4", "users/row.html:2"}, stops)
+
+	r.True(d.ClearBreakpoint(plush.Breakpoint{Line: 4}))
+	r.False(d.ClearBreakpoint(plush.Breakpoint{Line: 4}))
+	stops = nil
+	d.StepNext()
+	_, err = plush.Render(debugTemplate, debugContext(d))
+	r.NoError(err)
+	r.Equal([]string{"users/index.html:1", "users/row.html:2"}, stops)
+}
+
+func Test_BreakpointDebugger_Step(t *testing.T) {
+	r := require.New(t)
+	var stops []int
+	var d *plush.BreakpointDebugger
+	d = plush.NewBreakpointDebugger(func(s *plush.DebugState) error {
+		stops = append(stops, s.Line)
+		d.StepNext()
+		return nil
+	})
+	d.StepNext()
+	_, err := plush.Render("a\n<%= for (i) in [1, 2] { %><%= i %><% } %>\nb", plush.NewContext().WithDebugger(d))
+	r.NoError(err)
+	r.Equal([]int{1, 2, 3}, stops)
+}
+
+func Test_ParseBreakpoint(t *testing.T) {
+	r := require.New(t)
+	for in, want := range map[string]plush.Breakpoint{
+		"12":                {Line: 12},
+		"users/_row.html:3": {File: "users/_row.html", Line: 3},
+		`C:\t\a.plush:7`:    {File: `C:\t\a.plush`, Line: 7},
+	} {
+		b, err := plush.ParseBreakpoint(in)
+		r.NoError(err)
+		r.Equal(want, b)
+		r.Equal(in, b.String())
+	}
+	for _, in := range []string{"", "a.html", "a.html:0", "a.html:x"} {
+		_, err := plush.ParseBreakpoint(in)
+		r.Error(err, in)
+	}
+}
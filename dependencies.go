@@ -0,0 +1,388 @@
+package plush
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/gobuffalo/plush/v5/ast"
+	"github.com/gobuffalo/plush/v5/helpers/content"
+	"github.com/gobuffalo/plush/v5/parser"
+)
+
+// Dependencies lists what a template uses from outside of itself. All
+// name lists are sorted and free of duplicates.
+type Dependencies struct {
+	// Partials holds the static names passed to partial(), including
+	// those of nested partials when they are resolved.
+	Partials []string
+	// DynamicPartials holds the partial() calls whose name is computed
+	// at render time and can not be followed.
+	DynamicPartials []DynamicPartial
+	// ContentFor and ContentOf hold the keys of contentFor blocks and
+	// contentOf calls.
+	ContentFor []string
+	ContentOf  []string
+	// Helpers holds the names of the helpers called.
+	Helpers []string
+	// Variables holds the free variables read from the context. Values
+	// passed to a partial in its data map are not included.
+	Variables []string
+}
+
+// DynamicPartial is a partial() call whose name is not a string literal.
+type DynamicPartial struct {
+	// File is the partial the call was found in, or "" for the template
+	// itself.
+	File string
+	Line int
+	// Expression is the source of the name argument.
+	Expression string
+}
+
+// Dependencies returns the partials, content keys, helpers and context
+// variables the template uses. When feeder is not nil, partials with
+// static names are loaded through it and their dependencies are merged
+// in recursively; recursive partials are followed once.
+func (t *Template) Dependencies(feeder PartialFeeder) (*Dependencies, error) {
+	if err := t.Parse(); err != nil {
+		return nil, err
+	}
+
+	r := &depResolver{
+		feeder:   feeder,
+		partials: map[string]*depSet{},
+		active:   map[string]bool{},
+	}
+	set, err := r.program("", t.Program)
+	if err != nil {
+		return nil, err
+	}
+	return set.dependencies(), nil
+}
+
+// depSet collects the dependencies of one template.
+type depSet struct {
+	partials   map[string]bool
+	dynamic    []DynamicPartial
+	contentFor map[string]bool
+	contentOf  map[string]bool
+	helpers    map[string]bool
+	variables  map[string]bool
+}
+
+func newDepSet() *depSet {
+	return &depSet{
+		partials:   map[string]bool{},
+		contentFor: map[string]bool{},
+		contentOf:  map[string]bool{},
+		helpers:    map[string]bool{},
+		variables:  map[string]bool{},
+	}
+}
+
+func (s *depSet) addDynamic(d DynamicPartial) {
+	for _, seen := range s.dynamic {
+		if seen == d {
+			return
+		}
+	}
+	s.dynamic = append(s.dynamic, d)
+}
+
+func (s *depSet) dependencies() *Dependencies {
+	return &Dependencies{
+		Partials:        sortedKeys(s.partials),
+		DynamicPartials: s.dynamic,
+		ContentFor:      sortedKeys(s.contentFor),
+		ContentOf:       sortedKeys(s.contentOf),
+		Helpers:         sortedKeys(s.helpers),
+		Variables:       sortedKeys(s.variables),
+	}
+}
+
+func sortedKeys(m map[string]bool) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// depResolver walks a template and the partials it renders.
+type depResolver struct {
+	feeder PartialFeeder
+	// partials caches the resolved dependencies of each partial.
+	partials map[string]*depSet
+	// active holds the partials being resolved, to stop at cycles.
+	active map[string]bool
+}
+
+func (r *depResolver) program(file string, program *ast.Program) (*depSet, error) {
+	w := &depWalker{resolver: r, file: file, set: newDepSet()}
+	w.node(program, newCheckScope(nil))
+	return w.set, w.err
+}
+
+// partial returns the dependencies of the named partial, or nil when it
+// can not be resolved.
+func (r *depResolver) partial(name string) (*depSet, error) {
+	if r.feeder == nil || r.active[name] {
+		return nil, nil
+	}
+	if set, ok := r.partials[name]; ok {
+		return set, nil
+	}
+
+	src, err := r.feeder(name)
+	if err != nil {
+		return nil, fmt.Errorf("partial %q: %w", name, err)
+	}
+	program, err := parser.Parse(preprocessTrimTags(src))
+	if err != nil {
+		return nil, fmt.Errorf("partial %q: %w", name, err)
+	}
+
+	r.active[name] = true
+	set, err := r.program(name, program)
+	delete(r.active, name)
+	if err != nil {
+		return nil, err
+	}
+	r.partials[name] = set
+	return set, nil
+}
+
+// depWalker collects the dependencies of a single template. Local
+// variables are tracked with the same scopes Check uses.
+type depWalker struct {
+	resolver *depResolver
+	file     string
+	set      *depSet
+	err      error
+}
+
+func (w *depWalker) node(n ast.Node, scope *checkScope) {
+	ast.Inspect(n, func(n ast.Node) bool {
+		if w.err != nil {
+			return false
+		}
+
+		switch n := n.(type) {
+		case *ast.LetStatement:
+			w.expr(n.Value, scope)
+			if n.Name != nil {
+				scope.vars[n.Name.Value] = nil
+			}
+			return false
+		case *ast.AssignExpression:
+			w.expr(n.Value, scope)
+			if n.Name != nil {
+				w.variable(n.Name.Value, scope)
+			}
+			return false
+		case *ast.Identifier:
+			if n.Callee == nil {
+				w.variable(n.Value, scope)
+			}
+		case *ast.HashLiteral:
+			for _, key := range n.Order {
+				w.expr(n.Pairs[key], scope)
+			}
+			return false
+		case *ast.IfExpression:
+			w.expr(n.Condition, scope)
+			w.block(n.Block, scope)
+			for _, ei := range n.ElseIf {
+				w.expr(ei.Condition, scope)
+				w.block(ei.Block, scope)
+			}
+			w.block(n.ElseBlock, scope)
+			return false
+		case *ast.ForExpression:
+			w.expr(n.Iterable, scope)
+			inner := newCheckScope(scope)
+			inner.vars[n.KeyName] = nil
+			inner.vars[n.ValueName] = nil
+			w.block(n.Block, inner)
+			return false
+		case *ast.FunctionLiteral:
+			inner := newCheckScope(scope)
+			for _, p := range n.Parameters {
+				inner.vars[p.Value] = nil
+			}
+			w.block(n.Block, inner)
+			return false
+		case *ast.CallExpression:
+			w.call(n, scope)
+			return false
+		}
+		return true
+	})
+}
+
+func (w *depWalker) expr(e ast.Expression, scope *checkScope) {
+	if e != nil {
+		w.node(e, scope)
+	}
+}
+
+func (w *depWalker) block(b *ast.BlockStatement, scope *checkScope) {
+	if b != nil {
+		w.node(b, newCheckScope(scope))
+	}
+}
+
+func (w *depWalker) variable(name string, scope *checkScope) {
+	if _, ok := scope.lookup(name); !ok && name != "nil" {
+		w.set.variables[name] = true
+	}
+}
+
+func (w *depWalker) call(call *ast.CallExpression, scope *checkScope) {
+	name := ""
+	if id, ok := call.Function.(*ast.Identifier); ok && id.Callee == nil && call.Callee == nil {
+		if _, local := scope.lookup(id.Value); !local {
+			name = id.Value
+			w.set.helpers[name] = true
+		}
+	} else {
+		w.expr(call.Function, scope)
+	}
+	if call.Callee != nil && !isSharedCallee(call) {
+		w.expr(call.Callee, scope)
+	}
+	for _, a := range call.Arguments {
+		w.expr(a, scope)
+	}
+
+	switch name {
+	case "partial":
+		w.partial(call, scope)
+	case content.ForKey:
+		if key, ok := firstStringArg(call); ok {
+			w.set.contentFor[key] = true
+		}
+	case content.OfKey:
+		if key, ok := firstStringArg(call); ok {
+			w.set.contentOf[key] = true
+		}
+	}
+
+	w.block(call.Block, scope)
+	w.block(call.ElseBlock, scope)
+	if call.ChainCallee != nil {
+		inner := newCheckScope(scope)
+		inner.vars[call.Function.String()] = nil
+		w.expr(call.ChainCallee, inner)
+	}
+}
+
+// isSharedCallee reports whether the receiver of a method call is also
+// the callee of its function identifier and so has already been walked.
+func isSharedCallee(call *ast.CallExpression) bool {
+	id, ok := call.Function.(*ast.Identifier)
+	return ok && id.Callee != nil && ast.Expression(id.Callee) == call.Callee
+}
+
+func (w *depWalker) partial(call *ast.CallExpression, scope *checkScope) {
+	name, ok := firstStringArg(call)
+	if !ok {
+		if len(call.Arguments) > 0 {
+			w.dynamic(call, call.Arguments[0])
+		}
+		return
+	}
+
+	passed := map[string]bool{}
+	var layout ast.Expression
+	if len(call.Arguments) > 1 {
+		if data, ok := call.Arguments[1].(*ast.HashLiteral); ok {
+			for _, key := range data.Order {
+				passed[hashKeyName(key)] = true
+				if hashKeyName(key) == "layout" {
+					layout = data.Pairs[key]
+				}
+			}
+		}
+	}
+	if !w.include(name, passed, scope) || layout == nil {
+		return
+	}
+
+	// The partial is rendered into its layout, which sees the same data
+	// and the partial as yield.
+	s, ok := layout.(*ast.StringLiteral)
+	if !ok {
+		w.dynamic(call, layout)
+		return
+	}
+	passed["yield"] = true
+	w.include(s.Value, passed, scope)
+}
+
+// dynamic records the partial of call named by the computed expression
+// name.
+func (w *depWalker) dynamic(call *ast.CallExpression, name ast.Expression) {
+	w.set.addDynamic(DynamicPartial{
+		File:       w.file,
+		Line:       call.T().LineNumber,
+		Expression: name.String(),
+	})
+}
+
+// include records the partial name and merges in its dependencies, except
+// the variables passed to it. It reports false when resolving the partial
+// failed.
+func (w *depWalker) include(name string, passed map[string]bool, scope *checkScope) bool {
+	w.set.partials[name] = true
+
+	child, err := w.resolver.partial(name)
+	if err != nil {
+		w.err = err
+		return false
+	}
+	if child == nil {
+		return true
+	}
+
+	for k := range child.partials {
+		w.set.partials[k] = true
+	}
+	for _, d := range child.dynamic {
+		w.set.addDynamic(d)
+	}
+	for k := range child.contentFor {
+		w.set.contentFor[k] = true
+	}
+	for k := range child.contentOf {
+		w.set.contentOf[k] = true
+	}
+	for k := range child.helpers {
+		w.set.helpers[k] = true
+	}
+	for k := range child.variables {
+		if !passed[k] {
+			w.variable(k, scope)
+		}
+	}
+	return true
+}
+
+func firstStringArg(call *ast.CallExpression) (string, bool) {
+	if len(call.Arguments) == 0 {
+		return "", false
+	}
+	s, ok := call.Arguments[0].(*ast.StringLiteral)
+	if !ok {
+		return "", false
+	}
+	return s.Value, true
+}
+
+func hashKeyName(key ast.Expression) string {
+	if s, ok := key.(*ast.StringLiteral); ok {
+		return s.Value
+	}
+	return key.String()
+}
@@ -0,0 +1,96 @@
+package plush_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/gobuffalo/plush/v5"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Template_Dependencies(t *testing.T) {
+	r := require.New(t)
+	input := `<% let title = upcase(page.Title) %>
+<h1><%= title %></h1>
+<%= for (i, p) in posts { %><%= p.Name %> <%= truncate(p.Body, {size: 10}) %><% } %>
+<% contentFor("sidebar") { %><%= sidebarText %><% } %>
+<%= contentOf("footer") %>
+<%= if (current_user) { %><%= current_user.Name %><% } %>
+<% let f = fn(x) { return x + offset } %><%= f(1) %>
+<%= partial("header.html") %>
+<%= partial(kind + ".html") %>`
+
+	tmpl, err := plush.NewTemplate(input)
+	r.NoError(err)
+
+	deps, err := tmpl.Dependencies(nil)
+	r.NoError(err)
+	r.Equal([]string{"header.html"}, deps.Partials)
+	r.Equal([]plush.DynamicPartial{{Line: 9, Expression: "(kind + \".html\")"}}, deps.DynamicPartials)
+	r.Equal([]string{"sidebar"}, deps.ContentFor)
+	r.Equal([]string{"footer"}, deps.ContentOf)
+	r.Equal([]string{"contentFor", "contentOf", "partial", "truncate", "upcase"}, deps.Helpers)
+	r.Equal([]string{"current_user", "kind", "offset", "page", "posts", "sidebarText"}, deps.Variables)
+}
+
+func Test_Template_Dependencies_Resolves_Partials(t *testing.T) {
+	r := require.New(t)
+	partials := map[string]string{
+		"layout/header.html": `<%= partial("layout/nav.html", {links: menu}) %><%= site_name %>`,
+		"layout/nav.html":    `<%= for (l) in links { %><%= link_to(l.Name, l.URL) %><% } %><%= partial(nav_extra) %>`,
+		"loop.html":          `<%= partial("loop.html") %><%= depth %>`,
+	}
+	feeder := func(name string) (string, error) {
+		if s, ok := partials[name]; ok {
+			return s, nil
+		}
+		return "", fmt.Errorf("could not find %s", name)
+	}
+
+	tmpl, err := plush.NewTemplate(`<% let site_name = "x" %><%= partial("layout/header.html") %><%= partial("loop.html") %>`)
+	r.NoError(err)
+
+	deps, err := tmpl.Dependencies(feeder)
+	r.NoError(err)
+	r.Equal([]string{"layout/header.html", "layout/nav.html", "loop.html"}, deps.Partials)
+	r.Equal([]plush.DynamicPartial{{File: "layout/nav.html", Line: 1, Expression: "nav_extra"}}, deps.DynamicPartials)
+	r.Equal([]string{"link_to", "partial"}, deps.Helpers)
+	// links is passed in the data map and site_name is declared before
+	// the partial is rendered.
+	r.Equal([]string{"depth", "menu", "nav_extra"}, deps.Variables)
+
+	tmpl, err = plush.NewTemplate(`<%= partial("missing.html") %>`)
+	r.NoError(err)
+	_, err = tmpl.Dependencies(feeder)
+	r.Error(err)
+	r.Contains(err.Error(), `partial "missing.html"`)
+}
+
+func Test_Template_Dependencies_Partial_Layout(t *testing.T) {
+	r := require.New(t)
+	partials := map[string]string{
+		"card.html":  `<%= name %>`,
+		"shell.html": `<div class="<%= theme %>"><%= yield %></div>`,
+	}
+	feeder := func(name string) (string, error) {
+		if s, ok := partials[name]; ok {
+			return s, nil
+		}
+		return "", fmt.Errorf("could not find %s", name)
+	}
+
+	tmpl, err := plush.NewTemplate(`<%= partial("card.html", {name: "x", layout: "shell.html"}) %>
+<%= partial("card.html", {layout: shell}) %>`)
+	r.NoError(err)
+
+	deps, err := tmpl.Dependencies(feeder)
+	r.NoError(err)
+	r.Equal([]string{"card.html", "shell.html"}, deps.Partials)
+	r.Equal([]plush.DynamicPartial{{Line: 2, Expression: "shell"}}, deps.DynamicPartials)
+	r.Equal([]string{"name", "shell", "theme"}, deps.Variables)
+
+	partials["shell.html"] = `<%= partial("missing.html") %>`
+	_, err = tmpl.Dependencies(feeder)
+	r.Error(err)
+	r.Contains(err.Error(), `partial "missing.html"`)
+}
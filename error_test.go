@@ -21,3 +21,19 @@ func Test_Error_Type(t *testing.T) {
 	_, err := plush.Render(`<%= sqlError() %>`, ctx)
 	r.True(errors.Is(err, sql.ErrNoRows))
 }
+
+func Test_WrapTemplateError(t *testing.T) {
+	r := require.New(t)
+
+	_, err := plush.Render("<p>\n<%= nope %></p>", plush.NewContext())
+	r.Error(err)
+	err = plush.WrapTemplateError("index.plush.html", err)
+	r.True(plush.IsTemplateTraceError(err))
+	r.Equal(`index.plush.html:2: "nope": unknown identifier`, err.Error())
+
+	trace := &plush.TemplateTraceError{Frames: []plush.TemplateErrorFrame{{File: "a.html", Line: 1}}, Message: "boom"}
+	r.Same(trace, plush.WrapTemplateError("b.html", trace))
+
+	r.Equal("b.html: boom", plush.WrapTemplateError("b.html", errors.New("boom")).Error())
+	r.NoError(plush.WrapTemplateError("b.html", nil))
+}
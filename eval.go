@@ -0,0 +1,110 @@
+package plush
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/gobuffalo/plush/v5/ast"
+	"github.com/gobuffalo/plush/v5/helpers/hctx"
+	"github.com/gobuffalo/plush/v5/parser"
+)
+
+// ErrSideEffect is returned when a pure expression contains a let
+// statement or an assignment.
+var ErrSideEffect = errors.New("side effects are not allowed")
+
+// EvalOptions controls how an expression is parsed for evaluation.
+type EvalOptions struct {
+	// Pure rejects expressions that change variables: let statements,
+	// assignments and index assignments, including those in function
+	// literals and blocks. The helpers an expression calls are not
+	// checked.
+	Pure bool
+}
+
+// Eval evaluates a single Plush expression, as written between <%= and
+// %>, and returns its Go value instead of rendering it. Budgets attached
+// to ctx are charged like for a render.
+//
+//	ok, err := plush.Eval(`user.Age >= 18 && user.Email ~= "@example[.]com$"`, ctx)
+func Eval(expr string, ctx hctx.Context) (interface{}, error) {
+	return EvalWithOptions(expr, ctx, EvalOptions{})
+}
+
+// EvalWithOptions evaluates expr like Eval, honouring opts.
+func EvalWithOptions(expr string, ctx hctx.Context, opts EvalOptions) (interface{}, error) {
+	node, err := ParseExpr(expr, opts)
+	if err != nil {
+		return nil, err
+	}
+	if ctx == nil {
+		ctx = NewContext()
+	}
+	c := &compiler{
+		ctx:     ctx,
+		program: &ast.Program{},
+	}
+	v, err := c.evalExpression(node)
+	if err != nil {
+		return nil, err
+	}
+	return evalResult(v), nil
+}
+
+// evalResult returns the value a template function returned, instead of
+// the returnObject the interpreter wraps it in.
+func evalResult(v interface{}) interface{} {
+	r, ok := v.(returnObject)
+	if !ok {
+		return v
+	}
+	if len(r.Value) == 0 {
+		return nil
+	}
+	return evalResult(r.Value[len(r.Value)-1])
+}
+
+// ParseExpr parses a single Plush expression. With opts.Pure it returns
+// an error wrapping ErrSideEffect when the expression changes variables.
+func ParseExpr(expr string, opts EvalOptions) (ast.Expression, error) {
+	program, err := parser.Parse("<%= " + expr + " %>")
+	if err != nil {
+		return nil, err
+	}
+	if len(program.Statements) != 1 {
+		return nil, fmt.Errorf("%q is not a single expression", expr)
+	}
+	ret, ok := program.Statements[0].(*ast.ReturnStatement)
+	if !ok || ret.ReturnValue == nil {
+		return nil, fmt.Errorf("%q is not a single expression", expr)
+	}
+	if opts.Pure {
+		if err := checkPure(ret.ReturnValue); err != nil {
+			return nil, err
+		}
+	}
+	return ret.ReturnValue, nil
+}
+
+// checkPure reports the first let statement, assignment or index
+// assignment in node.
+func checkPure(node ast.Node) error {
+	var err error
+	ast.Inspect(node, func(n ast.Node) bool {
+		if err != nil {
+			return false
+		}
+		switch n := n.(type) {
+		case *ast.LetStatement:
+			err = fmt.Errorf("line %d: let %s: %w", n.T().LineNumber, n.Name.Value, ErrSideEffect)
+		case *ast.AssignExpression:
+			err = fmt.Errorf("line %d: assignment to %s: %w", n.T().LineNumber, n.Name.Value, ErrSideEffect)
+		case *ast.IndexExpression:
+			if n.Value != nil {
+				err = fmt.Errorf("line %d: assignment to %s: %w", n.T().LineNumber, n.Left, ErrSideEffect)
+			}
+		}
+		return err == nil
+	})
+	return err
+}
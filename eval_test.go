@@ -0,0 +1,105 @@
+package plush_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/gobuffalo/plush/v5"
+	"github.com/stretchr/testify/require"
+)
+
+type evalUser struct {
+	Name  string
+	Age   int
+	Email string
+	Tags  []string
+}
+
+func evalContext() *plush.Context {
+	return plush.NewContextWith(map[string]interface{}{
+		"user":  evalUser{Name: "mark", Age: 40, Email: "mark@example.com", Tags: []string{"beta"}},
+		"limit": 39.5,
+		"plans": map[string]interface{}{"pro": true},
+	})
+}
+
+func Test_Eval(t *testing.T) {
+	tests := []struct {
+		expr string
+		want interface{}
+	}{
+		{`1 + 2 * 3`, 7},
+		{`user.Age > limit`, true},
+		{`user.Age == 40.0`, true},
+		{`user.Email ~= "@example[.]com$"`, true},
+		{`user.Name ~= "^p"`, false},
+		{`len(user.Tags) > 0 && plans["pro"]`, true},
+		{`!plans["free"]`, true},
+		{`upcase(user.Name) + "!"`, "MARK!"},
+		{`[1, "a"]`, []interface{}{1, "a"}},
+		{`nil`, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.expr, func(t *testing.T) {
+			got, err := plush.Eval(tt.expr, evalContext())
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_Eval_Assignment(t *testing.T) {
+	r := require.New(t)
+	ctx := evalContext()
+	ctx.Set("count", 1)
+	v, err := plush.Eval(`count = count + 1`, ctx)
+	r.NoError(err)
+	r.Nil(v)
+	r.Equal(2, ctx.Value("count"))
+}
+
+func Test_Eval_Function_Result(t *testing.T) {
+	r := require.New(t)
+	ctx := evalContext()
+	double, err := plush.Eval(`fn(x) { return x * 2 }`, ctx)
+	r.NoError(err)
+	ctx.Set("double", double)
+
+	v, err := plush.Eval(`double(4)`, ctx)
+	r.NoError(err)
+	r.Equal(8, v)
+}
+
+func Test_Eval_Pure(t *testing.T) {
+	r := require.New(t)
+	for _, expr := range []string{
+		`count = 2`,
+		`plans["pro"] = false`,
+		`fn() { let x = 1; return x }()`,
+		`if (true) { count = 3 }`,
+	} {
+		_, err := plush.EvalWithOptions(expr, evalContext(), plush.EvalOptions{Pure: true})
+		r.True(errors.Is(err, plush.ErrSideEffect), "%s: %v", expr, err)
+	}
+
+	v, err := plush.EvalWithOptions(`user.Age >= 18`, evalContext(), plush.EvalOptions{Pure: true})
+	r.NoError(err)
+	r.Equal(true, v)
+}
+
+func Test_Eval_Errors(t *testing.T) {
+	r := require.New(t)
+	for _, expr := range []string{``, `1; 2`, `let x = 1`, `<b>`, `1 +`} {
+		_, err := plush.Eval(expr, nil)
+		r.Error(err, expr)
+	}
+	_, err := plush.Eval(`missing + 1`, nil)
+	r.ErrorContains(err, `"missing": unknown identifier`)
+}
+
+func Test_Eval_Budget(t *testing.T) {
+	r := require.New(t)
+	ctx := evalContext().WithBudget(plush.NewBudget(5))
+	_, err := plush.Eval(`for (i) in range(1, 10) { upcase("x") }`, ctx)
+	r.True(errors.Is(err, plush.ErrBudgetExceeded), "got %v", err)
+}
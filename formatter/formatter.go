@@ -0,0 +1,369 @@
+// Package formatter rewrites the code inside Plush template tags to a
+// canonical style, in the spirit of gofmt.
+//
+// Only the code between `<%`, `<%=` or `<%-` and `%>` is rewritten. HTML
+// text, comment tags (`<%# %>`) and `<%H %>` tags are copied through byte
+// for byte. Formatting is idempotent: formatting already formatted source
+// returns it unchanged.
+//
+// The canonical style is:
+//
+//   - one space after the opening delimiter and before `%>`
+//   - one space around binary operators and after commas and colons
+//   - no space inside parentheses, brackets and hash literals
+//   - `if` and `else if` conditions wrapped in parentheses
+//   - the line structure of multi-line code is kept, with each line
+//     indented two spaces per nesting level relative to the tag
+package formatter
+
+import (
+	"errors"
+	"sort"
+	"strings"
+
+	"github.com/gobuffalo/plush/v5/ast"
+	"github.com/gobuffalo/plush/v5/lexer"
+	"github.com/gobuffalo/plush/v5/parser"
+	"github.com/gobuffalo/plush/v5/token"
+)
+
+// Indent is the string used for each level of indentation inside
+// multi-line tags.
+const Indent = "  "
+
+// ErrChangedMeaning is returned when the formatted template would not
+// parse to the same program as the input. It indicates a formatter bug;
+// the input is left untouched in that case.
+var ErrChangedMeaning = errors.New("formatter: formatting would change the meaning of the template")
+
+// Format returns src with the code inside every tag rewritten to the
+// canonical style. Templates that do not parse are returned with the
+// parse error.
+func Format(src string) (string, error) {
+	prog, err := parser.Parse(src)
+	if err != nil {
+		return "", err
+	}
+
+	f := newFormatter(src, prog)
+	out := f.format()
+	if out == src {
+		return out, nil
+	}
+
+	check, err := parser.Parse(out)
+	if err != nil || check.String() != prog.String() {
+		return "", ErrChangedMeaning
+	}
+	return out, nil
+}
+
+// item is a token of a code tag prepared for printing.
+type item struct {
+	typ    token.Type
+	text   string
+	offset int
+	nl     int  // line breaks in the source before the item
+	block  bool // `{` or `}` delimiting a block rather than a hash
+	unary  bool // `-` used as a prefix operator
+}
+
+type formatter struct {
+	src    string
+	tokens []token.Token
+
+	// blocks holds the offsets of every `{` that opens a block.
+	blocks map[int]bool
+	// conds maps the offset of an `if` keyword to the offset of the `{`
+	// that ends its condition.
+	conds map[int]int
+	// braces tracks open braces across tags, true for blocks.
+	braces []bool
+
+	out strings.Builder
+}
+
+func newFormatter(src string, prog *ast.Program) *formatter {
+	f := &formatter{
+		src:    src,
+		blocks: map[int]bool{},
+		conds:  map[int]int{},
+	}
+
+	ast.Inspect(prog, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.BlockStatement:
+			if n.Pos() < len(src) && src[n.Pos()] == '{' {
+				f.blocks[n.Pos()] = true
+			}
+		case *ast.IfExpression:
+			if n.Condition != nil && n.Block != nil {
+				f.conds[n.Token.Offset] = n.Block.Pos()
+			}
+		case *ast.ElseIfExpression:
+			if n.Condition != nil && n.Block != nil {
+				f.conds[n.Token.Offset] = n.Block.Pos()
+			}
+		}
+		return true
+	})
+
+	l := lexer.New(src)
+	l.RecordComments()
+	for {
+		tok := l.NextToken()
+		if tok.Type == token.EOF {
+			break
+		}
+		f.tokens = append(f.tokens, tok)
+	}
+	f.tokens = append(f.tokens, l.Comments()...)
+	sort.SliceStable(f.tokens, func(i, j int) bool {
+		return f.tokens[i].Offset < f.tokens[j].Offset
+	})
+
+	return f
+}
+
+func (f *formatter) format() string {
+	last := 0
+	for i := 0; i < len(f.tokens); i++ {
+		tok := f.tokens[i]
+		if tok.Type != token.S_START && tok.Type != token.E_START && tok.Type != token.C_START {
+			continue
+		}
+
+		end := i + 1
+		for end < len(f.tokens) && f.tokens[end].Type != token.E_END {
+			end++
+		}
+		if end == len(f.tokens) {
+			// An unterminated tag is left as it is.
+			break
+		}
+		if tok.Type != token.C_START {
+			f.out.WriteString(f.src[last:tok.Offset])
+			f.formatTag(f.tokens[i : end+1])
+			last = f.tokens[end].EndOffset
+		}
+		i = end
+	}
+	f.out.WriteString(f.src[last:])
+	return f.out.String()
+}
+
+// formatTag prints a tag from its opening delimiter to its `%>`.
+func (f *formatter) formatTag(toks []token.Token) {
+	open := toks[0]
+	code := toks[1 : len(toks)-1]
+	closing := toks[len(toks)-1]
+
+	opener := open.Literal
+	if open.Type == token.S_START && len(code) > 0 && code[0].Type == token.MINUS && code[0].Offset == open.EndOffset {
+		opener = "<%-"
+		open.EndOffset = code[0].EndOffset
+		code = code[1:]
+	}
+
+	base := f.lineIndent()
+	f.out.WriteString(opener)
+	if len(code) == 0 {
+		f.out.WriteString(" %>")
+		return
+	}
+
+	items := f.items(open, code)
+	extra := 0
+	if items[0].nl > 0 {
+		extra = 1
+	}
+
+	depth := 0
+	for k, it := range items {
+		switch {
+		case it.nl > 0:
+			f.out.WriteString(strings.Repeat("\n", min(it.nl, 2)))
+			d := depth
+			for j := k; j < len(items) && isCloser(items[j].typ) && (j == k || items[j].nl == 0); j++ {
+				d--
+			}
+			f.out.WriteString(base + strings.Repeat(Indent, extra+max(d, 0)))
+		case k == 0 || spaceBetween(items[k-1], it):
+			f.out.WriteString(" ")
+		}
+		f.out.WriteString(it.text)
+
+		switch {
+		case isOpener(it.typ):
+			depth++
+		case isCloser(it.typ):
+			depth--
+		}
+	}
+
+	if strings.Contains(f.src[code[len(code)-1].EndOffset:closing.Offset], "\n") {
+		f.out.WriteString("\n" + base + "%>")
+		return
+	}
+	f.out.WriteString(" %>")
+}
+
+// items prepares the code tokens of a tag for printing.
+func (f *formatter) items(open token.Token, code []token.Token) []item {
+	items := make([]item, 0, len(code))
+	prev := open.EndOffset
+	for _, tok := range code {
+		it := item{
+			typ:    tok.Type,
+			text:   f.src[tok.Offset:tok.EndOffset],
+			offset: tok.Offset,
+			nl:     strings.Count(f.src[prev:tok.Offset], "\n"),
+		}
+		if tok.Type == token.COMMENT {
+			it.text = strings.TrimRight(it.text, " \t\r")
+		}
+		prev = tok.EndOffset
+
+		switch tok.Type {
+		case token.LBRACE:
+			it.block = f.blocks[tok.Offset]
+			f.braces = append(f.braces, it.block)
+		case token.RBRACE:
+			it.block = true
+			if n := len(f.braces); n > 0 {
+				it.block = f.braces[n-1]
+				f.braces = f.braces[:n-1]
+			}
+		case token.MINUS:
+			it.unary = len(items) == 0 || isPrefixPosition(items[len(items)-1].typ)
+		}
+		items = append(items, it)
+	}
+	return f.wrapConditions(items)
+}
+
+// wrapConditions adds parentheses around `if` conditions that are not
+// already wrapped in a single pair.
+func (f *formatter) wrapConditions(items []item) []item {
+	out := make([]item, 0, len(items))
+	for k := 0; k < len(items); k++ {
+		it := items[k]
+		out = append(out, it)
+
+		brace, ok := f.conds[it.offset]
+		if !ok || it.typ != token.IF {
+			continue
+		}
+		end := k + 1
+		for end < len(items) && items[end].offset != brace {
+			end++
+		}
+		if end == len(items) || end == k+1 || wrapped(items[k+1:end]) || hasComment(items[k+1:end]) {
+			continue
+		}
+
+		out = append(out, item{typ: token.LPAREN, text: "(", offset: it.offset})
+		out = append(out, items[k+1:end]...)
+		out = append(out, item{typ: token.RPAREN, text: ")", offset: items[end-1].offset})
+		k = end - 1
+	}
+	return out
+}
+
+// wrapped reports whether items is a single parenthesised expression.
+func wrapped(items []item) bool {
+	if items[0].typ != token.LPAREN {
+		return false
+	}
+	depth := 0
+	for k, it := range items {
+		switch it.typ {
+		case token.LPAREN:
+			depth++
+		case token.RPAREN:
+			depth--
+			if depth == 0 {
+				return k == len(items)-1
+			}
+		}
+	}
+	return false
+}
+
+func hasComment(items []item) bool {
+	for _, it := range items {
+		if it.typ == token.COMMENT {
+			return true
+		}
+	}
+	return false
+}
+
+// lineIndent returns the leading whitespace of the line being written.
+func (f *formatter) lineIndent() string {
+	s := f.out.String()
+	if i := strings.LastIndexByte(s, '\n'); i >= 0 {
+		s = s[i+1:]
+	}
+	return s[:len(s)-len(strings.TrimLeft(s, " \t"))]
+}
+
+func spaceBetween(prev, cur item) bool {
+	switch cur.typ {
+	case token.COMMA, token.SEMICOLON, token.COLON, token.RPAREN, token.RBRACKET, token.DOT:
+		return false
+	case token.RBRACE:
+		return cur.block
+	}
+
+	switch prev.typ {
+	case token.LPAREN, token.LBRACKET, token.DOT, token.BANG:
+		return false
+	case token.LBRACE:
+		return prev.block
+	case token.MINUS:
+		if prev.unary {
+			return false
+		}
+	}
+
+	switch cur.typ {
+	case token.LPAREN:
+		return !isOperand(prev.typ)
+	case token.LBRACKET:
+		return !isOperand(prev.typ) && prev.typ != token.STRING && prev.typ != token.B_STRING
+	}
+	return true
+}
+
+// isOperand reports whether a token of type t ends a value that can be
+// called or indexed.
+func isOperand(t token.Type) bool {
+	switch t {
+	case token.IDENT, token.RPAREN, token.RBRACKET, token.FUNCTION:
+		return true
+	}
+	return false
+}
+
+// isPrefixPosition reports whether an operator following a token of
+// type t is a prefix operator.
+func isPrefixPosition(t token.Type) bool {
+	switch t {
+	case token.ASSIGN, token.PLUS, token.MINUS, token.ASTERISK, token.SLASH,
+		token.EQ, token.NOT_EQ, token.LT, token.GT, token.LTEQ, token.GTEQ,
+		token.AND, token.OR, token.MATCHES, token.BANG,
+		token.LPAREN, token.LBRACKET, token.LBRACE, token.COMMA, token.COLON,
+		token.SEMICOLON, token.RETURN, token.IF, token.ELSE, token.IN, token.COMMENT:
+		return true
+	}
+	return false
+}
+
+func isOpener(t token.Type) bool {
+	return t == token.LPAREN || t == token.LBRACKET || t == token.LBRACE
+}
+
+func isCloser(t token.Type) bool {
+	return t == token.RPAREN || t == token.RBRACKET || t == token.RBRACE
+}
@@ -0,0 +1,102 @@
+package formatter_test
+
+import (
+	"testing"
+
+	"github.com/gobuffalo/plush/v5/formatter"
+	"github.com/gobuffalo/plush/v5/parser"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Format(t *testing.T) {
+	table := []struct {
+		name string
+		in   string
+		out  string
+	}{
+		{"tag spacing", `<p><%=x%></p><%   let y=1%>`, `<p><%= x %></p><% let y = 1 %>`},
+		{"empty tag", `<%%>`, `<% %>`},
+		{"trim tag", `<%-  name%>`, `<%- name %>`},
+		{"operators", `<%= a+b*-c==d&&!e %>`, `<%= a + b * -c == d && !e %>`},
+		{"calls", `<%= foo( a ,b ,  1 ) %>`, `<%= foo(a, b, 1) %>`},
+		{"method calls", `<%= user.Name.Upcase( ) %>`, `<%= user.Name.Upcase() %>`},
+		{"arrays and hashes", `<%= f([ 1,2 ] , { "a" :1,b:x [0] }) %>`, `<%= f([1, 2], {"a": 1, b: x[0]}) %>`},
+		{"strings untouched", `<%= "a  +  b" + ` + "`c  %`" + ` %>`, `<%= "a  +  b" + ` + "`c  %`" + ` %>`},
+		{"if without parens", `<%= if x{ %>a<% }else if y||z { %>b<% }else{ %>c<% } %>`, `<%= if (x) { %>a<% } else if (y || z) { %>b<% } else { %>c<% } %>`},
+		{"if with partial parens", `<%= if (a) && (b) { %>x<% } %>`, `<%= if ((a) && (b)) { %>x<% } %>`},
+		{"if with parens", `<%= if ( a ) { %>x<% } %>`, `<%= if (a) { %>x<% } %>`},
+		{"for", `<%= for (i,v) in range(1,3){ %><%=v%><% } %>`, `<%= for (i, v) in range(1, 3) { %><%= v %><% } %>`},
+		{"call block", `<%= form({action:"/x"}){ %>x<% } %>`, `<%= form({action: "/x"}) { %>x<% } %>`},
+		{"function literal", `<% let f = fn(a,b){return a+b} %>`, `<% let f = fn(a, b) { return a + b } %>`},
+		{"comment tags untouched", `<%#   note  %><%H  <b>{x}</b> %>`, `<%#   note  %><%H  <b>{x}</b> %>`},
+		{"line comment", "<% let x=1 # note  \n%>", "<% let x = 1 # note\n%>"},
+		{
+			"multi-line",
+			"<ul>\n    <%\n let  x=[1,\n2]\n\n\n\t  let f = fn(a){\n return a\n}\n    %>\n</ul>",
+			"<ul>\n    <%\n      let x = [1,\n        2]\n\n      let f = fn(a) {\n        return a\n      }\n    %>\n</ul>",
+		},
+		{
+			"multi-line from the opening line",
+			"<% let f = fn(a) {\n        return a\n   } %>",
+			"<% let f = fn(a) {\n  return a\n} %>",
+		},
+	}
+
+	for _, tt := range table {
+		t.Run(tt.name, func(st *testing.T) {
+			r := require.New(st)
+			out, err := formatter.Format(tt.in)
+			r.NoError(err)
+			r.Equal(tt.out, out)
+
+			again, err := formatter.Format(out)
+			r.NoError(err)
+			r.Equal(out, again)
+		})
+	}
+}
+
+func Test_Format_Leaves_HTML_Alone(t *testing.T) {
+	r := require.New(t)
+	in := "<div   class=\"a\">\n\t\\<%= not code %>  <%=x%>\n   </div>\n"
+	out, err := formatter.Format(in)
+	r.NoError(err)
+	r.Equal("<div   class=\"a\">\n\t\\<%= not code %>  <%= x %>\n   </div>\n", out)
+}
+
+func Test_Format_Parse_Error(t *testing.T) {
+	r := require.New(t)
+	_, err := formatter.Format(`<%= if { %>`)
+	r.Error(err)
+}
+
+func Fuzz_Format(f *testing.F) {
+	for _, seed := range []string{
+		`<p><%=x%></p>`,
+		`<%= if x{ %>a<% }else if(y) { %>b<% } %>`,
+		`<%- f(a,-1, {b:[1,2]}) %>`,
+		"<%\n let x = fn(a){\n return a * 2 # double\n}\n%>",
+		`<%= for (k, v) in {a: 1} { %><%= k %>=<%= v %><% } %>`,
+	} {
+		f.Add(seed)
+	}
+
+	f.Fuzz(func(t *testing.T, in string) {
+		prog, err := parser.Parse(in)
+		if err != nil {
+			return
+		}
+		out, err := formatter.Format(in)
+		if err != nil {
+			t.Fatalf("Format(%q): %s", in, err)
+		}
+		check, err := parser.Parse(out)
+		if err != nil || check.String() != prog.String() {
+			t.Fatalf("Format(%q) = %q changed the program", in, out)
+		}
+		again, err := formatter.Format(out)
+		if err != nil || again != out {
+			t.Fatalf("Format is not idempotent for %q: %q then %q (%v)", in, out, again, err)
+		}
+	})
+}
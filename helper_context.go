@@ -85,7 +85,18 @@ func (h HelperContext) BlockWith(hc hctx.Context) (string, error) {
 	}
 
 	bb := &strings.Builder{}
-	h.compiler.write(bb, i)
+	if sm := h.compiler.sourceMap; sm != nil {
+		h.compiler.sourceMap = sm.Sub()
+		defer func() { h.compiler.sourceMap = sm }()
+		if err := h.compiler.writeSource(bb, sourceLine{value: i, line: h.block.T().LineNumber}); err != nil {
+			return "", err
+		}
+		h.compiler.sourceMap.FinishBlock(bb.String())
+		return bb.String(), nil
+	}
+	if err := h.compiler.write(bb, i); err != nil {
+		return "", err
+	}
 
 	return bb.String(), nil
 }
@@ -0,0 +1,540 @@
+// Package js compiles Plush templates, typically partials, to standalone
+// JavaScript render functions, so the same markup can be rendered in the
+// browser.
+//
+// Only a subset of Plush compiles: literals, arrays and hashes, operators,
+// identifiers, indexing, if/else if/else, for loops with break and
+// continue, let, assignments, function literals and calls to functions
+// held by variables, to the helpers listed in Options.Helpers and to the
+// built-in len, raw, upcase, downcase, htmlEscape, toJSON, range, between
+// and until. Everything else, such as method calls, helpers taking a
+// block, partial() or holes, is rejected with an error wrapping
+// ErrUnsupported or ErrUnknownHelper.
+//
+// The compiled function renders what the interpreter renders for the same
+// data, with the same HTML escaping. The differences come from
+// JavaScript values: numbers that are whole behave as integers even when
+// they come from a float, `~=` uses JavaScript regular expressions,
+// dotted names read the properties of objects where Go reads the fields
+// of structs, and hashes are iterated in key order where Go's order is
+// random.
+package js
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/gobuffalo/plush/v5/ast"
+	"github.com/gobuffalo/plush/v5/parser"
+	"github.com/gobuffalo/plush/v5/token"
+)
+
+// ErrUnsupported is returned for a construct the JavaScript backend
+// doesn't compile.
+var ErrUnsupported = errors.New("not supported in JavaScript")
+
+// ErrUnknownHelper is returned for a call to a function that is neither a
+// built-in, a helper listed in Options.Helpers nor a template variable.
+var ErrUnknownHelper = errors.New("unknown helper")
+
+// builtins are the helpers the runtime implements.
+var builtins = []string{"len", "raw", "upcase", "downcase", "htmlEscape", "toJSON", "range", "between", "until"}
+
+var identifier = regexp.MustCompile(`^[A-Za-z_$][A-Za-z0-9_$]*$`)
+
+// Options controls the generated JavaScript.
+type Options struct {
+	// Name is the name of the render function; it defaults to render.
+	Name string
+	// Helpers are the names of the helpers the template may call besides
+	// the built-in ones. Their JavaScript implementations are passed to
+	// the render function, which calls them with the Plush arguments and
+	// `this` bound to an object whose raw(s) marks s as safe HTML and
+	// escape(s) escapes s.
+	Helpers []string
+	// Export declares the render function with an ES module export.
+	Export bool
+}
+
+// CompileString parses src and compiles it with Compile.
+func CompileString(src string, opts Options) (string, error) {
+	program, err := parser.Parse(src)
+	if err != nil {
+		return "", err
+	}
+	return Compile(program, opts)
+}
+
+// Compile returns the source of a JavaScript function rendering program:
+//
+//	var render = (function () { ... })();
+//	render(data, helpers) // returns the HTML as a string
+//
+// The keys of data are the template variables and helpers holds the
+// JavaScript implementations of opts.Helpers. Errors thrown while
+// rendering are prefixed with the template line, like Go's.
+func Compile(program *ast.Program, opts Options) (string, error) {
+	if opts.Name == "" {
+		opts.Name = "render"
+	}
+	if !identifier.MatchString(opts.Name) {
+		return "", fmt.Errorf("invalid function name %q", opts.Name)
+	}
+
+	c := &compiler{b: &strings.Builder{}, known: map[string]bool{}, fnBuf: -1}
+	for _, name := range builtins {
+		c.known[name] = true
+	}
+	for _, name := range opts.Helpers {
+		c.known[name] = true
+	}
+	ast.Inspect(program, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.LetStatement:
+			c.known[n.Name.Value] = true
+		case *ast.FunctionLiteral:
+			for _, p := range n.Parameters {
+				c.known[p.Value] = true
+			}
+		case *ast.ForExpression:
+			c.known[n.KeyName] = true
+			c.known[n.ValueName] = true
+		}
+		return true
+	})
+
+	if opts.Export {
+		c.b.WriteString("export ")
+	}
+	fmt.Fprintf(c.b, "var %s = (function () {\n\t\"use strict\";\n\n", opts.Name)
+	for _, line := range strings.SplitAfter(runtime, "\n") {
+		if line != "\n" && line != "" {
+			c.b.WriteString("\t")
+		}
+		c.b.WriteString(line)
+	}
+	fmt.Fprintf(c.b, "\n\treturn function %s(data, helpers) {\n", opts.Name)
+	c.depth = 2
+	c.emit("var line = 0;")
+	scope, out := c.name("s"), c.name("o")
+	c.emit("var %s = p.scope(data, helpers);", scope)
+	c.emit("var %s = [];", out)
+	c.emit("try {")
+	c.depth++
+	c.bufs = []string{out}
+	for _, s := range program.Statements {
+		if err := c.statement(s, scope, true); err != nil {
+			return "", err
+		}
+	}
+	c.depth--
+	c.emit("} catch (e) {")
+	c.emit("\tthrow p.fail(line, e);")
+	c.emit("}")
+	c.emit("return %s.join(\"\");", out)
+	c.b.WriteString("\t};\n})();\n")
+	return c.b.String(), nil
+}
+
+type compiler struct {
+	b     *strings.Builder
+	depth int
+	n     int
+	// known are the names a call may use.
+	known map[string]bool
+	// bufs is the stack of output buffers. Statements write to the last
+	// one; the output of if and for statements in <% %> tags goes to a
+	// buffer that is then dropped, as the interpreter drops it.
+	bufs []string
+	// fnBuf is the index in bufs of the buffer of the innermost function
+	// literal, or -1 outside functions.
+	fnBuf int
+	// loops holds the index in bufs of the buffer of each loop enclosing
+	// the statement within the innermost function.
+	loops []int
+	// line is the template line the generated code last recorded, or 0
+	// when it isn't known at this point of the code.
+	line int
+}
+
+func (c *compiler) emit(format string, args ...interface{}) {
+	c.b.WriteString(strings.Repeat("\t", c.depth))
+	fmt.Fprintf(c.b, format, args...)
+	c.b.WriteString("\n")
+}
+
+// name returns a fresh JavaScript variable name.
+func (c *compiler) name(prefix string) string {
+	c.n++
+	return prefix + strconv.Itoa(c.n)
+}
+
+func (c *compiler) out() string {
+	return c.bufs[len(c.bufs)-1]
+}
+
+func unsupported(n ast.Node, what string) error {
+	return fmt.Errorf("line %d: %s: %w", n.T().LineNumber, what, ErrUnsupported)
+}
+
+func quote(s string) string {
+	b, _ := json.Marshal(s)
+	return string(b)
+}
+
+// statement compiles s. top reports whether s is a statement of the
+// program rather than of a block: the interpreter drops the value of
+// top-level expression statements, while in blocks it keeps the HTML
+// they return.
+func (c *compiler) statement(s ast.Statement, scope string, top bool) error {
+	if line := s.T().LineNumber; line != c.line {
+		c.emit("line = %d;", line)
+		c.line = line
+	}
+	switch s := s.(type) {
+	case *ast.LetStatement:
+		v, err := c.expr(s.Value, scope)
+		if err != nil {
+			return err
+		}
+		c.emit("%s[%s] = %s;", scope, quote(s.Name.Value), v)
+	case *ast.ReturnStatement:
+		if s.Type == token.RETURN {
+			return c.returnStatement(s, scope)
+		}
+		switch e := s.ReturnValue.(type) {
+		case *ast.IfExpression:
+			return c.ifExpression(e, scope)
+		case *ast.ForExpression:
+			return c.forExpression(e, scope)
+		}
+		v, err := c.expr(s.ReturnValue, scope)
+		if err != nil {
+			return err
+		}
+		c.emit("p.write(%s, %s);", c.out(), v)
+	case *ast.ExpressionStatement:
+		switch e := s.Expression.(type) {
+		case *ast.HTMLLiteral:
+			c.emit("%s.push(%s);", c.out(), quote(e.Value))
+		case *ast.IfExpression:
+			return c.dropped(func() error { return c.ifExpression(e, scope) })
+		case *ast.ForExpression:
+			return c.dropped(func() error { return c.forExpression(e, scope) })
+		case *ast.BreakExpression:
+			return c.exitLoop(e, "break")
+		case *ast.ContinueExpression:
+			return c.exitLoop(e, "continue")
+		default:
+			v, err := c.expr(e, scope)
+			if err != nil {
+				return err
+			}
+			if top {
+				c.emit("%s;", v)
+			} else {
+				c.emit("p.keep(%s, %s);", c.out(), v)
+			}
+		}
+	default:
+		return unsupported(s, fmt.Sprintf("%T", s))
+	}
+	return nil
+}
+
+func (c *compiler) block(b *ast.BlockStatement, scope string) error {
+	// Blocks and the code following them are reached from several
+	// places, so each records its line again.
+	c.line = 0
+	defer func() { c.line = 0 }()
+	if b == nil {
+		return nil
+	}
+	for _, s := range b.Statements {
+		if err := c.statement(s, scope, false); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// dropped compiles an if or for statement whose output is dropped.
+func (c *compiler) dropped(compile func() error) error {
+	out := c.name("o")
+	c.emit("var %s = [];", out)
+	c.bufs = append(c.bufs, out)
+	defer func() { c.bufs = c.bufs[:len(c.bufs)-1] }()
+	return compile()
+}
+
+// pending returns the buffers opened since bufs[from], whose output the
+// interpreter keeps when a break, continue or return leaves them.
+func (c *compiler) pending(from int) string {
+	return "[" + strings.Join(c.bufs[from:], ", ") + "]"
+}
+
+func (c *compiler) exitLoop(n ast.Node, keyword string) error {
+	if len(c.loops) == 0 {
+		return unsupported(n, keyword+" outside a loop")
+	}
+	loop := c.loops[len(c.loops)-1]
+	if loop < len(c.bufs)-1 {
+		c.emit("p.flush(%s, %s);", c.bufs[loop], c.pending(loop+1))
+	}
+	c.emit("%s;", keyword)
+	return nil
+}
+
+func (c *compiler) returnStatement(s *ast.ReturnStatement, scope string) error {
+	if c.fnBuf < 0 {
+		return unsupported(s, "return outside a function")
+	}
+	if len(c.loops) > 0 {
+		return unsupported(s, "return inside a for loop")
+	}
+	v := "null"
+	if s.ReturnValue != nil {
+		var err error
+		if v, err = c.expr(s.ReturnValue, scope); err != nil {
+			return err
+		}
+	}
+	c.emit("return p.result(%s, %s);", c.pending(c.fnBuf), v)
+	return nil
+}
+
+func (c *compiler) ifExpression(e *ast.IfExpression, scope string) error {
+	s := c.name("s")
+	c.emit("var %s = Object.create(%s);", s, scope)
+	cond, err := c.expr(e.Condition, s)
+	if err != nil {
+		return err
+	}
+	c.emit("if (p.truthy(p.soft(function () { return %s; }))) {", cond)
+	c.depth++
+	if err := c.block(e.Block, s); err != nil {
+		return err
+	}
+	c.depth--
+	for _, ei := range e.ElseIf {
+		cond, err := c.expr(ei.Condition, s)
+		if err != nil {
+			return err
+		}
+		c.emit("} else if (p.truthy(p.soft(function () { return %s; }))) {", cond)
+		c.depth++
+		if err := c.block(ei.Block, s); err != nil {
+			return err
+		}
+		c.depth--
+	}
+	if e.ElseBlock != nil {
+		c.emit("} else {")
+		c.depth++
+		if err := c.block(e.ElseBlock, s); err != nil {
+			return err
+		}
+		c.depth--
+	}
+	c.emit("}")
+	return nil
+}
+
+func (c *compiler) forExpression(e *ast.ForExpression, scope string) error {
+	s, items, i := c.name("s"), c.name("items"), c.name("i")
+	c.emit("var %s = Object.create(%s);", s, scope)
+	iter, err := c.expr(e.Iterable, s)
+	if err != nil {
+		return err
+	}
+	c.emit("var %s = p.iter(%s);", items, iter)
+	c.emit("for (var %s = 0; %s < %s.length; %s++) {", i, i, items, i)
+	c.depth++
+	c.emit("%s[%s] = %s[%s][0];", s, quote(e.KeyName), items, i)
+	c.emit("%s[%s] = %s[%s][1];", s, quote(e.ValueName), items, i)
+	c.loops = append(c.loops, len(c.bufs)-1)
+	err = c.block(e.Block, s)
+	c.loops = c.loops[:len(c.loops)-1]
+	if err != nil {
+		return err
+	}
+	c.depth--
+	c.emit("}")
+	return nil
+}
+
+func (c *compiler) function(e *ast.FunctionLiteral) (string, error) {
+	b, depth, bufs, fnBuf, loops := c.b, c.depth, c.bufs, c.fnBuf, c.loops
+	defer func() {
+		c.b, c.depth, c.bufs, c.fnBuf, c.loops = b, depth, bufs, fnBuf, loops
+	}()
+
+	s, out := c.name("s"), c.name("o")
+	c.b = &strings.Builder{}
+	c.b.WriteString("p.fn(function (" + s + ", args) {\n")
+	c.depth++
+	for i, param := range e.Parameters {
+		c.emit("%s[%s] = p.arg(args, %d);", s, quote(param.Value), i)
+	}
+	c.emit("var %s = [];", out)
+	c.bufs = []string{out}
+	c.fnBuf = 0
+	c.loops = nil
+	if err := c.block(e.Block, s); err != nil {
+		return "", err
+	}
+	c.emit("return [p.html([%s])];", out)
+	c.b.WriteString(strings.Repeat("\t", depth) + "})")
+	return c.b.String(), nil
+}
+
+func (c *compiler) exprs(list []ast.Expression, scope string) (string, error) {
+	vs := make([]string, 0, len(list))
+	for _, e := range list {
+		v, err := c.expr(e, scope)
+		if err != nil {
+			return "", err
+		}
+		vs = append(vs, v)
+	}
+	return strings.Join(vs, ", "), nil
+}
+
+func (c *compiler) expr(e ast.Expression, scope string) (string, error) {
+	switch e := e.(type) {
+	case nil:
+		return "null", nil
+	case *ast.IntegerLiteral:
+		return strconv.Itoa(e.Value), nil
+	case *ast.FloatLiteral:
+		return strconv.FormatFloat(e.Value, 'g', -1, 64), nil
+	case *ast.StringLiteral:
+		return quote(e.Value), nil
+	case *ast.Boolean:
+		return strconv.FormatBool(e.Value), nil
+	case *ast.HTMLLiteral:
+		return "p.raw(" + quote(e.Value) + ")", nil
+	case *ast.Identifier:
+		if e.Callee != nil {
+			callee, err := c.expr(e.Callee, scope)
+			if err != nil {
+				return "", err
+			}
+			return fmt.Sprintf("p.prop(%s, %s)", callee, quote(e.Value)), nil
+		}
+		return fmt.Sprintf("p.get(%s, %s)", scope, quote(e.Value)), nil
+	case *ast.ArrayLiteral:
+		elems, err := c.exprs(e.Elements, scope)
+		if err != nil {
+			return "", err
+		}
+		return "[" + elems + "]", nil
+	case *ast.HashLiteral:
+		pairs := make([]string, 0, 2*len(e.Order))
+		for _, k := range e.Order {
+			v, err := c.expr(e.Pairs[k], scope)
+			if err != nil {
+				return "", err
+			}
+			pairs = append(pairs, quote(k.TokenLiteral()), v)
+		}
+		return "p.hash([" + strings.Join(pairs, ", ") + "])", nil
+	case *ast.PrefixExpression:
+		right, err := c.expr(e.Right, scope)
+		if err != nil {
+			return "", err
+		}
+		switch e.Operator {
+		case "!":
+			return fmt.Sprintf("!p.truthy(p.soft(function () { return %s; }))", right), nil
+		case "-":
+			return fmt.Sprintf("p.neg(p.soft(function () { return %s; }))", right), nil
+		}
+		return "", unsupported(e, "prefix operator "+e.Operator)
+	case *ast.InfixExpression:
+		left, err := c.expr(e.Left, scope)
+		if err != nil {
+			return "", err
+		}
+		right, err := c.expr(e.Right, scope)
+		if err != nil {
+			return "", err
+		}
+		switch e.Operator {
+		case "&&":
+			return fmt.Sprintf("p.and(function () { return %s; }, function () { return %s; })", left, right), nil
+		case "||":
+			return fmt.Sprintf("p.or(function () { return %s; }, function () { return %s; })", left, right), nil
+		case "==", "!=":
+			return fmt.Sprintf("p.op(%s, p.any(function () { return %s; }), p.any(function () { return %s; }))", quote(e.Operator), left, right), nil
+		}
+		return fmt.Sprintf("p.op(%s, %s, %s)", quote(e.Operator), left, right), nil
+	case *ast.IndexExpression:
+		if e.Callee != nil {
+			return "", unsupported(e, "field access on an indexed value")
+		}
+		left, err := c.expr(e.Left, scope)
+		if err != nil {
+			return "", err
+		}
+		index, err := c.expr(e.Index, scope)
+		if err != nil {
+			return "", err
+		}
+		if e.Value != nil {
+			v, err := c.expr(e.Value, scope)
+			if err != nil {
+				return "", err
+			}
+			return fmt.Sprintf("p.setIndex(%s, %s, %s)", left, index, v), nil
+		}
+		return fmt.Sprintf("p.index(%s, %s)", left, index), nil
+	case *ast.AssignExpression:
+		v, err := c.expr(e.Value, scope)
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("p.assign(%s, %s, %s)", scope, quote(e.Name.Value), v), nil
+	case *ast.FunctionLiteral:
+		return c.function(e)
+	case *ast.CallExpression:
+		return c.call(e, scope)
+	case *ast.IfExpression:
+		return "", unsupported(e, "if used as a value")
+	case *ast.ForExpression:
+		return "", unsupported(e, "for used as a value")
+	}
+	return "", unsupported(e, fmt.Sprintf("%T", e))
+}
+
+func (c *compiler) call(e *ast.CallExpression, scope string) (string, error) {
+	switch {
+	case e.Callee != nil:
+		return "", unsupported(e, "method call "+e.String())
+	case e.Block != nil:
+		return "", unsupported(e, "helper block "+e.Function.String())
+	case e.ChainCallee != nil:
+		return "", unsupported(e, "chained call "+e.String())
+	}
+
+	name := e.Function.String()
+	if id, ok := e.Function.(*ast.Identifier); ok && id.Callee == nil {
+		name = id.Value
+		if !c.known[name] {
+			return "", fmt.Errorf("line %d: call to %q: %w", e.T().LineNumber, name, ErrUnknownHelper)
+		}
+	}
+	f, err := c.expr(e.Function, scope)
+	if err != nil {
+		return "", err
+	}
+	args, err := c.exprs(e.Arguments, scope)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("p.call(%s, %s, %s, [%s])", f, quote(name), scope, args), nil
+}
@@ -0,0 +1,82 @@
+package js_test
+
+import (
+	"os/exec"
+	"strings"
+	"testing"
+
+	"github.com/gobuffalo/plush/v5/js"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Compile_Options(t *testing.T) {
+	r := require.New(t)
+
+	code, err := js.CompileString(`<%= title %>`, js.Options{})
+	r.NoError(err)
+	r.True(strings.HasPrefix(code, "var render = (function () {"))
+
+	code, err = js.CompileString(`<%= title %>`, js.Options{Name: "userCard", Export: true})
+	r.NoError(err)
+	r.True(strings.HasPrefix(code, "export var userCard = (function () {"))
+	r.Contains(code, "return function userCard(data, helpers) {")
+
+	_, err = js.CompileString(`<%= title %>`, js.Options{Name: "user-card"})
+	r.Error(err)
+	r.Contains(err.Error(), `invalid function name "user-card"`)
+}
+
+func Test_Compile_Unsupported(t *testing.T) {
+	table := []struct {
+		src string
+		err string
+	}{
+		{"<%= user.FullName() %>", "line 1: method call user.FullName(): "},
+		{"<%= contentFor(\"x\") { %>hi<% } %>", "line 1: helper block contentFor: "},
+		{"\n<% let x = if (a) { 1 } %>", "line 2: if used as a value: "},
+		{"<% return 1 %>", "line 1: return outside a function: "},
+		{"<% let f = fn() { for (x) in xs { return x } } %>", "line 1: return inside a for loop: "},
+		{"<%= users[0].Name %>", "line 1: field access on an indexed value: "},
+	}
+	for _, tt := range table {
+		t.Run(tt.src, func(t *testing.T) {
+			r := require.New(t)
+			_, err := js.CompileString(tt.src, js.Options{})
+			r.ErrorIs(err, js.ErrUnsupported)
+			r.Contains(err.Error(), tt.err)
+		})
+	}
+}
+
+func Test_Compile_Unknown_Helper(t *testing.T) {
+	r := require.New(t)
+
+	_, err := js.CompileString(`<%= partial("users/row.html") %>`, js.Options{})
+	r.ErrorIs(err, js.ErrUnknownHelper)
+	r.Contains(err.Error(), `line 1: call to "partial": `)
+
+	_, err = js.CompileString(`<%= partial("users/row.html") %>`, js.Options{Helpers: []string{"partial"}})
+	r.NoError(err)
+
+	// Variables holding functions may be called.
+	_, err = js.CompileString(`<% let f = fn(x) { return x } %><%= for (i, g) in [f] { %><%= g(i) %><% } %>`, js.Options{})
+	r.NoError(err)
+}
+
+func Test_Compile_Runtime_Error(t *testing.T) {
+	node, err := exec.LookPath("node")
+	if err != nil {
+		t.Skip("node is not installed")
+	}
+	r := require.New(t)
+
+	code, err := js.CompileString("<p>\n<%= 1 / n %>\n</p>", js.Options{})
+	r.NoError(err)
+	_, err = runNode(node, code+"\nrender({n: 0});\n")
+	r.Error(err)
+	r.Contains(err.Error(), "line 2: division by zero 1 / 0")
+
+	_, err = runNode(node, code+"\nrender({});\n")
+	r.Error(err)
+	r.Contains(err.Error(), `line 2: "n": unknown identifier`)
+}
@@ -0,0 +1,136 @@
+package js_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"html/template"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/gobuffalo/plush/v5"
+	"github.com/gobuffalo/plush/v5/js"
+	"github.com/stretchr/testify/require"
+)
+
+var update = flag.Bool("update", false, "rewrite the expected output in testdata/conformance")
+
+// goHelpers and jsHelpers implement the same helpers for the conformance
+// templates.
+var goHelpers = map[string]interface{}{
+	"shout": func(s string) string {
+		return strings.ToUpper(s) + "!"
+	},
+	"link": func(text, href string) template.HTML {
+		return template.HTML(`<a href="` + template.HTMLEscapeString(href) + `">` + template.HTMLEscapeString(text) + `</a>`)
+	},
+}
+
+const jsHelpers = `{
+	shout: function (s) { return s.toUpperCase() + "!"; },
+	link: function (text, href) { return this.raw('<a href="' + this.escape(href) + '">' + this.escape(text) + '</a>'); }
+}`
+
+// Test_Conformance renders every template of testdata/conformance with
+// the data of its .json file, through the interpreter and, when node is
+// installed, through the compiled JavaScript, and compares both to the
+// .html file.
+func Test_Conformance(t *testing.T) {
+	files, err := filepath.Glob(filepath.Join("testdata", "conformance", "*.plush"))
+	require.NoError(t, err)
+	require.NotEmpty(t, files)
+	node, _ := exec.LookPath("node")
+
+	for _, file := range files {
+		base := strings.TrimSuffix(file, ".plush")
+		t.Run(filepath.Base(base), func(t *testing.T) {
+			r := require.New(t)
+			src, err := os.ReadFile(file)
+			r.NoError(err)
+			data, err := os.ReadFile(base + ".json")
+			r.NoError(err)
+
+			ctx := plush.NewContextWith(goData(t, data))
+			for name, h := range goHelpers {
+				ctx.Set(name, h)
+			}
+			got, err := plush.Render(string(src), ctx)
+			r.NoError(err)
+			if *update {
+				r.NoError(os.WriteFile(base+".html", []byte(got), 0o644))
+			}
+			want, err := os.ReadFile(base + ".html")
+			r.NoError(err)
+			r.Equal(string(want), got, "Go")
+
+			if node == "" {
+				t.Log("node is not installed; skipping the JavaScript render")
+				return
+			}
+			names := make([]string, 0, len(goHelpers))
+			for name := range goHelpers {
+				names = append(names, name)
+			}
+			code, err := js.CompileString(string(src), js.Options{Helpers: names})
+			r.NoError(err)
+			got, err = runNode(node, code+"\nprocess.stdout.write(render("+string(data)+", "+jsHelpers+"));\n")
+			r.NoError(err)
+			r.Equal(string(want), got, "JavaScript")
+		})
+	}
+}
+
+// goData decodes the JSON data of a template, with whole numbers as ints
+// the way Go code usually passes them.
+func goData(t *testing.T, data []byte) map[string]interface{} {
+	t.Helper()
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	var m map[string]interface{}
+	require.NoError(t, dec.Decode(&m))
+	return goValue(m).(map[string]interface{})
+}
+
+func goValue(v interface{}) interface{} {
+	switch v := v.(type) {
+	case json.Number:
+		if i, err := v.Int64(); err == nil {
+			return int(i)
+		}
+		f, _ := v.Float64()
+		return f
+	case []interface{}:
+		for i := range v {
+			v[i] = goValue(v[i])
+		}
+	case map[string]interface{}:
+		for k := range v {
+			v[k] = goValue(v[k])
+		}
+	}
+	return v
+}
+
+func runNode(node, script string) (string, error) {
+	var stdout, stderr bytes.Buffer
+	cmd := exec.Command(node, "-")
+	cmd.Stdin = strings.NewReader(script)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		return "", &nodeError{err: err, stderr: stderr.String()}
+	}
+	return stdout.String(), nil
+}
+
+type nodeError struct {
+	err    error
+	stderr string
+}
+
+func (e *nodeError) Error() string {
+	return e.err.Error() + ": " + e.stderr
+}
@@ -0,0 +1,515 @@
+package js
+
+// runtime is the JavaScript every compiled template embeds. It implements
+// the Plush semantics the generated code relies on: scopes, truthiness,
+// operators, HTML escaping and the formatting of values the way Go's fmt
+// prints them.
+const runtime = `var p = (function () {
+	var hasOwn = Object.prototype.hasOwnProperty;
+
+	function SafeHTML(s) { this.s = s; }
+	function Iter(items) { this.items = items; }
+	function Fn(run) { this.run = run; }
+	function Result(items) { this.items = items; }
+
+	function unknown(name) {
+		var e = new Error(JSON.stringify(name) + ": unknown identifier");
+		e.unknownIdentifier = true;
+		return e;
+	}
+
+	function isMap(v) {
+		if (v === null || typeof v !== "object" || Array.isArray(v)) {
+			return false;
+		}
+		var proto = Object.getPrototypeOf(v);
+		return proto === Object.prototype || proto === null;
+	}
+
+	var escapes = { "\u0000": "\uFFFD", "\"": "&#34;", "'": "&#39;", "&": "&amp;", "<": "&lt;", ">": "&gt;" };
+	function escape(s) {
+		return String(s).replace(/[\u0000"'&<>]/g, function (c) { return escapes[c]; });
+	}
+
+	function number(n) {
+		if (n !== n) {
+			return "NaN";
+		}
+		if (n === Infinity) {
+			return "+Inf";
+		}
+		if (n === -Infinity) {
+			return "-Inf";
+		}
+		var a = Math.abs(n);
+		if (Number.isInteger(n) || a >= 1e-4 && a < 1e6) {
+			return String(n);
+		}
+		var parts = n.toExponential().split("e");
+		var digits = parts[1].slice(1);
+		return parts[0] + "e" + parts[1].charAt(0) + (digits.length < 2 ? "0" : "") + digits;
+	}
+
+	function sprint(v) {
+		if (v === null || v === undefined) {
+			return "<nil>";
+		}
+		switch (typeof v) {
+		case "string":
+			return v;
+		case "number":
+			return number(v);
+		case "boolean":
+			return v ? "true" : "false";
+		}
+		if (v instanceof SafeHTML) {
+			return v.s;
+		}
+		if (Array.isArray(v)) {
+			return "[" + v.map(sprint).join(" ") + "]";
+		}
+		if (isMap(v)) {
+			return "map[" + Object.keys(v).sort().map(function (k) { return k + ":" + sprint(v[k]); }).join(" ") + "]";
+		}
+		return String(v);
+	}
+
+	function write(out, v) {
+		if (v === null || v === undefined) {
+			return;
+		}
+		switch (typeof v) {
+		case "string":
+			out.push(escape(v));
+			return;
+		case "number":
+			out.push(number(v));
+			return;
+		case "boolean":
+			out.push(v ? "true" : "false");
+			return;
+		}
+		if (v instanceof SafeHTML) {
+			out.push(v.s);
+		} else if (Array.isArray(v)) {
+			for (var i = 0; i < v.length; i++) {
+				write(out, v[i]);
+			}
+		} else if (v instanceof Result) {
+			write(out, v.items);
+		}
+	}
+
+	function truthy(v) {
+		if (v === null || v === undefined) {
+			return false;
+		}
+		if (typeof v === "boolean") {
+			return v;
+		}
+		if (typeof v === "string") {
+			return v !== "";
+		}
+		if (v instanceof SafeHTML) {
+			return v.s !== "";
+		}
+		return true;
+	}
+
+	function typeName(v) {
+		if (v === null || v === undefined) {
+			return "nil";
+		}
+		if (Array.isArray(v)) {
+			return "array";
+		}
+		if (v instanceof SafeHTML) {
+			return "html";
+		}
+		if (v instanceof Result) {
+			return "function result";
+		}
+		if (isMap(v)) {
+			return "map";
+		}
+		return typeof v;
+	}
+
+	function unable(o, l, r) {
+		return new Error("unable to operate (" + o + ") on " + typeName(l) + " and " + typeName(r));
+	}
+
+	function numbers(o, l, r) {
+		switch (o) {
+		case "+":
+			return l + r;
+		case "-":
+			return l - r;
+		case "*":
+			return l * r;
+		case "/":
+			if (r === 0) {
+				throw new Error("division by zero " + number(l) + " / " + number(r));
+			}
+			return Number.isInteger(l) && Number.isInteger(r) ? Math.trunc(l / r) : l / r;
+		case "<":
+			return l < r;
+		case ">":
+			return l > r;
+		case "<=":
+			return l <= r;
+		case ">=":
+			return l >= r;
+		case "==":
+			return l === r;
+		case "!=":
+			return l !== r;
+		}
+		throw new Error("unknown operator for numeric " + o);
+	}
+
+	function strings(o, l, r) {
+		r = sprint(r);
+		switch (o) {
+		case "+":
+			return l + r;
+		case "<":
+			return l < r;
+		case ">":
+			return l > r;
+		case "<=":
+			return l <= r;
+		case ">=":
+			return l >= r;
+		case "==":
+			return l === r;
+		case "!=":
+			return l !== r;
+		case "~=":
+			var re;
+			try {
+				re = new RegExp(r);
+			} catch (e) {
+				throw new Error("couldn't compile regex " + r);
+			}
+			return re.test(l);
+		}
+		throw new Error("unknown operator for string " + o);
+	}
+
+	function op(o, l, r) {
+		if (l === undefined) {
+			l = null;
+		}
+		if (r === undefined) {
+			r = null;
+		}
+		if (l === null || r === null) {
+			if (o === "==") {
+				return l === r;
+			}
+			if (o === "!=") {
+				return l !== r;
+			}
+			throw new Error("unknown operator '" + o + "' on '" + typeName(l) + "' and '" + typeName(r) + "'");
+		}
+		if (typeof l === "number" && typeof r === "number") {
+			return numbers(o, l, r);
+		}
+		if (typeof l === "string") {
+			return strings(o, l, r);
+		}
+		if (typeof l === "boolean") {
+			var lt = truthy(l), rt = truthy(r);
+			switch (o) {
+			case "+":
+				return lt && rt;
+			case "==":
+				return lt === rt;
+			case "!=":
+				return lt !== rt;
+			}
+			throw new Error("unknown operator (" + o + ") on bool and bool");
+		}
+		if (Array.isArray(l)) {
+			if (o === "+") {
+				return l.concat([r]);
+			}
+			throw new Error("unknown operator " + JSON.stringify(o) + " on array and " + typeName(r));
+		}
+		throw unable(o, l, r);
+	}
+
+	function utf8Length(s) {
+		var n = 0;
+		for (var i = 0; i < s.length; i++) {
+			var c = s.charCodeAt(i);
+			if (c < 0x80) {
+				n += 1;
+			} else if (c < 0x800) {
+				n += 2;
+			} else if (c >= 0xD800 && c < 0xDC00 && i + 1 < s.length) {
+				n += 4;
+				i++;
+			} else {
+				n += 3;
+			}
+		}
+		return n;
+	}
+
+	function json(v) {
+		if (v === null || v === undefined || typeof v === "function" || v instanceof Fn) {
+			return "null";
+		}
+		if (v instanceof SafeHTML) {
+			v = v.s;
+		}
+		if (v instanceof Iter) {
+			return "{}";
+		}
+		if (Array.isArray(v)) {
+			return "[" + v.map(json).join(",") + "]";
+		}
+		if (typeof v === "object") {
+			return "{" + Object.keys(v).sort().map(function (k) { return json(k) + ":" + json(v[k]); }).join(",") + "}";
+		}
+		return JSON.stringify(v).replace(/[<>&\u2028\u2029]/g, function (c) {
+			return "\\u" + ("000" + c.charCodeAt(0).toString(16)).slice(-4);
+		});
+	}
+
+	function between(a, b) {
+		var items = [];
+		for (var i = a; i < b; i++) {
+			items.push(i);
+		}
+		return new Iter(items);
+	}
+
+	var builtins = {
+		len: function (v) {
+			if (v === null || v === undefined) {
+				return 0;
+			}
+			if (typeof v === "string") {
+				return utf8Length(v);
+			}
+			if (v instanceof SafeHTML) {
+				return utf8Length(v.s);
+			}
+			if (Array.isArray(v)) {
+				return v.length;
+			}
+			if (isMap(v)) {
+				return Object.keys(v).length;
+			}
+			throw new Error("len: " + typeName(v) + " has no length");
+		},
+		raw: function (s) { return new SafeHTML(sprint(s)); },
+		upcase: function (s) { return sprint(s).toUpperCase(); },
+		downcase: function (s) { return sprint(s).toLowerCase(); },
+		htmlEscape: function (s) { return escape(sprint(s)); },
+		toJSON: function (v) { return new SafeHTML(json(v)); },
+		range: function (a, b) { return between(a, b + 1); },
+		between: function (a, b) { return between(a + 1, b); },
+		until: function (a) { return between(0, a); }
+	};
+
+	var api = {
+		raw: builtins.raw,
+		escape: escape
+	};
+
+	return {
+		scope: function (data, helpers) {
+			var base = Object.create(null);
+			var k;
+			for (k in builtins) {
+				base[k] = builtins[k];
+			}
+			for (k in helpers || {}) {
+				if (hasOwn.call(helpers, k)) {
+					base[k] = helpers[k];
+				}
+			}
+			var s = Object.create(base);
+			for (k in data || {}) {
+				if (hasOwn.call(data, k)) {
+					s[k] = data[k];
+				}
+			}
+			return s;
+		},
+		get: function (s, name) {
+			var v = s[name];
+			if (v !== null && v !== undefined) {
+				return v;
+			}
+			if (name === "nil") {
+				return null;
+			}
+			throw unknown(name);
+		},
+		assign: function (s, name, v) {
+			for (var o = s; o !== null; o = Object.getPrototypeOf(o)) {
+				if (hasOwn.call(o, name)) {
+					o[name] = v;
+					return null;
+				}
+			}
+			throw unknown(name);
+		},
+		prop: function (v, name) {
+			if (v === null || v === undefined) {
+				return null;
+			}
+			if (!isMap(v) || !hasOwn.call(v, name)) {
+				throw new Error(typeName(v) + " does not have a field or method named '" + name + "'");
+			}
+			return v[name] === undefined ? null : v[name];
+		},
+		index: function (l, i) {
+			if (Array.isArray(l)) {
+				if (!Number.isInteger(i)) {
+					throw new Error("can't access Slice/Array with a non int Index (" + sprint(i) + ")");
+				}
+				if (i < 0 || i >= l.length) {
+					throw new Error("array index out of bounds, got index " + i + ", while array size is " + l.length);
+				}
+				return l[i] === undefined ? null : l[i];
+			}
+			if (isMap(l)) {
+				if (typeof i !== "string") {
+					throw new Error("cannot use " + sprint(i) + " (" + typeName(i) + " constant) as string value in map index");
+				}
+				return hasOwn.call(l, i) && l[i] !== undefined ? l[i] : null;
+			}
+			throw new Error("could not index " + typeName(l) + " with " + typeName(i));
+		},
+		setIndex: function (l, i, v) {
+			if (Array.isArray(l)) {
+				if (!Number.isInteger(i)) {
+					throw new Error("can't access Slice/Array with a non int Index (" + sprint(i) + ")");
+				}
+				if (i < 0 || i >= l.length) {
+					throw new Error("array index out of bounds, got index " + i + ", while array size is " + l.length);
+				}
+				l[i] = v;
+				return null;
+			}
+			if (isMap(l) && typeof i === "string") {
+				l[i] = v;
+				return null;
+			}
+			throw new Error("could not index " + typeName(l) + " with " + typeName(i));
+		},
+		hash: function (pairs) {
+			var m = {};
+			for (var i = 0; i < pairs.length; i += 2) {
+				m[pairs[i]] = pairs[i + 1];
+			}
+			return m;
+		},
+		iter: function (v) {
+			var items = [];
+			var i;
+			if (v === null || v === undefined) {
+				return items;
+			}
+			if (v instanceof Iter) {
+				v = v.items;
+			}
+			if (Array.isArray(v)) {
+				for (i = 0; i < v.length; i++) {
+					items.push([i, v[i] === undefined ? null : v[i]]);
+				}
+				return items;
+			}
+			if (isMap(v)) {
+				var keys = Object.keys(v).sort();
+				for (i = 0; i < keys.length; i++) {
+					items.push([keys[i], v[keys[i]]]);
+				}
+				return items;
+			}
+			throw new Error("could not iterate over " + typeName(v));
+		},
+		call: function (f, name, s, args) {
+			if (f instanceof Fn) {
+				return f.run(Object.create(s), args);
+			}
+			if (typeof f !== "function") {
+				throw new Error(name + " is an invalid function");
+			}
+			var v;
+			try {
+				v = f.apply(api, args);
+			} catch (e) {
+				throw new Error("could not call " + name + " function: " + (e && e.message !== undefined ? e.message : e));
+			}
+			return v === undefined ? null : v;
+		},
+		fn: function (run) { return new Fn(run); },
+		arg: function (args, i) {
+			return i < args.length && args[i] !== undefined ? args[i] : null;
+		},
+		html: function (bufs) {
+			var s = "";
+			for (var i = 0; i < bufs.length; i++) {
+				s += bufs[i].join("");
+			}
+			return new SafeHTML(s);
+		},
+		result: function (bufs, v) {
+			return new Result([this.html(bufs), v]);
+		},
+		flush: function (out, bufs) {
+			out.push(this.html(bufs).s);
+		},
+		soft: function (f) {
+			try {
+				return f();
+			} catch (e) {
+				if (e && e.unknownIdentifier) {
+					return null;
+				}
+				throw e;
+			}
+		},
+		any: function (f) {
+			try {
+				return f();
+			} catch (e) {
+				return null;
+			}
+		},
+		and: function (l, r) {
+			return truthy(this.any(l)) && truthy(this.any(r));
+		},
+		or: function (l, r) {
+			return truthy(this.any(l)) || truthy(this.any(r));
+		},
+		neg: function (v) {
+			if (typeof v !== "number") {
+				throw new Error("unknown operator -");
+			}
+			return -v;
+		},
+		op: op,
+		truthy: truthy,
+		write: write,
+		keep: function (out, v) {
+			if (v instanceof SafeHTML) {
+				out.push(v.s);
+			}
+		},
+		raw: function (s) { return new SafeHTML(s); },
+		fail: function (line, e) {
+			var err = new Error("line " + line + ": " + (e && e.message !== undefined ? e.message : e));
+			err.cause = e;
+			return err;
+		}
+	};
+})();
+`
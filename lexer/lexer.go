@@ -2,27 +2,53 @@ package lexer
 
 import (
 	"strings"
+	"unicode"
+	"unicode/utf8"
 
 	"github.com/gobuffalo/plush/v5/token"
 )
 
-// Lexer moves through the source input and tokenizes its content
+// Lexer moves through the source input and tokenizes its content.
+// Positions are byte offsets into the input, while line and column
+// numbers are counted in runes so multi-byte characters are never split.
 type Lexer struct {
 	input        string
 	position     int  // current position in input (points to current char)
 	readPosition int  // current reading position in input (after current char)
-	ch           byte // current char under examination
+	ch           rune // current char under examination
 	inside       bool
 	curLine      int
+	curColumn    int // rune column of the current char, 1-based
+
+	recordComments bool
+	comments       []token.Token
 }
 
 // New Lexer from the input string
 func New(input string) *Lexer {
-	l := &Lexer{input: input, curLine: 1}
+	return NewAt(input, 1)
+}
+
+// NewAt returns a Lexer like New that numbers the first line of input
+// line, for input cut from a larger template.
+func NewAt(input string, line int) *Lexer {
+	l := &Lexer{input: input, curLine: line}
 	l.readChar()
 	return l
 }
 
+// RecordComments makes the lexer keep the `#` line comments it skips
+// inside tags so they can be read back with Comments.
+func (l *Lexer) RecordComments() {
+	l.recordComments = true
+}
+
+// Comments returns the `#` line comments seen so far. It is always empty
+// unless RecordComments was called before lexing.
+func (l *Lexer) Comments() []token.Token {
+	return l.comments
+}
+
 // NextToken from the source input
 func (l *Lexer) NextToken() token.Token {
 	if l.inside {
@@ -36,6 +62,9 @@ func (l *Lexer) NextToken() token.Token {
 		tok.Literal = ""
 		tok.Type = token.EOF
 		tok.LineNumber = l.curLine
+		tok.Column = l.curColumn
+		tok.Offset = l.position
+		tok.EndOffset = l.position
 		return tok
 	}
 
@@ -44,9 +73,13 @@ func (l *Lexer) NextToken() token.Token {
 		return l.nextInsideToken()
 	}
 
+	line, column, offset := l.curLine, l.curColumn, l.position
 	tok.Type = token.HTML
 	tok.Literal = l.readHTML()
-	tok.LineNumber = l.curLine
+	tok.LineNumber = line
+	tok.Column = column
+	tok.Offset = offset
+	tok.EndOffset = l.position
 
 	return tok
 }
@@ -55,6 +88,7 @@ func (l *Lexer) nextInsideToken() token.Token {
 	var tok token.Token
 
 	l.skipWhitespace()
+	line, column, offset := l.curLine, l.curColumn, l.position
 
 	switch l.ch {
 	case '=':
@@ -71,7 +105,7 @@ func (l *Lexer) nextInsideToken() token.Token {
 			tokSplit := strings.Split(tok.Literal, ".")
 			switch {
 			case len(tokSplit) > 2:
-				return l.newIllegalTokenLiteral(token.ILLEGAL, tok.Literal)
+				return l.newIllegalTokenLiteral(token.ILLEGAL, tok.Literal, line, column, offset)
 			case len(tokSplit) == 2:
 				tok.Type = "FLOAT"
 			default:
@@ -186,7 +220,17 @@ func (l *Lexer) nextInsideToken() token.Token {
 				break
 			}
 		}
-		tok = l.nextInsideToken()
+		if l.recordComments {
+			l.comments = append(l.comments, token.Token{
+				Type:       token.COMMENT,
+				Literal:    l.input[offset:l.position],
+				LineNumber: line,
+				Column:     column,
+				Offset:     offset,
+				EndOffset:  l.position,
+			})
+		}
+		return l.nextInsideToken()
 	case '[':
 		tok = l.newToken(token.LBRACKET)
 	case ']':
@@ -198,20 +242,26 @@ func (l *Lexer) nextInsideToken() token.Token {
 		if isLetter(l.ch) {
 			tok.Literal = l.readIdentifier()
 			tok.Type = token.LookupIdent(tok.Literal)
-			tok.LineNumber = l.curLine
+			tok.LineNumber = line
+			tok.Column = column
+			tok.Offset = offset
+			tok.EndOffset = l.position
 			return tok
 		} else if isDigit(l.ch) {
 			tok.Literal = l.readNumber()
 			tokSplit := strings.Split(tok.Literal, ".")
 			switch {
 			case len(tokSplit) > 2:
-				return l.newIllegalTokenLiteral(token.ILLEGAL, tok.Literal)
+				return l.newIllegalTokenLiteral(token.ILLEGAL, tok.Literal, line, column, offset)
 			case len(tokSplit) == 2:
 				tok.Type = "FLOAT"
 			default:
 				tok.Type = "INT"
 			}
-			tok.LineNumber = l.curLine
+			tok.LineNumber = line
+			tok.Column = column
+			tok.Offset = offset
+			tok.EndOffset = l.position
 			return tok
 		} else {
 			tok = l.newToken(token.ILLEGAL)
@@ -219,7 +269,10 @@ func (l *Lexer) nextInsideToken() token.Token {
 	}
 
 	l.readChar()
-	tok.LineNumber = l.curLine
+	tok.LineNumber = line
+	tok.Column = column
+	tok.Offset = offset
+	tok.EndOffset = l.position
 	return tok
 }
 
@@ -235,37 +288,51 @@ func (l *Lexer) skipWhitespace() {
 }
 
 func (l *Lexer) readChar() {
+	width := 1
 	if l.readPosition >= len(l.input) {
 		l.ch = 0
+		l.position = len(l.input)
+		l.readPosition = len(l.input) + 1
+		return
+	} else if b := l.input[l.readPosition]; b < utf8.RuneSelf {
+		l.ch = rune(b)
 	} else {
-		l.ch = l.input[l.readPosition]
+		l.ch, width = utf8.DecodeRuneInString(l.input[l.readPosition:])
 	}
 
 	if l.ch == '\n' {
 		l.curLine++
+		l.curColumn = 0
+	} else {
+		l.curColumn++
 	}
 
 	l.position = l.readPosition
-	l.readPosition++
+	l.readPosition += width
 }
 
-func (l *Lexer) peekChar() byte {
+func (l *Lexer) peekChar() rune {
 	if l.readPosition >= len(l.input) {
 		return 0
 	}
-	return l.input[l.readPosition]
+	if b := l.input[l.readPosition]; b < utf8.RuneSelf {
+		return rune(b)
+	}
+	r, _ := utf8.DecodeRuneInString(l.input[l.readPosition:])
+	return r
 }
 
-func (l *Lexer) prevChar() byte {
-	if l.readPosition < 2 {
-		return l.input[l.readPosition-1]
+func (l *Lexer) prevChar() rune {
+	if l.position == 0 {
+		return l.ch
 	}
-	return l.input[l.readPosition-2]
+	r, _ := utf8.DecodeLastRuneInString(l.input[:l.position])
+	return r
 }
 
 func (l *Lexer) readIdentifier() string {
 	position := l.position
-	for isLetter(l.ch) || isDigit(l.ch) {
+	for isLetter(l.ch) || isDigit(l.ch) || unicode.IsDigit(l.ch) || unicode.IsMark(l.ch) {
 		l.readChar()
 	}
 	return l.input[position:l.position]
@@ -338,6 +405,9 @@ func (l *Lexer) readHString() string {
 			}
 		}
 	}
+	if l.ch == 0 {
+		return l.input[position:]
+	}
 	return l.input[position : l.position-1]
 }
 func (l *Lexer) readHTML() string {
@@ -367,22 +437,25 @@ func (l *Lexer) readHTML() string {
 	return strings.Replace(l.input[position:l.position], "\\<%", "<%", -1)
 }
 
-func isLetter(ch byte) bool {
-	return 'a' <= ch && ch <= 'z' || 'A' <= ch && ch <= 'Z' || ch == '_' || ch == '-'
+func isLetter(ch rune) bool {
+	if ch < utf8.RuneSelf {
+		return 'a' <= ch && ch <= 'z' || 'A' <= ch && ch <= 'Z' || ch == '_' || ch == '-'
+	}
+	return unicode.IsLetter(ch)
 }
 
-func isDigit(ch byte) bool {
+func isDigit(ch rune) bool {
 	return '0' <= ch && ch <= '9' || ch == '.'
 }
 
-func isDot(ch byte) bool {
+func isDot(ch rune) bool {
 	return ch == '.'
 }
 
 func (l *Lexer) newToken(tokenType token.Type) token.Token {
-	return token.Token{Type: tokenType, Literal: string(l.ch), LineNumber: l.curLine}
+	return token.Token{Type: tokenType, Literal: string(l.ch), LineNumber: l.curLine, Column: l.curColumn}
 }
 
-func (l *Lexer) newIllegalTokenLiteral(tokenType token.Type, literal string) token.Token {
-	return token.Token{Type: tokenType, Literal: literal, LineNumber: l.curLine}
+func (l *Lexer) newIllegalTokenLiteral(tokenType token.Type, literal string, line, column, offset int) token.Token {
+	return token.Token{Type: tokenType, Literal: literal, LineNumber: line, Column: column, Offset: offset, EndOffset: l.position}
 }
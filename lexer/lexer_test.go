@@ -3,6 +3,7 @@ package lexer_test
 import (
 	"log"
 	"testing"
+	"unicode/utf8"
 
 	"github.com/gobuffalo/plush/v5/lexer"
 	"github.com/gobuffalo/plush/v5/token"
@@ -421,3 +422,154 @@ my-helper()
 		r.Equal(tt.expectedType, tok.Type)
 	}
 }
+
+func Test_Next_Token_Unicode_Identifiers(t *testing.T) {
+	r := require.New(t)
+	input := `<%= let 名前 = "太郎"; größe + ñame_2 %>`
+	tests := []struct {
+		tokenType    token.Type
+		tokenLiteral string
+	}{
+		{token.E_START, "<%="},
+		{token.LET, "let"},
+		{token.IDENT, "名前"},
+		{token.ASSIGN, "="},
+		{token.STRING, "太郎"},
+		{token.SEMICOLON, ";"},
+		{token.IDENT, "größe"},
+		{token.PLUS, "+"},
+		{token.IDENT, "ñame_2"},
+		{token.E_END, "%>"},
+		{token.EOF, ""},
+	}
+
+	l := lexer.New(input)
+	for _, tt := range tests {
+		tok := l.NextToken()
+		r.Equal(tt.tokenType, tok.Type)
+		r.Equal(tt.tokenLiteral, tok.Literal)
+	}
+}
+
+func Test_Next_Token_Rune_Columns(t *testing.T) {
+	r := require.New(t)
+	input := "<p>ü</p><%= 名前 + ü %>\n<%= x %>"
+	tests := []struct {
+		tokenType token.Type
+		line      int
+		column    int
+	}{
+		{token.HTML, 1, 1},
+		{token.E_START, 1, 9},
+		{token.IDENT, 1, 13},
+		{token.PLUS, 1, 16},
+		{token.IDENT, 1, 18},
+		{token.E_END, 1, 20},
+		{token.HTML, 2, 0},
+		{token.E_START, 2, 1},
+		{token.IDENT, 2, 5},
+	}
+
+	l := lexer.New(input)
+	for _, tt := range tests {
+		tok := l.NextToken()
+		r.Equal(tt.tokenType, tok.Type)
+		r.Equal(tt.line, tok.LineNumber, tok.Literal)
+		r.Equal(tt.column, tok.Column, tok.Literal)
+	}
+}
+
+func Test_Next_Token_Illegal_Rune_Is_Not_Split(t *testing.T) {
+	r := require.New(t)
+	l := lexer.New(`<%= a € b %>`)
+
+	r.Equal(token.Type(token.E_START), l.NextToken().Type)
+	r.Equal(token.Type(token.IDENT), l.NextToken().Type)
+	tok := l.NextToken()
+	r.Equal(token.Type(token.ILLEGAL), tok.Type)
+	r.Equal("€", tok.Literal)
+	r.Equal(token.Type(token.IDENT), l.NextToken().Type)
+}
+
+func Test_Next_Token_Line_Comment_Before_End(t *testing.T) {
+	r := require.New(t)
+	l := lexer.New("<% # comment\n%>abc")
+
+	r.Equal(token.Type(token.S_START), l.NextToken().Type)
+	r.Equal(token.Type(token.E_END), l.NextToken().Type)
+	tok := l.NextToken()
+	r.Equal(token.Type(token.HTML), tok.Type)
+	r.Equal("abc", tok.Literal)
+}
+
+func Test_Next_Token_Line_Comment_Keeps_Next_Char(t *testing.T) {
+	r := require.New(t)
+	l := lexer.New("<% # comment\n[1] %>")
+	tests := []struct {
+		tokenType    token.Type
+		tokenLiteral string
+	}{
+		{token.S_START, "<%"},
+		{token.LBRACKET, "["},
+		{token.INT, "1"},
+		{token.RBRACKET, "]"},
+		{token.E_END, "%>"},
+		{token.EOF, ""},
+	}
+
+	for _, tt := range tests {
+		tok := l.NextToken()
+		r.Equal(tt.tokenType, tok.Type)
+		r.Equal(tt.tokenLiteral, tok.Literal)
+	}
+}
+
+func Test_Next_Token_Multi_Line_Start_Line(t *testing.T) {
+	r := require.New(t)
+	l := lexer.New("a\nb<%= \"c\nd\" + e %>")
+	tests := []struct {
+		tokenType token.Type
+		line      int
+	}{
+		{token.HTML, 1},
+		{token.E_START, 2},
+		{token.STRING, 2},
+		{token.PLUS, 3},
+		{token.IDENT, 3},
+	}
+
+	for _, tt := range tests {
+		tok := l.NextToken()
+		r.Equal(tt.tokenType, tok.Type)
+		r.Equal(tt.line, tok.LineNumber, tok.Literal)
+	}
+}
+
+func Fuzz_Lexer_Mixed_Scripts(f *testing.F) {
+	seeds := []string{
+		`<p>日本語</p><%= 名前 %>`,
+		`<% let größe = 3; %><%= größe * 2 %>`,
+		`<%= "Ünïcödé \"quoted\" 文字列" %>`,
+		"<% # コメント\n%>straße",
+		`<%= for (i, 値) in [1, 2] { %><%= 値 %><% } %>`,
+		"<%H 未終了",
+		"\xff<%= \xfe %>",
+	}
+	for _, seed := range seeds {
+		f.Add(seed)
+	}
+
+	f.Fuzz(func(t *testing.T, input string) {
+		l := lexer.New(input)
+		for i := 0; i <= len(input)+1; i++ {
+			tok := l.NextToken()
+			if utf8.ValidString(input) && !utf8.ValidString(tok.Literal) {
+				t.Fatalf("token %s split a rune: %q", tok.Type, tok.Literal)
+			}
+			if tok.Type == token.EOF {
+				return
+			}
+		}
+		t.Fatalf("lexer did not reach EOF for %q", input)
+	})
+}
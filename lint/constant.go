@@ -0,0 +1,129 @@
+package lint
+
+import (
+	"fmt"
+
+	"github.com/gobuffalo/plush/v5/ast"
+)
+
+// constTruth reports the truthiness of e when it does not depend on the
+// context, following the interpreter's rules: only false, nil and the
+// empty string are falsy.
+func constTruth(e ast.Expression) (bool, bool) {
+	switch e := e.(type) {
+	case *ast.Boolean:
+		return e.Value, true
+	case *ast.StringLiteral:
+		return e.Value != "", true
+	case *ast.IntegerLiteral, *ast.FloatLiteral, *ast.ArrayLiteral, *ast.HashLiteral, *ast.FunctionLiteral:
+		return true, true
+	case *ast.PrefixExpression:
+		if e.Operator == "!" {
+			v, ok := constTruth(e.Right)
+			return !v, ok
+		}
+		if e.Operator == "-" {
+			_, ok := constValue(e)
+			return true, ok
+		}
+	case *ast.InfixExpression:
+		switch e.Operator {
+		case "&&":
+			l, lok := constTruth(e.Left)
+			r, rok := constTruth(e.Right)
+			switch {
+			case lok && rok:
+				return l && r, true
+			case lok && !l, rok && !r:
+				return false, true
+			}
+			return false, false
+		case "||":
+			l, lok := constTruth(e.Left)
+			r, rok := constTruth(e.Right)
+			switch {
+			case lok && rok:
+				return l || r, true
+			case lok && l, rok && r:
+				return true, true
+			}
+			return false, false
+		}
+		return compareConst(e)
+	}
+	return false, false
+}
+
+// constValue returns the value of a literal, or of a negated number.
+func constValue(e ast.Expression) (interface{}, bool) {
+	switch e := e.(type) {
+	case *ast.Boolean:
+		return e.Value, true
+	case *ast.StringLiteral:
+		return e.Value, true
+	case *ast.IntegerLiteral:
+		return e.Value, true
+	case *ast.FloatLiteral:
+		return e.Value, true
+	case *ast.PrefixExpression:
+		if e.Operator != "-" {
+			return nil, false
+		}
+		switch v, _ := constValue(e.Right); v := v.(type) {
+		case int:
+			return -v, true
+		case float64:
+			return -v, true
+		}
+	}
+	return nil, false
+}
+
+// compareConst evaluates comparisons between two constants.
+func compareConst(e *ast.InfixExpression) (bool, bool) {
+	l, ok := constValue(e.Left)
+	if !ok {
+		return false, false
+	}
+	r, ok := constValue(e.Right)
+	if !ok {
+		return false, false
+	}
+
+	switch e.Operator {
+	case "==", "!=":
+		// Values of different kinds are compared by the interpreter's
+		// own rules, which are not worth duplicating here.
+		if fmt.Sprintf("%T", l) != fmt.Sprintf("%T", r) {
+			return false, false
+		}
+		return (l == r) == (e.Operator == "=="), true
+	}
+
+	lf, lok := toFloat(l)
+	rf, rok := toFloat(r)
+	if !lok || !rok {
+		return false, false
+	}
+	switch e.Operator {
+	case "<":
+		return lf < rf, true
+	case "<=":
+		return lf <= rf, true
+	case ">":
+		return lf > rf, true
+	case ">=":
+		return lf >= rf, true
+	}
+	return false, false
+}
+
+func toFloat(v interface{}) (float64, bool) {
+	switch v := v.(type) {
+	case int:
+		return float64(v), true
+	case float64:
+		return v, true
+	}
+	return 0, false
+}
@@ -0,0 +1,129 @@
+// Package lint reports likely bugs in Plush templates without rendering
+// them.
+//
+// A Rule inspects a parsed template and reports Findings through a
+// Pass. The rules shipped with the package are listed in Rules; custom
+// rules can be added through Config.
+package lint
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/gobuffalo/plush/v5/ast"
+	"github.com/gobuffalo/plush/v5/parser"
+)
+
+// Finding is a problem reported by a rule.
+type Finding struct {
+	File    string
+	Line    int
+	Column  int
+	Rule    string
+	Message string
+
+	offset int
+}
+
+// String formats the finding as `file:line:column: message (rule)`.
+func (f Finding) String() string {
+	return fmt.Sprintf("%s:%d:%d: %s (%s)", f.File, f.Line, f.Column, f.Message, f.Rule)
+}
+
+// Rule is a single check. Check is called once per template.
+type Rule struct {
+	Name  string
+	Doc   string
+	Check func(p *Pass)
+}
+
+// Pass holds the template a rule is checking.
+type Pass struct {
+	File    string
+	Source  string
+	Program *ast.Program
+
+	rule     string
+	findings []Finding
+}
+
+// Reportf records a finding at the start of node n.
+func (p *Pass) Reportf(n ast.Node, format string, args ...interface{}) {
+	f := Finding{
+		File:    p.File,
+		Rule:    p.rule,
+		Message: fmt.Sprintf(format, args...),
+		offset:  ast.SpanOf(n).Start,
+	}
+	if ast.SpanOf(n).Stop > ast.SpanOf(n).Start && ast.SpanOf(n).Start <= len(p.Source) {
+		f.Line, f.Column = position(p.Source, ast.SpanOf(n).Start)
+	} else {
+		f.Line, f.Column = n.T().LineNumber, n.T().Column
+	}
+	p.findings = append(p.findings, f)
+}
+
+// Config selects the rules to run.
+type Config struct {
+	// Rules to run. When nil, Rules is used.
+	Rules []*Rule
+	// Disable names rules that are skipped.
+	Disable []string
+}
+
+// Lint parses src and runs the configured rules over it. file is only
+// used to label findings. Findings are sorted by position.
+func Lint(file, src string, cfg Config) ([]Finding, error) {
+	prog, err := parser.Parse(src)
+	if err != nil {
+		return nil, err
+	}
+
+	rules := cfg.Rules
+	if rules == nil {
+		rules = Rules
+	}
+	disabled := map[string]bool{}
+	for _, name := range cfg.Disable {
+		disabled[name] = true
+	}
+
+	var findings []Finding
+	for _, r := range rules {
+		if disabled[r.Name] {
+			continue
+		}
+		p := &Pass{File: file, Source: src, Program: prog, rule: r.Name}
+		r.Check(p)
+		findings = append(findings, p.findings...)
+	}
+
+	sort.SliceStable(findings, func(i, j int) bool {
+		return findings[i].offset < findings[j].offset
+	})
+	return findings, nil
+}
+
+// Lookup returns the rule in Rules with the given name.
+func Lookup(name string) (*Rule, bool) {
+	for _, r := range Rules {
+		if r.Name == name {
+			return r, true
+		}
+	}
+	return nil, false
+}
+
+// position returns the 1-based line and rune column of offset in src.
+func position(src string, offset int) (int, int) {
+	line, column := 1, 1
+	for _, r := range src[:offset] {
+		if r == '\n' {
+			line++
+			column = 1
+			continue
+		}
+		column++
+	}
+	return line, column
+}
@@ -0,0 +1,158 @@
+package lint_test
+
+import (
+	"testing"
+
+	"github.com/gobuffalo/plush/v5/ast"
+	"github.com/gobuffalo/plush/v5/lint"
+	"github.com/stretchr/testify/require"
+)
+
+func findings(t *testing.T, src string, rules ...*lint.Rule) []string {
+	t.Helper()
+	cfg := lint.Config{}
+	if len(rules) > 0 {
+		cfg.Rules = rules
+	}
+	res, err := lint.Lint("t.plush", src, cfg)
+	require.NoError(t, err)
+
+	out := []string{}
+	for _, f := range res {
+		out = append(out, f.String())
+	}
+	return out
+}
+
+func Test_Lint_Unused_Let(t *testing.T) {
+	r := require.New(t)
+	src := "<% let a = 1 %>\n<% let b = a %>\n<% let c = {x: 1} %><%= c.x %>"
+	r.Equal([]string{
+		"t.plush:2:4: b is declared but never used (unused-let)",
+	}, findings(t, src, lint.UnusedLet))
+}
+
+func Test_Lint_Unused_Let_Skips_Partials(t *testing.T) {
+	r := require.New(t)
+	src := `<% let title = "x" %><%= partial("header") %>`
+	r.Empty(findings(t, src, lint.UnusedLet))
+}
+
+func Test_Lint_Unused_Let_Outside_Partial_Scope(t *testing.T) {
+	r := require.New(t)
+	src := `<% let title = "x" %><%= if (a) { %><% let note = 1 %><% } %>
+<%= for (i) in list { %><% let item = i %><%= partial("row") %><% } %>
+<% let footer = "y" %>`
+	r.Equal([]string{
+		"t.plush:1:40: note is declared but never used (unused-let)",
+		"t.plush:3:4: footer is declared but never used (unused-let)",
+	}, findings(t, src, lint.UnusedLet))
+}
+
+func Test_Lint_Unreachable(t *testing.T) {
+	r := require.New(t)
+	src := `<% let f = fn() {
+	return 1
+	let x = 2
+} %>
+<%= for (v) in [1, 2] { %>
+	<% break %>
+	<p><%= v %></p>
+<% } %>
+<% return 1 %><p>top level keeps rendering</p>`
+	r.Equal([]string{
+		"t.plush:3:2: unreachable code after return (unreachable)",
+		"t.plush:6:13: unreachable code after break (unreachable)",
+	}, findings(t, src, lint.Unreachable))
+}
+
+func Test_Lint_Raw_Non_Literal(t *testing.T) {
+	r := require.New(t)
+	src := `<%= raw("<b>ok</b>") %><%= raw(user.Bio) %>`
+	r.Equal([]string{
+		"t.plush:1:28: raw() applied to a non-literal value may render unescaped input (raw-non-literal)",
+	}, findings(t, src, lint.RawNonLiteral))
+}
+
+func Test_Lint_Constant_Condition(t *testing.T) {
+	r := require.New(t)
+	src := `<%= if (true) { %>a<% } else if (1 > 2) { %>b<% } %>
+<%= if (x || "yes") { %>c<% } %>
+<%= if (!"") { %>d<% } %>
+<%= if (x && y) { %>e<% } %>
+<%= if (1 == 1.0) { %>f<% } %>`
+	r.Equal([]string{
+		"t.plush:1:9: condition is always true (constant-condition)",
+		"t.plush:1:34: condition is always false (constant-condition)",
+		"t.plush:2:9: condition is always true (constant-condition)",
+		"t.plush:3:9: condition is always true (constant-condition)",
+	}, findings(t, src, lint.ConstantCondition))
+}
+
+func Test_Lint_Dynamic_Partial(t *testing.T) {
+	r := require.New(t)
+	src := `<%= partial("a.html") %><%= partial(name) %><%= partial("b/" + kind) %>`
+	r.Equal([]string{
+		"t.plush:1:29: partial() name is not a constant string (dynamic-partial)",
+		"t.plush:1:49: partial() name is not a constant string (dynamic-partial)",
+	}, findings(t, src, lint.DynamicPartial))
+}
+
+func Test_Lint_Deprecated_Helper(t *testing.T) {
+	r := require.New(t)
+	src := `<%= camelize_down_first("a_b") %><%= camelize("a_b") %>`
+	r.Equal([]string{
+		"t.plush:1:5: camelize_down_first is deprecated, use camelize instead (deprecated-helper)",
+	}, findings(t, src, lint.DeprecatedHelper))
+}
+
+func Test_Lint_Disable(t *testing.T) {
+	r := require.New(t)
+	src := `<% let x = 1 %><%= raw(y) %>`
+
+	res, err := lint.Lint("t.plush", src, lint.Config{})
+	r.NoError(err)
+	r.Len(res, 2)
+
+	res, err = lint.Lint("t.plush", src, lint.Config{Disable: []string{"unused-let"}})
+	r.NoError(err)
+	r.Len(res, 1)
+	r.Equal("raw-non-literal", res[0].Rule)
+	r.Equal(1, res[0].Line)
+	r.Equal(20, res[0].Column)
+}
+
+func Test_Lint_Custom_Rule(t *testing.T) {
+	r := require.New(t)
+	noDebug := &lint.Rule{
+		Name: "no-debug",
+		Check: func(p *lint.Pass) {
+			ast.Inspect(p.Program, func(n ast.Node) bool {
+				if id, ok := n.(*ast.Identifier); ok && id.Value == "debug" {
+					p.Reportf(id, "remove debug output")
+				}
+				return true
+			})
+		},
+	}
+
+	r.Equal([]string{
+		"t.plush:2:5: remove debug output (no-debug)",
+	}, findings(t, "<p>\n<%= debug(x) %></p>", noDebug))
+}
+
+func Test_Lint_Parse_Error(t *testing.T) {
+	r := require.New(t)
+	_, err := lint.Lint("t.plush", "<%= if { %>", lint.Config{})
+	r.Error(err)
+}
+
+func Test_Lookup(t *testing.T) {
+	r := require.New(t)
+	rule, ok := lint.Lookup("unreachable")
+	r.True(ok)
+	r.Equal(lint.Unreachable, rule)
+
+	_, ok = lint.Lookup("nope")
+	r.False(ok)
+}
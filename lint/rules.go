@@ -0,0 +1,287 @@
+package lint
+
+import (
+	"strings"
+
+	"github.com/gobuffalo/plush/v5/ast"
+	"github.com/gobuffalo/plush/v5/token"
+)
+
+// Rules are the rules run by default, in the order they run.
+var Rules = []*Rule{
+	UnusedLet,
+	Unreachable,
+	RawNonLiteral,
+	ConstantCondition,
+	DynamicPartial,
+	DeprecatedHelper,
+}
+
+// DeprecatedHelpers maps deprecated helper names to their replacement.
+// It is used by the DeprecatedHelper rule and may be extended.
+var DeprecatedHelpers = map[string]string{
+	"camelize_down_first": "camelize",
+}
+
+// UnusedLet reports `let` variables that are never read. Variables in
+// scope at a partial() call are not reported, since the partial can read
+// them from its context.
+var UnusedLet = &Rule{
+	Name:  "unused-let",
+	Doc:   "let variables that are never used",
+	Check: checkUnusedLet,
+}
+
+// Unreachable reports statements that follow a `return`, `break` or
+// `continue` in the same block.
+var Unreachable = &Rule{
+	Name:  "unreachable",
+	Doc:   "code after return, break or continue",
+	Check: checkUnreachable,
+}
+
+// RawNonLiteral reports raw() calls whose argument is not a string
+// literal, as they may render unescaped user input.
+var RawNonLiteral = &Rule{
+	Name:  "raw-non-literal",
+	Doc:   "raw() applied to a non-literal value",
+	Check: checkRawNonLiteral,
+}
+
+// ConstantCondition reports `if` and `else if` conditions that are
+// always true or always false.
+var ConstantCondition = &Rule{
+	Name:  "constant-condition",
+	Doc:   "if conditions that are always true or false",
+	Check: checkConstantCondition,
+}
+
+// DynamicPartial reports partial() calls whose name is not a constant
+// string, which makes the template's dependencies impossible to follow.
+var DynamicPartial = &Rule{
+	Name:  "dynamic-partial",
+	Doc:   "partial() calls with a non-constant name",
+	Check: checkDynamicPartial,
+}
+
+// DeprecatedHelper reports calls to the helpers in DeprecatedHelpers.
+var DeprecatedHelper = &Rule{
+	Name:  "deprecated-helper",
+	Doc:   "use of deprecated helpers",
+	Check: checkDeprecatedHelper,
+}
+
+func checkUnusedLet(p *Pass) {
+	var lets []*ast.LetStatement
+	used := map[string]bool{}
+
+	ast.Inspect(p.Program, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.LetStatement:
+			if n.Name != nil {
+				lets = append(lets, n)
+			}
+			if n.Value != nil {
+				ast.Inspect(n.Value, func(n ast.Node) bool {
+					markUsed(used, n)
+					return true
+				})
+			}
+			return false
+		case *ast.AssignExpression:
+			if n.Value != nil {
+				ast.Inspect(n.Value, func(n ast.Node) bool {
+					markUsed(used, n)
+					return true
+				})
+			}
+			return false
+		}
+		markUsed(used, n)
+		return true
+	})
+
+	seen := seenByPartials(p.Program)
+	reported := map[string]bool{}
+	for _, let := range lets {
+		name := let.Name.Value
+		if used[name] || seen[let] || reported[name] {
+			continue
+		}
+		reported[name] = true
+		p.Reportf(let, "%s is declared but never used", name)
+	}
+}
+
+// seenByPartials returns the let statements in scope at a partial() call:
+// those that come before the call in its block or in an enclosing one.
+// Blocks render with their own context, so a partial can't see the
+// variables of the blocks it isn't in.
+func seenByPartials(program *ast.Program) map[*ast.LetStatement]bool {
+	seen := map[*ast.LetStatement]bool{}
+	var stack []ast.Node
+	var scopes [][]*ast.LetStatement
+	ast.Inspect(program, func(n ast.Node) bool {
+		if n == nil {
+			switch n := stack[len(stack)-1].(type) {
+			case *ast.Program, *ast.BlockStatement:
+				scopes = scopes[:len(scopes)-1]
+			case *ast.LetStatement:
+				// Declared once its value is evaluated, so a partial in
+				// the value doesn't see it.
+				if n.Name != nil {
+					scopes[len(scopes)-1] = append(scopes[len(scopes)-1], n)
+				}
+			}
+			stack = stack[:len(stack)-1]
+			return true
+		}
+		stack = append(stack, n)
+		switch n := n.(type) {
+		case *ast.Program, *ast.BlockStatement:
+			scopes = append(scopes, nil)
+		case *ast.CallExpression:
+			if helperName(n) == "partial" {
+				for _, scope := range scopes {
+					for _, let := range scope {
+						seen[let] = true
+					}
+				}
+			}
+		}
+		return true
+	})
+	return seen
+}
+
+// markUsed records n as a read of a variable if it is the root of an
+// identifier chain.
+func markUsed(used map[string]bool, n ast.Node) {
+	if id, ok := n.(*ast.Identifier); ok && id.Callee == nil {
+		used[id.Value] = true
+	}
+}
+
+func checkUnreachable(p *Pass) {
+	ast.Inspect(p.Program, func(n ast.Node) bool {
+		block, ok := n.(*ast.BlockStatement)
+		if !ok {
+			return true
+		}
+
+		exit := ""
+		for _, s := range block.Statements {
+			if exit != "" && !isBlankHTML(s) {
+				p.Reportf(s, "unreachable code after %s", exit)
+				break
+			}
+			exit = exitKeyword(s)
+		}
+		return true
+	})
+}
+
+// exitKeyword returns the keyword of s if it leaves the enclosing block.
+func exitKeyword(s ast.Statement) string {
+	switch s := s.(type) {
+	case *ast.ReturnStatement:
+		if s.Type == token.RETURN {
+			return "return"
+		}
+	case *ast.ExpressionStatement:
+		switch s.Expression.(type) {
+		case *ast.BreakExpression:
+			return "break"
+		case *ast.ContinueExpression:
+			return "continue"
+		}
+	}
+	return ""
+}
+
+func isBlankHTML(s ast.Statement) bool {
+	es, ok := s.(*ast.ExpressionStatement)
+	if !ok {
+		return false
+	}
+	h, ok := es.Expression.(*ast.HTMLLiteral)
+	return ok && strings.TrimSpace(h.Value) == ""
+}
+
+func checkRawNonLiteral(p *Pass) {
+	ast.Inspect(p.Program, func(n ast.Node) bool {
+		call, ok := n.(*ast.CallExpression)
+		if !ok || helperName(call) != "raw" {
+			return true
+		}
+		for _, arg := range call.Arguments {
+			if _, ok := arg.(*ast.StringLiteral); !ok {
+				p.Reportf(call, "raw() applied to a non-literal value may render unescaped input")
+				break
+			}
+		}
+		return true
+	})
+}
+
+func checkConstantCondition(p *Pass) {
+	check := func(cond ast.Expression) {
+		if cond == nil {
+			return
+		}
+		if v, ok := constTruth(cond); ok {
+			p.Reportf(cond, "condition is always %t", v)
+		}
+	}
+
+	ast.Inspect(p.Program, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.IfExpression:
+			check(n.Condition)
+		case *ast.ElseIfExpression:
+			check(n.Condition)
+		}
+		return true
+	})
+}
+
+func checkDynamicPartial(p *Pass) {
+	ast.Inspect(p.Program, func(n ast.Node) bool {
+		call, ok := n.(*ast.CallExpression)
+		if !ok || helperName(call) != "partial" || len(call.Arguments) == 0 {
+			return true
+		}
+		if _, ok := call.Arguments[0].(*ast.StringLiteral); !ok {
+			p.Reportf(call, "partial() name is not a constant string")
+		}
+		return true
+	})
+}
+
+func checkDeprecatedHelper(p *Pass) {
+	ast.Inspect(p.Program, func(n ast.Node) bool {
+		call, ok := n.(*ast.CallExpression)
+		if !ok {
+			return true
+		}
+		name := helperName(call)
+		if repl, ok := DeprecatedHelpers[name]; ok {
+			if repl != "" {
+				p.Reportf(call, "%s is deprecated, use %s instead", name, repl)
+			} else {
+				p.Reportf(call, "%s is deprecated", name)
+			}
+		}
+		return true
+	})
+}
+
+// helperName returns the name of the helper called by call, or "" for
+// method calls and calls of computed values.
+func helperName(call *ast.CallExpression) string {
+	id, ok := call.Function.(*ast.Identifier)
+	if !ok || id.Callee != nil || call.Callee != nil {
+		return ""
+	}
+	return id.Value
+}
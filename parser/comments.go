@@ -0,0 +1,76 @@
+package parser
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/gobuffalo/plush/v5/ast"
+)
+
+// collectComments merges the block comments seen by the parser with the
+// line comments recorded by the lexer and attaches each one to its
+// nearest node.
+func (p *parser) collectComments(prog *ast.Program) []*ast.Comment {
+	comments := append([]*ast.Comment{}, p.blockComments...)
+	for _, tok := range p.Lexer.Comments() {
+		if insideComment(p.blockComments, tok.Offset) {
+			continue
+		}
+		c := &ast.Comment{
+			TokenAble: ast.TokenAble{Token: tok},
+			Text:      strings.TrimPrefix(tok.Literal, "#"),
+		}
+		c.SetSpan(tok.Offset, tok.EndOffset)
+		comments = append(comments, c)
+	}
+	if len(comments) == 0 {
+		return nil
+	}
+	sort.SliceStable(comments, func(i, j int) bool {
+		return comments[i].Pos() < comments[j].Pos()
+	})
+
+	var nodes []ast.Node
+	ast.Inspect(prog, func(n ast.Node) bool {
+		if n == nil || n == ast.Node(prog) {
+			return true
+		}
+		if ast.SpanOf(n).Stop > ast.SpanOf(n).Start && !insideComment(comments, ast.SpanOf(n).Start) {
+			nodes = append(nodes, n)
+		}
+		return true
+	})
+
+	for _, c := range comments {
+		var leading, trailing ast.Node
+		for _, n := range nodes {
+			switch {
+			case ast.SpanOf(n).Start >= c.End():
+				if leading == nil || ast.SpanOf(n).Start < ast.SpanOf(leading).Start {
+					leading = n
+				}
+			case ast.SpanOf(n).Stop <= c.Pos():
+				if trailing == nil || ast.SpanOf(n).Stop > ast.SpanOf(trailing).Stop {
+					trailing = n
+				}
+			}
+		}
+		if leading != nil {
+			c.Node = leading
+		} else if trailing != nil {
+			c.Node = trailing
+			c.Trailing = true
+		}
+	}
+
+	return comments
+}
+
+func insideComment(comments []*ast.Comment, offset int) bool {
+	for _, c := range comments {
+		if offset >= c.Pos() && offset < c.End() {
+			return true
+		}
+	}
+	return false
+}
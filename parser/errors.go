@@ -1,9 +1,47 @@
 package parser
 
-import "strings"
+import (
+	"errors"
+	"strings"
 
-type errSlice []string
+	"github.com/gobuffalo/plush/v5/token"
+)
 
-func (e errSlice) Error() string {
-	return strings.Join(e, "\n")
+// Error is a syntax error found while parsing. Line and Column locate the
+// token the parser was looking at when it gave up; Msg is the full message,
+// which usually starts with `line N:`.
+type Error struct {
+	Line   int
+	Column int
+	Msg    string
+}
+
+func (e *Error) Error() string {
+	return e.Msg
+}
+
+// ErrorList is the error returned by Parse. It holds every syntax error
+// found, in source order.
+type ErrorList []*Error
+
+func (e ErrorList) Error() string {
+	msgs := make([]string, len(e))
+	for i, err := range e {
+		msgs[i] = err.Msg
+	}
+	return strings.Join(msgs, "\n")
+}
+
+// Errors returns the syntax errors held by err, or nil when err does not
+// come from the parser.
+func Errors(err error) ErrorList {
+	var list ErrorList
+	if errors.As(err, &list) {
+		return list
+	}
+	return nil
+}
+
+func (p *parser) addError(t token.Token, msg string) {
+	p.errors = append(p.errors, &Error{Line: t.LineNumber, Column: t.Column, Msg: msg})
 }
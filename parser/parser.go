@@ -15,10 +15,39 @@ type (
 	infixParseFn  func(ast.Expression) ast.Expression
 )
 
+// Options controls optional parser behaviour.
+type Options struct {
+	// Comments keeps `<%# %>` and `#` comments in Program.Comments, each
+	// attached to its nearest node, instead of discarding them.
+	Comments bool
+	// Line is the line number of the first line of s, for source cut
+	// from a larger template. Zero means 1.
+	Line int
+}
+
 // Parse the string and return an AST or an error
 func Parse(s string) (*ast.Program, error) {
-	p := newParser(lexer.New(s))
+	return ParseWithOptions(s, Options{})
+}
+
+// ParseWithOptions parses the string like Parse, honouring opts. Node
+// spans are byte offsets into s.
+func ParseWithOptions(s string, opts Options) (*ast.Program, error) {
+	line := opts.Line
+	if line < 1 {
+		line = 1
+	}
+	l := lexer.NewAt(s, line)
+	if opts.Comments {
+		l.RecordComments()
+	}
+	p := newParser(l)
+	p.source = s
+	p.keepComments = opts.Comments
 	prog := p.parseProgram()
+	if opts.Comments {
+		prog.Comments = p.collectComments(prog)
+	}
 
 	if len(p.errors) > 0 {
 		return prog, p.errors
@@ -30,7 +59,7 @@ func Parse(s string) (*ast.Program, error) {
 func newParser(l *lexer.Lexer) *parser {
 	p := &parser{
 		Lexer:  l,
-		errors: []string{},
+		errors: ErrorList{},
 	}
 
 	p.prefixParseFns = make(map[token.Type]prefixParseFn)
@@ -83,7 +112,7 @@ func newParser(l *lexer.Lexer) *parser {
 
 type parser struct {
 	*lexer.Lexer
-	errors errSlice
+	errors ErrorList
 
 	curToken  token.Token
 	peekToken token.Token
@@ -91,6 +120,27 @@ type parser struct {
 	prefixParseFns map[token.Type]prefixParseFn
 	infixParseFns  map[token.Type]infixParseFn
 	inForBlock     bool
+
+	source        string
+	keepComments  bool
+	blockComments []*ast.Comment
+}
+
+// spanned is implemented by every ast node through ast.TokenAble.
+type spanned interface {
+	ast.Node
+	SetSpan(start, stop int)
+}
+
+// finishSpan records the span of n from start to the end of the current
+// token, unless a more precise span was already recorded.
+func (p *parser) finishSpan(n ast.Node, start int) {
+	if n == nil || ast.SpanOf(n).Stop != 0 {
+		return
+	}
+	if s, ok := n.(spanned); ok {
+		s.SetSpan(start, p.curToken.EndOffset)
+	}
 }
 
 func (p *parser) parseProgram() *ast.Program {
@@ -143,17 +193,17 @@ func (p *parser) expectPeek(t token.Type) bool {
 
 func (p *parser) invalidIfCondition(t string) {
 	msg := fmt.Sprintf("line %d: syntax error: invalid if condition, got %s", p.curToken.LineNumber, t)
-	p.errors = append(p.errors, msg)
+	p.addError(p.curToken, msg)
 }
 
 func (p *parser) peekError(t token.Type) {
 	msg := fmt.Sprintf("line %d: expected next token to be %s, got %s instead", p.curToken.LineNumber, t, p.peekToken.Type)
-	p.errors = append(p.errors, msg)
+	p.addError(p.curToken, msg)
 }
 
 func (p *parser) noPrefixParseFnError(t token.Type) {
 	msg := fmt.Sprintf("line %d: no prefix parse function for %s found", p.curToken.LineNumber, t)
-	p.errors = append(p.errors, msg)
+	p.addError(p.curToken, msg)
 }
 
 func (p *parser) parseStatement() ast.Statement {
@@ -162,6 +212,15 @@ func (p *parser) parseStatement() ast.Statement {
 	// If you are adding another case here, please make sure the callee does
 	// not return nil or you should add nil checking and explicitly return
 	// concrete nil. (https://github.com/gobuffalo/plush/pull/171)
+	start := p.curToken.Offset
+	stmt := p.parseStatementNode()
+	if stmt != nil {
+		p.finishSpan(stmt, start)
+	}
+	return stmt
+}
+
+func (p *parser) parseStatementNode() ast.Statement {
 	switch p.curToken.Type {
 	case token.H_START:
 		return p.parseHoleStatment()
@@ -204,6 +263,7 @@ func (p *parser) parseLetStatement() *ast.LetStatement {
 	}
 
 	stmt.Name = &ast.Identifier{TokenAble: ast.TokenAble{Token: p.curToken}, Value: p.curToken.Literal}
+	stmt.Name.SetSpan(p.curToken.Offset, p.curToken.EndOffset)
 
 	if !p.expectPeek(token.ASSIGN) {
 		return stmt
@@ -224,6 +284,9 @@ func (p *parser) parseExpressionStatement() *ast.ExpressionStatement {
 	stmt := &ast.ExpressionStatement{TokenAble: ast.TokenAble{Token: p.curToken}}
 
 	stmt.Expression = p.parseExpression(LOWEST)
+	if stmt.Expression != nil && ast.SpanOf(stmt.Expression).Stop != 0 {
+		stmt.SetSpan(ast.SpanOf(stmt.Expression).Start, ast.SpanOf(stmt.Expression).Stop)
+	}
 	if p.peekTokenIs(token.SEMICOLON) {
 		p.nextToken()
 	}
@@ -242,7 +305,12 @@ func (p *parser) parseExpression(precedence int) ast.Expression {
 		return nil
 	}
 
+	start := p.curToken.Offset
 	leftExp := prefix()
+	if leftExp != nil {
+		p.finishSpan(leftExp, start)
+		start = ast.SpanOf(leftExp).Start
+	}
 
 	for !p.peekTokenIs(token.SEMICOLON) && precedence < p.peekPrecedence() {
 		infix := p.infixParseFns[p.peekToken.Type]
@@ -252,6 +320,9 @@ func (p *parser) parseExpression(precedence int) ast.Expression {
 
 		p.nextToken()
 		leftExp = infix(leftExp)
+		if leftExp != nil {
+			p.finishSpan(leftExp, start)
+		}
 	}
 
 	return leftExp
@@ -278,10 +349,14 @@ func (p *parser) parseIdentifier() ast.Expression {
 	orignalCalleAddress := id
 	ss := strings.Split(p.curToken.Literal, ".")
 	id.Value = ss[0]
+	stop := p.curToken.Offset + len(ss[0])
+	id.SetSpan(p.curToken.Offset, stop)
 
 	for i := 1; i < len(ss); i++ {
 		s := ss[i]
 		id = &ast.Identifier{TokenAble: ast.TokenAble{Token: p.curToken}, Value: s, Callee: id}
+		stop += len(s) + 1
+		id.SetSpan(p.curToken.Offset, stop)
 	}
 
 	//To avoid a recursive loop to reach the original calle address
@@ -317,7 +392,7 @@ func (p *parser) parseForLoopControlFlow() ast.Expression {
 	var stmt ast.Expression
 
 	if !p.inForBlock {
-		p.errors = append(p.errors, fmt.Sprintf("line %d: %s is not in a loop", p.curToken.LineNumber, p.curToken.Literal))
+		p.addError(p.curToken, fmt.Sprintf("line %d: %s is not in a loop", p.curToken.LineNumber, p.curToken.Literal))
 		return nil
 	}
 
@@ -344,7 +419,7 @@ func (p *parser) parseIntegerLiteral() ast.Expression {
 	value, err := strconv.Atoi(p.curToken.Literal)
 	if err != nil {
 		msg := fmt.Sprintf("could not parse %q as integer", p.curToken.Literal)
-		p.errors = append(p.errors, msg)
+		p.addError(p.curToken, msg)
 		return nil
 	}
 
@@ -359,7 +434,7 @@ func (p *parser) parseFloatLiteral() ast.Expression {
 	value, err := strconv.ParseFloat(p.curToken.Literal, 64)
 	if err != nil {
 		msg := fmt.Sprintf("could not parse %q as float", p.curToken.Literal)
-		p.errors = append(p.errors, msg)
+		p.addError(p.curToken, msg)
 		return nil
 	}
 
@@ -373,10 +448,32 @@ func (p *parser) parseStringLiteral() ast.Expression {
 }
 
 func (p *parser) parseCommentLiteral() ast.Expression {
-	for p.curToken.Type != token.E_END {
+	start := p.curToken
+	for p.curToken.Type != token.E_END && p.curToken.Type != token.EOF {
 		p.nextToken()
 	}
 
+	if p.keepComments {
+		stop := p.curToken.Offset
+		if p.curToken.Type == token.EOF {
+			stop = len(p.source)
+		}
+		c := &ast.Comment{
+			TokenAble: ast.TokenAble{Token: token.Token{
+				Type:       token.COMMENT,
+				Literal:    p.source[start.Offset:p.curToken.EndOffset],
+				LineNumber: start.LineNumber,
+				Column:     start.Column,
+				Offset:     start.Offset,
+				EndOffset:  p.curToken.EndOffset,
+			}},
+			Text:  p.source[start.EndOffset:stop],
+			Block: true,
+		}
+		c.SetSpan(start.Offset, p.curToken.EndOffset)
+		p.blockComments = append(p.blockComments, c)
+	}
+
 	return &ast.StringLiteral{TokenAble: ast.TokenAble{Token: p.curToken}, Value: ""}
 }
 
@@ -436,7 +533,7 @@ func (p *parser) parseForExpression() ast.Expression {
 		return nil
 	}
 
-	ln := p.curToken.LineNumber
+	start := p.curToken
 	p.inForBlock = true
 	s := []string{}
 
@@ -446,7 +543,7 @@ func (p *parser) parseForExpression() ast.Expression {
 		}
 
 		if p.peekTokenIs(token.LBRACE) || p.peekTokenIs(token.EOF) {
-			p.errors = append(p.errors, fmt.Sprintf("line %d: expected ) got %s", ln, p.peekToken.Literal))
+			p.addError(start, fmt.Sprintf("line %d: expected ) got %s", start.LineNumber, p.peekToken.Literal))
 			return nil
 		}
 
@@ -474,6 +571,13 @@ func (p *parser) parseForExpression() ast.Expression {
 		if ce.Block != nil {
 			expression.Block = ce.Block
 			ce.Block = nil
+			// The call no longer owns the block, so it ends at its ")".
+			stop := expression.Block.Pos()
+			for stop > ce.Pos() && strings.ContainsRune(" \t\r\n", rune(p.source[stop-1])) {
+				stop--
+			}
+			ce.SetSpan(ce.Pos(), stop)
+			expression.SetSpan(expression.Token.Offset, expression.Block.End())
 			return expression
 		}
 	}
@@ -483,10 +587,7 @@ func (p *parser) parseForExpression() ast.Expression {
 	}
 
 	expression.Block = p.parseBlockStatement()
-
-	if p.curTokenIs(token.RBRACE) {
-		p.nextToken()
-	}
+	expression.SetSpan(expression.Token.Offset, expression.Block.End())
 
 	p.inForBlock = false
 
@@ -536,13 +637,14 @@ func (p *parser) parseElseIfExpression() *ast.ElseIfExpression {
 	}
 
 	expression.Block = p.parseBlockStatement()
+	expression.SetSpan(expression.Token.Offset, expression.Block.End())
 
 	return expression
 }
 
 func (p *parser) parseIfCondition() ast.Expression {
 	if p.peekTokenIs(token.LBRACE) || p.peekTokenIs(token.E_END) || p.peekTokenIs(token.EOF) {
-		p.errors = append(p.errors, fmt.Sprintf("line %d: syntax error: missing if condition", p.curToken.LineNumber))
+		p.addError(p.curToken, fmt.Sprintf("line %d: syntax error: missing if condition", p.curToken.LineNumber))
 		return nil
 	}
 
@@ -566,6 +668,7 @@ func (p *parser) parseIfCondition() ast.Expression {
 func (p *parser) parseBlockStatement() *ast.BlockStatement {
 	block := &ast.BlockStatement{TokenAble: ast.TokenAble{Token: p.curToken}}
 	block.Statements = []ast.Statement{}
+	defer p.finishSpan(block, block.Token.Offset)
 
 	p.nextToken()
 
@@ -615,12 +718,14 @@ func (p *parser) parseFunctionParameters() []*ast.Identifier {
 
 	p.nextToken()
 	ident := &ast.Identifier{TokenAble: ast.TokenAble{Token: p.curToken}, Value: p.curToken.Literal}
+	ident.SetSpan(p.curToken.Offset, p.curToken.EndOffset)
 	identifiers = append(identifiers, ident)
 
 	for p.peekTokenIs(token.COMMA) {
 		p.nextToken()
 		p.nextToken()
 		ident := &ast.Identifier{TokenAble: ast.TokenAble{Token: p.curToken}, Value: p.curToken.Literal}
+		ident.SetSpan(p.curToken.Offset, p.curToken.EndOffset)
 		identifiers = append(identifiers, ident)
 	}
 
@@ -635,7 +740,7 @@ func (p *parser) parseCallExpression(function ast.Expression) ast.Expression {
 
 	if function == nil {
 		msg := fmt.Sprintf("line %d: syntax error: attempted to call nil function", p.curToken.LineNumber)
-		p.errors = append(p.errors, msg)
+		p.addError(p.curToken, msg)
 		return nil
 	}
 	exp := &ast.CallExpression{
@@ -646,14 +751,20 @@ func (p *parser) parseCallExpression(function ast.Expression) ast.Expression {
 	ss := strings.Split(function.String(), ".")
 
 	if len(ss) > 1 {
+		span := ast.SpanOf(function)
+		start, stop := span.Start, span.Start
+		stop += len(ss[0])
 		exp.Callee = &ast.Identifier{
 			TokenAble: ast.TokenAble{Token: token.Token{Type: token.IDENT, Literal: ss[0]}},
+			Span:      ast.Span{Start: start, Stop: stop},
 			Value:     ss[0],
 		}
 
 		for i := 1; i < len(ss)-1; i++ {
+			stop += len(ss[i]) + 1
 			c := &ast.Identifier{
 				TokenAble: ast.TokenAble{Token: token.Token{Type: token.IDENT, Literal: ss[i]}},
+				Span:      ast.Span{Start: start, Stop: stop},
 				Value:     ss[i],
 				Callee:    exp.Callee.(*ast.Identifier),
 			}
@@ -662,9 +773,14 @@ func (p *parser) parseCallExpression(function ast.Expression) ast.Expression {
 
 		exp.Function = &ast.Identifier{
 			TokenAble: ast.TokenAble{Token: token.Token{Type: token.IDENT, Literal: ss[len(ss)-1]}},
+			Span:      ast.Span{Start: stop + 1, Stop: span.Stop},
 			Value:     ss[len(ss)-1],
 			Callee:    exp.Callee.(*ast.Identifier),
 		}
+		if span.Stop == 0 {
+			exp.Callee.(*ast.Identifier).Span = ast.Span{}
+			exp.Function.(*ast.Identifier).Span = ast.Span{}
+		}
 	}
 
 	exp.Arguments = p.parseExpressionList(token.RPAREN)
@@ -724,7 +840,7 @@ func (p *parser) parseArrayLiteral() ast.Expression {
 func (p *parser) parseIndexExpression(left ast.Expression) ast.Expression {
 	if left == nil {
 		msg := fmt.Sprintf("line %d: syntax error: invalid index access on nil expression", p.curToken.LineNumber)
-		p.errors = append(p.errors, msg)
+		p.addError(p.curToken, msg)
 		return nil
 	}
 	exp := &ast.IndexExpression{TokenAble: ast.TokenAble{Token: p.curToken}, Left: left}
@@ -761,7 +877,7 @@ func (p *parser) parseIndexExpression(left ast.Expression) ast.Expression {
 func (p *parser) assignCallee(exp ast.Expression, calleeIdent *ast.Identifier) (assignedCallee ast.Expression) {
 	if exp == nil || calleeIdent == nil {
 		msg := fmt.Sprintf("line %d: syntax error: invalid callee assignment with nil values", p.curToken.LineNumber)
-		p.errors = append(p.errors, msg)
+		p.addError(p.curToken, msg)
 		return nil
 	}
 	assignedCallee = nil
@@ -776,7 +892,7 @@ func (p *parser) assignCallee(exp ast.Expression, calleeIdent *ast.Identifier) (
 			assignedCallee = ss
 		default:
 			msg := fmt.Sprintf("line %d: syntax error: invalid nested index access, expected an identifier %v", p.curToken.LineNumber, ss)
-			p.errors = append(p.errors, msg)
+			p.addError(p.curToken, msg)
 		}
 	case *ast.CallExpression:
 		ss.Callee = calleeIdent
@@ -786,7 +902,7 @@ func (p *parser) assignCallee(exp ast.Expression, calleeIdent *ast.Identifier) (
 		assignedCallee = ss
 	default:
 		msg := fmt.Sprintf("line %d: syntax error: invalid nested index access, got %v", p.curToken.LineNumber, ss)
-		p.errors = append(p.errors, msg)
+		p.addError(p.curToken, msg)
 	}
 
 	return
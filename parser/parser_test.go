@@ -1316,6 +1316,24 @@ func Test_For_Expression_Split(t *testing.T) {
 	r.Len(exp.Block.Statements, 3)
 }
 
+func Test_For_Expression_Followed_By_Call(t *testing.T) {
+	r := require.New(t)
+	input := `<% for (v) in anArray { v }
+	print(1) %>`
+
+	program, err := parser.Parse(input)
+	r.NoError(err)
+
+	r.Len(program.Statements, 2)
+
+	stmt := program.Statements[0].(*ast.ExpressionStatement)
+	r.IsType(&ast.ForExpression{}, stmt.Expression)
+
+	stmt = program.Statements[1].(*ast.ExpressionStatement)
+	call := stmt.Expression.(*ast.CallExpression)
+	r.Equal("print", call.Function.String())
+}
+
 func Test_For_Expression_Func(t *testing.T) {
 	r := require.New(t)
 	input := `<% for (k,v) in range(1,3) { %>
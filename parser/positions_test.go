@@ -0,0 +1,135 @@
+package parser_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/gobuffalo/plush/v5/ast"
+	"github.com/gobuffalo/plush/v5/parser"
+	"github.com/stretchr/testify/require"
+)
+
+func spanText(src string, n ast.Node) string {
+	span := ast.SpanOf(n)
+	return src[span.Start:span.Stop]
+}
+
+func Test_Node_Spans(t *testing.T) {
+	r := require.New(t)
+	src := `<p><%= user.Name %></p><% let total = a + b * 2 %><%= for (i, v) in range(1, 3) { %><%= v %><% } %><%= greet("hi", [1, 2]) %>`
+	program, err := parser.Parse(src)
+	r.NoError(err)
+
+	spans := map[string]bool{}
+	ast.Inspect(program, func(n ast.Node) bool {
+		if n == nil || n == ast.Node(program) {
+			return true
+		}
+		_, ok := n.(ast.Spanned)
+		r.True(ok, "%T is not spanned", n)
+		span := ast.SpanOf(n)
+		r.True(span.Start >= 0 && span.Start < span.Stop && span.Stop <= len(src), "%T has invalid span %d:%d", n, span.Start, span.Stop)
+		spans[spanText(src, n)] = true
+		return true
+	})
+
+	for _, want := range []string{
+		"<p>",
+		"<%= user.Name",
+		"user.Name",
+		"user",
+		"let total = a + b * 2",
+		"total",
+		"a + b * 2",
+		"b * 2",
+		"for (i, v) in range(1, 3) { %><%= v %><% }",
+		"range(1, 3)",
+		"{ %><%= v %><% }",
+		`greet("hi", [1, 2])`,
+		`"hi"`,
+		"[1, 2]",
+	} {
+		r.True(spans[want], "missing span %q", want)
+	}
+}
+
+func Test_Node_Spans_Are_Byte_Offsets(t *testing.T) {
+	r := require.New(t)
+	src := `<p>日本</p><%= 名前 + "ü" %>`
+	program, err := parser.Parse(src)
+	r.NoError(err)
+
+	ret := program.Statements[1].(*ast.ReturnStatement)
+	infix := ret.ReturnValue.(*ast.InfixExpression)
+	r.Equal(`名前 + "ü"`, spanText(src, infix))
+	r.Equal("名前", spanText(src, infix.Left))
+	r.Equal(len("<p>日本</p><%= "), infix.Pos())
+}
+
+func Test_Parse_Drops_Comments_By_Default(t *testing.T) {
+	r := require.New(t)
+	program, err := parser.Parse("<%# hello %><% # note\nlet x = 1 %>")
+	r.NoError(err)
+	r.Empty(program.Comments)
+}
+
+func Test_Parse_With_Comments(t *testing.T) {
+	r := require.New(t)
+	src := "<%# header %>\n<p><%= title %></p>\n<% let x = 1 # trailing\n%>"
+	program, err := parser.ParseWithOptions(src, parser.Options{Comments: true})
+	r.NoError(err)
+	r.Len(program.Comments, 2)
+
+	block := program.Comments[0]
+	r.True(block.Block)
+	r.Equal(" header ", block.Text)
+	r.Equal("<%# header %>", spanText(src, block))
+	r.Equal("\n<p>", spanText(src, block.Node))
+	r.False(block.Trailing)
+
+	line := program.Comments[1]
+	r.False(line.Block)
+	r.Equal(" trailing", line.Text)
+	r.Equal(3, line.T().LineNumber)
+	r.Equal("let x = 1", spanText(src, line.Node))
+	r.True(line.Trailing)
+
+	r.Equal([]*ast.Comment{line}, program.CommentsFor(line.Node))
+}
+
+func Test_Parse_Unterminated_Block_Comment(t *testing.T) {
+	r := require.New(t)
+	program, err := parser.ParseWithOptions("<p></p><%# never closed", parser.Options{Comments: true})
+	r.NoError(err)
+	r.Len(program.Comments, 1)
+	r.Equal(" never closed", program.Comments[0].Text)
+}
+
+func Test_Parse_Error_Positions(t *testing.T) {
+	r := require.New(t)
+	_, err := parser.Parse("<p>é</p>\n<%= if { %>")
+	r.Error(err)
+
+	errs := parser.Errors(err)
+	r.NotEmpty(errs)
+	r.Equal(2, errs[0].Line)
+	r.Equal(5, errs[0].Column)
+	r.Equal(err.Error(), errs.Error())
+	r.True(strings.HasPrefix(errs[0].Error(), "line 2: "))
+
+	r.Nil(parser.Errors(errors.New("other")))
+}
+
+func Test_Parse_With_Start_Line(t *testing.T) {
+	r := require.New(t)
+	program, err := parser.ParseWithOptions("<%= a %>\n<%= b %>", parser.Options{Line: 7})
+	r.NoError(err)
+	r.Len(program.Statements, 3)
+	r.Equal(7, program.Statements[0].T().LineNumber)
+	r.Equal(8, program.Statements[2].T().LineNumber)
+
+	_, err = parser.ParseWithOptions("\n<%= if { %>", parser.Options{Line: 4})
+	r.Error(err)
+	r.Equal(5, parser.Errors(err)[0].Line)
+}
@@ -0,0 +1,92 @@
+package plush
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/gobuffalo/plush/v5/helpers/hctx"
+)
+
+// ErrPartialCycle is returned, along with ErrPartialDepthExceeded, when
+// partials nest too deep because a partial keeps rendering itself, directly
+// or through other partials.
+var ErrPartialCycle = errors.New("partial cycle")
+
+// partial_chain is the context key holding the partials being rendered.
+// Like already_in_partial, it has a unique suffix to avoid collisions with
+// user-defined variables.
+var partial_chain = "__plush_internal_partial_chain_" + fmt.Sprintf("%d", time.Now().UnixNano()) + "__"
+
+// partialCall is a partial being rendered, linked to the partial rendering
+// it.
+type partialCall struct {
+	file   string
+	depth  int
+	parent *partialCall
+}
+
+// CheckPartialDepth returns an error wrapping ErrPartialDepthExceeded when
+// a partial rendered with ctx would nest deeper than the maximum partial
+// depth of its budget, and nil otherwise.
+func CheckPartialDepth(ctx hctx.Context) error {
+	if ctx == nil {
+		return nil
+	}
+	var depth int
+	if call, ok := ctx.Value(partial_chain).(*partialCall); ok {
+		depth = call.depth
+	}
+	if max := budgetOf(ctx).MaxPartialDepth(); depth >= max {
+		return fmt.Errorf("%w (%d)", ErrPartialDepthExceeded, max)
+	}
+	return nil
+}
+
+// EnterPartial records that ctx, the context of a partial, renders the
+// template file named by meta.TemplateFileKey for the template callerFile
+// at callerLine. When the partial nests too deep it returns a
+// TemplateTraceError wrapping ErrPartialDepthExceeded, and also
+// ErrPartialCycle with the repeating partials when the partial is already
+// being rendered.
+func EnterPartial(ctx hctx.Context, name string, callerFile string, callerLine int) error {
+	if ctx == nil {
+		return nil
+	}
+	file := TemplateFilenameForError(ctx)
+	if file == "" {
+		file = name
+	}
+	parent, _ := ctx.Value(partial_chain).(*partialCall)
+	if err := CheckPartialDepth(ctx); err != nil {
+		if cycle := partialCycle(parent, file); cycle != "" {
+			err = fmt.Errorf("%w: %s: %w", ErrPartialCycle, cycle, err)
+		}
+		return WrapPartialRenderError(callerFile, callerLine, file, err)
+	}
+	depth := 1
+	if parent != nil {
+		depth = parent.depth + 1
+	}
+	ctx.Set(partial_chain, &partialCall{file: file, depth: depth, parent: parent})
+	return nil
+}
+
+// partialCycle returns the partials from the innermost render of file down
+// to file again, such as "a.plush -> b.plush -> a.plush", or "" when file
+// is not being rendered.
+func partialCycle(parent *partialCall, file string) string {
+	cycle := []string{file}
+	for call := parent; call != nil; call = call.parent {
+		cycle = append(cycle, call.file)
+		if call.file != file {
+			continue
+		}
+		for i, j := 0, len(cycle)-1; i < j; i, j = i+1, j-1 {
+			cycle[i], cycle[j] = cycle[j], cycle[i]
+		}
+		return strings.Join(cycle, " -> ")
+	}
+	return ""
+}
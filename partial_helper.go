@@ -23,6 +23,9 @@ func PartialHelper(name string, data map[string]interface{}, help HelperContext)
 	if help.Context == nil {
 		return "", fmt.Errorf("invalid context. abort")
 	}
+	if err := CheckContext(help.Context); err != nil {
+		return "", err
+	}
 
 	if ctx, ok := help.Context.(*Context); ok {
 		if err := ctx.Budget().SpendSubRender(); err != nil {
@@ -63,6 +66,12 @@ func PartialHelper(name string, data map[string]interface{}, help HelperContext)
 		help.Set(meta.TemplateFileKey, name)
 	}
 	childFile := TemplateFilenameForError(help.Context)
+	if err := EnterPartial(help.Context, name, parentFile, parentLine); err != nil {
+		return "", err
+	}
+	if DebuggerFrom(help.Context) != nil {
+		debugPartial(help, DebugPartial{File: childFile, CallerFile: parentFile, CallerLine: parentLine})
+	}
 
 	pf, ok := help.Value("partialFeeder").(func(string) (string, error))
 	if !ok {
@@ -103,6 +112,9 @@ func PartialHelper(name string, data map[string]interface{}, help HelperContext)
 			part = template.JSEscapeString(string(part))
 		}
 	}
+	if err := budgetOf(help.Context).CheckOutput(len(part)); err != nil {
+		return "", WrapPartialRenderError(parentFile, parentLine, childFile, err)
+	}
 
 	if layout, ok := data["layout"].(string); ok {
 		return PartialHelper(
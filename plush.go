@@ -191,6 +191,14 @@ func RenderWithBudgetConfig(input string, limit int64, costs BudgetCosts, ctx *C
 	return Render(input, ctx)
 }
 
+// budgetOf returns the budget of ctx, if any.
+func budgetOf(ctx hctx.Context) *Budget {
+	if provider, ok := ctx.(interface{ Budget() *Budget }); ok {
+		return provider.Budget()
+	}
+	return nil
+}
+
 func isHole(ctx hctx.Context) bool {
 	if c, ok := ctx.(*Context); ok {
 		return isHoleContext(c)
@@ -415,7 +423,8 @@ func renderInterpreter(input string, ctx hctx.Context) (string, error) {
 	// - Not in hole rendering pass (prevents infinite recursion)
 	// - Cache is enabled and backend is available
 	// - Template has a filename for cache key
-	if filename != "" {
+	sourceMap := SourceMapRecorderFrom(ctx).NewBuilder(ctx)
+	if filename != "" && sourceMap == nil && DebuggerFrom(ctx) == nil && CoverageFrom(ctx) == nil {
 		cacheT, cacheErr := renderFromCache(filename, input, ctx)
 		if cacheErr == nil {
 			return cacheT, nil
@@ -431,11 +440,12 @@ func renderInterpreter(input string, ctx hctx.Context) (string, error) {
 	isPlushFile := isFilePlush(filename)
 
 	// Execute template to get skeleton with hole markers
-	s, holeMarkers, err := t.Exec(ctx)
+	s, holeMarkers, err := t.exec(ctx, sourceMap)
 	if err != nil {
 		return "", err
 	}
 	if !isPlushFile {
+		sourceMap.Finish(s)
 		return s, err
 	}
 	//Don't bloat the cache with Skeletons that have no holes
@@ -466,11 +476,17 @@ func renderInterpreter(input string, ctx hctx.Context) (string, error) {
 	// If we have holes and this is the main render pass (not hole rendering),
 	// render holes concurrently and fill them into the skeleton
 	if !isHole(ctx) && len(t.PunchHole) > 0 {
-		hc := renderHolesConcurrently(t.PunchHole, ctx)
-		return fillHoles(s, hc)
+		hc := renderHolesConcurrently(sourceMap.MarkHoles(t.PunchHole), ctx)
+		filled, err := fillHoles(s, hc)
+		if err == nil {
+			sourceMap.FillHoles(hc)
+			sourceMap.Finish(filled)
+		}
+		return filled, err
 	}
 
 	// Return skeleton as-is (either no holes or we're in hole rendering pass)
+	sourceMap.Finish(s)
 	return s, nil
 }
 
@@ -515,12 +531,28 @@ func InterpreterPartialRenderEnabled(ctx hctx.Context) bool {
 // fillHoles replaces all markers in the rendered string with their rendered content using stored positions.
 func fillHoles(rendered string, holes []HoleMarker) (string, error) {
 
-	var sb strings.Builder
-	last := 0
+	size := int64(len(rendered))
+	var maxOutput int64
 	for _, pos := range holes {
 		if pos.err != nil {
 			return "", pos.err
 		}
+		size += int64(len(pos.content) - (pos.end - pos.start))
+		if pos.maxOutput > 0 {
+			maxOutput = pos.maxOutput
+		}
+	}
+	// Check the size of the filled output before building it.
+	if maxOutput > 0 && size > maxOutput {
+		return "", ErrOutputLimitExceeded
+	}
+
+	var sb strings.Builder
+	if size > 0 {
+		sb.Grow(int(size))
+	}
+	last := 0
+	for _, pos := range holes {
 		sb.WriteString(rendered[last:pos.start])
 		sb.WriteString(pos.content)
 		last = pos.end
@@ -567,12 +599,34 @@ func renderHolesConcurrentlyWith(holes []HoleMarker, ctx hctx.Context, renderer
 		go func() {
 			defer wg.Done()
 			for k := range jobs {
+				// A cancelled or timed out render fails as a whole instead
+				// of filling its holes with the error.
+				if err := CheckContext(holeCtx); err != nil {
+					holes[k].err = err
+					continue
+				}
+				if err := budgetOf(holeCtx).CheckDeadline(); err != nil {
+					holes[k].err = err
+					continue
+				}
 				childCtx := holeCtx.New()
+				if holes[k].line > 0 {
+					childCtx.Set(sourceMapHoleLineKey, holes[k].line)
+				}
 				content, err := renderer(holes[k].input, childCtx)
 				if err != nil {
+					if cerr := CheckContext(holeCtx); cerr != nil {
+						holes[k].err = cerr
+						continue
+					}
+					if errors.Is(err, ErrRenderTimeout) {
+						holes[k].err = err
+						continue
+					}
 					content = err.Error() + " in " + currentfileName
 				}
 				holes[k].content = content
+				holes[k].maxOutput = budgetOf(holeCtx).MaxOutput()
 			}
 		}()
 	}
@@ -623,6 +677,7 @@ func holesCopy(holes []HoleMarker) []HoleMarker {
 		holesCopy[i] = holes[i]
 		holesCopy[i].content = ""
 		holesCopy[i].err = nil
+		holesCopy[i].maxOutput = 0
 	}
 	return holesCopy
 }
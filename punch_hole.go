@@ -10,6 +10,12 @@ type HoleMarker struct {
 	start, end  int
 	content     string
 	err         error
+	// line is the template line holding the hole, set while a source map
+	// is recorded.
+	line int
+	// maxOutput is the output limit of the render the hole was rendered
+	// for, which fillHoles enforces on the filled output.
+	maxOutput int64
 }
 
 func PunchHoleMarkerName(index int) string {
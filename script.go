@@ -0,0 +1,183 @@
+package plush
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// ErrOutsideScriptRoot is returned by the file helpers of a script for
+// paths outside of ScriptEnv.Root, or for any path when it is empty.
+var ErrOutsideScriptRoot = errors.New("path is outside of the script root")
+
+// ExitError is returned by RunScriptWith when a script calls exit with a
+// non-zero code.
+type ExitError struct {
+	Code int
+}
+
+func (e *ExitError) Error() string {
+	return fmt.Sprintf("exit status %d", e.Code)
+}
+
+// ScriptEnv is the environment a script runs in with RunScriptWith.
+type ScriptEnv struct {
+	// Stdin is read by readStdin. Defaults to an empty reader.
+	Stdin io.Reader
+	// Stdout is written by print and println. Defaults to io.Discard.
+	Stdout io.Writer
+	// Args are the arguments of the script, available as args.
+	Args []string
+	// Root is the directory the file helpers are limited to. Relative
+	// paths are resolved against it, and paths leading out of it, through
+	// ".." or symbolic links, are rejected. Empty disables file access.
+	Root string
+	// Budget limits the work the script can do; nil is unlimited.
+	Budget *Budget
+}
+
+// RunScriptWith runs a Plush script, as RunScript does, with helpers for
+// IO that are limited to env:
+//
+//	print(v), println(v)        write v to env.Stdout
+//	readStdin()                 returns all of env.Stdin
+//	readFile(path)              returns the content of a file
+//	writeFile(path, content)    writes a file, creating its directory
+//	fileExists(path)            reports whether a file exists
+//	listFiles(dir)              returns the names in a directory, sorted
+//	args                        env.Args
+//	exit(code)                  stops the script
+//
+// exit(0) stops the script without an error; other codes are returned as
+// an *ExitError.
+func RunScriptWith(input string, ctx *Context, env ScriptEnv) error {
+	if ctx == nil {
+		ctx = NewContext()
+	}
+	if env.Stdin == nil {
+		env.Stdin = strings.NewReader("")
+	}
+	if env.Stdout == nil {
+		env.Stdout = io.Discard
+	}
+	sc := NewContextWithOuter(nil, ctx)
+	if env.Budget != nil {
+		sc.WithBudget(env.Budget)
+	}
+	sc.Set("print", func(i interface{}) error {
+		_, err := fmt.Fprint(env.Stdout, i)
+		return err
+	})
+	sc.Set("println", func(i interface{}) error {
+		_, err := fmt.Fprintln(env.Stdout, i)
+		return err
+	})
+	sc.Set("readStdin", func() (string, error) {
+		b, err := io.ReadAll(env.Stdin)
+		return string(b), err
+	})
+	sc.Set("readFile", func(name string) (string, error) {
+		path, err := env.path(name)
+		if err != nil {
+			return "", err
+		}
+		b, err := os.ReadFile(path)
+		return string(b), err
+	})
+	sc.Set("writeFile", func(name string, content interface{}) error {
+		path, err := env.path(name)
+		if err != nil {
+			return err
+		}
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			return err
+		}
+		return os.WriteFile(path, []byte(fmt.Sprint(content)), 0o644)
+	})
+	sc.Set("fileExists", func(name string) (bool, error) {
+		path, err := env.path(name)
+		if err != nil {
+			return false, err
+		}
+		_, err = os.Stat(path)
+		if errors.Is(err, os.ErrNotExist) {
+			return false, nil
+		}
+		return err == nil, err
+	})
+	sc.Set("listFiles", func(name string) ([]string, error) {
+		path, err := env.path(name)
+		if err != nil {
+			return nil, err
+		}
+		entries, err := os.ReadDir(path)
+		if err != nil {
+			return nil, err
+		}
+		names := make([]string, len(entries))
+		for i, entry := range entries {
+			names[i] = entry.Name()
+		}
+		return names, nil
+	})
+	args := env.Args
+	if args == nil {
+		args = []string{}
+	}
+	sc.Set("args", args)
+	sc.Set("exit", func(code int) error {
+		return &ExitError{Code: code}
+	})
+
+	_, err := Render("<% "+input+" %>", sc)
+	var exit *ExitError
+	if errors.As(err, &exit) {
+		if exit.Code == 0 {
+			return nil
+		}
+		return exit
+	}
+	return err
+}
+
+// path resolves name against env.Root and rejects it when it leads out of
+// the root.
+func (env ScriptEnv) path(name string) (string, error) {
+	if env.Root == "" {
+		return "", fmt.Errorf("%s: %w", name, ErrOutsideScriptRoot)
+	}
+	root, err := filepath.Abs(env.Root)
+	if err != nil {
+		return "", err
+	}
+	root = resolveExisting(root)
+	path := filepath.FromSlash(name)
+	if !filepath.IsAbs(path) {
+		path = filepath.Join(root, path)
+	}
+	rel, err := filepath.Rel(root, resolveExisting(filepath.Clean(path)))
+	if err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return "", fmt.Errorf("%s: %w", name, ErrOutsideScriptRoot)
+	}
+	return filepath.Join(root, rel), nil
+}
+
+// resolveExisting follows the symbolic links of the longest part of path
+// that exists.
+func resolveExisting(path string) string {
+	rest := ""
+	for {
+		if resolved, err := filepath.EvalSymlinks(path); err == nil {
+			return filepath.Join(resolved, rest)
+		}
+		dir := filepath.Dir(path)
+		if dir == path {
+			return filepath.Join(path, rest)
+		}
+		rest = filepath.Join(filepath.Base(path), rest)
+		path = dir
+	}
+}
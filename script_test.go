@@ -2,7 +2,11 @@ package plush_test
 
 import (
 	"bytes"
+	"errors"
 	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/gobuffalo/plush/v5"
@@ -41,3 +45,75 @@ let x = fn(f) {
 x(fn() {
   out("asdfasdf")
 })`
+
+func Test_Run_Script_With_Env(t *testing.T) {
+	r := require.New(t)
+	root := t.TempDir()
+	r.NoError(os.WriteFile(filepath.Join(root, "names.txt"), []byte("mark"), 0o644))
+
+	out := &bytes.Buffer{}
+	err := plush.RunScriptWith(`
+for (n) in args {
+  println(n + " " + readFile("names.txt"))
+}
+writeFile("gen/out.txt", len(args))
+print(readStdin())
+print(fileExists("gen/out.txt"))
+print(listFiles("."))
+`, nil, plush.ScriptEnv{
+		Stdin:  strings.NewReader("in|"),
+		Stdout: out,
+		Args:   []string{"hello", "bye"},
+		Root:   root,
+	})
+	r.NoError(err)
+	r.Equal("hello mark\nbye mark\nin|true[gen names.txt]", out.String())
+	b, err := os.ReadFile(filepath.Join(root, "gen", "out.txt"))
+	r.NoError(err)
+	r.Equal("2", string(b))
+}
+
+func Test_Run_Script_With_Env_Exit(t *testing.T) {
+	r := require.New(t)
+	out := &bytes.Buffer{}
+	err := plush.RunScriptWith(`print("a")
+if (len(args) == 0) { exit(0) }
+print("b")`, nil, plush.ScriptEnv{Stdout: out})
+	r.NoError(err)
+	r.Equal("a", out.String())
+
+	err = plush.RunScriptWith(`for (i) in range(1, 3) { if (i == 2) { exit(3) } }`, nil, plush.ScriptEnv{})
+	var exit *plush.ExitError
+	r.True(errors.As(err, &exit), "got %v", err)
+	r.Equal(3, exit.Code)
+}
+
+func Test_Run_Script_With_Env_Sandbox(t *testing.T) {
+	r := require.New(t)
+	root := t.TempDir()
+	outside := t.TempDir()
+	r.NoError(os.WriteFile(filepath.Join(outside, "secret"), []byte("x"), 0o644))
+	r.NoError(os.Symlink(outside, filepath.Join(root, "link")))
+
+	for _, script := range []string{
+		`readFile("../secret")`,
+		`readFile("` + filepath.ToSlash(filepath.Join(outside, "secret")) + `")`,
+		`readFile("link/secret")`,
+		`writeFile("link/new", "x")`,
+		`listFiles("..")`,
+	} {
+		err := plush.RunScriptWith(script, nil, plush.ScriptEnv{Root: root})
+		r.True(errors.Is(err, plush.ErrOutsideScriptRoot), "%s: %v", script, err)
+	}
+
+	err := plush.RunScriptWith(`readFile("a")`, nil, plush.ScriptEnv{})
+	r.True(errors.Is(err, plush.ErrOutsideScriptRoot), "got %v", err)
+}
+
+func Test_Run_Script_With_Env_Budget(t *testing.T) {
+	r := require.New(t)
+	err := plush.RunScriptWith(`for (i) in range(1, 100) { print(i) }`, nil, plush.ScriptEnv{
+		Budget: plush.NewBudget(10),
+	})
+	r.True(errors.Is(err, plush.ErrBudgetExceeded), "got %v", err)
+}
@@ -0,0 +1,425 @@
+package plush
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gobuffalo/plush/v5/ast"
+	"github.com/gobuffalo/plush/v5/helpers/hctx"
+)
+
+var sourceMapRecorderKey = "__plush_internal_source_map_recorder_" + fmt.Sprintf("%d", time.Now().UnixNano()) + "__"
+var sourceMapHoleLineKey = "__plush_internal_source_map_hole_line_" + fmt.Sprintf("%d", time.Now().UnixNano()) + "__"
+var sourceMapRenderKey = "__plush_internal_source_map_render_" + fmt.Sprintf("%d", time.Now().UnixNano()) + "__"
+
+// SourceSegment maps the output bytes [Start, End) to the template line
+// that wrote them. File is the template file name from the context, or ""
+// when the template was rendered without one.
+type SourceSegment struct {
+	Start int    `json:"start"`
+	End   int    `json:"end"`
+	File  string `json:"file"`
+	Line  int    `json:"line"`
+}
+
+// SourceMap maps byte ranges of rendered output back to the templates,
+// partials and lines that produced them. Segments are sorted by Start and
+// do not overlap. A SourceMap can be exported as JSON with encoding/json.
+type SourceMap struct {
+	Segments []SourceSegment `json:"segments"`
+}
+
+// Lookup returns the segment holding the output byte at offset.
+func (m *SourceMap) Lookup(offset int) (SourceSegment, bool) {
+	if m == nil {
+		return SourceSegment{}, false
+	}
+	i := sort.Search(len(m.Segments), func(i int) bool {
+		return m.Segments[i].End > offset
+	})
+	if i == len(m.Segments) || m.Segments[i].Start > offset {
+		return SourceSegment{}, false
+	}
+	return m.Segments[i], true
+}
+
+// Annotate returns output with an HTML comment naming the source of each
+// segment, such as `<!-- plush: users/_row.plush.html:3 -->`. It is meant
+// for development builds: comments are only inserted at the start of a
+// line or right after a `>`, so attribute values are left alone, but the
+// content of elements such as <textarea> or <script> may change.
+func (m *SourceMap) Annotate(output string) string {
+	if m == nil || len(m.Segments) == 0 {
+		return output
+	}
+
+	var sb strings.Builder
+	sb.Grow(len(output) + len(m.Segments)*32)
+	last := 0
+	var prev SourceSegment
+	for _, seg := range m.Segments {
+		if seg.Start < last || seg.Start > len(output) {
+			continue
+		}
+		if seg.File == prev.File && seg.Line == prev.Line {
+			continue
+		}
+		if seg.Start > 0 && output[seg.Start-1] != '\n' && output[seg.Start-1] != '>' {
+			continue
+		}
+		sb.WriteString(output[last:seg.Start])
+		sb.WriteString(sourceComment(seg))
+		last = seg.Start
+		prev = seg
+	}
+	sb.WriteString(output[last:])
+	return sb.String()
+}
+
+func sourceComment(seg SourceSegment) string {
+	if seg.File == "" {
+		return fmt.Sprintf("<!-- plush: line %d -->", seg.Line)
+	}
+	return fmt.Sprintf("<!-- plush: %s:%d -->", strings.ReplaceAll(seg.File, "--", "- -"), seg.Line)
+}
+
+// RenderWithSourceMap renders input like Render and also returns a map
+// from byte ranges of the output to the template lines that produced them,
+// following partials, layouts, helper blocks and punch holes.
+//
+// The punch hole cache is bypassed so every byte is rendered, and the VM
+// renders through its generic instruction loop. The recorder stays
+// attached to ctx: rendering a layout with the same context afterwards
+// maps the yielded content back to the inner template.
+func RenderWithSourceMap(input string, ctx hctx.Context) (string, *SourceMap, error) {
+	if ctx == nil {
+		ctx = NewContext()
+	}
+	rec := SourceMapRecorderFrom(ctx)
+	if rec == nil {
+		rec = NewSourceMapRecorder()
+		ctx.Set(sourceMapRecorderKey, rec)
+	}
+	// A render that failed before may have left its own render behind.
+	ctx.Set(sourceMapRenderKey, &rec.top)
+
+	s, err := Render(input, ctx)
+	if err != nil {
+		return "", nil, err
+	}
+	segments, _ := rec.top.last(s)
+	return s, &SourceMap{Segments: segments}, nil
+}
+
+// SourceMapRecorder collects the source maps of every render sharing a
+// context. Each render hands its output and segments to the render it is
+// nested in, such as the template rendering a partial, which splices them
+// in where it writes that output.
+type SourceMapRecorder struct {
+	// top holds the outputs of the renders not nested in another, so a
+	// layout rendered afterwards with the same context can map them.
+	top sourceMapRender
+}
+
+func NewSourceMapRecorder() *SourceMapRecorder {
+	return &SourceMapRecorder{}
+}
+
+// sourceMapRender holds the outputs rendered for a render, by the renders
+// nested in it and by its helper blocks, until the render writes them.
+type sourceMapRender struct {
+	mu      sync.Mutex
+	parent  *sourceMapRender
+	outputs []renderedOutput
+}
+
+type renderedOutput struct {
+	output   string
+	segments []SourceSegment
+}
+
+func (r *sourceMapRender) add(output string, segments []SourceSegment) {
+	if output == "" {
+		return
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.outputs = append(r.outputs, renderedOutput{output: output, segments: segments})
+}
+
+// take removes the first output equal to text handed to r, or to the
+// renders r is nested in, and returns its segments.
+func (r *sourceMapRender) take(text string) ([]SourceSegment, bool) {
+	for ; r != nil; r = r.parent {
+		r.mu.Lock()
+		for i, o := range r.outputs {
+			if o.output == text {
+				r.outputs = append(r.outputs[:i], r.outputs[i+1:]...)
+				r.mu.Unlock()
+				return o.segments, true
+			}
+		}
+		r.mu.Unlock()
+	}
+	return nil, false
+}
+
+// last returns the segments of the last output equal to text handed to r,
+// leaving it in place.
+func (r *sourceMapRender) last(text string) ([]SourceSegment, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for i := len(r.outputs) - 1; i >= 0; i-- {
+		if r.outputs[i].output == text {
+			return r.outputs[i].segments, true
+		}
+	}
+	return nil, false
+}
+
+// SourceMapRecorderFrom returns the recorder attached to ctx, or nil when
+// the render is not recording a source map.
+func SourceMapRecorderFrom(ctx hctx.Context) *SourceMapRecorder {
+	if ctx == nil {
+		return nil
+	}
+	rec, _ := ctx.Value(sourceMapRecorderKey).(*SourceMapRecorder)
+	return rec
+}
+
+// NewBuilder returns a builder for the template rendered with ctx. The
+// render is nested in the render ctx was derived from, if any, and renders
+// nested in it must use a context derived from ctx.
+func (r *SourceMapRecorder) NewBuilder(ctx hctx.Context) *SourceMapBuilder {
+	if r == nil {
+		return nil
+	}
+	parent, _ := ctx.Value(sourceMapRenderKey).(*sourceMapRender)
+	if parent == nil {
+		parent = &r.top
+	}
+	render := &sourceMapRender{parent: parent}
+	ctx.Set(sourceMapRenderKey, render)
+	b := &SourceMapBuilder{ctx: ctx, render: render, file: TemplateFilenameForError(ctx)}
+	if line, ok := ctx.Value(sourceMapHoleLineKey).(int); ok && isHole(ctx) {
+		// A punch hole is rendered as a one line template of its own.
+		b.lineOffset = line - 1
+	}
+	return b
+}
+
+// SourceMapBuilder records the segments of a single output buffer. All
+// methods are safe to call on a nil builder, which records nothing.
+type SourceMapBuilder struct {
+	ctx        hctx.Context
+	render     *sourceMapRender
+	file       string
+	lineOffset int
+	segments   []SourceSegment
+}
+
+// Sub returns an empty builder for another buffer of the same template.
+func (b *SourceMapBuilder) Sub() *SourceMapBuilder {
+	if b == nil {
+		return nil
+	}
+	return &SourceMapBuilder{ctx: b.ctx, render: b.render, file: b.file, lineOffset: b.lineOffset}
+}
+
+// Len returns the number of segments recorded so far.
+func (b *SourceMapBuilder) Len() int {
+	if b == nil {
+		return 0
+	}
+	return len(b.segments)
+}
+
+// Splice records segments mapped elsewhere for output written at offset.
+func (b *SourceMapBuilder) Splice(offset int, segments []SourceSegment) {
+	if b == nil {
+		return
+	}
+	for _, seg := range segments {
+		seg.Start += offset
+		seg.End += offset
+		b.segments = append(b.segments, seg)
+	}
+}
+
+// Fill maps the bytes of out[start:end] that are not covered by segments
+// recorded after the first from ones to line. Gaps whose text is the
+// output of a nested render or helper block not written yet are spliced
+// in from it; html marks text copied from the template, which is mapped
+// line by line.
+func (b *SourceMapBuilder) Fill(out string, start, end, from, line int, html bool) {
+	if b == nil || start >= end {
+		return
+	}
+	nested := append([]SourceSegment(nil), b.segments[from:]...)
+	sort.Slice(nested, func(i, j int) bool { return nested[i].Start < nested[j].Start })
+
+	cursor := start
+	for _, seg := range nested {
+		if seg.Start > cursor {
+			b.gap(out, cursor, min(seg.Start, end), line, html)
+		}
+		cursor = max(cursor, seg.End)
+	}
+	if cursor < end {
+		b.gap(out, cursor, end, line, html)
+	}
+}
+
+func (b *SourceMapBuilder) gap(out string, start, end, line int, html bool) {
+	if start >= end {
+		return
+	}
+	text := out[start:end]
+	if !html {
+		if segments, ok := b.render.take(text); ok {
+			b.Splice(start, segments)
+			return
+		}
+		b.add(start, end, line)
+		return
+	}
+
+	// The lexer counts a leading newline of an HTML literal as part of the
+	// next line; each line of the text is mapped to the one it ends.
+	if strings.HasPrefix(text, "\n") {
+		line--
+	}
+	for start < end {
+		n := strings.IndexByte(out[start:end], '\n') + 1
+		if n == 0 {
+			n = end - start
+		}
+		b.add(start, start+n, line)
+		start += n
+		line++
+	}
+}
+
+func (b *SourceMapBuilder) add(start, end, line int) {
+	b.segments = append(b.segments, SourceSegment{
+		Start: start,
+		End:   end,
+		File:  b.file,
+		Line:  line + b.lineOffset,
+	})
+}
+
+// Segments returns the recorded segments sorted by Start.
+func (b *SourceMapBuilder) Segments() []SourceSegment {
+	if b == nil {
+		return nil
+	}
+	segments := append([]SourceSegment(nil), b.segments...)
+	sort.SliceStable(segments, func(i, j int) bool { return segments[i].Start < segments[j].Start })
+	return segments
+}
+
+// Finish hands output, the result of the render, to the render it is
+// nested in so that writing it there splices its segments in.
+func (b *SourceMapBuilder) Finish(output string) {
+	if b == nil {
+		return
+	}
+	b.render.parent.add(output, b.Segments())
+	b.ctx.Set(sourceMapRenderKey, b.render.parent)
+}
+
+// FinishBlock keeps output, rendered by a helper block of the render, so
+// that writing it in the render splices its segments in.
+func (b *SourceMapBuilder) FinishBlock(output string) {
+	if b == nil {
+		return
+	}
+	b.render.add(output, b.Segments())
+}
+
+// MarkHoles sets the line of each marker on holes and returns them, so
+// that the punch hole renders are mapped to the template line holding the
+// hole.
+func (b *SourceMapBuilder) MarkHoles(holes []HoleMarker) []HoleMarker {
+	if b == nil {
+		return holes
+	}
+	m := SourceMap{Segments: b.Segments()}
+	for i := range holes {
+		if seg, ok := m.Lookup(holes[i].start); ok {
+			holes[i].line = seg.Line
+		}
+	}
+	return holes
+}
+
+// FillHoles rewrites the segments recorded for a skeleton to match the
+// output of filling holes in it, as done by FillPunchHoles.
+func (b *SourceMapBuilder) FillHoles(holes []HoleMarker) {
+	if b == nil || len(holes) == 0 {
+		return
+	}
+	shift := func(p int) int {
+		for _, h := range holes {
+			if h.start >= 0 && h.end <= p {
+				p += len(h.content) - (h.end - h.start)
+			}
+		}
+		return p
+	}
+
+	skeleton := b.Segments()
+	b.segments = b.segments[:0]
+	for _, seg := range skeleton {
+		start := seg.Start
+		for _, h := range holes {
+			if h.start < 0 || h.end <= start || h.start >= seg.End {
+				continue
+			}
+			if h.start > start {
+				b.segments = append(b.segments, SourceSegment{Start: shift(start), End: shift(h.start), File: seg.File, Line: seg.Line})
+			}
+			start = h.end
+		}
+		if start < seg.End {
+			b.segments = append(b.segments, SourceSegment{Start: shift(start), End: shift(seg.End), File: seg.File, Line: seg.Line})
+		}
+	}
+	for _, h := range holes {
+		if h.start < 0 || h.content == "" {
+			continue
+		}
+		offset := shift(h.start)
+		if segments, ok := b.render.take(h.content); ok {
+			b.Splice(offset, segments)
+			continue
+		}
+		b.segments = append(b.segments, SourceSegment{Start: offset, End: offset + len(h.content), File: b.file, Line: h.line})
+	}
+}
+
+// sourceLine wraps a value produced by a statement inside a block with the
+// line of that statement while a source map is recorded.
+type sourceLine struct {
+	value interface{}
+	line  int
+	html  bool
+}
+
+func statementSourceLine(s ast.Statement, value interface{}) sourceLine {
+	_, html := htmlStatement(s)
+	return sourceLine{value: value, line: s.T().LineNumber, html: html}
+}
+
+func htmlStatement(s ast.Statement) (*ast.HTMLLiteral, bool) {
+	es, ok := s.(*ast.ExpressionStatement)
+	if !ok {
+		return nil, false
+	}
+	h, ok := es.Expression.(*ast.HTMLLiteral)
+	return h, ok
+}
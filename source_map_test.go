@@ -0,0 +1,215 @@
+package plush_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"html/template"
+	"strings"
+	"testing"
+
+	"github.com/gobuffalo/plush/v5"
+	"github.com/gobuffalo/plush/v5/helpers/meta"
+	"github.com/stretchr/testify/require"
+)
+
+// sourceOf returns "file:line" for the output byte at the first occurrence
+// of sub.
+func sourceOf(t *testing.T, out string, m *plush.SourceMap, sub string) string {
+	t.Helper()
+	i := strings.Index(out, sub)
+	require.NotEqual(t, -1, i, "%q not found in %q", sub, out)
+	seg, ok := m.Lookup(i)
+	require.True(t, ok, "no segment for %q", sub)
+	return fmt.Sprintf("%s:%d", seg.File, seg.Line)
+}
+
+func requireCovered(t *testing.T, out string, m *plush.SourceMap) {
+	t.Helper()
+	last := 0
+	for _, seg := range m.Segments {
+		require.Equal(t, last, seg.Start, "gap or overlap before %+v", seg)
+		require.Less(t, seg.Start, seg.End)
+		last = seg.End
+	}
+	require.Equal(t, len(out), last)
+}
+
+func Test_RenderWithSourceMap_Lines(t *testing.T) {
+	r := require.New(t)
+	ctx := plush.NewContext()
+	ctx.Set(meta.TemplateFileKey, "users/index.html")
+	ctx.Set("users", []string{"mark", "ringo"})
+
+	input := `<ul>
+<%= for (u) in users { %>
+  <li><%= u %></li>
+<% } %>
+</ul>`
+	out, m, err := plush.RenderWithSourceMap(input, ctx)
+	r.NoError(err)
+
+	plain, err := plush.Render(input, plush.NewContextWith(map[string]interface{}{"users": []string{"mark", "ringo"}}))
+	r.NoError(err)
+	r.Equal(plain, out)
+
+	requireCovered(t, out, m)
+	r.Equal("users/index.html:1", sourceOf(t, out, m, "<ul>"))
+	r.Equal("users/index.html:3", sourceOf(t, out, m, "<li>"))
+	r.Equal("users/index.html:3", sourceOf(t, out, m, "ringo"))
+	r.Equal("users/index.html:5", sourceOf(t, out, m, "</ul>"))
+}
+
+func Test_RenderWithSourceMap_Partials(t *testing.T) {
+	r := require.New(t)
+	ctx := plush.NewContext()
+	ctx.Set(meta.TemplateFileKey, "index.html")
+	ctx.Set("partialFeeder", func(name string) (string, error) {
+		switch name {
+		case "_row.html":
+			return "<tr>\n<td><%= n %></td>\n</tr>", nil
+		case "_layout.html":
+			return "<table>\n<%= yield %>\n</table>", nil
+		}
+		return "", fmt.Errorf("unknown partial %s", name)
+	})
+
+	input := `<h1>Rows</h1>
+<%= partial("_row.html", {n: "one", layout: "_layout.html"}) %>
+<p>end</p>`
+	out, m, err := plush.RenderWithSourceMap(input, ctx)
+	r.NoError(err)
+	r.Equal("<h1>Rows</h1>\n<table>\n<tr>\n<td>one</td>\n</tr>\n</table>\n<p>end</p>", out)
+
+	requireCovered(t, out, m)
+	r.Equal("index.html:1", sourceOf(t, out, m, "<h1>"))
+	r.Equal("_layout.html:1", sourceOf(t, out, m, "<table>"))
+	r.Equal("_row.html:1", sourceOf(t, out, m, "<tr>"))
+	r.Equal("_row.html:2", sourceOf(t, out, m, "one"))
+	r.Equal("_layout.html:3", sourceOf(t, out, m, "</table>"))
+	r.Equal("index.html:3", sourceOf(t, out, m, "<p>"))
+}
+
+func Test_RenderWithSourceMap_Output_Equal_To_Partial(t *testing.T) {
+	r := require.New(t)
+	ctx := plush.NewContext()
+	ctx.Set(meta.TemplateFileKey, "main.html")
+	ctx.Set("v", "<b>a</b>")
+	ctx.Set("partialFeeder", func(name string) (string, error) {
+		return "<b>a</b>", nil
+	})
+
+	out, m, err := plush.RenderWithSourceMap(`<%= partial("a.html") %>
+<%= raw(v) %>
+<%= partial("b.html") %>`, ctx)
+	r.NoError(err)
+	r.Equal("<b>a</b>\n<b>a</b>\n<b>a</b>", out)
+
+	requireCovered(t, out, m)
+	r.Equal("a.html:1", sourceOf(t, out, m, "<b>a</b>\n"))
+	seg, ok := m.Lookup(len("<b>a</b>\n"))
+	r.True(ok)
+	r.Equal("main.html:2", fmt.Sprintf("%s:%d", seg.File, seg.Line))
+	seg, ok = m.Lookup(len(out) - 1)
+	r.True(ok)
+	r.Equal("b.html:1", fmt.Sprintf("%s:%d", seg.File, seg.Line))
+}
+
+func Test_RenderWithSourceMap_Helper_Blocks(t *testing.T) {
+	r := require.New(t)
+	ctx := plush.NewContext()
+	ctx.Set(meta.TemplateFileKey, "page.html")
+
+	input := `<% contentFor("title") { %>
+<title>Home</title>
+<% } %>
+<head>
+<%= contentOf("title") %>
+</head>`
+	out, m, err := plush.RenderWithSourceMap(input, ctx)
+	r.NoError(err)
+
+	requireCovered(t, out, m)
+	r.Equal("page.html:2", sourceOf(t, out, m, "<title>"))
+	r.Equal("page.html:4", sourceOf(t, out, m, "<head>"))
+	r.Equal("page.html:6", sourceOf(t, out, m, "</head>"))
+}
+
+func Test_RenderWithSourceMap_Layout_Reuses_Context(t *testing.T) {
+	r := require.New(t)
+	ctx := plush.NewContext()
+	ctx.Set(meta.TemplateFileKey, "show.html")
+
+	inner, _, err := plush.RenderWithSourceMap("<p>\nshow</p>", ctx)
+	r.NoError(err)
+
+	ctx.Set(meta.TemplateFileKey, "application.html")
+	ctx.Set("yield", template.HTML(inner))
+	out, m, err := plush.RenderWithSourceMap("<body>\n<%= yield %>\n</body>", ctx)
+	r.NoError(err)
+	r.Equal("<body>\n<p>\nshow</p>\n</body>", out)
+
+	requireCovered(t, out, m)
+	r.Equal("application.html:1", sourceOf(t, out, m, "<body>"))
+	r.Equal("show.html:2", sourceOf(t, out, m, "show"))
+	r.Equal("application.html:3", sourceOf(t, out, m, "</body>"))
+}
+
+func Test_RenderWithSourceMap_Punch_Holes(t *testing.T) {
+	r := require.New(t)
+	ctx := plush.NewContext()
+	ctx.Set(meta.TemplateFileKey, "holes.plush")
+	ctx.Set("name", "mark")
+
+	input := "<div>\n<%H name %>\n</div>"
+	out, m, err := plush.RenderWithSourceMap(input, ctx)
+	r.NoError(err)
+	r.Equal("<div>\nmark\n</div>", out)
+
+	requireCovered(t, out, m)
+	r.Equal("holes.plush:1", sourceOf(t, out, m, "<div>"))
+	r.Equal("holes.plush:2", sourceOf(t, out, m, "mark"))
+	r.Equal("holes.plush:3", sourceOf(t, out, m, "</div>"))
+}
+
+func Test_SourceMap_JSON(t *testing.T) {
+	r := require.New(t)
+	ctx := plush.NewContext()
+	ctx.Set(meta.TemplateFileKey, "a.html")
+
+	_, m, err := plush.RenderWithSourceMap("<p>\n<%= 1 %></p>", ctx)
+	r.NoError(err)
+
+	b, err := json.Marshal(m)
+	r.NoError(err)
+	r.JSONEq(`{"segments":[
+		{"start":0,"end":4,"file":"a.html","line":1},
+		{"start":4,"end":5,"file":"a.html","line":2},
+		{"start":5,"end":9,"file":"a.html","line":2}
+	]}`, string(b))
+}
+
+func Test_SourceMap_Annotate(t *testing.T) {
+	r := require.New(t)
+	ctx := plush.NewContext()
+	ctx.Set(meta.TemplateFileKey, "a.html")
+	ctx.Set("href", "/home")
+
+	out, m, err := plush.RenderWithSourceMap("<div>\n<a href=\"<%= href %>\">home</a>\n</div>", ctx)
+	r.NoError(err)
+	r.Equal("<!-- plush: a.html:1 --><div>\n<!-- plush: a.html:2 --><a href=\"/home\">home</a>\n<!-- plush: a.html:3 --></div>", m.Annotate(out))
+}
+
+func Test_SourceMap_Lookup(t *testing.T) {
+	r := require.New(t)
+	m := &plush.SourceMap{Segments: []plush.SourceSegment{
+		{Start: 0, End: 3, Line: 1},
+		{Start: 3, End: 5, Line: 2},
+	}}
+
+	seg, ok := m.Lookup(3)
+	r.True(ok)
+	r.Equal(2, seg.Line)
+
+	_, ok = m.Lookup(5)
+	r.False(ok)
+}
@@ -59,14 +59,21 @@ func (t *Template) Parse() error {
 
 // Exec the template using the content and return the results
 func (t *Template) Exec(ctx hctx.Context) (string, []HoleMarker, error) {
+	return t.exec(ctx, nil)
+}
+
+func (t *Template) exec(ctx hctx.Context, sourceMap *SourceMapBuilder) (string, []HoleMarker, error) {
 	err := t.Parse()
 	if err != nil {
 		return "", nil, err
 	}
 
 	ev := compiler{
-		ctx:     ctx,
-		program: t.Program,
+		ctx:       ctx,
+		program:   t.Program,
+		sourceMap: sourceMap,
+		debugger:  DebuggerFrom(ctx),
+		coverage:  TemplateCoverageFor(ctx, t.Program),
 	}
 
 	s, err := ev.compile()
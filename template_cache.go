@@ -134,3 +134,9 @@ func cacheVMBytecodeWithKey(key string, program *ast.Program, bytecode interface
 	t.IsCache = false
 	templateCacheBackend.Set(key, t)
 }
+
+// TemplateCacheEnabled reports whether a template cache has been set up
+// with PlushCacheSetup.
+func TemplateCacheEnabled() bool {
+	return cacheEnabled && templateCacheBackend != nil
+}
@@ -18,6 +18,9 @@ type TemplateErrorFrame struct {
 type TemplateTraceError struct {
 	Frames  []TemplateErrorFrame
 	Message string
+	// err is the error the trace was built from, so that errors.Is finds
+	// sentinel errors such as ErrBudgetExceeded through partials.
+	err error
 }
 
 func (e *TemplateTraceError) Error() string {
@@ -40,6 +43,14 @@ func (e *TemplateTraceError) Error() string {
 	return strings.Join(parts, ":") + ": " + message
 }
 
+// Unwrap returns the error the trace was built from.
+func (e *TemplateTraceError) Unwrap() error {
+	if e == nil {
+		return nil
+	}
+	return e.err
+}
+
 func IsTemplateTraceError(err error) bool {
 	var trace *TemplateTraceError
 	return errors.As(err, &trace)
@@ -54,7 +65,7 @@ func WrapPartialRenderError(parentFile string, parentLine int, childFile string,
 	if errors.As(err, &trace) {
 		frames := append([]TemplateErrorFrame{}, parent)
 		frames = append(frames, trace.Frames...)
-		return &TemplateTraceError{Frames: compactTemplateErrorFrames(frames), Message: trace.Message}
+		return &TemplateTraceError{Frames: compactTemplateErrorFrames(frames), Message: trace.Message, err: trace.err}
 	}
 	message := strings.TrimSpace(err.Error())
 	childLine, rest, ok := splitLineErrorPrefix(message)
@@ -62,7 +73,20 @@ func WrapPartialRenderError(parentFile string, parentLine int, childFile string,
 		message = rest
 	}
 	frames := []TemplateErrorFrame{parent, {File: childFile, Line: childLine}}
-	return &TemplateTraceError{Frames: compactTemplateErrorFrames(frames), Message: message}
+	return &TemplateTraceError{Frames: compactTemplateErrorFrames(frames), Message: message, err: err}
+}
+
+// WrapTemplateError returns err as a TemplateTraceError starting at file,
+// so that errors from the top level template read like those from its
+// partials. The line is taken from a leading `line N:` in the message.
+// TemplateTraceErrors are returned unchanged.
+func WrapTemplateError(file string, err error) error {
+	if err == nil || IsTemplateTraceError(err) {
+		return err
+	}
+	line, message, _ := splitLineErrorPrefix(err.Error())
+	frames := []TemplateErrorFrame{{File: file, Line: line}}
+	return &TemplateTraceError{Frames: compactTemplateErrorFrames(frames), Message: message, err: err}
 }
 
 func TemplateFilenameForError(ctx hctx.Context) string {
@@ -24,3 +24,29 @@ func Test_Template_Exec_Concurrency(t *testing.T) {
 	err = wg.Wait()
 	r.NoError(err)
 }
+
+func Test_Template_HasContextWrites(t *testing.T) {
+	tests := []struct {
+		input  string
+		writes bool
+	}{
+		{`<p><%= name %></p>`, false},
+		{`<% let x = 1 %>`, true},
+		{`<%= if (a) { %>x<% } else if (b) { x = 2 } %>`, true},
+		{`<%= for (v) in list { %><%= v %><% } %>`, false},
+		{`<%= for (v) in list { m["k"] = v } %>`, true},
+		{`<%= greet() { let y = 1 } %>`, true},
+		{`<%= let f = fn() { return 1 } %>`, true},
+		{`<%= {"a": [1, fn() { z = 1 }]} %>`, true},
+		{`<%H let hole = 1 %>`, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			r := require.New(t)
+			tmpl, err := plush.NewTemplate(tt.input)
+			r.NoError(err)
+			r.Equal(tt.writes, tmpl.HasContextWrites)
+		})
+	}
+}
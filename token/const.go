@@ -11,6 +11,7 @@ const (
 	STRING   = "STRING"   // "foobar"
 	B_STRING = "B_STRING" // `foobar`
 	HTML     = "HTML"     // <p>adf</p>
+	COMMENT  = "COMMENT"  // # note
 	DOT      = "DOT"      // .23
 
 	// Operators
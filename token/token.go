@@ -5,9 +5,17 @@ type Type string
 
 // Token of a section of input source.
 type Token struct {
-	Type       Type
-	Literal    string
+	Type    Type
+	Literal string
+	// LineNumber is the line where the token starts, also for tokens
+	// spanning several lines such as HTML and strings.
 	LineNumber int
+	// Column is the 1-based rune column where the token starts.
+	Column int
+	// Offset and EndOffset are the byte offsets of the first byte of the
+	// token and of the byte just past it in the lexed input.
+	Offset    int
+	EndOffset int
 }
 
 var keywords = map[string]Type{
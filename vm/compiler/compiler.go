@@ -39,6 +39,7 @@ func New() *Compiler {
 		localNames:          map[int]string{},
 		lineNumbers:         map[int]int{},
 		properties:          map[int]object.PropertyAccess{},
+		branches:            map[int]object.BranchPoint{},
 		lastInstruction:     EmittedInstruction{},
 		previousInstruction: EmittedInstruction{},
 	}
@@ -546,6 +547,7 @@ func (c *Compiler) compileIfExpression(node *ast.IfExpression) error {
 	jumpNotTruthyPos := c.emit(code.OpJumpNotTruthy, 9999)
 
 	blockStart := len(c.currentInstructions())
+	c.markBranch(node.Token, 0)
 	if err := c.compileScopedBlockStatement(node.Block); err != nil {
 		return err
 	}
@@ -555,13 +557,14 @@ func (c *Compiler) compileIfExpression(node *ast.IfExpression) error {
 	afterBlockPos := len(c.currentInstructions())
 	c.changeOperand(jumpNotTruthyPos, afterBlockPos)
 
-	for _, elseIf := range node.ElseIf {
+	for i, elseIf := range node.ElseIf {
 		if err := c.compileCondition(elseIf.Condition); err != nil {
 			return err
 		}
 
 		elseIfJumpNotTruthyPos := c.emit(code.OpJumpNotTruthy, 9999)
 		elseIfBlockStart := len(c.currentInstructions())
+		c.markBranch(node.Token, i+1)
 		if err := c.compileScopedBlockStatement(elseIf.Block); err != nil {
 			return err
 		}
@@ -572,6 +575,7 @@ func (c *Compiler) compileIfExpression(node *ast.IfExpression) error {
 		c.changeOperand(elseIfJumpNotTruthyPos, afterElseIfPos)
 	}
 
+	c.markBranch(node.Token, len(node.ElseIf)+1)
 	if node.ElseBlock == nil {
 		c.emit(code.OpNull)
 	} else {
@@ -922,8 +926,9 @@ func (c *Compiler) compileFunctionLiteral(node *ast.FunctionLiteral, name string
 	localNames := c.currentLocalNames()
 	lineNumbers := c.currentLineNumbers()
 	properties := c.currentProperties()
+	branches := c.currentBranches()
 	instructions := c.leaveScope()
-	instructions, callNames, lineNumbers, properties = optimizeScope(instructions, callNames, lineNumbers, properties, c.constants)
+	instructions, callNames, lineNumbers, properties, branches = optimizeScope(instructions, callNames, lineNumbers, properties, branches, c.constants)
 
 	for _, s := range freeSymbols {
 		c.loadSymbol(s)
@@ -935,6 +940,7 @@ func (c *Compiler) compileFunctionLiteral(node *ast.FunctionLiteral, name string
 		LocalNames:     localNames,
 		LineNumbers:    lineNumbers,
 		Properties:     properties,
+		Branches:       branches,
 		PropertyCaches: object.NewInlineCacheSlots(len(instructions)),
 		CallCaches:     object.NewInlineCacheSlots(len(instructions)),
 		NumLocals:      numLocals,
@@ -1046,6 +1052,13 @@ func (c *Compiler) compileForExpression(node *ast.ForExpression) error {
 	if err != nil {
 		return err
 	}
+	// Every iteration runs the body from its first instruction.
+	if body, ok := c.constants[blockIndex].(*object.CompiledFunction); ok {
+		if body.Branches == nil {
+			body.Branches = map[int]object.BranchPoint{}
+		}
+		body.Branches[0] = object.BranchPoint{Line: node.Token.LineNumber, Column: node.Token.Column}
+	}
 
 	c.emit(code.OpFor, blockIndex, c.addStringConstant(node.KeyName), c.addStringConstant(node.ValueName), numFree)
 	return nil
@@ -1072,8 +1085,9 @@ func (c *Compiler) compileBlockConstant(block *ast.BlockStatement, params []stri
 	localNames := c.currentLocalNames()
 	lineNumbers := c.currentLineNumbers()
 	properties := c.currentProperties()
+	branches := c.currentBranches()
 	instructions := c.leaveScope()
-	instructions, callNames, lineNumbers, properties = optimizeScope(instructions, callNames, lineNumbers, properties, c.constants)
+	instructions, callNames, lineNumbers, properties, branches = optimizeScope(instructions, callNames, lineNumbers, properties, branches, c.constants)
 
 	for _, s := range freeSymbols {
 		c.loadSymbol(s)
@@ -1085,6 +1099,7 @@ func (c *Compiler) compileBlockConstant(block *ast.BlockStatement, params []stri
 		LocalNames:     localNames,
 		LineNumbers:    lineNumbers,
 		Properties:     properties,
+		Branches:       branches,
 		PropertyCaches: object.NewInlineCacheSlots(len(instructions)),
 		CallCaches:     object.NewInlineCacheSlots(len(instructions)),
 		NumLocals:      numLocals,
@@ -1108,11 +1123,12 @@ func (c *Compiler) Bytecode() *Bytecode {
 	for k, v := range c.globalNames {
 		names[k] = v
 	}
-	instructions, callNames, lineNumbers, properties := optimizeScope(
+	instructions, callNames, lineNumbers, properties, branches := optimizeScope(
 		c.currentInstructions(),
 		c.currentCallNames(),
 		c.currentLineNumbers(),
 		c.currentProperties(),
+		c.currentBranches(),
 		c.constants,
 	)
 	staticOutput, static := staticOutputFromInstructions(instructions, c.constants)
@@ -1142,6 +1158,7 @@ func (c *Compiler) Bytecode() *Bytecode {
 		LocalNames:       c.currentLocalNames(),
 		LineNumbers:      lineNumbers,
 		Properties:       properties,
+		Branches:         branches,
 		PropertyCaches:   object.NewInlineCacheSlots(len(instructions)),
 		CallCaches:       object.NewInlineCacheSlots(len(instructions)),
 		NumLocals:        c.currentLocalCount(),
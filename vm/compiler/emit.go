@@ -1,6 +1,7 @@
 package compiler
 
 import (
+	"github.com/gobuffalo/plush/v5/token"
 	"github.com/gobuffalo/plush/v5/vm/code"
 	"github.com/gobuffalo/plush/v5/vm/object"
 )
@@ -169,6 +170,28 @@ func (c *Compiler) currentProperties() map[int]object.PropertyAccess {
 	return copied
 }
 
+func (c *Compiler) currentBranches() map[int]object.BranchPoint {
+	branches := c.scopes[c.scopeIndex].branches
+	if len(branches) == 0 {
+		return nil
+	}
+	copied := make(map[int]object.BranchPoint, len(branches))
+	for pos, branch := range branches {
+		copied[pos] = branch
+	}
+	return copied
+}
+
+// markBranch records that the next instruction starts the given branch of
+// the if expression at tok, for coverage.
+func (c *Compiler) markBranch(tok token.Token, branch int) {
+	c.scopes[c.scopeIndex].branches[len(c.currentInstructions())] = object.BranchPoint{
+		Line:   tok.LineNumber,
+		Column: tok.Column,
+		Branch: branch,
+	}
+}
+
 func (c *Compiler) currentLocalCount() int {
 	return c.scopes[c.scopeIndex].numLocals
 }
@@ -193,6 +216,7 @@ func (c *Compiler) enterScope() {
 		localNames:          map[int]string{},
 		lineNumbers:         map[int]int{},
 		properties:          map[int]object.PropertyAccess{},
+		branches:            map[int]object.BranchPoint{},
 		lastInstruction:     EmittedInstruction{},
 		previousInstruction: EmittedInstruction{},
 	}
@@ -0,0 +1,893 @@
+package compiler
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"html/template"
+	"math"
+	"sort"
+
+	"github.com/gobuffalo/plush/v5/vm/code"
+	"github.com/gobuffalo/plush/v5/vm/object"
+)
+
+// BytecodeFormat is the version of the binary bytecode encoding. It must be
+// bumped whenever Bytecode, the fast render plans or their encoding change.
+const BytecodeFormat = 2
+
+// EngineVersion identifies the engine able to run encoded bytecode. It
+// combines BytecodeFormat with a fingerprint of the instruction set, so
+// bytecode encoded by a build with different opcodes is rejected too.
+var EngineVersion = engineVersion()
+
+// ErrEngineVersion is returned when decoding bytecode encoded by another
+// engine version.
+var ErrEngineVersion = errors.New("bytecode was encoded by another engine version")
+
+const bytecodeMagic = "plushbc\x00"
+
+func engineVersion() string {
+	h := fnv.New64a()
+	for op := 0; op < 256; op++ {
+		def, err := code.Lookup(byte(op))
+		if err != nil {
+			continue
+		}
+		fmt.Fprintf(h, "%d:%s:%v;", op, def.Name, def.OperandWidths)
+	}
+	return fmt.Sprintf("%d-%016x", BytecodeFormat, h.Sum64())
+}
+
+// MarshalBinary encodes the bytecode, its constants and its fast render
+// plan. Runtime state such as inline caches and output size statistics is
+// not encoded; decoded bytecode starts with fresh state.
+func (b *Bytecode) MarshalBinary() ([]byte, error) {
+	if b == nil {
+		return nil, errors.New("cannot encode nil bytecode")
+	}
+	e := &encoder{buf: []byte(bytecodeMagic)}
+	e.string(EngineVersion)
+	e.bytecode(b)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// UnmarshalBytecode decodes bytecode encoded by MarshalBinary. It returns
+// an error wrapping ErrEngineVersion when data was encoded by another
+// engine version.
+func UnmarshalBytecode(data []byte) (*Bytecode, error) {
+	if len(data) < len(bytecodeMagic) || string(data[:len(bytecodeMagic)]) != bytecodeMagic {
+		return nil, errors.New("not encoded bytecode")
+	}
+	d := &decoder{buf: data[len(bytecodeMagic):]}
+	if version := d.string(); d.err == nil && version != EngineVersion {
+		return nil, fmt.Errorf("%w: got %s, want %s", ErrEngineVersion, version, EngineVersion)
+	}
+	b := d.bytecode()
+	if d.err == nil && len(d.buf) != 0 {
+		d.fail("%d trailing bytes", len(d.buf))
+	}
+	if d.err != nil {
+		return nil, fmt.Errorf("decoding bytecode: %w", d.err)
+	}
+	return b, nil
+}
+
+const (
+	constantInteger byte = iota + 1
+	constantFloat
+	constantString
+	constantBoolean
+	constantNull
+	constantHTML
+	constantFunction
+)
+
+type encoder struct {
+	buf []byte
+	err error
+}
+
+func (e *encoder) fail(format string, args ...interface{}) {
+	if e.err == nil {
+		e.err = fmt.Errorf(format, args...)
+	}
+}
+
+func (e *encoder) uint(v uint64) { e.buf = binary.AppendUvarint(e.buf, v) }
+
+func (e *encoder) int(v int) { e.buf = binary.AppendVarint(e.buf, int64(v)) }
+
+func (e *encoder) int64(v int64) { e.buf = binary.AppendVarint(e.buf, v) }
+
+func (e *encoder) float(v float64) { e.uint(math.Float64bits(v)) }
+
+func (e *encoder) bool(v bool) {
+	if v {
+		e.buf = append(e.buf, 1)
+		return
+	}
+	e.buf = append(e.buf, 0)
+}
+
+func (e *encoder) byte(v byte) { e.buf = append(e.buf, v) }
+
+func (e *encoder) string(s string) {
+	e.uint(uint64(len(s)))
+	e.buf = append(e.buf, s...)
+}
+
+func (e *encoder) strings(s []string) {
+	e.uint(uint64(len(s)))
+	for _, v := range s {
+		e.string(v)
+	}
+}
+
+// names and lines write maps sorted by key so that encoding is
+// deterministic.
+func (e *encoder) names(m map[int]string) {
+	e.uint(uint64(len(m)))
+	for _, k := range sortedKeys(m) {
+		e.int(k)
+		e.string(m[k])
+	}
+}
+
+func (e *encoder) lines(m map[int]int) {
+	e.uint(uint64(len(m)))
+	for _, k := range sortedKeys(m) {
+		e.int(k)
+		e.int(m[k])
+	}
+}
+
+func (e *encoder) properties(m map[int]object.PropertyAccess) {
+	e.uint(uint64(len(m)))
+	for _, k := range sortedKeys(m) {
+		e.int(k)
+		e.string(m[k].Receiver)
+		e.string(m[k].Full)
+		e.bool(m[k].Method)
+	}
+}
+
+func (e *encoder) branches(m map[int]object.BranchPoint) {
+	e.uint(uint64(len(m)))
+	for _, k := range sortedKeys(m) {
+		e.int(k)
+		e.int(m[k].Line)
+		e.int(m[k].Column)
+		e.int(m[k].Branch)
+	}
+}
+
+func sortedKeys[V any](m map[int]V) []int {
+	keys := make([]int, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Ints(keys)
+	return keys
+}
+
+func (e *encoder) bytecode(b *Bytecode) {
+	e.string(string(b.Instructions))
+	e.names(b.CallNames)
+	e.names(b.LocalNames)
+	e.lines(b.LineNumbers)
+	e.properties(b.Properties)
+	e.branches(b.Branches)
+	e.int(b.NumLocals)
+	e.int(b.NumGlobals)
+	e.uint(uint64(len(b.Constants)))
+	for _, c := range b.Constants {
+		e.constant(c)
+	}
+	e.names(b.GlobalNames)
+	e.bool(b.Static)
+	e.string(b.StaticOutput)
+	e.int(b.StaticSize)
+	e.bool(b.FastRenderPlan != nil)
+	if b.FastRenderPlan != nil {
+		e.plan(b.FastRenderPlan)
+	}
+	e.int(b.FastRejectLine)
+	e.string(b.FastReject)
+	e.bool(b.HasHoles)
+	e.bool(b.HasPartials)
+	e.bool(b.HasContextWrites)
+}
+
+func (e *encoder) constant(c object.Object) {
+	switch c := c.(type) {
+	case *object.Integer:
+		e.byte(constantInteger)
+		e.int64(c.Value)
+	case *object.Float:
+		e.byte(constantFloat)
+		e.float(c.Value)
+	case *object.String:
+		e.byte(constantString)
+		e.string(c.Value)
+	case *object.Boolean:
+		e.byte(constantBoolean)
+		e.bool(c.Value)
+	case *object.Null:
+		e.byte(constantNull)
+	case *object.Native:
+		html, ok := c.Value.(template.HTML)
+		if !ok {
+			e.fail("cannot encode native constant of type %T", c.Value)
+			return
+		}
+		e.byte(constantHTML)
+		e.string(string(html))
+	case *object.CompiledFunction:
+		e.byte(constantFunction)
+		e.string(string(c.Instructions))
+		e.names(c.CallNames)
+		e.names(c.LocalNames)
+		e.lines(c.LineNumbers)
+		e.properties(c.Properties)
+		e.branches(c.Branches)
+		e.int(c.NumLocals)
+		e.int(c.NumParameters)
+	default:
+		e.fail("cannot encode constant of type %T", c)
+	}
+}
+
+func (e *encoder) plan(p *FastRenderPlan) {
+	e.strings(p.Bindings)
+	e.segments(p.Segments)
+	e.int(p.StaticSize)
+	e.int(p.NameCount)
+}
+
+func (e *encoder) segments(segments []FastRenderSegment) {
+	e.uint(uint64(len(segments)))
+	for i := range segments {
+		e.segment(&segments[i])
+	}
+}
+
+func (e *encoder) segment(s *FastRenderSegment) {
+	e.byte(byte(s.Kind))
+	e.string(s.Value)
+	e.int(s.NameIndex)
+	e.bool(s.NullOnMissing)
+	e.string(s.Property)
+	e.string(s.Receiver)
+	e.string(s.Full)
+	e.int(s.Line)
+	e.loop(s.Loop)
+	e.value(&s.ValuePlan)
+	e.call(s.Call)
+	e.blockCall(s.BlockCall)
+	e.conditional(s.Conditional)
+	e.partial(s.Partial)
+	e.bool(s.Generic != nil)
+	if s.Generic != nil {
+		e.bool(s.Generic.WholeTemplate)
+		e.string(s.Generic.Reason)
+		e.int(s.Generic.Line)
+	}
+	e.assignTarget(s.AssignTarget)
+}
+
+func (e *encoder) loop(l *FastLoopPlan) {
+	e.bool(l != nil)
+	if l == nil {
+		return
+	}
+	e.string(l.IterableName)
+	e.int(l.IterableNameIndex)
+	e.value(&l.Iterable)
+	e.string(l.KeyName)
+	e.string(l.ValueName)
+	e.strings(l.OuterNames)
+	e.parts(l.Parts)
+	e.int(l.StaticSize)
+	e.bool(l.Silent)
+	e.bool(l.HasLet)
+	e.bool(l.HasAssign)
+	e.bool(l.PartFlagsSet)
+	e.int(l.Line)
+}
+
+func (e *encoder) parts(parts []FastLoopPart) {
+	e.uint(uint64(len(parts)))
+	for i := range parts {
+		p := &parts[i]
+		e.byte(byte(p.Kind))
+		e.string(p.Value)
+		e.int(p.NameIndex)
+		e.string(p.Receiver)
+		e.string(p.Full)
+		e.int(p.Line)
+		e.value(&p.ValuePlan)
+		e.call(p.Call)
+		e.blockCall(p.BlockCall)
+		e.partial(p.Partial)
+		e.assignTarget(p.AssignTarget)
+		e.loopConditional(p.Conditional)
+		e.loop(p.Loop)
+	}
+}
+
+func (e *encoder) value(v *FastValuePlan) {
+	e.byte(byte(v.Kind))
+	e.string(v.Value)
+	e.int(v.NameIndex)
+	e.bool(v.NullOnMissing)
+	e.int64(v.IntValue)
+	e.float(v.FloatValue)
+	e.bool(v.BoolValue)
+	e.string(v.Operator)
+	e.valuePtr(v.Left)
+	e.valuePtr(v.Right)
+	e.call(v.Call)
+	e.values(v.Elements)
+	e.uint(uint64(len(v.Pairs)))
+	for i := range v.Pairs {
+		e.string(v.Pairs[i].Key)
+		e.valuePtr(v.Pairs[i].KeyPlan)
+		e.value(&v.Pairs[i].Value)
+		e.int(v.Pairs[i].Line)
+	}
+	e.uint(uint64(len(v.Path)))
+	for i := range v.Path {
+		step := &v.Path[i]
+		e.byte(byte(step.Kind))
+		e.string(step.Value)
+		e.int(step.Index)
+		e.string(step.Receiver)
+		e.string(step.Full)
+		e.bool(step.Method)
+		e.int(step.Line)
+		e.values(step.Args)
+	}
+	e.int(v.Line)
+}
+
+func (e *encoder) valuePtr(v *FastValuePlan) {
+	e.bool(v != nil)
+	if v != nil {
+		e.value(v)
+	}
+}
+
+func (e *encoder) values(values []FastValuePlan) {
+	e.uint(uint64(len(values)))
+	for i := range values {
+		e.value(&values[i])
+	}
+}
+
+func (e *encoder) call(c *FastCallPlan) {
+	e.bool(c != nil)
+	if c == nil {
+		return
+	}
+	e.string(c.Name)
+	e.int(c.NameIndex)
+	e.values(c.Args)
+	e.bool(c.Silent)
+	e.int(c.Line)
+}
+
+func (e *encoder) blockCall(c *FastBlockCallPlan) {
+	e.bool(c != nil)
+	if c == nil {
+		return
+	}
+	if c.BlockBytecode == nil {
+		e.fail("cannot encode block helper %q on line %d: its body is not compiled", c.Name, c.Line)
+		return
+	}
+	e.string(c.Name)
+	e.int(c.NameIndex)
+	e.values(c.Args)
+	e.string(c.BlockSource)
+	e.bytecode(c.BlockBytecode)
+	e.bool(c.Silent)
+	e.int(c.Line)
+}
+
+func (e *encoder) conditional(c *FastConditionalPlan) {
+	e.bool(c != nil)
+	if c == nil {
+		return
+	}
+	e.uint(uint64(len(c.Branches)))
+	for i := range c.Branches {
+		e.value(&c.Branches[i].Condition)
+		e.segments(c.Branches[i].Segments)
+		e.int(c.Branches[i].Line)
+	}
+	e.segments(c.ElseSegments)
+	e.int(c.Line)
+	e.bool(c.Silent)
+}
+
+func (e *encoder) loopConditional(c *FastLoopConditionalPlan) {
+	e.bool(c != nil)
+	if c == nil {
+		return
+	}
+	e.uint(uint64(len(c.Branches)))
+	for i := range c.Branches {
+		e.value(&c.Branches[i].Condition)
+		e.parts(c.Branches[i].Parts)
+		e.int(c.Branches[i].Line)
+	}
+	e.parts(c.ElseParts)
+	e.int(c.Line)
+	e.bool(c.Silent)
+}
+
+func (e *encoder) partial(p *FastPartialPlan) {
+	e.bool(p != nil)
+	if p == nil {
+		return
+	}
+	e.string(p.Name)
+	e.uint(uint64(len(p.Data)))
+	for i := range p.Data {
+		e.string(p.Data[i].Key)
+		e.value(&p.Data[i].Value)
+		e.int(p.Data[i].Line)
+	}
+	e.int(p.Line)
+}
+
+func (e *encoder) assignTarget(t *FastAssignTarget) {
+	e.bool(t != nil)
+	if t == nil {
+		return
+	}
+	e.byte(byte(t.Kind))
+	e.string(t.Name)
+	e.int(t.NameIndex)
+	e.value(&t.Container)
+	e.value(&t.Index)
+	e.int(t.Line)
+}
+
+// decoder reads what encoder writes. The first error is kept and every
+// later read returns a zero value, so callers check err once at the end.
+type decoder struct {
+	buf []byte
+	err error
+}
+
+func (d *decoder) fail(format string, args ...interface{}) {
+	if d.err == nil {
+		d.err = fmt.Errorf(format, args...)
+		d.buf = nil
+	}
+}
+
+func (d *decoder) uint() uint64 {
+	v, n := binary.Uvarint(d.buf)
+	if n <= 0 {
+		d.fail("truncated data")
+		return 0
+	}
+	d.buf = d.buf[n:]
+	return v
+}
+
+func (d *decoder) int64() int64 {
+	v, n := binary.Varint(d.buf)
+	if n <= 0 {
+		d.fail("truncated data")
+		return 0
+	}
+	d.buf = d.buf[n:]
+	return v
+}
+
+func (d *decoder) int() int { return int(d.int64()) }
+
+func (d *decoder) float() float64 { return math.Float64frombits(d.uint()) }
+
+func (d *decoder) byte() byte {
+	if len(d.buf) == 0 {
+		d.fail("truncated data")
+		return 0
+	}
+	v := d.buf[0]
+	d.buf = d.buf[1:]
+	return v
+}
+
+func (d *decoder) bool() bool { return d.byte() != 0 }
+
+// count reads a length and checks it against the bytes left, so corrupt
+// data cannot cause a huge allocation.
+func (d *decoder) count() int {
+	n := d.uint()
+	if n > uint64(len(d.buf)) {
+		d.fail("length %d exceeds the remaining %d bytes", n, len(d.buf))
+		return 0
+	}
+	return int(n)
+}
+
+func (d *decoder) string() string {
+	n := d.count()
+	s := string(d.buf[:n])
+	d.buf = d.buf[n:]
+	return s
+}
+
+func (d *decoder) strings() []string {
+	n := d.count()
+	if n == 0 {
+		return nil
+	}
+	s := make([]string, n)
+	for i := range s {
+		s[i] = d.string()
+	}
+	return s
+}
+
+func (d *decoder) names() map[int]string {
+	n := d.count()
+	m := make(map[int]string, n)
+	for i := 0; i < n; i++ {
+		k := d.int()
+		m[k] = d.string()
+	}
+	return m
+}
+
+func (d *decoder) lines() map[int]int {
+	n := d.count()
+	m := make(map[int]int, n)
+	for i := 0; i < n; i++ {
+		k := d.int()
+		m[k] = d.int()
+	}
+	return m
+}
+
+func (d *decoder) properties() map[int]object.PropertyAccess {
+	n := d.count()
+	m := make(map[int]object.PropertyAccess, n)
+	for i := 0; i < n; i++ {
+		k := d.int()
+		m[k] = object.PropertyAccess{Receiver: d.string(), Full: d.string(), Method: d.bool()}
+	}
+	return m
+}
+
+func (d *decoder) branches() map[int]object.BranchPoint {
+	n := d.count()
+	m := make(map[int]object.BranchPoint, n)
+	for i := 0; i < n; i++ {
+		k := d.int()
+		m[k] = object.BranchPoint{Line: d.int(), Column: d.int(), Branch: d.int()}
+	}
+	return m
+}
+
+func (d *decoder) instructions() code.Instructions {
+	s := d.string()
+	if s == "" {
+		return nil
+	}
+	return code.Instructions(s)
+}
+
+func (d *decoder) bytecode() *Bytecode {
+	b := &Bytecode{
+		Instructions: d.instructions(),
+		CallNames:    d.names(),
+		LocalNames:   d.names(),
+		LineNumbers:  d.lines(),
+		Properties:   d.properties(),
+		Branches:     d.branches(),
+		NumLocals:    d.int(),
+		NumGlobals:   d.int(),
+	}
+	if n := d.count(); n > 0 {
+		b.Constants = make([]object.Object, n)
+		for i := range b.Constants {
+			b.Constants[i] = d.constant()
+		}
+	}
+	b.GlobalNames = d.names()
+	b.Static = d.bool()
+	b.StaticOutput = d.string()
+	b.StaticSize = d.int()
+	if d.bool() {
+		b.FastRenderPlan = d.plan()
+	}
+	b.FastRejectLine = d.int()
+	b.FastReject = d.string()
+	b.HasHoles = d.bool()
+	b.HasPartials = d.bool()
+	b.HasContextWrites = d.bool()
+	b.PropertyCaches = object.NewInlineCacheSlots(len(b.Instructions))
+	b.CallCaches = object.NewInlineCacheSlots(len(b.Instructions))
+	b.OutputSizeStats = &OutputSizeStats{}
+	b.LayoutSizeStats = &OutputSizeStats{}
+	b.PartialSizeStats = &OutputSizeStats{}
+	return b
+}
+
+func (d *decoder) constant() object.Object {
+	switch kind := d.byte(); kind {
+	case constantInteger:
+		return &object.Integer{Value: d.int64()}
+	case constantFloat:
+		return &object.Float{Value: d.float()}
+	case constantString:
+		return &object.String{Value: d.string()}
+	case constantBoolean:
+		if d.bool() {
+			return object.TrueObject
+		}
+		return object.FalseObject
+	case constantNull:
+		return object.NullObject
+	case constantHTML:
+		return &object.Native{Value: template.HTML(d.string())}
+	case constantFunction:
+		fn := &object.CompiledFunction{
+			Instructions:  d.instructions(),
+			CallNames:     d.names(),
+			LocalNames:    d.names(),
+			LineNumbers:   d.lines(),
+			Properties:    d.properties(),
+			Branches:      d.branches(),
+			NumLocals:     d.int(),
+			NumParameters: d.int(),
+		}
+		fn.PropertyCaches = object.NewInlineCacheSlots(len(fn.Instructions))
+		fn.CallCaches = object.NewInlineCacheSlots(len(fn.Instructions))
+		return fn
+	default:
+		d.fail("unknown constant kind %d", kind)
+		return object.NullObject
+	}
+}
+
+func (d *decoder) plan() *FastRenderPlan {
+	return &FastRenderPlan{
+		Bindings:   d.strings(),
+		Segments:   d.segments(),
+		StaticSize: d.int(),
+		NameCount:  d.int(),
+	}
+}
+
+func (d *decoder) segments() []FastRenderSegment {
+	n := d.count()
+	if n == 0 {
+		return nil
+	}
+	segments := make([]FastRenderSegment, n)
+	for i := range segments {
+		s := &segments[i]
+		s.Kind = FastRenderSegmentKind(d.byte())
+		s.Value = d.string()
+		s.NameIndex = d.int()
+		s.NullOnMissing = d.bool()
+		s.Property = d.string()
+		s.Receiver = d.string()
+		s.Full = d.string()
+		s.Line = d.int()
+		s.Loop = d.loop()
+		s.ValuePlan = d.value()
+		s.Call = d.call()
+		s.BlockCall = d.blockCall()
+		s.Conditional = d.conditional()
+		s.Partial = d.partial()
+		if d.bool() {
+			s.Generic = &FastGenericPlan{WholeTemplate: d.bool(), Reason: d.string(), Line: d.int()}
+		}
+		s.AssignTarget = d.assignTarget()
+	}
+	return segments
+}
+
+func (d *decoder) loop() *FastLoopPlan {
+	if !d.bool() {
+		return nil
+	}
+	return &FastLoopPlan{
+		IterableName:      d.string(),
+		IterableNameIndex: d.int(),
+		Iterable:          d.value(),
+		KeyName:           d.string(),
+		ValueName:         d.string(),
+		OuterNames:        d.strings(),
+		Parts:             d.parts(),
+		StaticSize:        d.int(),
+		Silent:            d.bool(),
+		HasLet:            d.bool(),
+		HasAssign:         d.bool(),
+		PartFlagsSet:      d.bool(),
+		Line:              d.int(),
+		SizeStats:         &LoopSizeStats{},
+	}
+}
+
+func (d *decoder) parts() []FastLoopPart {
+	n := d.count()
+	if n == 0 {
+		return nil
+	}
+	parts := make([]FastLoopPart, n)
+	for i := range parts {
+		p := &parts[i]
+		p.Kind = FastLoopPartKind(d.byte())
+		p.Value = d.string()
+		p.NameIndex = d.int()
+		p.Receiver = d.string()
+		p.Full = d.string()
+		p.Line = d.int()
+		p.ValuePlan = d.value()
+		p.Call = d.call()
+		p.BlockCall = d.blockCall()
+		p.Partial = d.partial()
+		p.AssignTarget = d.assignTarget()
+		p.Conditional = d.loopConditional()
+		p.Loop = d.loop()
+	}
+	return parts
+}
+
+func (d *decoder) value() FastValuePlan {
+	v := FastValuePlan{
+		Kind:          FastValueKind(d.byte()),
+		Value:         d.string(),
+		NameIndex:     d.int(),
+		NullOnMissing: d.bool(),
+		IntValue:      d.int64(),
+		FloatValue:    d.float(),
+		BoolValue:     d.bool(),
+		Operator:      d.string(),
+		Left:          d.valuePtr(),
+		Right:         d.valuePtr(),
+		Call:          d.call(),
+		Elements:      d.values(),
+	}
+	if n := d.count(); n > 0 {
+		v.Pairs = make([]FastValuePair, n)
+		for i := range v.Pairs {
+			v.Pairs[i] = FastValuePair{Key: d.string(), KeyPlan: d.valuePtr(), Value: d.value(), Line: d.int()}
+		}
+	}
+	if n := d.count(); n > 0 {
+		v.Path = make([]FastPathStep, n)
+		for i := range v.Path {
+			v.Path[i] = FastPathStep{
+				Kind:     FastPathStepKind(d.byte()),
+				Value:    d.string(),
+				Index:    d.int(),
+				Receiver: d.string(),
+				Full:     d.string(),
+				Method:   d.bool(),
+				Line:     d.int(),
+				Args:     d.values(),
+			}
+		}
+	}
+	v.Line = d.int()
+	return v
+}
+
+func (d *decoder) valuePtr() *FastValuePlan {
+	if !d.bool() {
+		return nil
+	}
+	v := d.value()
+	return &v
+}
+
+func (d *decoder) values() []FastValuePlan {
+	n := d.count()
+	if n == 0 {
+		return nil
+	}
+	values := make([]FastValuePlan, n)
+	for i := range values {
+		values[i] = d.value()
+	}
+	return values
+}
+
+func (d *decoder) call() *FastCallPlan {
+	if !d.bool() {
+		return nil
+	}
+	return &FastCallPlan{Name: d.string(), NameIndex: d.int(), Args: d.values(), Silent: d.bool(), Line: d.int()}
+}
+
+func (d *decoder) blockCall() *FastBlockCallPlan {
+	if !d.bool() {
+		return nil
+	}
+	return &FastBlockCallPlan{
+		Name:          d.string(),
+		NameIndex:     d.int(),
+		Args:          d.values(),
+		BlockSource:   d.string(),
+		BlockBytecode: d.bytecode(),
+		Silent:        d.bool(),
+		Line:          d.int(),
+	}
+}
+
+func (d *decoder) conditional() *FastConditionalPlan {
+	if !d.bool() {
+		return nil
+	}
+	c := &FastConditionalPlan{}
+	if n := d.count(); n > 0 {
+		c.Branches = make([]FastConditionalBranch, n)
+		for i := range c.Branches {
+			c.Branches[i] = FastConditionalBranch{Condition: d.value(), Segments: d.segments(), Line: d.int()}
+		}
+	}
+	c.ElseSegments = d.segments()
+	c.Line = d.int()
+	c.Silent = d.bool()
+	return c
+}
+
+func (d *decoder) loopConditional() *FastLoopConditionalPlan {
+	if !d.bool() {
+		return nil
+	}
+	c := &FastLoopConditionalPlan{}
+	if n := d.count(); n > 0 {
+		c.Branches = make([]FastLoopConditionalBranch, n)
+		for i := range c.Branches {
+			c.Branches[i] = FastLoopConditionalBranch{Condition: d.value(), Parts: d.parts(), Line: d.int()}
+		}
+	}
+	c.ElseParts = d.parts()
+	c.Line = d.int()
+	c.Silent = d.bool()
+	return c
+}
+
+func (d *decoder) partial() *FastPartialPlan {
+	if !d.bool() {
+		return nil
+	}
+	p := &FastPartialPlan{Name: d.string()}
+	if n := d.count(); n > 0 {
+		p.Data = make([]FastPartialDataPair, n)
+		for i := range p.Data {
+			p.Data[i] = FastPartialDataPair{Key: d.string(), Value: d.value(), Line: d.int()}
+		}
+	}
+	p.Line = d.int()
+	return p
+}
+
+func (d *decoder) assignTarget() *FastAssignTarget {
+	if !d.bool() {
+		return nil
+	}
+	return &FastAssignTarget{
+		Kind:      FastAssignTargetKind(d.byte()),
+		Name:      d.string(),
+		NameIndex: d.int(),
+		Container: d.value(),
+		Index:     d.value(),
+		Line:      d.int(),
+	}
+}
@@ -0,0 +1,177 @@
+package compiler
+
+import (
+	"errors"
+	"reflect"
+	"sync/atomic"
+	"testing"
+
+	"github.com/gobuffalo/plush/v5/ast"
+	"github.com/gobuffalo/plush/v5/parser"
+	"github.com/gobuffalo/plush/v5/vm/object"
+	"github.com/stretchr/testify/require"
+)
+
+var encodingTemplates = []string{
+	`<h1>static</h1>`,
+	`<p><%= name %> <%= user.Name %> <%= user.Profile.Age + 1 %></p>`,
+	`<%= for (i, item) in items { %><%= if (i > 0) { %>, <% } %><%= item.Name %><% } %>`,
+	`<%= for (item) in items { %><%= for (tag) in item.Tags { %><%= tag %><% } %><% } %>`,
+	`<%= for (i, v) in [1, 2, 3] { if (v == 2) { continue } if (v == 3) { break } return v } %>`,
+	`<%= for (item) in items { %><% let label = item.Name + "!" %><% total = total + 1 %><%= label %><% } %>`,
+	`<%= if (a) { %>a<% } else if (b && !c) { %>b<% } else { %>c<% } %>`,
+	`<%= upcase(name) %> <%= truncate(title, {size: 10}) %> <%= user.Greeting("hi") %>`,
+	`<%= contentFor("sidebar") { %><b><%= name %></b><% } %><%= content("sidebar") %>`,
+	`<%= partial("users/form.html", {user: user, count: 2, ratio: 0.5}) %>`,
+	`<% let double = fn(x) { return x * 2 } %><%= double(-3) %>`,
+	`<%= [1, 2.5, "a", true][0] %><%= {a: 1, "b c": nil}["a"] %>`,
+	`<% let h = {a: 1} %><% h["a"] = 2 %><% h.b = 3 %><%= h["a"] %>`,
+	`<%= if (true) { return "early" } %>after`,
+	`<%= "a" + name %><%= items[1].Tags[0] %><%= data["key"] %>`,
+	`<% for (k, v) in map { %><%= k %>=<%= v %><% } %>`,
+}
+
+func compileForEncoding(t *testing.T, input string) *Bytecode {
+	t.Helper()
+	program, err := parser.Parse(input)
+	require.NoError(t, err)
+	comp := New()
+	require.NoError(t, comp.Compile(program))
+	return comp.Bytecode()
+}
+
+func Test_Bytecode_Encoding_Round_Trip(t *testing.T) {
+	for _, input := range encodingTemplates {
+		t.Run(input, func(t *testing.T) {
+			r := require.New(t)
+			bytecode := compileForEncoding(t, input)
+
+			data, err := bytecode.MarshalBinary()
+			r.NoError(err)
+			decoded, err := UnmarshalBytecode(data)
+			r.NoError(err)
+			requireSameEncodedValue(t, "Bytecode", reflect.ValueOf(bytecode).Elem(), reflect.ValueOf(decoded).Elem())
+
+			again, err := decoded.MarshalBinary()
+			r.NoError(err)
+			r.Equal(data, again)
+		})
+	}
+}
+
+func Test_Bytecode_Encoding_Fresh_Runtime_State(t *testing.T) {
+	r := require.New(t)
+	bytecode := compileForEncoding(t, `<%= for (item) in items { %><%= item.Name %><% } %>`)
+	r.NotNil(bytecode.FastRenderPlan)
+	bytecode.OutputSizeStats.Observe(100)
+
+	data, err := bytecode.MarshalBinary()
+	r.NoError(err)
+	decoded, err := UnmarshalBytecode(data)
+	r.NoError(err)
+	r.Len(decoded.PropertyCaches, len(decoded.Instructions))
+	r.Len(decoded.CallCaches, len(decoded.Instructions))
+	r.NotNil(decoded.OutputSizeStats)
+	r.Zero(decoded.OutputSizeStats.Samples())
+	r.NotNil(decoded.LayoutSizeStats)
+	r.NotNil(decoded.PartialSizeStats)
+	r.NotNil(decoded.FastRenderPlan.Segments[0].Loop.SizeStats)
+}
+
+func Test_Bytecode_Encoding_Rejects_Engine_Version(t *testing.T) {
+	r := require.New(t)
+	data, err := compileForEncoding(t, `<%= name %>`).MarshalBinary()
+	r.NoError(err)
+
+	previous := EngineVersion
+	EngineVersion = "0-test"
+	defer func() { EngineVersion = previous }()
+
+	_, err = UnmarshalBytecode(data)
+	r.Error(err)
+	r.True(errors.Is(err, ErrEngineVersion))
+}
+
+func Test_Bytecode_Encoding_Errors(t *testing.T) {
+	r := require.New(t)
+	data, err := compileForEncoding(t, `<%= for (item) in items { %><%= item.Name %><% } %>`).MarshalBinary()
+	r.NoError(err)
+
+	_, err = UnmarshalBytecode([]byte("nonsense"))
+	r.Error(err)
+
+	for _, n := range []int{len(data) - 1, len(data) / 2, len(bytecodeMagic) + 3} {
+		_, err = UnmarshalBytecode(data[:n])
+		r.Error(err, "truncated to %d bytes", n)
+	}
+
+	_, err = UnmarshalBytecode(append(data[:len(data):len(data)], 0))
+	r.ErrorContains(err, "trailing bytes")
+
+	_, err = (&Bytecode{Constants: []object.Object{&object.Native{Value: 1}}}).MarshalBinary()
+	r.ErrorContains(err, "cannot encode native constant of type int")
+
+	_, err = (&Bytecode{Constants: []object.Object{&object.Closure{}}}).MarshalBinary()
+	r.ErrorContains(err, "cannot encode constant of type *object.Closure")
+}
+
+func Test_Engine_Version(t *testing.T) {
+	r := require.New(t)
+	r.Regexp(`^2-[0-9a-f]{16}$`, EngineVersion)
+	r.Equal(EngineVersion, engineVersion())
+}
+
+var (
+	atomicValueType   = reflect.TypeOf(atomic.Value{})
+	cacheSlotType     = reflect.TypeOf(object.InlineCacheSlot{})
+	outputStatsType   = reflect.TypeOf(&OutputSizeStats{})
+	loopStatsType     = reflect.TypeOf(&LoopSizeStats{})
+	layoutProfileType = reflect.TypeOf(LayoutOutputSizeProfile{})
+	blockType         = reflect.TypeOf(&ast.BlockStatement{})
+)
+
+// requireSameEncodedValue compares every field of want and got except
+// runtime state, which is not encoded. Walking the fields with reflection
+// makes this test fail when a field is added without being encoded.
+func requireSameEncodedValue(t *testing.T, path string, want, got reflect.Value) {
+	t.Helper()
+	switch want.Type() {
+	case atomicValueType, cacheSlotType, outputStatsType, loopStatsType, layoutProfileType, blockType:
+		return
+	}
+
+	switch want.Kind() {
+	case reflect.Ptr:
+		if want.IsNil() || got.IsNil() {
+			require.Equal(t, want.IsNil(), got.IsNil(), path)
+			return
+		}
+		requireSameEncodedValue(t, path, want.Elem(), got.Elem())
+	case reflect.Interface:
+		if want.IsNil() || got.IsNil() {
+			require.Equal(t, want.IsNil(), got.IsNil(), path)
+			return
+		}
+		require.Equal(t, want.Elem().Type(), got.Elem().Type(), path)
+		requireSameEncodedValue(t, path, want.Elem(), got.Elem())
+	case reflect.Struct:
+		for i := 0; i < want.NumField(); i++ {
+			requireSameEncodedValue(t, path+"."+want.Type().Field(i).Name, want.Field(i), got.Field(i))
+		}
+	case reflect.Slice:
+		if want.Type().Elem() == cacheSlotType {
+			return
+		}
+		require.Equal(t, want.Len(), got.Len(), path)
+		for i := 0; i < want.Len(); i++ {
+			requireSameEncodedValue(t, path, want.Index(i), got.Index(i))
+		}
+	case reflect.Map:
+		require.Equal(t, want.Len(), got.Len(), path)
+		for _, k := range want.MapKeys() {
+			requireSameEncodedValue(t, path, want.MapIndex(k), got.MapIndex(k))
+		}
+	default:
+		require.Equal(t, want.Interface(), got.Interface(), path)
+	}
+}
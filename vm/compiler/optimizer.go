@@ -16,8 +16,9 @@ func optimizeScope(
 	callNames map[int]string,
 	lineNumbers map[int]int,
 	properties map[int]object.PropertyAccess,
+	branches map[int]object.BranchPoint,
 	constants []object.Object,
-) (code.Instructions, map[int]string, map[int]int, map[int]object.PropertyAccess) {
+) (code.Instructions, map[int]string, map[int]int, map[int]object.PropertyAccess, map[int]object.BranchPoint) {
 	type instruction struct {
 		oldPos   int
 		op       code.Opcode
@@ -80,7 +81,7 @@ func optimizeScope(
 		}
 	}
 	if len(remove) == 0 && len(replace) == 0 {
-		return instructions, callNames, lineNumbers, properties
+		return instructions, callNames, lineNumbers, properties, branches
 	}
 
 	oldToNew := map[int]int{}
@@ -144,7 +145,8 @@ func optimizeScope(
 	return out,
 		remapStringMap(callNames, oldToNew, remove),
 		remapIntMap(lineNumbers, oldToNew, remove),
-		remapPropertyMap(properties, oldToNew, remove)
+		remapPropertyMap(properties, oldToNew, remove),
+		remapBranchMap(branches, oldToNew, remove)
 }
 
 func writeReplacement(op code.Opcode, operands []int, constants []object.Object) (code.Instructions, bool) {
@@ -239,3 +241,22 @@ func remapPropertyMap(input map[int]object.PropertyAccess, oldToNew map[int]int,
 	}
 	return out
 }
+
+func remapBranchMap(input map[int]object.BranchPoint, oldToNew map[int]int, remove map[int]bool) map[int]object.BranchPoint {
+	if len(input) == 0 {
+		return nil
+	}
+	out := map[int]object.BranchPoint{}
+	for pos, value := range input {
+		if remove[pos] {
+			continue
+		}
+		if newPos, ok := oldToNew[pos]; ok {
+			out[newPos] = value
+		}
+	}
+	if len(out) == 0 {
+		return nil
+	}
+	return out
+}
@@ -53,11 +53,12 @@ func Test_Optimize_Scope_Remaps_Jump_And_Metadata(t *testing.T) {
 	instructions = append(instructions, code.Make(code.OpWrite)...)
 	instructions = append(instructions, code.Make(code.OpPop)...)
 
-	optimized, callNames, lineNumbers, properties := optimizeScope(
+	optimized, callNames, lineNumbers, properties, branches := optimizeScope(
 		instructions,
 		map[int]string{0: "jump", 3: "constant", 6: "removed"},
 		map[int]int{0: 1, 3: 2, 6: 3},
 		map[int]object.PropertyAccess{3: {Receiver: "x", Full: "x.y"}},
+		map[int]object.BranchPoint{3: {Line: 2, Column: 4}, 6: {Line: 3}},
 		constants,
 	)
 
@@ -71,6 +72,7 @@ func Test_Optimize_Scope_Remaps_Jump_And_Metadata(t *testing.T) {
 	require.Equal(t, map[int]string{0: "jump", 3: "constant"}, callNames)
 	require.Equal(t, map[int]int{0: 1, 3: 2}, lineNumbers)
 	require.Equal(t, map[int]object.PropertyAccess{3: {Receiver: "x", Full: "x.y"}}, properties)
+	require.Equal(t, map[int]object.BranchPoint{3: {Line: 2, Column: 4}}, branches)
 }
 
 func Test_Optimize_Scope_Does_Not_Fuse_Shared_Branch_Write_Target(t *testing.T) {
@@ -86,25 +88,28 @@ func Test_Optimize_Scope_Does_Not_Fuse_Shared_Branch_Write_Target(t *testing.T)
 	instructions = append(instructions, code.Make(code.OpConstant, 1)...)
 	instructions = append(instructions, code.Make(code.OpWrite)...)
 
-	optimized, _, _, _ := optimizeScope(instructions, nil, nil, nil, constants)
+	optimized, _, _, _, _ := optimizeScope(instructions, nil, nil, nil, nil, constants)
 	require.Equal(t, instructions, optimized)
 }
 
 func Test_Optimize_Scope_Noop_And_Empty_Remaps(t *testing.T) {
 	instructions := code.Make(code.OpTrue)
-	optimized, callNames, lineNumbers, properties := optimizeScope(instructions, nil, nil, nil, nil)
+	optimized, callNames, lineNumbers, properties, branches := optimizeScope(instructions, nil, nil, nil, nil, nil)
 	require.Equal(t, code.Instructions(instructions), optimized)
 	require.Nil(t, callNames)
 	require.Nil(t, lineNumbers)
 	require.Nil(t, properties)
+	require.Nil(t, branches)
 
 	require.Nil(t, remapStringMap(nil, nil, nil))
 	require.Nil(t, remapIntMap(nil, nil, nil))
 	require.Nil(t, remapPropertyMap(nil, nil, nil))
+	require.Nil(t, remapBranchMap(nil, nil, nil))
 
 	require.Nil(t, remapStringMap(map[int]string{1: "removed"}, nil, map[int]bool{1: true}))
 	require.Nil(t, remapIntMap(map[int]int{1: 7}, nil, map[int]bool{1: true}))
 	require.Nil(t, remapPropertyMap(map[int]object.PropertyAccess{1: {Receiver: "x"}}, nil, map[int]bool{1: true}))
+	require.Nil(t, remapBranchMap(map[int]object.BranchPoint{1: {Line: 1}}, nil, map[int]bool{1: true}))
 }
 
 func Test_Optimize_Scope_Remaps_Unknown_Opcode_And_Out_Of_Range_Jump(t *testing.T) {
@@ -113,7 +118,7 @@ func Test_Optimize_Scope_Remaps_Unknown_Opcode_And_Out_Of_Range_Jump(t *testing.
 	instructions = append(instructions, code.Make(code.OpPop)...)
 	instructions = append(instructions, code.Make(code.OpJump, 999)...)
 
-	optimized, _, _, _ := optimizeScope(instructions, nil, nil, nil, nil)
+	optimized, _, _, _, _ := optimizeScope(instructions, nil, nil, nil, nil, nil)
 
 	require.Equal(t, byte(255), optimized[0])
 	require.True(t, instructionContainsOpcode(optimized, code.OpPop))
@@ -131,7 +136,7 @@ func Test_Optimize_Scope_Remaps_Jump_Target_Inside_Instruction(t *testing.T) {
 	instructions = append(instructions, code.Make(code.OpPop)...)
 	instructions = append(instructions, code.Make(code.OpJump, 4)...)
 
-	optimized, _, _, _ := optimizeScope(instructions, nil, nil, nil, nil)
+	optimized, _, _, _, _ := optimizeScope(instructions, nil, nil, nil, nil, nil)
 
 	def, err := code.Lookup(byte(code.OpJump))
 	require.NoError(t, err)
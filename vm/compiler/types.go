@@ -14,6 +14,7 @@ type Bytecode struct {
 	LocalNames        map[int]string
 	LineNumbers       map[int]int
 	Properties        map[int]object.PropertyAccess
+	Branches          map[int]object.BranchPoint
 	PropertyCaches    []object.InlineCacheSlot
 	CallCaches        []object.InlineCacheSlot
 	NumLocals         int
@@ -310,6 +311,7 @@ type CompilationScope struct {
 	localNames          map[int]string
 	lineNumbers         map[int]int
 	properties          map[int]object.PropertyAccess
+	branches            map[int]object.BranchPoint
 	numLocals           int
 	lastInstruction     EmittedInstruction
 	previousInstruction EmittedInstruction
@@ -0,0 +1,750 @@
+// Package gen compiles Plush templates ahead of time into Go source.
+//
+// The generated file exposes
+//
+//	func Render(w io.Writer, ctx hctx.Context) error
+//
+// which writes static HTML directly and reads the context values the
+// template was declared with through their Go types, inlining field
+// access. Output statements the generator can not type, such as helper
+// calls, are rendered by the VM one statement at a time. Templates that
+// keep state between statements, with `let`, assignments, functions,
+// helper blocks or punch holes, are rendered by the VM as a whole.
+//
+// When the context does not hold the declared types the template is
+// rendered by the VM, which reports errors exactly like vm.Render. Static
+// code that could panic, reading through a nil pointer or indexing out of
+// range, is guarded instead: a top level statement whose guard fails
+// drops its output and is rendered by the VM on its own.
+package gen
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	gotoken "go/token"
+	"go/types"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/gobuffalo/plush/v5/ast"
+	"github.com/gobuffalo/plush/v5/parser"
+	"github.com/gobuffalo/plush/v5/token"
+)
+
+// Config controls the generated code.
+type Config struct {
+	// Package is the package name of the generated file.
+	Package string
+	// Func is the name of the generated render function. It defaults to
+	// Render.
+	Func string
+	// File names the template in the generated comments.
+	File string
+	// Types declares the Go types of context values. Values that are not
+	// declared are only read by the VM.
+	Types map[string]types.Type
+}
+
+// Generate compiles the template input into a Go source file.
+func Generate(input string, cfg Config) ([]byte, error) {
+	if cfg.Package == "" {
+		return nil, fmt.Errorf("gen: no package name")
+	}
+	if cfg.Func == "" {
+		cfg.Func = "Render"
+	}
+	if !gotoken.IsIdentifier(cfg.Func) || !gotoken.IsExported(cfg.Func) {
+		return nil, fmt.Errorf("gen: %q is not an exported function name", cfg.Func)
+	}
+	program, err := parser.Parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	g := &generator{
+		cfg:     cfg,
+		src:     input,
+		prefix:  strings.ToLower(cfg.Func[:1]) + cfg.Func[1:],
+		imports: map[string]string{},
+		names:   map[string]bool{},
+	}
+	for _, name := range stdImports {
+		g.names[name] = true
+	}
+	g.names["hctx"] = true
+	g.names["vmplush"] = true
+	g.reason = Unsupported(input, program)
+	if g.reason == "" {
+		g.compile(program)
+	}
+
+	src := g.file()
+	out, err := format.Source(src)
+	if err != nil {
+		return nil, fmt.Errorf("gen: formatting generated code: %w\n%s", err, src)
+	}
+	return out, nil
+}
+
+// Unsupported returns why program, parsed from input, can only be
+// rendered by the VM as a whole, or "" when its statements can be
+// compiled one by one.
+func Unsupported(input string, program *ast.Program) string {
+	if strings.Contains(input, "<%-") {
+		return "it uses trim tags"
+	}
+	reason := ""
+	ast.Inspect(program, func(n ast.Node) bool {
+		if reason != "" {
+			return false
+		}
+		switch n := n.(type) {
+		case *ast.LetStatement:
+			reason = "it declares variables with let"
+		case *ast.AssignExpression:
+			reason = "it assigns variables"
+		case *ast.FunctionLiteral:
+			reason = "it defines functions"
+		case *ast.HoleStatement:
+			reason = "it has punch holes"
+		case *ast.ReturnStatement:
+			if n.Type != token.E_START {
+				reason = "it returns early"
+			}
+		case *ast.BreakExpression, *ast.ContinueExpression:
+			reason = "it uses break or continue"
+		case *ast.CallExpression:
+			if n.Block != nil {
+				reason = fmt.Sprintf("it calls %s with a block", n.Function)
+			}
+		}
+		return reason == ""
+	})
+	return reason
+}
+
+type generator struct {
+	cfg    Config
+	src    string
+	prefix string
+	// reason is why the template is rendered by the VM as a whole.
+	reason string
+
+	// imports maps the import paths of declared types to their names.
+	imports map[string]string
+	names   map[string]bool
+	uses    map[string]bool
+
+	// declared holds the declared variables read by the static code.
+	declared  []*variable
+	fragments []fragment
+	body      bytes.Buffer
+
+	// guards are the checks the expressions compiled since the last call
+	// to guard need before they are evaluated.
+	guards []string
+	// guarded reports whether the current top level statement has guards.
+	guarded bool
+}
+
+// fragment is a statement rendered by the VM.
+type fragment struct {
+	line   int
+	source string
+}
+
+// variable is a template variable with a static type.
+type variable struct {
+	name   string
+	goName string
+	typ    types.Type
+	used   bool
+	// nonNil reports whether the prologue checked the pointer is not nil.
+	nonNil bool
+}
+
+type scope struct {
+	parent *scope
+	vars   map[string]*variable
+}
+
+func (s *scope) lookup(name string) *variable {
+	for ; s != nil; s = s.parent {
+		if v, ok := s.vars[name]; ok {
+			return v
+		}
+	}
+	return nil
+}
+
+func (g *generator) use(pkg string) {
+	if g.uses == nil {
+		g.uses = map[string]bool{}
+	}
+	g.uses[pkg] = true
+}
+
+// compile writes the static code of program to g.body.
+func (g *generator) compile(program *ast.Program) {
+	root := &scope{vars: map[string]*variable{}}
+	names := make([]string, 0, len(g.cfg.Types))
+	for name := range g.cfg.Types {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if goName, ok := goIdent(name); ok && g.cfg.Types[name] != nil {
+			v := &variable{name: name, goName: goName, typ: g.cfg.Types[name]}
+			_, v.nonNil = v.typ.(*types.Pointer)
+			root.vars[name] = v
+			g.declared = append(g.declared, v)
+		}
+	}
+
+	for _, s := range program.Statements {
+		used := make([]bool, len(g.declared))
+		for i, v := range g.declared {
+			used[i] = v.used
+		}
+		var w bytes.Buffer
+		g.guards, g.guarded = nil, false
+		if g.statement(&w, s, root) {
+			if !g.guarded {
+				g.body.Write(w.Bytes())
+				continue
+			}
+			fmt.Fprintf(&g.body, "if mark := b.Len(); !func() bool {\n%sreturn true\n}() {\nb.Truncate(mark)\n%s}\n", w.Bytes(), g.fragment(s))
+			continue
+		}
+		// Variables are only read when a statement compiles.
+		for i, v := range g.declared {
+			v.used = used[i]
+		}
+		g.body.WriteString(g.fragment(s))
+	}
+}
+
+// guard writes the pending guards to w. The static code of a statement
+// returns false when one fails.
+func (g *generator) guard(w *bytes.Buffer) {
+	if len(g.guards) == 0 {
+		return
+	}
+	var conds []string
+	seen := map[string]bool{}
+	for _, c := range g.guards {
+		if !seen[c] {
+			seen[c] = true
+			conds = append(conds, c)
+		}
+	}
+	fmt.Fprintf(w, "if !(%s) {\nreturn false\n}\n", strings.Join(conds, " && "))
+	g.guards = g.guards[:0]
+	g.guarded = true
+}
+
+// fragment compiles the top level statement s for the VM and returns the
+// code rendering it, or "" when s renders nothing.
+func (g *generator) fragment(s ast.Statement) string {
+	var src string
+	var start int
+	switch s := s.(type) {
+	case *ast.ReturnStatement:
+		if s.ReturnValue == nil {
+			return ""
+		}
+		start = ast.SpanOf(s.ReturnValue).Start
+		src = "<%= " + g.src[start:ast.SpanOf(s.ReturnValue).Stop] + " %>"
+	case *ast.ExpressionStatement:
+		if s.Expression == nil {
+			return ""
+		}
+		start = ast.SpanOf(s.Expression).Start
+		src = "<% " + g.src[start:ast.SpanOf(s.Expression).Stop] + " %>"
+	default:
+		return ""
+	}
+
+	line := 1 + strings.Count(g.src[:start], "\n")
+	g.fragments = append(g.fragments, fragment{line: line, source: src})
+	g.use("sync")
+	return fmt.Sprintf("if t, err := %s(); err != nil {\nreturn \"\", true, err\n} else if s, err := t.Render(ctx); err != nil {\nreturn \"\", true, err\n} else {\nb.WriteString(s)\n}\n", g.fragmentName(len(g.fragments)-1))
+}
+
+func (g *generator) fragmentName(i int) string {
+	return fmt.Sprintf("%sFragment%d", g.prefix, i)
+}
+
+// statement compiles s to w and reports whether it could.
+func (g *generator) statement(w *bytes.Buffer, s ast.Statement, sc *scope) bool {
+	switch s := s.(type) {
+	case *ast.ExpressionStatement:
+		if h, ok := s.Expression.(*ast.HTMLLiteral); ok {
+			if h.Value != "" {
+				fmt.Fprintf(w, "b.WriteString(%s)\n", strconv.Quote(h.Value))
+			}
+			return true
+		}
+		// The parser reads a <%# comment %> as an empty string ending
+		// on its %>. It renders nothing.
+		if l, ok := s.Expression.(*ast.StringLiteral); ok && l.Token.Type == token.E_END && l.Value == "" {
+			return true
+		}
+		return false
+	case *ast.ReturnStatement:
+		if s.Type != token.E_START || s.ReturnValue == nil {
+			return false
+		}
+		switch e := s.ReturnValue.(type) {
+		case *ast.IfExpression:
+			return g.ifExpression(w, e, sc)
+		case *ast.ForExpression:
+			return g.forExpression(w, e, sc)
+		}
+		return g.write(w, s.ReturnValue, sc)
+	}
+	return false
+}
+
+func (g *generator) block(w *bytes.Buffer, b *ast.BlockStatement, sc *scope) bool {
+	if b == nil {
+		return true
+	}
+	for _, s := range b.Statements {
+		if !g.statement(w, s, sc) {
+			return false
+		}
+	}
+	return true
+}
+
+func (g *generator) ifExpression(w *bytes.Buffer, e *ast.IfExpression, sc *scope) bool {
+	// The guards of every condition are checked before the first one.
+	var guards, out bytes.Buffer
+	cond, ok := g.condition(e.Condition, sc)
+	if !ok {
+		return false
+	}
+	g.guard(&guards)
+	fmt.Fprintf(&out, "if %s {\n", cond)
+	if !g.block(&out, e.Block, &scope{parent: sc}) {
+		return false
+	}
+	for _, ei := range e.ElseIf {
+		cond, ok := g.condition(ei.Condition, sc)
+		if !ok {
+			return false
+		}
+		g.guard(&guards)
+		fmt.Fprintf(&out, "} else if %s {\n", cond)
+		if !g.block(&out, ei.Block, &scope{parent: sc}) {
+			return false
+		}
+	}
+	if e.ElseBlock != nil {
+		out.WriteString("} else {\n")
+		if !g.block(&out, e.ElseBlock, &scope{parent: sc}) {
+			return false
+		}
+	}
+	out.WriteString("}\n")
+	w.Write(guards.Bytes())
+	w.Write(out.Bytes())
+	return true
+}
+
+func (g *generator) condition(e ast.Expression, sc *scope) (string, bool) {
+	src, t, ok := g.expr(e, sc)
+	if !ok {
+		return "", false
+	}
+	if b, ok := basic(t); !ok || b.Kind() != types.Bool {
+		return "", false
+	}
+	return src, true
+}
+
+func (g *generator) forExpression(w *bytes.Buffer, e *ast.ForExpression, sc *scope) bool {
+	iter, t, ok := g.expr(e.Iterable, sc)
+	if !ok {
+		return false
+	}
+	var elem types.Type
+	switch u := t.(type) {
+	case *types.Slice:
+		elem = u.Elem()
+	case *types.Array:
+		elem = u.Elem()
+	default:
+		return false
+	}
+
+	inner := &scope{parent: sc, vars: map[string]*variable{}}
+	var key, value *variable
+	if e.KeyName != "" && e.KeyName != "_" {
+		if key = g.local(e.KeyName, types.Typ[types.Int]); key == nil {
+			return false
+		}
+		inner.vars[e.KeyName] = key
+	}
+	if e.ValueName != "" && e.ValueName != "_" {
+		if value = g.local(e.ValueName, elem); value == nil {
+			return false
+		}
+		inner.vars[e.ValueName] = value
+	}
+
+	g.guard(w)
+	var body bytes.Buffer
+	if !g.block(&body, e.Block, inner) {
+		return false
+	}
+	switch {
+	case value != nil && value.used:
+		fmt.Fprintf(w, "for %s, %s := range %s {\n", usedName(key), value.goName, iter)
+	case key != nil && key.used:
+		fmt.Fprintf(w, "for %s := range %s {\n", key.goName, iter)
+	default:
+		fmt.Fprintf(w, "for range %s {\n", iter)
+	}
+	w.Write(body.Bytes())
+	w.WriteString("}\n")
+	return true
+}
+
+func (g *generator) local(name string, t types.Type) *variable {
+	goName, ok := goIdent(name)
+	if !ok {
+		return nil
+	}
+	return &variable{name: name, goName: goName, typ: t}
+}
+
+func usedName(v *variable) string {
+	if v == nil || !v.used {
+		return "_"
+	}
+	return v.goName
+}
+
+// goIdent returns the Go identifier holding the template variable name.
+// Template names are prefixed so they never clash with the generated
+// code or Go keywords.
+func goIdent(name string) (string, bool) {
+	if name == "" {
+		return "", false
+	}
+	for i, r := range name {
+		if r != '_' && !unicode.IsLetter(r) && (i == 0 || !unicode.IsDigit(r)) {
+			return "", false
+		}
+	}
+	return "v_" + name, true
+}
+
+// write compiles the output of the expression e.
+func (g *generator) write(w *bytes.Buffer, e ast.Expression, sc *scope) bool {
+	src, t, ok := g.expr(e, sc)
+	if !ok {
+		return false
+	}
+	g.guard(w)
+	if isHTML(t) {
+		fmt.Fprintf(w, "b.WriteString(string(%s))\n", src)
+		return true
+	}
+	b, ok := basic(t)
+	if !ok {
+		return false
+	}
+	switch {
+	case b.Kind() == types.String:
+		g.use("html/template")
+		fmt.Fprintf(w, "b.WriteString(template.HTMLEscapeString(%s))\n", src)
+	case b.Kind() == types.Bool:
+		fmt.Fprintf(w, "b.WriteString(strconv.FormatBool(%s))\n", src)
+	case b.Kind() == types.Int:
+		fmt.Fprintf(w, "b.WriteString(strconv.Itoa(%s))\n", src)
+	case b.Info()&types.IsInteger != 0 && b.Info()&types.IsUnsigned != 0:
+		fmt.Fprintf(w, "b.WriteString(strconv.FormatUint(uint64(%s), 10))\n", src)
+	case b.Info()&types.IsInteger != 0:
+		fmt.Fprintf(w, "b.WriteString(strconv.FormatInt(int64(%s), 10))\n", src)
+	case b.Info()&types.IsFloat != 0:
+		g.use("fmt")
+		fmt.Fprintf(w, "b.WriteString(fmt.Sprint(%s))\n", src)
+	default:
+		return false
+	}
+	if b.Kind() != types.String {
+		g.use("strconv")
+	}
+	return true
+}
+
+// expr compiles e into a Go expression of a known type.
+func (g *generator) expr(e ast.Expression, sc *scope) (string, types.Type, bool) {
+	switch e := e.(type) {
+	case *ast.Identifier:
+		if e.Callee == nil {
+			v := sc.lookup(e.Value)
+			if v == nil {
+				return "", nil, false
+			}
+			v.used = true
+			return v.goName, v.typ, true
+		}
+		recv, t, ok := g.expr(e.Callee, sc)
+		if !ok {
+			return "", nil, false
+		}
+		ft, ok := field(t, e.Value)
+		if !ok {
+			return "", nil, false
+		}
+		if _, ok := t.(*types.Pointer); ok && !checked(e.Callee, sc) {
+			g.guards = append(g.guards, recv+" != nil")
+		}
+		return recv + "." + e.Value, ft, true
+	case *ast.StringLiteral:
+		return strconv.Quote(e.Value), types.Typ[types.String], true
+	case *ast.IntegerLiteral:
+		return strconv.Itoa(e.Value), types.Typ[types.Int], true
+	case *ast.FloatLiteral:
+		return "float64(" + strconv.FormatFloat(e.Value, 'g', -1, 64) + ")", types.Typ[types.Float64], true
+	case *ast.Boolean:
+		return strconv.FormatBool(e.Value), types.Typ[types.Bool], true
+	case *ast.PrefixExpression:
+		return g.prefixExpression(e, sc)
+	case *ast.InfixExpression:
+		return g.infixExpression(e, sc)
+	case *ast.IndexExpression:
+		left, t, ok := g.expr(e.Left, sc)
+		if !ok {
+			return "", nil, false
+		}
+		index, it, ok := g.expr(e.Index, sc)
+		if b, isBasic := basic(it); !ok || !isBasic || b.Kind() != types.Int {
+			return "", nil, false
+		}
+		var elem types.Type
+		length := int64(-1)
+		switch u := t.(type) {
+		case *types.Slice:
+			elem = u.Elem()
+		case *types.Array:
+			elem, length = u.Elem(), u.Len()
+		default:
+			return "", nil, false
+		}
+		// Go rejects constant indexes out of range at compile time, so
+		// only literal ones known to be in range are kept.
+		lit, isLit := e.Index.(*ast.IntegerLiteral)
+		switch {
+		case isLit && (lit.Value < 0 || length >= 0 && int64(lit.Value) >= length):
+			return "", nil, false
+		case isLit && length >= 0:
+		case isLit:
+			g.guards = append(g.guards, index+" < len("+left+")")
+		case constant(e.Index):
+			return "", nil, false
+		default:
+			g.guards = append(g.guards, index+" >= 0 && "+index+" < len("+left+")")
+		}
+		return left + "[" + index + "]", elem, true
+	}
+	return "", nil, false
+}
+
+// checked reports whether e is a declared pointer the prologue checked.
+func checked(e ast.Expression, sc *scope) bool {
+	id, ok := e.(*ast.Identifier)
+	if !ok || id.Callee != nil {
+		return false
+	}
+	v := sc.lookup(id.Value)
+	return v != nil && v.nonNil
+}
+
+// constant reports whether e reads no variables, so Go evaluates it at
+// compile time.
+func constant(e ast.Expression) bool {
+	c := true
+	ast.Inspect(e, func(n ast.Node) bool {
+		if _, ok := n.(*ast.Identifier); ok {
+			c = false
+		}
+		return c
+	})
+	return c
+}
+
+func (g *generator) prefixExpression(e *ast.PrefixExpression, sc *scope) (string, types.Type, bool) {
+	right, t, ok := g.expr(e.Right, sc)
+	if !ok {
+		return "", nil, false
+	}
+	b, ok := basic(t)
+	if !ok {
+		return "", nil, false
+	}
+	switch {
+	case e.Operator == "!" && b.Kind() == types.Bool:
+		return "!(" + right + ")", t, true
+	case e.Operator == "-" && b.Info()&(types.IsInteger|types.IsFloat) != 0 && b.Info()&types.IsUnsigned == 0:
+		return "-(" + right + ")", t, true
+	}
+	return "", nil, false
+}
+
+func (g *generator) infixExpression(e *ast.InfixExpression, sc *scope) (string, types.Type, bool) {
+	left, lt, ok := g.expr(e.Left, sc)
+	if !ok {
+		return "", nil, false
+	}
+	right, rt, ok := g.expr(e.Right, sc)
+	if !ok || !identical(lt, rt) {
+		return "", nil, false
+	}
+	b, ok := basic(lt)
+	if !ok {
+		return "", nil, false
+	}
+	src := "(" + left + " " + e.Operator + " " + right + ")"
+	numeric := b.Info()&(types.IsInteger|types.IsFloat) != 0
+
+	switch e.Operator {
+	case "+":
+		if numeric || b.Kind() == types.String {
+			return src, lt, true
+		}
+	case "-", "*":
+		if numeric {
+			return src, lt, true
+		}
+	case "==", "!=":
+		return src, types.Typ[types.Bool], true
+	case "<", ">", "<=", ">=":
+		if numeric || b.Kind() == types.String {
+			return src, types.Typ[types.Bool], true
+		}
+	case "&&", "||":
+		if b.Kind() == types.Bool {
+			return src, lt, true
+		}
+	}
+	return "", nil, false
+}
+
+// stdImports are the packages the generated code may import itself.
+var stdImports = map[string]string{
+	"bytes":         "bytes",
+	"fmt":           "fmt",
+	"html/template": "template",
+	"io":            "io",
+	"strconv":       "strconv",
+	"strings":       "strings",
+	"sync":          "sync",
+}
+
+// qualifier names the packages of declared types in the generated code,
+// importing them as needed.
+func (g *generator) qualifier(pkg *types.Package) string {
+	if name, ok := stdImports[pkg.Path()]; ok {
+		g.use(pkg.Path())
+		return name
+	}
+	if name, ok := g.imports[pkg.Path()]; ok {
+		return name
+	}
+	name := pkg.Name()
+	for i := 2; g.names[name]; i++ {
+		name = fmt.Sprintf("%s%d", pkg.Name(), i)
+	}
+	g.names[name] = true
+	g.imports[pkg.Path()] = name
+	return name
+}
+
+// file assembles the generated source.
+func (g *generator) file() []byte {
+	var buf bytes.Buffer
+	from := ""
+	if g.cfg.File != "" {
+		from = " from " + g.cfg.File
+	}
+	fmt.Fprintf(&buf, "// Code generated by plush gen%s. DO NOT EDIT.\n\npackage %s\n\n", from, g.cfg.Package)
+
+	// Type names are resolved before the imports are written.
+	var prologue bytes.Buffer
+	for _, v := range g.declared {
+		if !v.used {
+			continue
+		}
+		check := "!ok"
+		if v.nonNil {
+			check += " || " + v.goName + " == nil"
+		}
+		fmt.Fprintf(&prologue, "%s, ok := ctx.Value(%s).(%s)\nif %s {\nreturn \"\", false, nil\n}\n", v.goName, strconv.Quote(v.name), types.TypeString(v.typ, g.qualifier), check)
+	}
+
+	std := []string{"io"}
+	if g.reason == "" {
+		std = append(std, "bytes")
+		for _, p := range []string{"fmt", "html/template", "strconv", "sync"} {
+			if g.uses[p] {
+				std = append(std, p)
+			}
+		}
+	}
+	sort.Strings(std)
+	buf.WriteString("import (\n")
+	for _, p := range std {
+		fmt.Fprintf(&buf, "%q\n", p)
+	}
+	buf.WriteString("\n\"github.com/gobuffalo/plush/v5/helpers/hctx\"\nvmplush \"github.com/gobuffalo/plush/v5/vm/plush\"\n")
+	if len(g.imports) > 0 {
+		buf.WriteString("\n")
+		paths := make([]string, 0, len(g.imports))
+		for p := range g.imports {
+			paths = append(paths, p)
+		}
+		sort.Strings(paths)
+		for _, p := range paths {
+			fmt.Fprintf(&buf, "%s %q\n", g.imports[p], p)
+		}
+	}
+	buf.WriteString(")\n\n")
+
+	name := g.cfg.Func
+	source := g.prefix + "Source"
+	subject := "the template"
+	if g.cfg.File != "" {
+		subject = g.cfg.File
+	}
+	fmt.Fprintf(&buf, "// %s is the template %s was generated from.\nconst %s = %s\n\n", source, name, source, strconv.Quote(g.src))
+
+	if g.reason != "" {
+		fmt.Fprintf(&buf, "// %s renders %s to w.\n//\n// The template is rendered by the VM: %s.\n", name, subject, g.reason)
+		fmt.Fprintf(&buf, "func %s(w io.Writer, ctx hctx.Context) error {\nout, err := vmplush.Render(%s, ctx)\nif err != nil {\nreturn err\n}\n_, err = io.WriteString(w, out)\nreturn err\n}\n", name, source)
+		return buf.Bytes()
+	}
+
+	for i, f := range g.fragments {
+		fmt.Fprintf(&buf, "// %s compiles the statement on line %d for the VM.\nvar %s = sync.OnceValues(func() (*vmplush.Template, error) {\nreturn vmplush.CompileAt(%s, %d)\n})\n\n", g.fragmentName(i), f.line, g.fragmentName(i), strconv.Quote(f.source), f.line)
+	}
+
+	static := g.prefix + "Static"
+	fmt.Fprintf(&buf, "// %s renders %s to w.\nfunc %s(w io.Writer, ctx hctx.Context) error {\n", name, subject, name)
+	fmt.Fprintf(&buf, "out, ok, err := %s(ctx)\nif !ok {\nout, err = vmplush.Render(%s, ctx)\n}\nif err != nil {\nreturn err\n}\n_, err = io.WriteString(w, out)\nreturn err\n}\n\n", static, source)
+
+	fmt.Fprintf(&buf, "// %s renders the template with the declared types. It reports false\n// when the context does not hold them or holds nil pointers.\n", static)
+	fmt.Fprintf(&buf, "func %s(ctx hctx.Context) (string, bool, error) {\n", static)
+	buf.Write(prologue.Bytes())
+	buf.WriteString("var b bytes.Buffer\n")
+	buf.Write(g.body.Bytes())
+	buf.WriteString("return b.String(), true, nil\n}\n")
+	return buf.Bytes()
+}
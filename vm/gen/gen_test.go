@@ -0,0 +1,293 @@
+package gen_test
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"go/types"
+	"html/template"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/gobuffalo/plush/v5"
+	"github.com/gobuffalo/plush/v5/helpers/hctx"
+	"github.com/gobuffalo/plush/v5/vm/gen"
+	"github.com/gobuffalo/plush/v5/vm/gen/internal/fixtures"
+	"github.com/gobuffalo/plush/v5/vm/gen/internal/golden"
+	vmplush "github.com/gobuffalo/plush/v5/vm/plush"
+	"github.com/stretchr/testify/require"
+)
+
+var update = flag.Bool("update", false, "rewrite the generated files in internal/golden")
+
+const fixturesPath = "github.com/gobuffalo/plush/v5/vm/gen/internal/fixtures"
+
+// corpusTypes declares the context values of every template in testdata.
+var corpusTypes = map[string]string{
+	"user": "*" + fixturesPath + ".User",
+}
+
+var (
+	loaderOnce sync.Once
+	loader     *gen.TypeLoader
+)
+
+func loadTypes(t *testing.T, exprs map[string]string) map[string]types.Type {
+	t.Helper()
+	loaderOnce.Do(func() { loader = gen.NewTypeLoader("") })
+	decl := map[string]types.Type{}
+	for name, expr := range exprs {
+		typ, err := loader.Load(expr)
+		require.NoError(t, err)
+		decl[name] = typ
+	}
+	return decl
+}
+
+// corpus returns the template files in testdata and the name of the
+// function generated for each.
+func corpus(t *testing.T) map[string]string {
+	t.Helper()
+	files, err := filepath.Glob(filepath.Join("testdata", "*.plush.html"))
+	require.NoError(t, err)
+	require.NotEmpty(t, files)
+
+	funcs := map[string]string{}
+	for _, f := range files {
+		name := strings.TrimSuffix(filepath.Base(f), ".plush.html")
+		funcs[f] = "Render" + strings.ToUpper(name[:1]) + name[1:]
+	}
+	return funcs
+}
+
+func Test_Generate_Golden(t *testing.T) {
+	r := require.New(t)
+	decl := loadTypes(t, corpusTypes)
+
+	funcs := corpus(t)
+	files := make([]string, 0, len(funcs))
+	for f := range funcs {
+		files = append(files, f)
+	}
+	sort.Strings(files)
+
+	registry := &bytes.Buffer{}
+	registry.WriteString("// Code generated by the gen tests with -update. DO NOT EDIT.\n\npackage golden\n\nimport (\n\"io\"\n\n\"github.com/gobuffalo/plush/v5/helpers/hctx\"\n)\n\n")
+	registry.WriteString("// Templates maps the files in vm/gen/testdata to their generated render function.\nvar Templates = map[string]func(io.Writer, hctx.Context) error{\n")
+
+	for _, f := range files {
+		src, err := os.ReadFile(f)
+		r.NoError(err)
+		out, err := gen.Generate(string(src), gen.Config{
+			Package: "golden",
+			Func:    funcs[f],
+			File:    filepath.ToSlash(f),
+			Types:   decl,
+		})
+		r.NoError(err)
+
+		goldenFile := filepath.Join("internal", "golden", strings.TrimSuffix(filepath.Base(f), ".plush.html")+".go")
+		requireGolden(t, goldenFile, out)
+		fmt.Fprintf(registry, "%q: %s,\n", filepath.Base(f), funcs[f])
+	}
+	registry.WriteString("}\n")
+
+	out, err := format.Source(registry.Bytes())
+	r.NoError(err)
+	requireGolden(t, filepath.Join("internal", "golden", "templates.go"), out)
+}
+
+func requireGolden(t *testing.T, path string, got []byte) {
+	t.Helper()
+	if *update {
+		require.NoError(t, os.WriteFile(path, got, 0o644))
+		return
+	}
+	want, err := os.ReadFile(path)
+	require.NoError(t, err, "run go test ./vm/gen -update to create it")
+	require.Equal(t, string(want), string(got), "%s is stale; run go test ./vm/gen -update", path)
+}
+
+// corpusContexts are the contexts every template is rendered with.
+var corpusContexts = map[string]func() hctx.Context{
+	"full": func() hctx.Context {
+		boss := &fixtures.User{Name: "Ada", Address: fixtures.Address{City: "London", Country: "UK"}}
+		return corpusContext(&fixtures.User{
+			Name:    "Mark <m>",
+			Email:   `mark&co@example.com`,
+			Age:     42,
+			Score:   9.75,
+			Admin:   true,
+			Bio:     template.HTML("<em>Go</em> developer"),
+			Tags:    []string{"go", "plush", `"quoted"`},
+			Friends: []*fixtures.User{boss, {Name: "Rob", Address: fixtures.Address{City: "Sydney"}}},
+			Manager: boss,
+			Address: fixtures.Address{City: "Paris", Country: "France"},
+			Visits:  7,
+		})
+	},
+	"minor": func() hctx.Context {
+		return corpusContext(&fixtures.User{
+			Name:    "Kid",
+			Age:     12,
+			Tags:    []string{"a"},
+			Manager: &fixtures.User{Name: "Parent"},
+		})
+	},
+	"nil manager": func() hctx.Context {
+		return corpusContext(&fixtures.User{Name: "Solo", Tags: []string{"x"}})
+	},
+	"nil friend": func() hctx.Context {
+		return corpusContext(&fixtures.User{Name: "Host", Friends: []*fixtures.User{{Name: "Guest"}, nil}, Manager: &fixtures.User{}})
+	},
+	"no tags": func() hctx.Context {
+		return corpusContext(&fixtures.User{Name: "Empty", Manager: &fixtures.User{}})
+	},
+	"undeclared type": func() hctx.Context {
+		return corpusContext(map[string]interface{}{"Name": "map"})
+	},
+	"missing": func() hctx.Context {
+		return corpusContext(nil)
+	},
+}
+
+func corpusContext(user interface{}) hctx.Context {
+	ctx := plush.NewContext()
+	if user != nil {
+		ctx.Set("user", user)
+	}
+	ctx.Set("title", "Profile")
+	ctx.Set("partialFeeder", func(name string) (string, error) {
+		return `<div class="card"><%= name %></div>`, nil
+	})
+	calls := 0
+	ctx.Set("count", func() int {
+		calls++
+		return calls
+	})
+	return ctx
+}
+
+func Test_Generated_Matches_VM(t *testing.T) {
+	for file := range corpus(t) {
+		src, err := os.ReadFile(file)
+		require.NoError(t, err)
+		render, ok := golden.Templates[filepath.Base(file)]
+		require.True(t, ok, "%s has no generated function; run go test ./vm/gen -update", file)
+
+		for name, ctx := range corpusContexts {
+			t.Run(filepath.Base(file)+"/"+name, func(t *testing.T) {
+				r := require.New(t)
+				want, wantErr := vmplush.Render(string(src), ctx())
+
+				var got bytes.Buffer
+				err := render(&got, ctx())
+				if wantErr != nil {
+					r.Error(err)
+					r.Equal(wantErr.Error(), err.Error())
+					return
+				}
+				r.NoError(err)
+				r.Equal(want, got.String())
+			})
+		}
+	}
+}
+
+func Test_Generate_Static_Code(t *testing.T) {
+	r := require.New(t)
+	decl := loadTypes(t, corpusTypes)
+
+	out, err := gen.Generate(`<h1><%= user.Name %></h1><%= for (f) in user.Friends { %><%= f.Address.City %><% } %>`, gen.Config{Package: "views", Types: decl})
+	r.NoError(err)
+	src := string(out)
+	r.Contains(src, "package views")
+	r.Contains(src, "func Render(w io.Writer, ctx hctx.Context) error {")
+	r.Contains(src, `v_user, ok := ctx.Value("user").(*fixtures.User)`)
+	r.Contains(src, `b.WriteString("<h1>")`)
+	r.Contains(src, "b.WriteString(template.HTMLEscapeString(v_user.Name))")
+	r.Contains(src, "for _, v_f := range v_user.Friends {")
+	r.Contains(src, "b.WriteString(template.HTMLEscapeString(v_f.Address.City))")
+	r.Contains(src, `fixtures "`+fixturesPath+`"`)
+	r.Contains(src, "if !ok || v_user == nil {")
+	r.Contains(src, "if !(v_f != nil) {\n\t\t\t\treturn false\n\t\t\t}")
+	r.Contains(src, `return vmplush.CompileAt("<%= for (f) in user.Friends { %><%= f.Address.City %><% } %>", 1)`)
+	r.NotContains(src, "recover()")
+
+	out, err = gen.Generate(`<%= user.Name %><%= user.Tags[0] %><%= user.Tags[-1] %>`, gen.Config{Package: "views", Types: decl})
+	r.NoError(err)
+	src = string(out)
+	r.Contains(src, "b.WriteString(template.HTMLEscapeString(v_user.Name))\n\tif mark")
+	r.Contains(src, "if !(0 < len(v_user.Tags)) {")
+	r.Contains(src, `return vmplush.CompileAt("<%= user.Tags[-1] %>", 1)`)
+}
+
+func Test_Generated_Falls_Back_Per_Statement(t *testing.T) {
+	r := require.New(t)
+
+	calls := 0
+	ctx := corpusContext(&fixtures.User{Name: "Host", Friends: []*fixtures.User{nil}})
+	ctx.Set("count", func() int {
+		calls++
+		return calls
+	})
+	var out bytes.Buffer
+	r.NoError(golden.RenderEffects(&out, ctx))
+	r.Equal(1, calls)
+	r.Contains(out.String(), "<p>1 visits</p>")
+}
+
+func Test_Generate_Falls_Back(t *testing.T) {
+	r := require.New(t)
+	decl := loadTypes(t, corpusTypes)
+
+	out, err := gen.Generate("<p>\n<%= upcase(user.Name) %></p>", gen.Config{Package: "views", Types: decl})
+	r.NoError(err)
+	r.Contains(string(out), `return vmplush.CompileAt("<%= upcase(user.Name) %>", 2)`)
+	r.NotContains(string(out), "fixtures")
+
+	out, err = gen.Generate(`<% let x = 1 %><%= x %>`, gen.Config{Package: "views", Func: "Page"})
+	r.NoError(err)
+	r.Contains(string(out), "// The template is rendered by the VM: it declares variables with let.")
+	r.Contains(string(out), "out, err := vmplush.Render(pageSource, ctx)")
+}
+
+func Test_Generate_Errors(t *testing.T) {
+	r := require.New(t)
+
+	_, err := gen.Generate("<%= if { %>", gen.Config{Package: "views"})
+	r.Error(err)
+
+	_, err = gen.Generate("x", gen.Config{})
+	r.Error(err)
+
+	_, err = gen.Generate("x", gen.Config{Package: "views", Func: "render"})
+	r.Error(err)
+}
+
+func Test_TypeLoader(t *testing.T) {
+	r := require.New(t)
+	loaderOnce.Do(func() { loader = gen.NewTypeLoader("") })
+
+	for expr, want := range map[string]string{
+		"string":                       "string",
+		"[]int":                        "[]int",
+		"html/template.HTML":           "html/template.HTML",
+		"[]*" + fixturesPath + ".User": "[]*" + fixturesPath + ".User",
+		fixturesPath + ".Address":      fixturesPath + ".Address",
+	} {
+		typ, err := loader.Load(expr)
+		r.NoError(err, expr)
+		r.Equal(want, typ.String())
+	}
+
+	for _, expr := range []string{"", "User", fixturesPath + ".Missing", fixturesPath + ".user"} {
+		_, err := loader.Load(expr)
+		r.Error(err, expr)
+	}
+}
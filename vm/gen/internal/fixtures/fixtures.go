@@ -0,0 +1,31 @@
+// Package fixtures holds the types the generator tests declare templates
+// with.
+package fixtures
+
+import "html/template"
+
+type User struct {
+	Name    string
+	Email   string
+	Age     int
+	Score   float64
+	Admin   bool
+	Bio     template.HTML
+	Tags    []string
+	Friends []*User
+	Manager *User
+	Address Address
+	Visits  uint
+
+	password string
+}
+
+type Address struct {
+	City    string
+	Country string
+}
+
+// Greeting is a method, which the generator does not inline.
+func (u User) Greeting() string {
+	return "Hello, " + u.Name
+}
@@ -0,0 +1,25 @@
+// Code generated by plush gen from testdata/content.plush.html. DO NOT EDIT.
+
+package golden
+
+import (
+	"io"
+
+	"github.com/gobuffalo/plush/v5/helpers/hctx"
+	vmplush "github.com/gobuffalo/plush/v5/vm/plush"
+)
+
+// renderContentSource is the template RenderContent was generated from.
+const renderContentSource = "<% contentFor(\"title\") { %><%= user.Name %>'s page<% } %>\n<title><%= contentOf(\"title\") %></title>\n"
+
+// RenderContent renders testdata/content.plush.html to w.
+//
+// The template is rendered by the VM: it calls contentFor with a block.
+func RenderContent(w io.Writer, ctx hctx.Context) error {
+	out, err := vmplush.Render(renderContentSource, ctx)
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
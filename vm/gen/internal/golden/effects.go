@@ -0,0 +1,103 @@
+// Code generated by plush gen from testdata/effects.plush.html. DO NOT EDIT.
+
+package golden
+
+import (
+	"bytes"
+	"html/template"
+	"io"
+	"sync"
+
+	"github.com/gobuffalo/plush/v5/helpers/hctx"
+	vmplush "github.com/gobuffalo/plush/v5/vm/plush"
+
+	fixtures "github.com/gobuffalo/plush/v5/vm/gen/internal/fixtures"
+)
+
+// renderEffectsSource is the template RenderEffects was generated from.
+const renderEffectsSource = "<p><%= count() %> visits</p>\n<p>Reports to <%= user.Manager.Name %></p>\n<%= for (friend) in user.Friends { %><%= friend.Name %> <% } %>\n"
+
+// renderEffectsFragment0 compiles the statement on line 1 for the VM.
+var renderEffectsFragment0 = sync.OnceValues(func() (*vmplush.Template, error) {
+	return vmplush.CompileAt("<%= count() %>", 1)
+})
+
+// renderEffectsFragment1 compiles the statement on line 2 for the VM.
+var renderEffectsFragment1 = sync.OnceValues(func() (*vmplush.Template, error) {
+	return vmplush.CompileAt("<%= user.Manager.Name %>", 2)
+})
+
+// renderEffectsFragment2 compiles the statement on line 3 for the VM.
+var renderEffectsFragment2 = sync.OnceValues(func() (*vmplush.Template, error) {
+	return vmplush.CompileAt("<%= for (friend) in user.Friends { %><%= friend.Name %> <% } %>", 3)
+})
+
+// RenderEffects renders testdata/effects.plush.html to w.
+func RenderEffects(w io.Writer, ctx hctx.Context) error {
+	out, ok, err := renderEffectsStatic(ctx)
+	if !ok {
+		out, err = vmplush.Render(renderEffectsSource, ctx)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderEffectsStatic renders the template with the declared types. It reports false
+// when the context does not hold them or holds nil pointers.
+func renderEffectsStatic(ctx hctx.Context) (string, bool, error) {
+	v_user, ok := ctx.Value("user").(*fixtures.User)
+	if !ok || v_user == nil {
+		return "", false, nil
+	}
+	var b bytes.Buffer
+	b.WriteString("<p>")
+	if t, err := renderEffectsFragment0(); err != nil {
+		return "", true, err
+	} else if s, err := t.Render(ctx); err != nil {
+		return "", true, err
+	} else {
+		b.WriteString(s)
+	}
+	b.WriteString(" visits</p>\n<p>Reports to ")
+	if mark := b.Len(); !func() bool {
+		if !(v_user.Manager != nil) {
+			return false
+		}
+		b.WriteString(template.HTMLEscapeString(v_user.Manager.Name))
+		return true
+	}() {
+		b.Truncate(mark)
+		if t, err := renderEffectsFragment1(); err != nil {
+			return "", true, err
+		} else if s, err := t.Render(ctx); err != nil {
+			return "", true, err
+		} else {
+			b.WriteString(s)
+		}
+	}
+	b.WriteString("</p>\n")
+	if mark := b.Len(); !func() bool {
+		for _, v_friend := range v_user.Friends {
+			if !(v_friend != nil) {
+				return false
+			}
+			b.WriteString(template.HTMLEscapeString(v_friend.Name))
+			b.WriteString(" ")
+		}
+		return true
+	}() {
+		b.Truncate(mark)
+		if t, err := renderEffectsFragment2(); err != nil {
+			return "", true, err
+		} else if s, err := t.Render(ctx); err != nil {
+			return "", true, err
+		} else {
+			b.WriteString(s)
+		}
+	}
+	b.WriteString("\n")
+	return b.String(), true, nil
+}
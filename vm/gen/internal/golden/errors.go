@@ -0,0 +1,58 @@
+// Code generated by plush gen from testdata/errors.plush.html. DO NOT EDIT.
+
+package golden
+
+import (
+	"bytes"
+	"html/template"
+	"io"
+	"sync"
+
+	"github.com/gobuffalo/plush/v5/helpers/hctx"
+	vmplush "github.com/gobuffalo/plush/v5/vm/plush"
+
+	fixtures "github.com/gobuffalo/plush/v5/vm/gen/internal/fixtures"
+)
+
+// renderErrorsSource is the template RenderErrors was generated from.
+const renderErrorsSource = "<p><%= user.Name %></p>\n<p>\n  ok\n  <%= missing %>\n</p>\n"
+
+// renderErrorsFragment0 compiles the statement on line 4 for the VM.
+var renderErrorsFragment0 = sync.OnceValues(func() (*vmplush.Template, error) {
+	return vmplush.CompileAt("<%= missing %>", 4)
+})
+
+// RenderErrors renders testdata/errors.plush.html to w.
+func RenderErrors(w io.Writer, ctx hctx.Context) error {
+	out, ok, err := renderErrorsStatic(ctx)
+	if !ok {
+		out, err = vmplush.Render(renderErrorsSource, ctx)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderErrorsStatic renders the template with the declared types. It reports false
+// when the context does not hold them or holds nil pointers.
+func renderErrorsStatic(ctx hctx.Context) (string, bool, error) {
+	v_user, ok := ctx.Value("user").(*fixtures.User)
+	if !ok || v_user == nil {
+		return "", false, nil
+	}
+	var b bytes.Buffer
+	b.WriteString("<p>")
+	b.WriteString(template.HTMLEscapeString(v_user.Name))
+	b.WriteString("</p>\n<p>\n  ok\n  ")
+	if t, err := renderErrorsFragment0(); err != nil {
+		return "", true, err
+	} else if s, err := t.Render(ctx); err != nil {
+		return "", true, err
+	} else {
+		b.WriteString(s)
+	}
+	b.WriteString("\n</p>\n")
+	return b.String(), true, nil
+}
@@ -0,0 +1,137 @@
+// Code generated by plush gen from testdata/helpers.plush.html. DO NOT EDIT.
+
+package golden
+
+import (
+	"bytes"
+	"fmt"
+	"html/template"
+	"io"
+	"strconv"
+	"sync"
+
+	"github.com/gobuffalo/plush/v5/helpers/hctx"
+	vmplush "github.com/gobuffalo/plush/v5/vm/plush"
+
+	fixtures "github.com/gobuffalo/plush/v5/vm/gen/internal/fixtures"
+)
+
+// renderHelpersSource is the template RenderHelpers was generated from.
+const renderHelpersSource = "<h1><%= upcase(user.Name) %></h1>\n<p><%= user.Greeting() %> (<%= len(user.Tags) %> tags)</p>\n<p><%= title %>: <%= user.Address.City %></p>\n<% if (user.Admin) { %>hidden<% } %>\n<%= for (t) in user.Tags { %><%= truncate(t, {size: 3}) %> <% } %>\n<%= if (user.Age > 30) { %>senior<% } %>\n<%= \"<b>literal</b>\" %> <%= 1 + 2 * 3 %> <%= 2.5 %> <%= -user.Age %>\n"
+
+// renderHelpersFragment0 compiles the statement on line 1 for the VM.
+var renderHelpersFragment0 = sync.OnceValues(func() (*vmplush.Template, error) {
+	return vmplush.CompileAt("<%= upcase(user.Name) %>", 1)
+})
+
+// renderHelpersFragment1 compiles the statement on line 2 for the VM.
+var renderHelpersFragment1 = sync.OnceValues(func() (*vmplush.Template, error) {
+	return vmplush.CompileAt("<%= user.Greeting() %>", 2)
+})
+
+// renderHelpersFragment2 compiles the statement on line 2 for the VM.
+var renderHelpersFragment2 = sync.OnceValues(func() (*vmplush.Template, error) {
+	return vmplush.CompileAt("<%= len(user.Tags) %>", 2)
+})
+
+// renderHelpersFragment3 compiles the statement on line 3 for the VM.
+var renderHelpersFragment3 = sync.OnceValues(func() (*vmplush.Template, error) {
+	return vmplush.CompileAt("<%= title %>", 3)
+})
+
+// renderHelpersFragment4 compiles the statement on line 4 for the VM.
+var renderHelpersFragment4 = sync.OnceValues(func() (*vmplush.Template, error) {
+	return vmplush.CompileAt("<% if (user.Admin) { %>hidden<% } %>", 4)
+})
+
+// renderHelpersFragment5 compiles the statement on line 5 for the VM.
+var renderHelpersFragment5 = sync.OnceValues(func() (*vmplush.Template, error) {
+	return vmplush.CompileAt("<%= for (t) in user.Tags { %><%= truncate(t, {size: 3}) %> <% } %>", 5)
+})
+
+// RenderHelpers renders testdata/helpers.plush.html to w.
+func RenderHelpers(w io.Writer, ctx hctx.Context) error {
+	out, ok, err := renderHelpersStatic(ctx)
+	if !ok {
+		out, err = vmplush.Render(renderHelpersSource, ctx)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderHelpersStatic renders the template with the declared types. It reports false
+// when the context does not hold them or holds nil pointers.
+func renderHelpersStatic(ctx hctx.Context) (string, bool, error) {
+	v_user, ok := ctx.Value("user").(*fixtures.User)
+	if !ok || v_user == nil {
+		return "", false, nil
+	}
+	var b bytes.Buffer
+	b.WriteString("<h1>")
+	if t, err := renderHelpersFragment0(); err != nil {
+		return "", true, err
+	} else if s, err := t.Render(ctx); err != nil {
+		return "", true, err
+	} else {
+		b.WriteString(s)
+	}
+	b.WriteString("</h1>\n<p>")
+	if t, err := renderHelpersFragment1(); err != nil {
+		return "", true, err
+	} else if s, err := t.Render(ctx); err != nil {
+		return "", true, err
+	} else {
+		b.WriteString(s)
+	}
+	b.WriteString(" (")
+	if t, err := renderHelpersFragment2(); err != nil {
+		return "", true, err
+	} else if s, err := t.Render(ctx); err != nil {
+		return "", true, err
+	} else {
+		b.WriteString(s)
+	}
+	b.WriteString(" tags)</p>\n<p>")
+	if t, err := renderHelpersFragment3(); err != nil {
+		return "", true, err
+	} else if s, err := t.Render(ctx); err != nil {
+		return "", true, err
+	} else {
+		b.WriteString(s)
+	}
+	b.WriteString(": ")
+	b.WriteString(template.HTMLEscapeString(v_user.Address.City))
+	b.WriteString("</p>\n")
+	if t, err := renderHelpersFragment4(); err != nil {
+		return "", true, err
+	} else if s, err := t.Render(ctx); err != nil {
+		return "", true, err
+	} else {
+		b.WriteString(s)
+	}
+	b.WriteString("\n")
+	if t, err := renderHelpersFragment5(); err != nil {
+		return "", true, err
+	} else if s, err := t.Render(ctx); err != nil {
+		return "", true, err
+	} else {
+		b.WriteString(s)
+	}
+	b.WriteString("\n")
+	if v_user.Age > 30 {
+		b.WriteString("senior")
+	}
+	b.WriteString("\n")
+	b.WriteString(template.HTMLEscapeString("<b>literal</b>"))
+	b.WriteString(" ")
+	b.WriteString(strconv.Itoa((1 + (2 * 3))))
+	b.WriteString(" ")
+	b.WriteString(fmt.Sprint(float64(2.5)))
+	b.WriteString(" ")
+	b.WriteString(strconv.Itoa(-(v_user.Age)))
+	b.WriteString("\n")
+	return b.String(), true, nil
+}
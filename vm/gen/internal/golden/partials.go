@@ -0,0 +1,58 @@
+// Code generated by plush gen from testdata/partials.plush.html. DO NOT EDIT.
+
+package golden
+
+import (
+	"bytes"
+	"html/template"
+	"io"
+	"sync"
+
+	"github.com/gobuffalo/plush/v5/helpers/hctx"
+	vmplush "github.com/gobuffalo/plush/v5/vm/plush"
+
+	fixtures "github.com/gobuffalo/plush/v5/vm/gen/internal/fixtures"
+)
+
+// renderPartialsSource is the template RenderPartials was generated from.
+const renderPartialsSource = "<main>\n  <%= partial(\"users/card.html\", {name: user.Name}) %>\n  <footer><%= user.Address.Country %></footer>\n</main>\n"
+
+// renderPartialsFragment0 compiles the statement on line 2 for the VM.
+var renderPartialsFragment0 = sync.OnceValues(func() (*vmplush.Template, error) {
+	return vmplush.CompileAt("<%= partial(\"users/card.html\", {name: user.Name}) %>", 2)
+})
+
+// RenderPartials renders testdata/partials.plush.html to w.
+func RenderPartials(w io.Writer, ctx hctx.Context) error {
+	out, ok, err := renderPartialsStatic(ctx)
+	if !ok {
+		out, err = vmplush.Render(renderPartialsSource, ctx)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderPartialsStatic renders the template with the declared types. It reports false
+// when the context does not hold them or holds nil pointers.
+func renderPartialsStatic(ctx hctx.Context) (string, bool, error) {
+	v_user, ok := ctx.Value("user").(*fixtures.User)
+	if !ok || v_user == nil {
+		return "", false, nil
+	}
+	var b bytes.Buffer
+	b.WriteString("<main>\n  ")
+	if t, err := renderPartialsFragment0(); err != nil {
+		return "", true, err
+	} else if s, err := t.Render(ctx); err != nil {
+		return "", true, err
+	} else {
+		b.WriteString(s)
+	}
+	b.WriteString("\n  <footer>")
+	b.WriteString(template.HTMLEscapeString(v_user.Address.Country))
+	b.WriteString("</footer>\n</main>\n")
+	return b.String(), true, nil
+}
@@ -0,0 +1,187 @@
+// Code generated by plush gen from testdata/profile.plush.html. DO NOT EDIT.
+
+package golden
+
+import (
+	"bytes"
+	"fmt"
+	"html/template"
+	"io"
+	"strconv"
+	"sync"
+
+	"github.com/gobuffalo/plush/v5/helpers/hctx"
+	vmplush "github.com/gobuffalo/plush/v5/vm/plush"
+
+	fixtures "github.com/gobuffalo/plush/v5/vm/gen/internal/fixtures"
+)
+
+// renderProfileSource is the template RenderProfile was generated from.
+const renderProfileSource = "<section class=\"profile\">\n  <h1><%= user.Name %> &lt;<%= user.Email %>&gt;</h1>\n  <p>Age <%= user.Age %>, next year <%= user.Age + 1 %>. Score <%= user.Score %>, visits <%= user.Visits %>.</p>\n  <div class=\"bio\"><%= user.Bio %></div>\n  <p>Admin: <%= user.Admin %>, adult: <%= user.Age >= 18 %></p>\n  <%= if (user.Admin) { %>\n    <span class=\"badge\">admin</span>\n  <% } else if (user.Age < 18 && !user.Admin) { %>\n    <span class=\"badge\">minor</span>\n  <% } else { %>\n    <span class=\"badge\">member</span>\n  <% } %>\n  <ul>\n  <%= for (i, tag) in user.Tags { %>\n    <li data-index=\"<%= i %>\"><%= tag + \"!\" %></li>\n  <% } %>\n  </ul>\n  <%= for (friend) in user.Friends { %>\n    <a href=\"/users/<%= friend.Name %>\"><%= friend.Name %> from <%= friend.Address.City %></a>\n  <% } %>\n  <%= for (i) in user.Tags { %>#<%= i %> <% } %>\n  <p>First tag: <%= user.Tags[0] %></p>\n  <p>Reports to <%= user.Manager.Name %> in <%= user.Manager.Address.Country %></p>\n</section>\n"
+
+// renderProfileFragment0 compiles the statement on line 18 for the VM.
+var renderProfileFragment0 = sync.OnceValues(func() (*vmplush.Template, error) {
+	return vmplush.CompileAt("<%= for (friend) in user.Friends { %>\n    <a href=\"/users/<%= friend.Name %>\"><%= friend.Name %> from <%= friend.Address.City %></a>\n  <% } %>", 18)
+})
+
+// renderProfileFragment1 compiles the statement on line 22 for the VM.
+var renderProfileFragment1 = sync.OnceValues(func() (*vmplush.Template, error) {
+	return vmplush.CompileAt("<%= user.Tags[0] %>", 22)
+})
+
+// renderProfileFragment2 compiles the statement on line 23 for the VM.
+var renderProfileFragment2 = sync.OnceValues(func() (*vmplush.Template, error) {
+	return vmplush.CompileAt("<%= user.Manager.Name %>", 23)
+})
+
+// renderProfileFragment3 compiles the statement on line 23 for the VM.
+var renderProfileFragment3 = sync.OnceValues(func() (*vmplush.Template, error) {
+	return vmplush.CompileAt("<%= user.Manager.Address.Country %>", 23)
+})
+
+// RenderProfile renders testdata/profile.plush.html to w.
+func RenderProfile(w io.Writer, ctx hctx.Context) error {
+	out, ok, err := renderProfileStatic(ctx)
+	if !ok {
+		out, err = vmplush.Render(renderProfileSource, ctx)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderProfileStatic renders the template with the declared types. It reports false
+// when the context does not hold them or holds nil pointers.
+func renderProfileStatic(ctx hctx.Context) (string, bool, error) {
+	v_user, ok := ctx.Value("user").(*fixtures.User)
+	if !ok || v_user == nil {
+		return "", false, nil
+	}
+	var b bytes.Buffer
+	b.WriteString("<section class=\"profile\">\n  <h1>")
+	b.WriteString(template.HTMLEscapeString(v_user.Name))
+	b.WriteString(" &lt;")
+	b.WriteString(template.HTMLEscapeString(v_user.Email))
+	b.WriteString("&gt;</h1>\n  <p>Age ")
+	b.WriteString(strconv.Itoa(v_user.Age))
+	b.WriteString(", next year ")
+	b.WriteString(strconv.Itoa((v_user.Age + 1)))
+	b.WriteString(". Score ")
+	b.WriteString(fmt.Sprint(v_user.Score))
+	b.WriteString(", visits ")
+	b.WriteString(strconv.FormatUint(uint64(v_user.Visits), 10))
+	b.WriteString(".</p>\n  <div class=\"bio\">")
+	b.WriteString(string(v_user.Bio))
+	b.WriteString("</div>\n  <p>Admin: ")
+	b.WriteString(strconv.FormatBool(v_user.Admin))
+	b.WriteString(", adult: ")
+	b.WriteString(strconv.FormatBool((v_user.Age >= 18)))
+	b.WriteString("</p>\n  ")
+	if v_user.Admin {
+		b.WriteString("\n    <span class=\"badge\">admin</span>\n  ")
+	} else if (v_user.Age < 18) && !(v_user.Admin) {
+		b.WriteString("\n    <span class=\"badge\">minor</span>\n  ")
+	} else {
+		b.WriteString("\n    <span class=\"badge\">member</span>\n  ")
+	}
+	b.WriteString("\n  <ul>\n  ")
+	for v_i, v_tag := range v_user.Tags {
+		b.WriteString("\n    <li data-index=\"")
+		b.WriteString(strconv.Itoa(v_i))
+		b.WriteString("\">")
+		b.WriteString(template.HTMLEscapeString((v_tag + "!")))
+		b.WriteString("</li>\n  ")
+	}
+	b.WriteString("\n  </ul>\n  ")
+	if mark := b.Len(); !func() bool {
+		for _, v_friend := range v_user.Friends {
+			b.WriteString("\n    <a href=\"/users/")
+			if !(v_friend != nil) {
+				return false
+			}
+			b.WriteString(template.HTMLEscapeString(v_friend.Name))
+			b.WriteString("\">")
+			if !(v_friend != nil) {
+				return false
+			}
+			b.WriteString(template.HTMLEscapeString(v_friend.Name))
+			b.WriteString(" from ")
+			if !(v_friend != nil) {
+				return false
+			}
+			b.WriteString(template.HTMLEscapeString(v_friend.Address.City))
+			b.WriteString("</a>\n  ")
+		}
+		return true
+	}() {
+		b.Truncate(mark)
+		if t, err := renderProfileFragment0(); err != nil {
+			return "", true, err
+		} else if s, err := t.Render(ctx); err != nil {
+			return "", true, err
+		} else {
+			b.WriteString(s)
+		}
+	}
+	b.WriteString("\n  ")
+	for _, v_i := range v_user.Tags {
+		b.WriteString("#")
+		b.WriteString(template.HTMLEscapeString(v_i))
+		b.WriteString(" ")
+	}
+	b.WriteString("\n  <p>First tag: ")
+	if mark := b.Len(); !func() bool {
+		if !(0 < len(v_user.Tags)) {
+			return false
+		}
+		b.WriteString(template.HTMLEscapeString(v_user.Tags[0]))
+		return true
+	}() {
+		b.Truncate(mark)
+		if t, err := renderProfileFragment1(); err != nil {
+			return "", true, err
+		} else if s, err := t.Render(ctx); err != nil {
+			return "", true, err
+		} else {
+			b.WriteString(s)
+		}
+	}
+	b.WriteString("</p>\n  <p>Reports to ")
+	if mark := b.Len(); !func() bool {
+		if !(v_user.Manager != nil) {
+			return false
+		}
+		b.WriteString(template.HTMLEscapeString(v_user.Manager.Name))
+		return true
+	}() {
+		b.Truncate(mark)
+		if t, err := renderProfileFragment2(); err != nil {
+			return "", true, err
+		} else if s, err := t.Render(ctx); err != nil {
+			return "", true, err
+		} else {
+			b.WriteString(s)
+		}
+	}
+	b.WriteString(" in ")
+	if mark := b.Len(); !func() bool {
+		if !(v_user.Manager != nil) {
+			return false
+		}
+		b.WriteString(template.HTMLEscapeString(v_user.Manager.Address.Country))
+		return true
+	}() {
+		b.Truncate(mark)
+		if t, err := renderProfileFragment3(); err != nil {
+			return "", true, err
+		} else if s, err := t.Render(ctx); err != nil {
+			return "", true, err
+		} else {
+			b.WriteString(s)
+		}
+	}
+	b.WriteString("</p>\n</section>\n")
+	return b.String(), true, nil
+}
@@ -0,0 +1,128 @@
+package gen
+
+import (
+	"fmt"
+	"go/importer"
+	"go/token"
+	"go/types"
+	"strings"
+	"sync"
+)
+
+// TypeLoader resolves type expressions such as `*github.com/x/models.User`
+// by type-checking the packages they name from source. Packages are loaded
+// once per loader, so reusing a loader across Generate calls saves time.
+type TypeLoader struct {
+	mu       sync.Mutex
+	importer types.ImporterFrom
+	dir      string
+}
+
+// NewTypeLoader returns a loader resolving import paths the way the go
+// command does from dir, which may be "" for the current directory.
+func NewTypeLoader(dir string) *TypeLoader {
+	if dir == "" {
+		dir = "."
+	}
+	return &TypeLoader{
+		importer: importer.ForCompiler(token.NewFileSet(), "source", nil).(types.ImporterFrom),
+		dir:      dir,
+	}
+}
+
+// Load resolves a type expression. Expressions are Go types whose named
+// types are qualified by their full import path: `string`, `[]int`,
+// `html/template.HTML` or `[]*github.com/x/models.User`.
+func (l *TypeLoader) Load(expr string) (types.Type, error) {
+	expr = strings.TrimSpace(expr)
+	switch {
+	case expr == "":
+		return nil, fmt.Errorf("empty type")
+	case strings.HasPrefix(expr, "*"):
+		elem, err := l.Load(expr[1:])
+		if err != nil {
+			return nil, err
+		}
+		return types.NewPointer(elem), nil
+	case strings.HasPrefix(expr, "[]"):
+		elem, err := l.Load(expr[2:])
+		if err != nil {
+			return nil, err
+		}
+		return types.NewSlice(elem), nil
+	}
+
+	if obj := types.Universe.Lookup(expr); obj != nil {
+		if tn, ok := obj.(*types.TypeName); ok {
+			return tn.Type(), nil
+		}
+	}
+
+	dot := strings.LastIndex(expr, ".")
+	if dot <= strings.LastIndex(expr, "/") {
+		return nil, fmt.Errorf("unknown type %q: named types must be qualified by their import path", expr)
+	}
+	path, name := expr[:dot], expr[dot+1:]
+
+	l.mu.Lock()
+	pkg, err := l.importer.ImportFrom(path, l.dir, 0)
+	l.mu.Unlock()
+	if err != nil {
+		return nil, fmt.Errorf("loading %s: %w", path, err)
+	}
+	tn, ok := pkg.Scope().Lookup(name).(*types.TypeName)
+	if !ok || !tn.Exported() {
+		return nil, fmt.Errorf("%s has no exported type %s", path, name)
+	}
+	return tn.Type(), nil
+}
+
+var htmlType = func() types.Type {
+	pkg := types.NewPackage("html/template", "template")
+	return types.NewNamed(types.NewTypeName(token.NoPos, pkg, "HTML", nil), types.Typ[types.String], nil)
+}()
+
+// isHTML reports whether t is html/template.HTML.
+func isHTML(t types.Type) bool {
+	n, ok := t.(*types.Named)
+	return ok && n.Obj().Pkg() != nil && n.Obj().Pkg().Path() == "html/template" && n.Obj().Name() == "HTML"
+}
+
+// basic returns the basic type of t when t is exactly a predeclared type,
+// not a named type defined on one.
+func basic(t types.Type) (*types.Basic, bool) {
+	b, ok := t.(*types.Basic)
+	if !ok || b.Kind() == types.UntypedNil || b.Info()&types.IsUntyped != 0 {
+		return nil, false
+	}
+	return b, true
+}
+
+// identical reports whether a and b are the same type, so that
+// comparisons and arithmetic between them behave the same in Go as in
+// Plush.
+func identical(a, b types.Type) bool {
+	return a != nil && b != nil && types.Identical(a, b)
+}
+
+// field returns the exported struct field name of t, or of the struct t
+// points to. Fields promoted through embedded pointers, which may be nil,
+// are not returned.
+func field(t types.Type, name string) (types.Type, bool) {
+	obj, index, _ := types.LookupFieldOrMethod(t, true, nil, name)
+	v, ok := obj.(*types.Var)
+	if !ok || !v.IsField() || !v.Exported() {
+		return nil, false
+	}
+	if p, ok := t.(*types.Pointer); ok {
+		t = p.Elem()
+	}
+	for _, i := range index[:len(index)-1] {
+		f := t.Underlying().(*types.Struct).Field(i)
+		if _, ok := f.Type().Underlying().(*types.Pointer); ok {
+			return nil, false
+		}
+		t = f.Type()
+	}
+	return v.Type(), true
+}
@@ -194,6 +194,7 @@ type CompiledFunction struct {
 	LocalNames     map[int]string
 	LineNumbers    map[int]int
 	Properties     map[int]PropertyAccess
+	Branches       map[int]BranchPoint
 	PropertyCaches []InlineCacheSlot
 	CallCaches     []InlineCacheSlot
 	NumLocals      int
@@ -211,6 +212,16 @@ type PropertyAccess struct {
 	Method   bool
 }
 
+// BranchPoint marks the first instruction of an if, else if or else body,
+// or of a for loop body. Line and Column locate the if or for keyword;
+// Branch is 0 for the if or loop body, i for the i-th else if, and one past
+// the last else if for the else, which an if without one still has.
+type BranchPoint struct {
+	Line   int
+	Column int
+	Branch int
+}
+
 type InlineCacheSlot struct {
 	value atomic.Value
 }
@@ -239,6 +250,18 @@ func (s *InlineCacheSlot) Store(value interface{}) {
 type Closure struct {
 	Fn   *CompiledFunction
 	Free []Object
+	// Origin is the program the closure was compiled in. It is set when
+	// the closure is stored in a context, so that other programs and Go
+	// code can call it.
+	Origin *Origin
+}
+
+// Origin holds what a closure needs to run outside of the program it was
+// compiled in.
+type Origin struct {
+	Constants   []Object
+	GlobalNames map[int]string
+	NumGlobals  int
 }
 
 func (c *Closure) Type() ObjectType { return CLOSURE_OBJ }
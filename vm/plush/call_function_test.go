@@ -0,0 +1,112 @@
+package plush_test
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+
+	rootplush "github.com/gobuffalo/plush/v5"
+	vmplush "github.com/gobuffalo/plush/v5/vm/plush"
+	"github.com/stretchr/testify/require"
+)
+
+const callFunctionTemplate = `<%
+let currency = "$"
+let fmtPrice = fn(p) {
+	return currency + p
+}
+let row = fn(name) { %><b><%= name %></b><% }
+%><%= fmtPrice(3) %>`
+
+func Test_CallFunction(t *testing.T) {
+	r := require.New(t)
+	ctx := rootplush.NewContext()
+	s, err := vmplush.Render(callFunctionTemplate, ctx)
+	r.NoError(err)
+	r.Equal("$3", s)
+
+	v, err := vmplush.CallFunction(ctx, "fmtPrice", 12)
+	r.NoError(err)
+	r.Equal("$12", v)
+
+	v, err = vmplush.CallFunction(ctx, "row", "mark")
+	r.NoError(err)
+	r.Equal("<b>mark</b>", fmt.Sprint(v))
+
+	// Later renders, compiled separately, can call the function too.
+	s, err = vmplush.Render(`<%= fmtPrice(4) %>|<%= row("paul") %>`, ctx)
+	r.NoError(err)
+	r.Equal("$4|<b>paul</b>", s)
+
+	ctx.Set("items", []callFunctionItem{{Price: 1}, {Price: 2}})
+	s, err = vmplush.Render(`<%= for (i) in items { %><%= fmtPrice(i.Price) %> <% } %>`, ctx)
+	r.NoError(err)
+	r.Equal("$1 $2 ", s)
+}
+
+type callFunctionItem struct {
+	Price int
+}
+
+func Test_CallFunction_Interpreter_Function(t *testing.T) {
+	r := require.New(t)
+	ctx := rootplush.NewContext()
+	_, err := rootplush.Render(callFunctionTemplate, ctx)
+	r.NoError(err)
+
+	v, err := vmplush.CallFunction(ctx, "fmtPrice", 12)
+	r.NoError(err)
+	r.Equal("$12", v)
+}
+
+func Test_CallFunction_Errors(t *testing.T) {
+	r := require.New(t)
+	ctx := rootplush.NewContext()
+	_, err := vmplush.Render(callFunctionTemplate, ctx)
+	r.NoError(err)
+
+	_, err = vmplush.CallFunction(ctx, "currency")
+	r.True(errors.Is(err, rootplush.ErrNotFunction), "got %v", err)
+	_, err = vmplush.CallFunction(ctx, "missing")
+	r.True(errors.Is(err, rootplush.ErrNotFunction), "got %v", err)
+
+	_, err = vmplush.CallFunction(ctx, "fmtPrice", 1, 2)
+	r.ErrorContains(err, "wrong number of arguments: want=1, got=2")
+}
+
+func Test_CallFunction_Concurrent(t *testing.T) {
+	r := require.New(t)
+	ctx := rootplush.NewContext()
+	_, err := vmplush.Render(callFunctionTemplate, ctx)
+	r.NoError(err)
+
+	var wg sync.WaitGroup
+	errs := make([]error, 20)
+	for i := range errs {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			v, err := vmplush.CallFunction(ctx, "fmtPrice", i)
+			if err == nil && v != "$"+fmt.Sprint(i) {
+				err = fmt.Errorf("got %v", v)
+			}
+			errs[i] = err
+		}(i)
+	}
+	wg.Wait()
+	for _, err := range errs {
+		r.NoError(err)
+	}
+}
+
+func Test_CallFunction_Budget(t *testing.T) {
+	r := require.New(t)
+	ctx := rootplush.NewContext()
+	_, err := vmplush.Render(`<% let spin = fn(n) { for (i) in range(1, n) { upcase("x") } } %>`, ctx)
+	r.NoError(err)
+
+	ctx.WithBudget(rootplush.NewBudget(10))
+	_, err = vmplush.CallFunction(ctx, "spin", 100)
+	r.True(errors.Is(err, rootplush.ErrBudgetExceeded), "got %v", err)
+}
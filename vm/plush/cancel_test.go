@@ -0,0 +1,81 @@
+package plush_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	rootplush "github.com/gobuffalo/plush/v5"
+	vmplush "github.com/gobuffalo/plush/v5/vm/plush"
+	"github.com/stretchr/testify/require"
+)
+
+type cancelRow struct {
+	Name string
+}
+
+func Test_VM_Render_Cancelled_In_Loop(t *testing.T) {
+	for _, input := range []string{
+		`<%= for (i, v) in rows { %><%= row(i) %><% } %>`,
+		`<%= for (v) in users { %><%= row(0) %><%= v.Name %><% } %>`,
+		`<% let n = 0 %><%= for (i, v) in rows { %><% n = row(n) + 1 %><% } %><%= n %>`,
+	} {
+		t.Run(input, func(t *testing.T) {
+			r := require.New(t)
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			var rendered int
+			pctx := rootplush.NewContextWithContext(ctx)
+			pctx.Set("rows", make([]int, 50000))
+			pctx.Set("users", make([]cancelRow, 50000))
+			pctx.Set("row", func(i int) int {
+				rendered++
+				if rendered == 10 {
+					cancel()
+				}
+				return i
+			})
+
+			_, err := renderVMContext(t, input, pctx)
+			r.Error(err)
+			r.True(errors.Is(err, context.Canceled), "got %v", err)
+			r.Equal(10, rendered)
+		})
+	}
+}
+
+func Test_VM_Render_Deadline_Exceeded(t *testing.T) {
+	ctx, cancel := context.WithDeadline(context.Background(), time.Now().Add(-time.Second))
+	defer cancel()
+
+	for _, input := range []string{
+		`<%= for (i) in range(1, 3) { %><%= i %><% } %>`,
+		`<%= f() %>`,
+		`<% let g = fn() { return 1 } %><%= g() %>`,
+		`<%= partial("row.html") %>`,
+	} {
+		t.Run(input, func(t *testing.T) {
+			pctx := rootplush.NewContextWithContext(ctx)
+			pctx.Set("f", func() string { return "x" })
+			pctx.Set("partialFeeder", func(string) (string, error) {
+				return "partial", nil
+			})
+			_, err := vmplush.Render(input, pctx)
+			require.True(t, errors.Is(err, context.DeadlineExceeded), "got %v", err)
+		})
+	}
+}
+
+func Test_VM_CallFunction_Cancelled(t *testing.T) {
+	r := require.New(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	pctx := rootplush.NewContextWithContext(ctx)
+	_, err := vmplush.Render(`<% let double = fn(x) { return x * 2 } %>`, pctx)
+	r.NoError(err)
+
+	cancel()
+	_, err = vmplush.CallFunction(pctx, "double", 2)
+	r.True(errors.Is(err, context.Canceled), "got %v", err)
+}
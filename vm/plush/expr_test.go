@@ -0,0 +1,90 @@
+package plush_test
+
+import (
+	"errors"
+	"testing"
+
+	rootplush "github.com/gobuffalo/plush/v5"
+	vmplush "github.com/gobuffalo/plush/v5/vm/plush"
+	"github.com/stretchr/testify/require"
+)
+
+type exprUser struct {
+	Name  string
+	Age   int
+	Email string
+	Tags  []string
+}
+
+func exprContext() *rootplush.Context {
+	return rootplush.NewContextWith(map[string]interface{}{
+		"user":  exprUser{Name: "mark", Age: 40, Email: "mark@example.com", Tags: []string{"beta"}},
+		"limit": 39.5,
+		"plans": map[string]interface{}{"pro": true},
+	})
+}
+
+func Test_CompileExpr_Matches_Eval(t *testing.T) {
+	for _, expr := range []string{
+		`1 + 2 * 3`,
+		`7 / 2.0`,
+		`user.Age > limit`,
+		`user.Age == 40.0`,
+		`user.Email ~= "@example[.]com$"`,
+		`user.Name ~= "^p"`,
+		`len(user.Tags) > 0 && plans["pro"]`,
+		`!plans["free"]`,
+		`upcase(user.Name) + "!"`,
+		`user.Tags[0]`,
+		`[1, "a"]`,
+		`{"a": 1}`,
+		`nil`,
+	} {
+		t.Run(expr, func(t *testing.T) {
+			r := require.New(t)
+			want, err := rootplush.Eval(expr, exprContext())
+			r.NoError(err)
+
+			compiled, err := vmplush.CompileExpr(expr)
+			r.NoError(err)
+			got, err := compiled.Eval(exprContext())
+			r.NoError(err)
+			r.Equal(want, got)
+
+			// A compiled expression is reusable.
+			got, err = compiled.Eval(exprContext())
+			r.NoError(err)
+			r.Equal(want, got)
+		})
+	}
+}
+
+func Test_CompileExpr_Pure(t *testing.T) {
+	r := require.New(t)
+	_, err := vmplush.CompilePureExpr(`plans = nil`)
+	r.True(errors.Is(err, rootplush.ErrSideEffect), "got %v", err)
+
+	compiled, err := vmplush.CompileExpr(`count = count + 1`)
+	r.NoError(err)
+	ctx := exprContext()
+	ctx.Set("count", 1)
+	_, err = compiled.Eval(ctx)
+	r.NoError(err)
+	r.Equal(2, ctx.Value("count"))
+}
+
+func Test_CompileExpr_Errors_And_Budget(t *testing.T) {
+	r := require.New(t)
+	_, err := vmplush.CompileExpr(`1; 2`)
+	r.Error(err)
+
+	compiled, err := vmplush.CompileExpr(`missing + 1`)
+	r.NoError(err)
+	_, err = compiled.Eval(nil)
+	r.Error(err)
+
+	compiled, err = vmplush.CompileExpr(`for (i) in range(1, 10) { upcase("x") }`)
+	r.NoError(err)
+	_, err = compiled.Eval(exprContext().WithBudget(rootplush.NewBudget(5)))
+	r.True(errors.Is(err, rootplush.ErrBudgetExceeded), "got %v", err)
+}
@@ -0,0 +1,347 @@
+// Code generated by go test ./vm/plush -update-gen. DO NOT EDIT.
+
+package genparity
+
+import (
+	"bytes"
+	"github.com/gobuffalo/plush/v5/helpers/hctx"
+	vmplush "github.com/gobuffalo/plush/v5/vm/plush"
+	"html/template"
+	"io"
+	"sync"
+)
+
+// renderBudget1Source is the template RenderBudget1 was generated from.
+const renderBudget1Source = "<%= for (i,v) in items { %><%= v %><% } %>"
+
+// RenderBudget1 renders parity_budget_test.go to w.
+func RenderBudget1(w io.Writer, ctx hctx.Context) error {
+	out, ok, err := renderBudget1Static(ctx)
+	if !ok {
+		out, err = vmplush.Render(renderBudget1Source, ctx)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderBudget1Static renders the template with the declared types. It reports false
+// when the context does not hold them or holds nil pointers.
+func renderBudget1Static(ctx hctx.Context) (string, bool, error) {
+	v_items, ok := ctx.Value("items").([]string)
+	if !ok {
+		return "", false, nil
+	}
+	var b bytes.Buffer
+	for _, v_v := range v_items {
+		b.WriteString(template.HTMLEscapeString(v_v))
+	}
+	return b.String(), true, nil
+}
+
+// renderBudget2Source is the template RenderBudget2 was generated from.
+const renderBudget2Source = "<% let f = fn(n) { if (n > 0) { return f(n - 1) } return n } %><%= f(depth) %>"
+
+// RenderBudget2 renders parity_budget_test.go to w.
+//
+// The template is rendered by the VM: it declares variables with let.
+func RenderBudget2(w io.Writer, ctx hctx.Context) error {
+	out, err := vmplush.Render(renderBudget2Source, ctx)
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderBudget3Source is the template RenderBudget3 was generated from.
+const renderBudget3Source = "<% let f = fn(n) { for (i) in [1] { if (n > 0) { return f(n - 1) } } return n } %><%= f(depth) %>"
+
+// RenderBudget3 renders parity_budget_test.go to w.
+//
+// The template is rendered by the VM: it declares variables with let.
+func RenderBudget3(w io.Writer, ctx hctx.Context) error {
+	out, err := vmplush.Render(renderBudget3Source, ctx)
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderBudget4Source is the template RenderBudget4 was generated from.
+const renderBudget4Source = "<% let f = fn(n) { return f(n + 1) } %><%= f(0) %>"
+
+// RenderBudget4 renders parity_budget_test.go to w.
+//
+// The template is rendered by the VM: it declares variables with let.
+func RenderBudget4(w io.Writer, ctx hctx.Context) error {
+	out, err := vmplush.Render(renderBudget4Source, ctx)
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderBudget5Source is the template RenderBudget5 was generated from.
+const renderBudget5Source = "<% let f = fn(n) { if (n > 0) { return f(n - 1) } return \"done\" } %><%= f(depth) %>"
+
+// RenderBudget5 renders parity_budget_test.go to w.
+//
+// The template is rendered by the VM: it declares variables with let.
+func RenderBudget5(w io.Writer, ctx hctx.Context) error {
+	out, err := vmplush.Render(renderBudget5Source, ctx)
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderBudget6Source is the template RenderBudget6 was generated from.
+const renderBudget6Source = "<%= partial(\"self.plush\") %>"
+
+// renderBudget6Fragment0 compiles the statement on line 1 for the VM.
+var renderBudget6Fragment0 = sync.OnceValues(func() (*vmplush.Template, error) {
+	return vmplush.CompileAt("<%= partial(\"self.plush\") %>", 1)
+})
+
+// RenderBudget6 renders parity_budget_test.go to w.
+func RenderBudget6(w io.Writer, ctx hctx.Context) error {
+	out, ok, err := renderBudget6Static(ctx)
+	if !ok {
+		out, err = vmplush.Render(renderBudget6Source, ctx)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderBudget6Static renders the template with the declared types. It reports false
+// when the context does not hold them or holds nil pointers.
+func renderBudget6Static(ctx hctx.Context) (string, bool, error) {
+	var b bytes.Buffer
+	if t, err := renderBudget6Fragment0(); err != nil {
+		return "", true, err
+	} else if s, err := t.Render(ctx); err != nil {
+		return "", true, err
+	} else {
+		b.WriteString(s)
+	}
+	return b.String(), true, nil
+}
+
+// renderBudget7Source is the template RenderBudget7 was generated from.
+const renderBudget7Source = "<%= partial(\"a.plush\") %>"
+
+// renderBudget7Fragment0 compiles the statement on line 1 for the VM.
+var renderBudget7Fragment0 = sync.OnceValues(func() (*vmplush.Template, error) {
+	return vmplush.CompileAt("<%= partial(\"a.plush\") %>", 1)
+})
+
+// RenderBudget7 renders parity_budget_test.go to w.
+func RenderBudget7(w io.Writer, ctx hctx.Context) error {
+	out, ok, err := renderBudget7Static(ctx)
+	if !ok {
+		out, err = vmplush.Render(renderBudget7Source, ctx)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderBudget7Static renders the template with the declared types. It reports false
+// when the context does not hold them or holds nil pointers.
+func renderBudget7Static(ctx hctx.Context) (string, bool, error) {
+	var b bytes.Buffer
+	if t, err := renderBudget7Fragment0(); err != nil {
+		return "", true, err
+	} else if s, err := t.Render(ctx); err != nil {
+		return "", true, err
+	} else {
+		b.WriteString(s)
+	}
+	return b.String(), true, nil
+}
+
+// renderBudget8Source is the template RenderBudget8 was generated from.
+const renderBudget8Source = "<%= partial(\"a.plush\", {n: 1}) %>"
+
+// renderBudget8Fragment0 compiles the statement on line 1 for the VM.
+var renderBudget8Fragment0 = sync.OnceValues(func() (*vmplush.Template, error) {
+	return vmplush.CompileAt("<%= partial(\"a.plush\", {n: 1}) %>", 1)
+})
+
+// RenderBudget8 renders parity_budget_test.go to w.
+func RenderBudget8(w io.Writer, ctx hctx.Context) error {
+	out, ok, err := renderBudget8Static(ctx)
+	if !ok {
+		out, err = vmplush.Render(renderBudget8Source, ctx)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderBudget8Static renders the template with the declared types. It reports false
+// when the context does not hold them or holds nil pointers.
+func renderBudget8Static(ctx hctx.Context) (string, bool, error) {
+	var b bytes.Buffer
+	if t, err := renderBudget8Fragment0(); err != nil {
+		return "", true, err
+	} else if s, err := t.Render(ctx); err != nil {
+		return "", true, err
+	} else {
+		b.WriteString(s)
+	}
+	return b.String(), true, nil
+}
+
+// renderBudget9Source is the template RenderBudget9 was generated from.
+const renderBudget9Source = "<%= partial(\"leaf.plush\") %>"
+
+// renderBudget9Fragment0 compiles the statement on line 1 for the VM.
+var renderBudget9Fragment0 = sync.OnceValues(func() (*vmplush.Template, error) {
+	return vmplush.CompileAt("<%= partial(\"leaf.plush\") %>", 1)
+})
+
+// RenderBudget9 renders parity_budget_test.go to w.
+func RenderBudget9(w io.Writer, ctx hctx.Context) error {
+	out, ok, err := renderBudget9Static(ctx)
+	if !ok {
+		out, err = vmplush.Render(renderBudget9Source, ctx)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderBudget9Static renders the template with the declared types. It reports false
+// when the context does not hold them or holds nil pointers.
+func renderBudget9Static(ctx hctx.Context) (string, bool, error) {
+	var b bytes.Buffer
+	if t, err := renderBudget9Fragment0(); err != nil {
+		return "", true, err
+	} else if s, err := t.Render(ctx); err != nil {
+		return "", true, err
+	} else {
+		b.WriteString(s)
+	}
+	return b.String(), true, nil
+}
+
+// renderBudget10Source is the template RenderBudget10 was generated from.
+const renderBudget10Source = "<%= partial(\"up.plush\") %>"
+
+// renderBudget10Fragment0 compiles the statement on line 1 for the VM.
+var renderBudget10Fragment0 = sync.OnceValues(func() (*vmplush.Template, error) {
+	return vmplush.CompileAt("<%= partial(\"up.plush\") %>", 1)
+})
+
+// RenderBudget10 renders parity_budget_test.go to w.
+func RenderBudget10(w io.Writer, ctx hctx.Context) error {
+	out, ok, err := renderBudget10Static(ctx)
+	if !ok {
+		out, err = vmplush.Render(renderBudget10Source, ctx)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderBudget10Static renders the template with the declared types. It reports false
+// when the context does not hold them or holds nil pointers.
+func renderBudget10Static(ctx hctx.Context) (string, bool, error) {
+	var b bytes.Buffer
+	if t, err := renderBudget10Fragment0(); err != nil {
+		return "", true, err
+	} else if s, err := t.Render(ctx); err != nil {
+		return "", true, err
+	} else {
+		b.WriteString(s)
+	}
+	return b.String(), true, nil
+}
+
+// renderBudget11Source is the template RenderBudget11 was generated from.
+const renderBudget11Source = "<%= partial(\"count.plush\") %>"
+
+// renderBudget11Fragment0 compiles the statement on line 1 for the VM.
+var renderBudget11Fragment0 = sync.OnceValues(func() (*vmplush.Template, error) {
+	return vmplush.CompileAt("<%= partial(\"count.plush\") %>", 1)
+})
+
+// RenderBudget11 renders parity_budget_test.go to w.
+func RenderBudget11(w io.Writer, ctx hctx.Context) error {
+	out, ok, err := renderBudget11Static(ctx)
+	if !ok {
+		out, err = vmplush.Render(renderBudget11Source, ctx)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderBudget11Static renders the template with the declared types. It reports false
+// when the context does not hold them or holds nil pointers.
+func renderBudget11Static(ctx hctx.Context) (string, bool, error) {
+	var b bytes.Buffer
+	if t, err := renderBudget11Fragment0(); err != nil {
+		return "", true, err
+	} else if s, err := t.Render(ctx); err != nil {
+		return "", true, err
+	} else {
+		b.WriteString(s)
+	}
+	return b.String(), true, nil
+}
+
+// renderBudget12Source is the template RenderBudget12 was generated from.
+const renderBudget12Source = "<%= partial(\"node.html\", {\"node\": list}) %>"
+
+// renderBudget12Fragment0 compiles the statement on line 1 for the VM.
+var renderBudget12Fragment0 = sync.OnceValues(func() (*vmplush.Template, error) {
+	return vmplush.CompileAt("<%= partial(\"node.html\", {\"node\": list}) %>", 1)
+})
+
+// RenderBudget12 renders parity_budget_test.go to w.
+func RenderBudget12(w io.Writer, ctx hctx.Context) error {
+	out, ok, err := renderBudget12Static(ctx)
+	if !ok {
+		out, err = vmplush.Render(renderBudget12Source, ctx)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderBudget12Static renders the template with the declared types. It reports false
+// when the context does not hold them or holds nil pointers.
+func renderBudget12Static(ctx hctx.Context) (string, bool, error) {
+	var b bytes.Buffer
+	if t, err := renderBudget12Fragment0(); err != nil {
+		return "", true, err
+	} else if s, err := t.Render(ctx); err != nil {
+		return "", true, err
+	} else {
+		b.WriteString(s)
+	}
+	return b.String(), true, nil
+}
@@ -0,0 +1,436 @@
+// Code generated by go test ./vm/plush -update-gen. DO NOT EDIT.
+
+package genparity
+
+import (
+	"bytes"
+	"github.com/gobuffalo/plush/v5/helpers/hctx"
+	vmplush "github.com/gobuffalo/plush/v5/vm/plush"
+	"io"
+	"sync"
+)
+
+// renderConditions1Source is the template RenderConditions1 was generated from.
+const renderConditions1Source = "<%= paths == nil %>"
+
+// renderConditions1Fragment0 compiles the statement on line 1 for the VM.
+var renderConditions1Fragment0 = sync.OnceValues(func() (*vmplush.Template, error) {
+	return vmplush.CompileAt("<%= paths == nil %>", 1)
+})
+
+// RenderConditions1 renders parity_conditions_test.go to w.
+func RenderConditions1(w io.Writer, ctx hctx.Context) error {
+	out, ok, err := renderConditions1Static(ctx)
+	if !ok {
+		out, err = vmplush.Render(renderConditions1Source, ctx)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderConditions1Static renders the template with the declared types. It reports false
+// when the context does not hold them or holds nil pointers.
+func renderConditions1Static(ctx hctx.Context) (string, bool, error) {
+	var b bytes.Buffer
+	if t, err := renderConditions1Fragment0(); err != nil {
+		return "", true, err
+	} else if s, err := t.Render(ctx); err != nil {
+		return "", true, err
+	} else {
+		b.WriteString(s)
+	}
+	return b.String(), true, nil
+}
+
+// renderConditions2Source is the template RenderConditions2 was generated from.
+const renderConditions2Source = "<%= nil == paths %>"
+
+// renderConditions2Fragment0 compiles the statement on line 1 for the VM.
+var renderConditions2Fragment0 = sync.OnceValues(func() (*vmplush.Template, error) {
+	return vmplush.CompileAt("<%= nil == paths %>", 1)
+})
+
+// RenderConditions2 renders parity_conditions_test.go to w.
+func RenderConditions2(w io.Writer, ctx hctx.Context) error {
+	out, ok, err := renderConditions2Static(ctx)
+	if !ok {
+		out, err = vmplush.Render(renderConditions2Source, ctx)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderConditions2Static renders the template with the declared types. It reports false
+// when the context does not hold them or holds nil pointers.
+func renderConditions2Static(ctx hctx.Context) (string, bool, error) {
+	var b bytes.Buffer
+	if t, err := renderConditions2Fragment0(); err != nil {
+		return "", true, err
+	} else if s, err := t.Render(ctx); err != nil {
+		return "", true, err
+	} else {
+		b.WriteString(s)
+	}
+	return b.String(), true, nil
+}
+
+// renderConditions3Source is the template RenderConditions3 was generated from.
+const renderConditions3Source = "<%= !paths %>"
+
+// renderConditions3Fragment0 compiles the statement on line 1 for the VM.
+var renderConditions3Fragment0 = sync.OnceValues(func() (*vmplush.Template, error) {
+	return vmplush.CompileAt("<%= !paths %>", 1)
+})
+
+// RenderConditions3 renders parity_conditions_test.go to w.
+func RenderConditions3(w io.Writer, ctx hctx.Context) error {
+	out, ok, err := renderConditions3Static(ctx)
+	if !ok {
+		out, err = vmplush.Render(renderConditions3Source, ctx)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderConditions3Static renders the template with the declared types. It reports false
+// when the context does not hold them or holds nil pointers.
+func renderConditions3Static(ctx hctx.Context) (string, bool, error) {
+	var b bytes.Buffer
+	if t, err := renderConditions3Fragment0(); err != nil {
+		return "", true, err
+	} else if s, err := t.Render(ctx); err != nil {
+		return "", true, err
+	} else {
+		b.WriteString(s)
+	}
+	return b.String(), true, nil
+}
+
+// renderConditions4Source is the template RenderConditions4 was generated from.
+const renderConditions4Source = "<%= if (paths) { %>yes<% } else { %>no<% } %>"
+
+// renderConditions4Fragment0 compiles the statement on line 1 for the VM.
+var renderConditions4Fragment0 = sync.OnceValues(func() (*vmplush.Template, error) {
+	return vmplush.CompileAt("<%= if (paths) { %>yes<% } else { %>no<% } %>", 1)
+})
+
+// RenderConditions4 renders parity_conditions_test.go to w.
+func RenderConditions4(w io.Writer, ctx hctx.Context) error {
+	out, ok, err := renderConditions4Static(ctx)
+	if !ok {
+		out, err = vmplush.Render(renderConditions4Source, ctx)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderConditions4Static renders the template with the declared types. It reports false
+// when the context does not hold them or holds nil pointers.
+func renderConditions4Static(ctx hctx.Context) (string, bool, error) {
+	var b bytes.Buffer
+	if t, err := renderConditions4Fragment0(); err != nil {
+		return "", true, err
+	} else if s, err := t.Render(ctx); err != nil {
+		return "", true, err
+	} else {
+		b.WriteString(s)
+	}
+	return b.String(), true, nil
+}
+
+// renderConditions5Source is the template RenderConditions5 was generated from.
+const renderConditions5Source = "<%= if (username && username != \"\") { return \"hi\" } else { return \"bye\" } %>"
+
+// RenderConditions5 renders parity_conditions_test.go to w.
+//
+// The template is rendered by the VM: it returns early.
+func RenderConditions5(w io.Writer, ctx hctx.Context) error {
+	out, err := vmplush.Render(renderConditions5Source, ctx)
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderConditions6Source is the template RenderConditions6 was generated from.
+const renderConditions6Source = "<p><%= if (username && username != \"\") { username = \"hi\" } else { username = \"bye\" } %><%= username %></p>"
+
+// RenderConditions6 renders parity_conditions_test.go to w.
+//
+// The template is rendered by the VM: it assigns variables.
+func RenderConditions6(w io.Writer, ctx hctx.Context) error {
+	out, err := vmplush.Render(renderConditions6Source, ctx)
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderConditions7Source is the template RenderConditions7 was generated from.
+const renderConditions7Source = "<p><% let username = \"Hello World\" %><%= if (username && username != \"\") {\n\t\tlet username = \"hi\"\n\t\tusername = \"hi\"\n\t} else {\n\t\tlet username = \"bye\"\n\t\tusername = \"1\"\n\t} %><%= username %></p>"
+
+// RenderConditions7 renders parity_conditions_test.go to w.
+//
+// The template is rendered by the VM: it declares variables with let.
+func RenderConditions7(w io.Writer, ctx hctx.Context) error {
+	out, err := vmplush.Render(renderConditions7Source, ctx)
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderConditions8Source is the template RenderConditions8 was generated from.
+const renderConditions8Source = "<p><% let username = \"Hello World\" %><%= if (username && username != \"\") {\n\t\tusername = \"hi\"\n\t\tif (username == \"hi\") {\n\t\t\tusername = \"hi2\"\n\t\t}\n\t} else {\n\t\tlet username = \"bye\"\n\t\tusername = \"1\"\n\t} %><%= username %></p>"
+
+// RenderConditions8 renders parity_conditions_test.go to w.
+//
+// The template is rendered by the VM: it declares variables with let.
+func RenderConditions8(w io.Writer, ctx hctx.Context) error {
+	out, err := vmplush.Render(renderConditions8Source, ctx)
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderConditions9Source is the template RenderConditions9 was generated from.
+const renderConditions9Source = "<%= user.Name %>:<%= if (user.Image) { return \"has image\" } else { return \"no image\" } %>"
+
+// RenderConditions9 renders parity_conditions_test.go to w.
+//
+// The template is rendered by the VM: it returns early.
+func RenderConditions9(w io.Writer, ctx hctx.Context) error {
+	out, err := vmplush.Render(renderConditions9Source, ctx)
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderConditions10Source is the template RenderConditions10 was generated from.
+const renderConditions10Source = "<%= if (len(record.Nodes) > 0 && record.Nodes[0].Label) { %>present<% } else { %>empty<% } %>"
+
+// renderConditions10Fragment0 compiles the statement on line 1 for the VM.
+var renderConditions10Fragment0 = sync.OnceValues(func() (*vmplush.Template, error) {
+	return vmplush.CompileAt("<%= if (len(record.Nodes) > 0 && record.Nodes[0].Label) { %>present<% } else { %>empty<% } %>", 1)
+})
+
+// RenderConditions10 renders parity_conditions_test.go to w.
+func RenderConditions10(w io.Writer, ctx hctx.Context) error {
+	out, ok, err := renderConditions10Static(ctx)
+	if !ok {
+		out, err = vmplush.Render(renderConditions10Source, ctx)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderConditions10Static renders the template with the declared types. It reports false
+// when the context does not hold them or holds nil pointers.
+func renderConditions10Static(ctx hctx.Context) (string, bool, error) {
+	var b bytes.Buffer
+	if t, err := renderConditions10Fragment0(); err != nil {
+		return "", true, err
+	} else if s, err := t.Render(ctx); err != nil {
+		return "", true, err
+	} else {
+		b.WriteString(s)
+	}
+	return b.String(), true, nil
+}
+
+// renderConditions11Source is the template RenderConditions11 was generated from.
+const renderConditions11Source = "<%= if (names && len(names) >= 1) { %>yes<% } else { %>no<% } %>"
+
+// renderConditions11Fragment0 compiles the statement on line 1 for the VM.
+var renderConditions11Fragment0 = sync.OnceValues(func() (*vmplush.Template, error) {
+	return vmplush.CompileAt("<%= if (names && len(names) >= 1) { %>yes<% } else { %>no<% } %>", 1)
+})
+
+// RenderConditions11 renders parity_conditions_test.go to w.
+func RenderConditions11(w io.Writer, ctx hctx.Context) error {
+	out, ok, err := renderConditions11Static(ctx)
+	if !ok {
+		out, err = vmplush.Render(renderConditions11Source, ctx)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderConditions11Static renders the template with the declared types. It reports false
+// when the context does not hold them or holds nil pointers.
+func renderConditions11Static(ctx hctx.Context) (string, bool, error) {
+	var b bytes.Buffer
+	if t, err := renderConditions11Fragment0(); err != nil {
+		return "", true, err
+	} else if s, err := t.Render(ctx); err != nil {
+		return "", true, err
+	} else {
+		b.WriteString(s)
+	}
+	return b.String(), true, nil
+}
+
+// renderConditions12Source is the template RenderConditions12 was generated from.
+const renderConditions12Source = "<%= paths || pages %>"
+
+// renderConditions12Fragment0 compiles the statement on line 1 for the VM.
+var renderConditions12Fragment0 = sync.OnceValues(func() (*vmplush.Template, error) {
+	return vmplush.CompileAt("<%= paths || pages %>", 1)
+})
+
+// RenderConditions12 renders parity_conditions_test.go to w.
+func RenderConditions12(w io.Writer, ctx hctx.Context) error {
+	out, ok, err := renderConditions12Static(ctx)
+	if !ok {
+		out, err = vmplush.Render(renderConditions12Source, ctx)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderConditions12Static renders the template with the declared types. It reports false
+// when the context does not hold them or holds nil pointers.
+func renderConditions12Static(ctx hctx.Context) (string, bool, error) {
+	var b bytes.Buffer
+	if t, err := renderConditions12Fragment0(); err != nil {
+		return "", true, err
+	} else if s, err := t.Render(ctx); err != nil {
+		return "", true, err
+	} else {
+		b.WriteString(s)
+	}
+	return b.String(), true, nil
+}
+
+// renderConditions13Source is the template RenderConditions13 was generated from.
+const renderConditions13Source = "<%= pages || paths %>"
+
+// renderConditions13Fragment0 compiles the statement on line 1 for the VM.
+var renderConditions13Fragment0 = sync.OnceValues(func() (*vmplush.Template, error) {
+	return vmplush.CompileAt("<%= pages || paths %>", 1)
+})
+
+// RenderConditions13 renders parity_conditions_test.go to w.
+func RenderConditions13(w io.Writer, ctx hctx.Context) error {
+	out, ok, err := renderConditions13Static(ctx)
+	if !ok {
+		out, err = vmplush.Render(renderConditions13Source, ctx)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderConditions13Static renders the template with the declared types. It reports false
+// when the context does not hold them or holds nil pointers.
+func renderConditions13Static(ctx hctx.Context) (string, bool, error) {
+	var b bytes.Buffer
+	if t, err := renderConditions13Fragment0(); err != nil {
+		return "", true, err
+	} else if s, err := t.Render(ctx); err != nil {
+		return "", true, err
+	} else {
+		b.WriteString(s)
+	}
+	return b.String(), true, nil
+}
+
+// renderConditions14Source is the template RenderConditions14 was generated from.
+const renderConditions14Source = "<%= paths && pages %>"
+
+// renderConditions14Fragment0 compiles the statement on line 1 for the VM.
+var renderConditions14Fragment0 = sync.OnceValues(func() (*vmplush.Template, error) {
+	return vmplush.CompileAt("<%= paths && pages %>", 1)
+})
+
+// RenderConditions14 renders parity_conditions_test.go to w.
+func RenderConditions14(w io.Writer, ctx hctx.Context) error {
+	out, ok, err := renderConditions14Static(ctx)
+	if !ok {
+		out, err = vmplush.Render(renderConditions14Source, ctx)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderConditions14Static renders the template with the declared types. It reports false
+// when the context does not hold them or holds nil pointers.
+func renderConditions14Static(ctx hctx.Context) (string, bool, error) {
+	var b bytes.Buffer
+	if t, err := renderConditions14Fragment0(); err != nil {
+		return "", true, err
+	} else if s, err := t.Render(ctx); err != nil {
+		return "", true, err
+	} else {
+		b.WriteString(s)
+	}
+	return b.String(), true, nil
+}
+
+// renderConditions15Source is the template RenderConditions15 was generated from.
+const renderConditions15Source = "<%= if (paths == \"cart\" || (page && page.PageTitle != \"cafe\") || paths == \"cart\") { %>hi<% } %>"
+
+// renderConditions15Fragment0 compiles the statement on line 1 for the VM.
+var renderConditions15Fragment0 = sync.OnceValues(func() (*vmplush.Template, error) {
+	return vmplush.CompileAt("<%= if (paths == \"cart\" || (page && page.PageTitle != \"cafe\") || paths == \"cart\") { %>hi<% } %>", 1)
+})
+
+// RenderConditions15 renders parity_conditions_test.go to w.
+func RenderConditions15(w io.Writer, ctx hctx.Context) error {
+	out, ok, err := renderConditions15Static(ctx)
+	if !ok {
+		out, err = vmplush.Render(renderConditions15Source, ctx)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderConditions15Static renders the template with the declared types. It reports false
+// when the context does not hold them or holds nil pointers.
+func renderConditions15Static(ctx hctx.Context) (string, bool, error) {
+	var b bytes.Buffer
+	if t, err := renderConditions15Fragment0(); err != nil {
+		return "", true, err
+	} else if s, err := t.Render(ctx); err != nil {
+		return "", true, err
+	} else {
+		b.WriteString(s)
+	}
+	return b.String(), true, nil
+}
@@ -0,0 +1,452 @@
+// Code generated by go test ./vm/plush -update-gen. DO NOT EDIT.
+
+package genparity
+
+import (
+	"bytes"
+	"github.com/gobuffalo/plush/v5/helpers/hctx"
+	vmplush "github.com/gobuffalo/plush/v5/vm/plush"
+	"io"
+	"sync"
+)
+
+// renderFunctions1Source is the template RenderFunctions1 was generated from.
+const renderFunctions1Source = "<%= greet(name) %>"
+
+// renderFunctions1Fragment0 compiles the statement on line 1 for the VM.
+var renderFunctions1Fragment0 = sync.OnceValues(func() (*vmplush.Template, error) {
+	return vmplush.CompileAt("<%= greet(name) %>", 1)
+})
+
+// RenderFunctions1 renders parity_functions_test.go to w.
+func RenderFunctions1(w io.Writer, ctx hctx.Context) error {
+	out, ok, err := renderFunctions1Static(ctx)
+	if !ok {
+		out, err = vmplush.Render(renderFunctions1Source, ctx)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderFunctions1Static renders the template with the declared types. It reports false
+// when the context does not hold them or holds nil pointers.
+func renderFunctions1Static(ctx hctx.Context) (string, bool, error) {
+	var b bytes.Buffer
+	if t, err := renderFunctions1Fragment0(); err != nil {
+		return "", true, err
+	} else if s, err := t.Render(ctx); err != nil {
+		return "", true, err
+	} else {
+		b.WriteString(s)
+	}
+	return b.String(), true, nil
+}
+
+// renderFunctions2Source is the template RenderFunctions2 was generated from.
+const renderFunctions2Source = "<%= render_section(section) %>"
+
+// renderFunctions2Fragment0 compiles the statement on line 1 for the VM.
+var renderFunctions2Fragment0 = sync.OnceValues(func() (*vmplush.Template, error) {
+	return vmplush.CompileAt("<%= render_section(section) %>", 1)
+})
+
+// RenderFunctions2 renders parity_functions_test.go to w.
+func RenderFunctions2(w io.Writer, ctx hctx.Context) error {
+	out, ok, err := renderFunctions2Static(ctx)
+	if !ok {
+		out, err = vmplush.Render(renderFunctions2Source, ctx)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderFunctions2Static renders the template with the declared types. It reports false
+// when the context does not hold them or holds nil pointers.
+func renderFunctions2Static(ctx hctx.Context) (string, bool, error) {
+	var b bytes.Buffer
+	if t, err := renderFunctions2Fragment0(); err != nil {
+		return "", true, err
+	} else if s, err := t.Render(ctx); err != nil {
+		return "", true, err
+	} else {
+		b.WriteString(s)
+	}
+	return b.String(), true, nil
+}
+
+// renderFunctions3Source is the template RenderFunctions3 was generated from.
+const renderFunctions3Source = "<% let add = fn(x) { return x + 2 } %><%= add(3) %>"
+
+// RenderFunctions3 renders parity_functions_test.go to w.
+//
+// The template is rendered by the VM: it declares variables with let.
+func RenderFunctions3(w io.Writer, ctx hctx.Context) error {
+	out, err := vmplush.Render(renderFunctions3Source, ctx)
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderFunctions4Source is the template RenderFunctions4 was generated from.
+const renderFunctions4Source = "<p><%= f() %></p>"
+
+// renderFunctions4Fragment0 compiles the statement on line 1 for the VM.
+var renderFunctions4Fragment0 = sync.OnceValues(func() (*vmplush.Template, error) {
+	return vmplush.CompileAt("<%= f() %>", 1)
+})
+
+// RenderFunctions4 renders parity_functions_test.go to w.
+func RenderFunctions4(w io.Writer, ctx hctx.Context) error {
+	out, ok, err := renderFunctions4Static(ctx)
+	if !ok {
+		out, err = vmplush.Render(renderFunctions4Source, ctx)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderFunctions4Static renders the template with the declared types. It reports false
+// when the context does not hold them or holds nil pointers.
+func renderFunctions4Static(ctx hctx.Context) (string, bool, error) {
+	var b bytes.Buffer
+	b.WriteString("<p>")
+	if t, err := renderFunctions4Fragment0(); err != nil {
+		return "", true, err
+	} else if s, err := t.Render(ctx); err != nil {
+		return "", true, err
+	} else {
+		b.WriteString(s)
+	}
+	b.WriteString("</p>")
+	return b.String(), true, nil
+}
+
+// renderFunctions5Source is the template RenderFunctions5 was generated from.
+const renderFunctions5Source = "<p><%= fail() %></p>"
+
+// renderFunctions5Fragment0 compiles the statement on line 1 for the VM.
+var renderFunctions5Fragment0 = sync.OnceValues(func() (*vmplush.Template, error) {
+	return vmplush.CompileAt("<%= fail() %>", 1)
+})
+
+// RenderFunctions5 renders parity_functions_test.go to w.
+func RenderFunctions5(w io.Writer, ctx hctx.Context) error {
+	out, ok, err := renderFunctions5Static(ctx)
+	if !ok {
+		out, err = vmplush.Render(renderFunctions5Source, ctx)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderFunctions5Static renders the template with the declared types. It reports false
+// when the context does not hold them or holds nil pointers.
+func renderFunctions5Static(ctx hctx.Context) (string, bool, error) {
+	var b bytes.Buffer
+	b.WriteString("<p>")
+	if t, err := renderFunctions5Fragment0(); err != nil {
+		return "", true, err
+	} else if s, err := t.Render(ctx); err != nil {
+		return "", true, err
+	} else {
+		b.WriteString(s)
+	}
+	b.WriteString("</p>")
+	return b.String(), true, nil
+}
+
+// renderFunctions6Source is the template RenderFunctions6 was generated from.
+const renderFunctions6Source = "<%= lookup(nil, \"k\") %><%= lookup(one, \"k\") %>"
+
+// renderFunctions6Fragment0 compiles the statement on line 1 for the VM.
+var renderFunctions6Fragment0 = sync.OnceValues(func() (*vmplush.Template, error) {
+	return vmplush.CompileAt("<%= lookup(nil, \"k\") %>", 1)
+})
+
+// renderFunctions6Fragment1 compiles the statement on line 1 for the VM.
+var renderFunctions6Fragment1 = sync.OnceValues(func() (*vmplush.Template, error) {
+	return vmplush.CompileAt("<%= lookup(one, \"k\") %>", 1)
+})
+
+// RenderFunctions6 renders parity_functions_test.go to w.
+func RenderFunctions6(w io.Writer, ctx hctx.Context) error {
+	out, ok, err := renderFunctions6Static(ctx)
+	if !ok {
+		out, err = vmplush.Render(renderFunctions6Source, ctx)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderFunctions6Static renders the template with the declared types. It reports false
+// when the context does not hold them or holds nil pointers.
+func renderFunctions6Static(ctx hctx.Context) (string, bool, error) {
+	var b bytes.Buffer
+	if t, err := renderFunctions6Fragment0(); err != nil {
+		return "", true, err
+	} else if s, err := t.Render(ctx); err != nil {
+		return "", true, err
+	} else {
+		b.WriteString(s)
+	}
+	if t, err := renderFunctions6Fragment1(); err != nil {
+		return "", true, err
+	} else if s, err := t.Render(ctx); err != nil {
+		return "", true, err
+	} else {
+		b.WriteString(s)
+	}
+	return b.String(), true, nil
+}
+
+// renderFunctions7Source is the template RenderFunctions7 was generated from.
+const renderFunctions7Source = "<%= foo(bar) %>"
+
+// renderFunctions7Fragment0 compiles the statement on line 1 for the VM.
+var renderFunctions7Fragment0 = sync.OnceValues(func() (*vmplush.Template, error) {
+	return vmplush.CompileAt("<%= foo(bar) %>", 1)
+})
+
+// RenderFunctions7 renders parity_functions_test.go to w.
+func RenderFunctions7(w io.Writer, ctx hctx.Context) error {
+	out, ok, err := renderFunctions7Static(ctx)
+	if !ok {
+		out, err = vmplush.Render(renderFunctions7Source, ctx)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderFunctions7Static renders the template with the declared types. It reports false
+// when the context does not hold them or holds nil pointers.
+func renderFunctions7Static(ctx hctx.Context) (string, bool, error) {
+	var b bytes.Buffer
+	if t, err := renderFunctions7Fragment0(); err != nil {
+		return "", true, err
+	} else if s, err := t.Render(ctx); err != nil {
+		return "", true, err
+	} else {
+		b.WriteString(s)
+	}
+	return b.String(), true, nil
+}
+
+// renderFunctions8Source is the template RenderFunctions8 was generated from.
+const renderFunctions8Source = "<%\nlet numberify = fn(arg) {\n\tif (arg == \"one\") {\n\t\treturn 1 + 1\n\t}\n\tif (arg == \"two\") {\n\t\treturn 44\n\t}\n\treturn \"unsupported\"\n} %><%= numberify(\"one\") %>"
+
+// RenderFunctions8 renders parity_functions_test.go to w.
+//
+// The template is rendered by the VM: it declares variables with let.
+func RenderFunctions8(w io.Writer, ctx hctx.Context) error {
+	out, err := vmplush.Render(renderFunctions8Source, ctx)
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderFunctions9Source is the template RenderFunctions9 was generated from.
+const renderFunctions9Source = "<%\nlet print = fn(obj) {\n\tif (obj.Secret) {\n\t\tif (obj.GiveHint) {\n\t\t\treturn truncate(obj.String, {size: 12, trail: \"****\"})\n\t\t}\n\t\treturn \"**********\"\n\t}\n\treturn obj.String\n}\n%>You are: <%= print(data) %>."
+
+// RenderFunctions9 renders parity_functions_test.go to w.
+//
+// The template is rendered by the VM: it declares variables with let.
+func RenderFunctions9(w io.Writer, ctx hctx.Context) error {
+	out, err := vmplush.Render(renderFunctions9Source, ctx)
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderFunctions10Source is the template RenderFunctions10 was generated from.
+const renderFunctions10Source = "<p><%= g.Greet(\"mark\") %></p>"
+
+// renderFunctions10Fragment0 compiles the statement on line 1 for the VM.
+var renderFunctions10Fragment0 = sync.OnceValues(func() (*vmplush.Template, error) {
+	return vmplush.CompileAt("<%= g.Greet(\"mark\") %>", 1)
+})
+
+// RenderFunctions10 renders parity_functions_test.go to w.
+func RenderFunctions10(w io.Writer, ctx hctx.Context) error {
+	out, ok, err := renderFunctions10Static(ctx)
+	if !ok {
+		out, err = vmplush.Render(renderFunctions10Source, ctx)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderFunctions10Static renders the template with the declared types. It reports false
+// when the context does not hold them or holds nil pointers.
+func renderFunctions10Static(ctx hctx.Context) (string, bool, error) {
+	var b bytes.Buffer
+	b.WriteString("<p>")
+	if t, err := renderFunctions10Fragment0(); err != nil {
+		return "", true, err
+	} else if s, err := t.Render(ctx); err != nil {
+		return "", true, err
+	} else {
+		b.WriteString(s)
+	}
+	b.WriteString("</p>")
+	return b.String(), true, nil
+}
+
+// renderFunctions11Source is the template RenderFunctions11 was generated from.
+const renderFunctions11Source = "<%= foo() %>|<%= bar({a: \"A\"}) %>"
+
+// renderFunctions11Fragment0 compiles the statement on line 1 for the VM.
+var renderFunctions11Fragment0 = sync.OnceValues(func() (*vmplush.Template, error) {
+	return vmplush.CompileAt("<%= foo() %>", 1)
+})
+
+// renderFunctions11Fragment1 compiles the statement on line 1 for the VM.
+var renderFunctions11Fragment1 = sync.OnceValues(func() (*vmplush.Template, error) {
+	return vmplush.CompileAt("<%= bar({a: \"A\"}) %>", 1)
+})
+
+// RenderFunctions11 renders parity_functions_test.go to w.
+func RenderFunctions11(w io.Writer, ctx hctx.Context) error {
+	out, ok, err := renderFunctions11Static(ctx)
+	if !ok {
+		out, err = vmplush.Render(renderFunctions11Source, ctx)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderFunctions11Static renders the template with the declared types. It reports false
+// when the context does not hold them or holds nil pointers.
+func renderFunctions11Static(ctx hctx.Context) (string, bool, error) {
+	var b bytes.Buffer
+	if t, err := renderFunctions11Fragment0(); err != nil {
+		return "", true, err
+	} else if s, err := t.Render(ctx); err != nil {
+		return "", true, err
+	} else {
+		b.WriteString(s)
+	}
+	b.WriteString("|")
+	if t, err := renderFunctions11Fragment1(); err != nil {
+		return "", true, err
+	} else if s, err := t.Render(ctx); err != nil {
+		return "", true, err
+	} else {
+		b.WriteString(s)
+	}
+	return b.String(), true, nil
+}
+
+// renderFunctions12Source is the template RenderFunctions12 was generated from.
+const renderFunctions12Source = "<%= my-helper() %>"
+
+// renderFunctions12Fragment0 compiles the statement on line 1 for the VM.
+var renderFunctions12Fragment0 = sync.OnceValues(func() (*vmplush.Template, error) {
+	return vmplush.CompileAt("<%= my-helper() %>", 1)
+})
+
+// RenderFunctions12 renders parity_functions_test.go to w.
+func RenderFunctions12(w io.Writer, ctx hctx.Context) error {
+	out, ok, err := renderFunctions12Static(ctx)
+	if !ok {
+		out, err = vmplush.Render(renderFunctions12Source, ctx)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderFunctions12Static renders the template with the declared types. It reports false
+// when the context does not hold them or holds nil pointers.
+func renderFunctions12Static(ctx hctx.Context) (string, bool, error) {
+	var b bytes.Buffer
+	if t, err := renderFunctions12Fragment0(); err != nil {
+		return "", true, err
+	} else if s, err := t.Render(ctx); err != nil {
+		return "", true, err
+	} else {
+		b.WriteString(s)
+	}
+	return b.String(), true, nil
+}
+
+// renderFunctions13Source is the template RenderFunctions13 was generated from.
+const renderFunctions13Source = "<% let countdown = fn(x) { if (x == 0) { return 0 } return countdown(x - 1) } %><%= countdown(3) %>"
+
+// RenderFunctions13 renders parity_functions_test.go to w.
+//
+// The template is rendered by the VM: it declares variables with let.
+func RenderFunctions13(w io.Writer, ctx hctx.Context) error {
+	out, err := vmplush.Render(renderFunctions13Source, ctx)
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderFunctions14Source is the template RenderFunctions14 was generated from.
+const renderFunctions14Source = "<%= raw(`CREATE VIEW x AS SELECT \"name\" FROM things`) %>"
+
+// renderFunctions14Fragment0 compiles the statement on line 1 for the VM.
+var renderFunctions14Fragment0 = sync.OnceValues(func() (*vmplush.Template, error) {
+	return vmplush.CompileAt("<%= raw(`CREATE VIEW x AS SELECT \"name\" FROM things`) %>", 1)
+})
+
+// RenderFunctions14 renders parity_functions_test.go to w.
+func RenderFunctions14(w io.Writer, ctx hctx.Context) error {
+	out, ok, err := renderFunctions14Static(ctx)
+	if !ok {
+		out, err = vmplush.Render(renderFunctions14Source, ctx)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderFunctions14Static renders the template with the declared types. It reports false
+// when the context does not hold them or holds nil pointers.
+func renderFunctions14Static(ctx hctx.Context) (string, bool, error) {
+	var b bytes.Buffer
+	if t, err := renderFunctions14Fragment0(); err != nil {
+		return "", true, err
+	} else if s, err := t.Render(ctx); err != nil {
+		return "", true, err
+	} else {
+		b.WriteString(s)
+	}
+	return b.String(), true, nil
+}
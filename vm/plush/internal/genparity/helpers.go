@@ -0,0 +1,726 @@
+// Code generated by go test ./vm/plush -update-gen. DO NOT EDIT.
+
+package genparity
+
+import (
+	"bytes"
+	"github.com/gobuffalo/plush/v5/helpers/hctx"
+	vmplush "github.com/gobuffalo/plush/v5/vm/plush"
+	"html/template"
+	"io"
+	"sync"
+)
+
+// renderHelpers1Source is the template RenderHelpers1 was generated from.
+const renderHelpers1Source = "<%= greet(first + last) %>"
+
+// renderHelpers1Fragment0 compiles the statement on line 1 for the VM.
+var renderHelpers1Fragment0 = sync.OnceValues(func() (*vmplush.Template, error) {
+	return vmplush.CompileAt("<%= greet(first + last) %>", 1)
+})
+
+// RenderHelpers1 renders parity_helpers_test.go to w.
+func RenderHelpers1(w io.Writer, ctx hctx.Context) error {
+	out, ok, err := renderHelpers1Static(ctx)
+	if !ok {
+		out, err = vmplush.Render(renderHelpers1Source, ctx)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderHelpers1Static renders the template with the declared types. It reports false
+// when the context does not hold them or holds nil pointers.
+func renderHelpers1Static(ctx hctx.Context) (string, bool, error) {
+	var b bytes.Buffer
+	if t, err := renderHelpers1Fragment0(); err != nil {
+		return "", true, err
+	} else if s, err := t.Render(ctx); err != nil {
+		return "", true, err
+	} else {
+		b.WriteString(s)
+	}
+	return b.String(), true, nil
+}
+
+// renderHelpers2Source is the template RenderHelpers2 was generated from.
+const renderHelpers2Source = "<%= count(1, 2, 3) %>|<%= prefix(\"a\", \"b\", \"c\") %>|<%= empty() %>|<%= fixed(4) %>"
+
+// renderHelpers2Fragment0 compiles the statement on line 1 for the VM.
+var renderHelpers2Fragment0 = sync.OnceValues(func() (*vmplush.Template, error) {
+	return vmplush.CompileAt("<%= count(1, 2, 3) %>", 1)
+})
+
+// renderHelpers2Fragment1 compiles the statement on line 1 for the VM.
+var renderHelpers2Fragment1 = sync.OnceValues(func() (*vmplush.Template, error) {
+	return vmplush.CompileAt("<%= prefix(\"a\", \"b\", \"c\") %>", 1)
+})
+
+// renderHelpers2Fragment2 compiles the statement on line 1 for the VM.
+var renderHelpers2Fragment2 = sync.OnceValues(func() (*vmplush.Template, error) {
+	return vmplush.CompileAt("<%= empty() %>", 1)
+})
+
+// renderHelpers2Fragment3 compiles the statement on line 1 for the VM.
+var renderHelpers2Fragment3 = sync.OnceValues(func() (*vmplush.Template, error) {
+	return vmplush.CompileAt("<%= fixed(4) %>", 1)
+})
+
+// RenderHelpers2 renders parity_helpers_test.go to w.
+func RenderHelpers2(w io.Writer, ctx hctx.Context) error {
+	out, ok, err := renderHelpers2Static(ctx)
+	if !ok {
+		out, err = vmplush.Render(renderHelpers2Source, ctx)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderHelpers2Static renders the template with the declared types. It reports false
+// when the context does not hold them or holds nil pointers.
+func renderHelpers2Static(ctx hctx.Context) (string, bool, error) {
+	var b bytes.Buffer
+	if t, err := renderHelpers2Fragment0(); err != nil {
+		return "", true, err
+	} else if s, err := t.Render(ctx); err != nil {
+		return "", true, err
+	} else {
+		b.WriteString(s)
+	}
+	b.WriteString("|")
+	if t, err := renderHelpers2Fragment1(); err != nil {
+		return "", true, err
+	} else if s, err := t.Render(ctx); err != nil {
+		return "", true, err
+	} else {
+		b.WriteString(s)
+	}
+	b.WriteString("|")
+	if t, err := renderHelpers2Fragment2(); err != nil {
+		return "", true, err
+	} else if s, err := t.Render(ctx); err != nil {
+		return "", true, err
+	} else {
+		b.WriteString(s)
+	}
+	b.WriteString("|")
+	if t, err := renderHelpers2Fragment3(); err != nil {
+		return "", true, err
+	} else if s, err := t.Render(ctx); err != nil {
+		return "", true, err
+	} else {
+		b.WriteString(s)
+	}
+	return b.String(), true, nil
+}
+
+// renderHelpers3Source is the template RenderHelpers3 was generated from.
+const renderHelpers3Source = "<%= count(1, 2, \"bad\") %>"
+
+// renderHelpers3Fragment0 compiles the statement on line 1 for the VM.
+var renderHelpers3Fragment0 = sync.OnceValues(func() (*vmplush.Template, error) {
+	return vmplush.CompileAt("<%= count(1, 2, \"bad\") %>", 1)
+})
+
+// RenderHelpers3 renders parity_helpers_test.go to w.
+func RenderHelpers3(w io.Writer, ctx hctx.Context) error {
+	out, ok, err := renderHelpers3Static(ctx)
+	if !ok {
+		out, err = vmplush.Render(renderHelpers3Source, ctx)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderHelpers3Static renders the template with the declared types. It reports false
+// when the context does not hold them or holds nil pointers.
+func renderHelpers3Static(ctx hctx.Context) (string, bool, error) {
+	var b bytes.Buffer
+	if t, err := renderHelpers3Fragment0(); err != nil {
+		return "", true, err
+	} else if s, err := t.Render(ctx); err != nil {
+		return "", true, err
+	} else {
+		b.WriteString(s)
+	}
+	return b.String(), true, nil
+}
+
+// renderHelpers4Source is the template RenderHelpers4 was generated from.
+const renderHelpers4Source = "<%= remember(\"x\") %><%= value %>"
+
+// renderHelpers4Fragment0 compiles the statement on line 1 for the VM.
+var renderHelpers4Fragment0 = sync.OnceValues(func() (*vmplush.Template, error) {
+	return vmplush.CompileAt("<%= remember(\"x\") %>", 1)
+})
+
+// RenderHelpers4 renders parity_helpers_test.go to w.
+func RenderHelpers4(w io.Writer, ctx hctx.Context) error {
+	out, ok, err := renderHelpers4Static(ctx)
+	if !ok {
+		out, err = vmplush.Render(renderHelpers4Source, ctx)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderHelpers4Static renders the template with the declared types. It reports false
+// when the context does not hold them or holds nil pointers.
+func renderHelpers4Static(ctx hctx.Context) (string, bool, error) {
+	v_value, ok := ctx.Value("value").(string)
+	if !ok {
+		return "", false, nil
+	}
+	var b bytes.Buffer
+	if t, err := renderHelpers4Fragment0(); err != nil {
+		return "", true, err
+	} else if s, err := t.Render(ctx); err != nil {
+		return "", true, err
+	} else {
+		b.WriteString(s)
+	}
+	b.WriteString(template.HTMLEscapeString(v_value))
+	return b.String(), true, nil
+}
+
+// renderHelpers5Source is the template RenderHelpers5 was generated from.
+const renderHelpers5Source = "<%= greet() %>"
+
+// renderHelpers5Fragment0 compiles the statement on line 1 for the VM.
+var renderHelpers5Fragment0 = sync.OnceValues(func() (*vmplush.Template, error) {
+	return vmplush.CompileAt("<%= greet() %>", 1)
+})
+
+// RenderHelpers5 renders parity_helpers_test.go to w.
+func RenderHelpers5(w io.Writer, ctx hctx.Context) error {
+	out, ok, err := renderHelpers5Static(ctx)
+	if !ok {
+		out, err = vmplush.Render(renderHelpers5Source, ctx)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderHelpers5Static renders the template with the declared types. It reports false
+// when the context does not hold them or holds nil pointers.
+func renderHelpers5Static(ctx hctx.Context) (string, bool, error) {
+	var b bytes.Buffer
+	if t, err := renderHelpers5Fragment0(); err != nil {
+		return "", true, err
+	} else if s, err := t.Render(ctx); err != nil {
+		return "", true, err
+	} else {
+		b.WriteString(s)
+	}
+	return b.String(), true, nil
+}
+
+// renderHelpers6Source is the template RenderHelpers6 was generated from.
+const renderHelpers6Source = "<%= fail() %>"
+
+// renderHelpers6Fragment0 compiles the statement on line 1 for the VM.
+var renderHelpers6Fragment0 = sync.OnceValues(func() (*vmplush.Template, error) {
+	return vmplush.CompileAt("<%= fail() %>", 1)
+})
+
+// RenderHelpers6 renders parity_helpers_test.go to w.
+func RenderHelpers6(w io.Writer, ctx hctx.Context) error {
+	out, ok, err := renderHelpers6Static(ctx)
+	if !ok {
+		out, err = vmplush.Render(renderHelpers6Source, ctx)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderHelpers6Static renders the template with the declared types. It reports false
+// when the context does not hold them or holds nil pointers.
+func renderHelpers6Static(ctx hctx.Context) (string, bool, error) {
+	var b bytes.Buffer
+	if t, err := renderHelpers6Fragment0(); err != nil {
+		return "", true, err
+	} else if s, err := t.Render(ctx); err != nil {
+		return "", true, err
+	} else {
+		b.WriteString(s)
+	}
+	return b.String(), true, nil
+}
+
+// renderHelpers7Source is the template RenderHelpers7 was generated from.
+const renderHelpers7Source = "<%= foo() %>|<%= bar({name: \"mark\"}) %>"
+
+// renderHelpers7Fragment0 compiles the statement on line 1 for the VM.
+var renderHelpers7Fragment0 = sync.OnceValues(func() (*vmplush.Template, error) {
+	return vmplush.CompileAt("<%= foo() %>", 1)
+})
+
+// renderHelpers7Fragment1 compiles the statement on line 1 for the VM.
+var renderHelpers7Fragment1 = sync.OnceValues(func() (*vmplush.Template, error) {
+	return vmplush.CompileAt("<%= bar({name: \"mark\"}) %>", 1)
+})
+
+// RenderHelpers7 renders parity_helpers_test.go to w.
+func RenderHelpers7(w io.Writer, ctx hctx.Context) error {
+	out, ok, err := renderHelpers7Static(ctx)
+	if !ok {
+		out, err = vmplush.Render(renderHelpers7Source, ctx)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderHelpers7Static renders the template with the declared types. It reports false
+// when the context does not hold them or holds nil pointers.
+func renderHelpers7Static(ctx hctx.Context) (string, bool, error) {
+	var b bytes.Buffer
+	if t, err := renderHelpers7Fragment0(); err != nil {
+		return "", true, err
+	} else if s, err := t.Render(ctx); err != nil {
+		return "", true, err
+	} else {
+		b.WriteString(s)
+	}
+	b.WriteString("|")
+	if t, err := renderHelpers7Fragment1(); err != nil {
+		return "", true, err
+	} else if s, err := t.Render(ctx); err != nil {
+		return "", true, err
+	} else {
+		b.WriteString(s)
+	}
+	return b.String(), true, nil
+}
+
+// renderHelpers8Source is the template RenderHelpers8 was generated from.
+const renderHelpers8Source = "<%= blockCheck() { return \"block\" } %>|<%= blockCheck() %>"
+
+// RenderHelpers8 renders parity_helpers_test.go to w.
+//
+// The template is rendered by the VM: it calls blockCheck with a block.
+func RenderHelpers8(w io.Writer, ctx hctx.Context) error {
+	out, err := vmplush.Render(renderHelpers8Source, ctx)
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderHelpers9Source is the template RenderHelpers9 was generated from.
+const renderHelpers9Source = "<%= join_values(\"left\", \"right\") %>|<%= join_values(\"left\", \"right\", \"wide\") %>"
+
+// renderHelpers9Fragment0 compiles the statement on line 1 for the VM.
+var renderHelpers9Fragment0 = sync.OnceValues(func() (*vmplush.Template, error) {
+	return vmplush.CompileAt("<%= join_values(\"left\", \"right\") %>", 1)
+})
+
+// renderHelpers9Fragment1 compiles the statement on line 1 for the VM.
+var renderHelpers9Fragment1 = sync.OnceValues(func() (*vmplush.Template, error) {
+	return vmplush.CompileAt("<%= join_values(\"left\", \"right\", \"wide\") %>", 1)
+})
+
+// RenderHelpers9 renders parity_helpers_test.go to w.
+func RenderHelpers9(w io.Writer, ctx hctx.Context) error {
+	out, ok, err := renderHelpers9Static(ctx)
+	if !ok {
+		out, err = vmplush.Render(renderHelpers9Source, ctx)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderHelpers9Static renders the template with the declared types. It reports false
+// when the context does not hold them or holds nil pointers.
+func renderHelpers9Static(ctx hctx.Context) (string, bool, error) {
+	var b bytes.Buffer
+	if t, err := renderHelpers9Fragment0(); err != nil {
+		return "", true, err
+	} else if s, err := t.Render(ctx); err != nil {
+		return "", true, err
+	} else {
+		b.WriteString(s)
+	}
+	b.WriteString("|")
+	if t, err := renderHelpers9Fragment1(); err != nil {
+		return "", true, err
+	} else if s, err := t.Render(ctx); err != nil {
+		return "", true, err
+	} else {
+		b.WriteString(s)
+	}
+	return b.String(), true, nil
+}
+
+// renderHelpers10Source is the template RenderHelpers10 was generated from.
+const renderHelpers10Source = "<%= format_count(\"items\") %>|<%= format_count(\"items\", 7) %>"
+
+// renderHelpers10Fragment0 compiles the statement on line 1 for the VM.
+var renderHelpers10Fragment0 = sync.OnceValues(func() (*vmplush.Template, error) {
+	return vmplush.CompileAt("<%= format_count(\"items\") %>", 1)
+})
+
+// renderHelpers10Fragment1 compiles the statement on line 1 for the VM.
+var renderHelpers10Fragment1 = sync.OnceValues(func() (*vmplush.Template, error) {
+	return vmplush.CompileAt("<%= format_count(\"items\", 7) %>", 1)
+})
+
+// RenderHelpers10 renders parity_helpers_test.go to w.
+func RenderHelpers10(w io.Writer, ctx hctx.Context) error {
+	out, ok, err := renderHelpers10Static(ctx)
+	if !ok {
+		out, err = vmplush.Render(renderHelpers10Source, ctx)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderHelpers10Static renders the template with the declared types. It reports false
+// when the context does not hold them or holds nil pointers.
+func renderHelpers10Static(ctx hctx.Context) (string, bool, error) {
+	var b bytes.Buffer
+	if t, err := renderHelpers10Fragment0(); err != nil {
+		return "", true, err
+	} else if s, err := t.Render(ctx); err != nil {
+		return "", true, err
+	} else {
+		b.WriteString(s)
+	}
+	b.WriteString("|")
+	if t, err := renderHelpers10Fragment1(); err != nil {
+		return "", true, err
+	} else if s, err := t.Render(ctx); err != nil {
+		return "", true, err
+	} else {
+		b.WriteString(s)
+	}
+	return b.String(), true, nil
+}
+
+// renderHelpers11Source is the template RenderHelpers11 was generated from.
+const renderHelpers11Source = "<%= wrap({name: \"mark\"}) { return prefix + name } %><%= name %>"
+
+// RenderHelpers11 renders parity_helpers_test.go to w.
+//
+// The template is rendered by the VM: it calls wrap with a block.
+func RenderHelpers11(w io.Writer, ctx hctx.Context) error {
+	out, err := vmplush.Render(renderHelpers11Source, ctx)
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderHelpers12Source is the template RenderHelpers12 was generated from.
+const renderHelpers12Source = "<%= renderSnippet() %>"
+
+// renderHelpers12Fragment0 compiles the statement on line 1 for the VM.
+var renderHelpers12Fragment0 = sync.OnceValues(func() (*vmplush.Template, error) {
+	return vmplush.CompileAt("<%= renderSnippet() %>", 1)
+})
+
+// RenderHelpers12 renders parity_helpers_test.go to w.
+func RenderHelpers12(w io.Writer, ctx hctx.Context) error {
+	out, ok, err := renderHelpers12Static(ctx)
+	if !ok {
+		out, err = vmplush.Render(renderHelpers12Source, ctx)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderHelpers12Static renders the template with the declared types. It reports false
+// when the context does not hold them or holds nil pointers.
+func renderHelpers12Static(ctx hctx.Context) (string, bool, error) {
+	var b bytes.Buffer
+	if t, err := renderHelpers12Fragment0(); err != nil {
+		return "", true, err
+	} else if s, err := t.Render(ctx); err != nil {
+		return "", true, err
+	} else {
+		b.WriteString(s)
+	}
+	return b.String(), true, nil
+}
+
+// renderHelpers13Source is the template RenderHelpers13 was generated from.
+const renderHelpers13Source = "<% seed() %><%= label %>|<%= widget.Label %>"
+
+// renderHelpers13Fragment0 compiles the statement on line 1 for the VM.
+var renderHelpers13Fragment0 = sync.OnceValues(func() (*vmplush.Template, error) {
+	return vmplush.CompileAt("<% seed() %>", 1)
+})
+
+// renderHelpers13Fragment1 compiles the statement on line 1 for the VM.
+var renderHelpers13Fragment1 = sync.OnceValues(func() (*vmplush.Template, error) {
+	return vmplush.CompileAt("<%= label %>", 1)
+})
+
+// renderHelpers13Fragment2 compiles the statement on line 1 for the VM.
+var renderHelpers13Fragment2 = sync.OnceValues(func() (*vmplush.Template, error) {
+	return vmplush.CompileAt("<%= widget.Label %>", 1)
+})
+
+// RenderHelpers13 renders parity_helpers_test.go to w.
+func RenderHelpers13(w io.Writer, ctx hctx.Context) error {
+	out, ok, err := renderHelpers13Static(ctx)
+	if !ok {
+		out, err = vmplush.Render(renderHelpers13Source, ctx)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderHelpers13Static renders the template with the declared types. It reports false
+// when the context does not hold them or holds nil pointers.
+func renderHelpers13Static(ctx hctx.Context) (string, bool, error) {
+	var b bytes.Buffer
+	if t, err := renderHelpers13Fragment0(); err != nil {
+		return "", true, err
+	} else if s, err := t.Render(ctx); err != nil {
+		return "", true, err
+	} else {
+		b.WriteString(s)
+	}
+	if t, err := renderHelpers13Fragment1(); err != nil {
+		return "", true, err
+	} else if s, err := t.Render(ctx); err != nil {
+		return "", true, err
+	} else {
+		b.WriteString(s)
+	}
+	b.WriteString("|")
+	if t, err := renderHelpers13Fragment2(); err != nil {
+		return "", true, err
+	} else if s, err := t.Render(ctx); err != nil {
+		return "", true, err
+	} else {
+		b.WriteString(s)
+	}
+	return b.String(), true, nil
+}
+
+// renderHelpers14Source is the template RenderHelpers14 was generated from.
+const renderHelpers14Source = "<%= seed() { %><%= label %>|<%= widget.Label %><% } %>-><%= label %>|<%= widget.Label %>"
+
+// RenderHelpers14 renders parity_helpers_test.go to w.
+//
+// The template is rendered by the VM: it calls seed with a block.
+func RenderHelpers14(w io.Writer, ctx hctx.Context) error {
+	out, err := vmplush.Render(renderHelpers14Source, ctx)
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderHelpers15Source is the template RenderHelpers15 was generated from.
+const renderHelpers15Source = "<%= scope() { %><%= label %><% label = \"updated\" %>|<%= label %><% } %>"
+
+// RenderHelpers15 renders parity_helpers_test.go to w.
+//
+// The template is rendered by the VM: it calls scope with a block.
+func RenderHelpers15(w io.Writer, ctx hctx.Context) error {
+	out, err := vmplush.Render(renderHelpers15Source, ctx)
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderHelpers16Source is the template RenderHelpers16 was generated from.
+const renderHelpers16Source = "<%= for (_, block) in blocks { %><%= render(block.Type + \".plush.html\", {settings: block}) %><% } %>"
+
+// renderHelpers16Fragment0 compiles the statement on line 1 for the VM.
+var renderHelpers16Fragment0 = sync.OnceValues(func() (*vmplush.Template, error) {
+	return vmplush.CompileAt("<%= for (_, block) in blocks { %><%= render(block.Type + \".plush.html\", {settings: block}) %><% } %>", 1)
+})
+
+// RenderHelpers16 renders parity_helpers_test.go to w.
+func RenderHelpers16(w io.Writer, ctx hctx.Context) error {
+	out, ok, err := renderHelpers16Static(ctx)
+	if !ok {
+		out, err = vmplush.Render(renderHelpers16Source, ctx)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderHelpers16Static renders the template with the declared types. It reports false
+// when the context does not hold them or holds nil pointers.
+func renderHelpers16Static(ctx hctx.Context) (string, bool, error) {
+	var b bytes.Buffer
+	if t, err := renderHelpers16Fragment0(); err != nil {
+		return "", true, err
+	} else if s, err := t.Render(ctx); err != nil {
+		return "", true, err
+	} else {
+		b.WriteString(s)
+	}
+	return b.String(), true, nil
+}
+
+// renderHelpers17Source is the template RenderHelpers17 was generated from.
+const renderHelpers17Source = "<% let value = \"before\" %><%= run() { value = \"after\" } %><%= value %>"
+
+// RenderHelpers17 renders parity_helpers_test.go to w.
+//
+// The template is rendered by the VM: it declares variables with let.
+func RenderHelpers17(w io.Writer, ctx hctx.Context) error {
+	out, err := vmplush.Render(renderHelpers17Source, ctx)
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderHelpers18Source is the template RenderHelpers18 was generated from.
+const renderHelpers18Source = "<%= run() { let inner = \"secret\" } %><%= inner %>"
+
+// RenderHelpers18 renders parity_helpers_test.go to w.
+//
+// The template is rendered by the VM: it calls run with a block.
+func RenderHelpers18(w io.Writer, ctx hctx.Context) error {
+	out, err := vmplush.Render(renderHelpers18Source, ctx)
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderHelpers19Source is the template RenderHelpers19 was generated from.
+const renderHelpers19Source = "<%= greeter.Greet(\"mark\") %>|<%= makeGreeter().Greet(\"mido\") %>|<%= makeName().Echo() %>"
+
+// renderHelpers19Fragment0 compiles the statement on line 1 for the VM.
+var renderHelpers19Fragment0 = sync.OnceValues(func() (*vmplush.Template, error) {
+	return vmplush.CompileAt("<%= greeter.Greet(\"mark\") %>", 1)
+})
+
+// renderHelpers19Fragment1 compiles the statement on line 1 for the VM.
+var renderHelpers19Fragment1 = sync.OnceValues(func() (*vmplush.Template, error) {
+	return vmplush.CompileAt("<%= makeGreeter().Greet(\"mido\") %>", 1)
+})
+
+// renderHelpers19Fragment2 compiles the statement on line 1 for the VM.
+var renderHelpers19Fragment2 = sync.OnceValues(func() (*vmplush.Template, error) {
+	return vmplush.CompileAt("<%= makeName().Echo() %>", 1)
+})
+
+// RenderHelpers19 renders parity_helpers_test.go to w.
+func RenderHelpers19(w io.Writer, ctx hctx.Context) error {
+	out, ok, err := renderHelpers19Static(ctx)
+	if !ok {
+		out, err = vmplush.Render(renderHelpers19Source, ctx)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderHelpers19Static renders the template with the declared types. It reports false
+// when the context does not hold them or holds nil pointers.
+func renderHelpers19Static(ctx hctx.Context) (string, bool, error) {
+	var b bytes.Buffer
+	if t, err := renderHelpers19Fragment0(); err != nil {
+		return "", true, err
+	} else if s, err := t.Render(ctx); err != nil {
+		return "", true, err
+	} else {
+		b.WriteString(s)
+	}
+	b.WriteString("|")
+	if t, err := renderHelpers19Fragment1(); err != nil {
+		return "", true, err
+	} else if s, err := t.Render(ctx); err != nil {
+		return "", true, err
+	} else {
+		b.WriteString(s)
+	}
+	b.WriteString("|")
+	if t, err := renderHelpers19Fragment2(); err != nil {
+		return "", true, err
+	} else if s, err := t.Render(ctx); err != nil {
+		return "", true, err
+	} else {
+		b.WriteString(s)
+	}
+	return b.String(), true, nil
+}
+
+// renderHelpers20Source is the template RenderHelpers20 was generated from.
+const renderHelpers20Source = "<%= missingHelper() %>"
+
+// renderHelpers20Fragment0 compiles the statement on line 1 for the VM.
+var renderHelpers20Fragment0 = sync.OnceValues(func() (*vmplush.Template, error) {
+	return vmplush.CompileAt("<%= missingHelper() %>", 1)
+})
+
+// RenderHelpers20 renders parity_helpers_test.go to w.
+func RenderHelpers20(w io.Writer, ctx hctx.Context) error {
+	out, ok, err := renderHelpers20Static(ctx)
+	if !ok {
+		out, err = vmplush.Render(renderHelpers20Source, ctx)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderHelpers20Static renders the template with the declared types. It reports false
+// when the context does not hold them or holds nil pointers.
+func renderHelpers20Static(ctx hctx.Context) (string, bool, error) {
+	var b bytes.Buffer
+	if t, err := renderHelpers20Fragment0(); err != nil {
+		return "", true, err
+	} else if s, err := t.Render(ctx); err != nil {
+		return "", true, err
+	} else {
+		b.WriteString(s)
+	}
+	return b.String(), true, nil
+}
@@ -0,0 +1,276 @@
+// Code generated by go test ./vm/plush -update-gen. DO NOT EDIT.
+
+package genparity
+
+import (
+	"bytes"
+	"github.com/gobuffalo/plush/v5/helpers/hctx"
+	vmplush "github.com/gobuffalo/plush/v5/vm/plush"
+	"io"
+	"sync"
+)
+
+// renderHoles1Source is the template RenderHoles1 was generated from.
+const renderHoles1Source = "<%H \"hello\" %>"
+
+// RenderHoles1 renders parity_holes_test.go to w.
+//
+// The template is rendered by the VM: it has punch holes.
+func RenderHoles1(w io.Writer, ctx hctx.Context) error {
+	out, err := vmplush.Render(renderHoles1Source, ctx)
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderHoles2Source is the template RenderHoles2 was generated from.
+const renderHoles2Source = "<%H missing_helper\" %><%H \"ok\" %>"
+
+// RenderHoles2 renders parity_holes_test.go to w.
+//
+// The template is rendered by the VM: it has punch holes.
+func RenderHoles2(w io.Writer, ctx hctx.Context) error {
+	out, err := vmplush.Render(renderHoles2Source, ctx)
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderHoles3Source is the template RenderHoles3 was generated from.
+const renderHoles3Source = "<%= \"x\" %><%H \"a\" %><%H \"b\" %><%H \"c\" %>"
+
+// RenderHoles3 renders parity_holes_test.go to w.
+//
+// The template is rendered by the VM: it has punch holes.
+func RenderHoles3(w io.Writer, ctx hctx.Context) error {
+	out, err := vmplush.Render(renderHoles3Source, ctx)
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderHoles4Source is the template RenderHoles4 was generated from.
+const renderHoles4Source = "<%H \"a\" %><%H \"b\" %><%= \"x\" %>"
+
+// RenderHoles4 renders parity_holes_test.go to w.
+//
+// The template is rendered by the VM: it has punch holes.
+func RenderHoles4(w io.Writer, ctx hctx.Context) error {
+	out, err := vmplush.Render(renderHoles4Source, ctx)
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderHoles5Source is the template RenderHoles5 was generated from.
+const renderHoles5Source = "<%H \"start\" %>middle<%H \"end\" %>"
+
+// RenderHoles5 renders parity_holes_test.go to w.
+//
+// The template is rendered by the VM: it has punch holes.
+func RenderHoles5(w io.Writer, ctx hctx.Context) error {
+	out, err := vmplush.Render(renderHoles5Source, ctx)
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderHoles6Source is the template RenderHoles6 was generated from.
+const renderHoles6Source = "<%H \"\" %>foo<%H  %>"
+
+// RenderHoles6 renders parity_holes_test.go to w.
+//
+// The template is rendered by the VM: it has punch holes.
+func RenderHoles6(w io.Writer, ctx hctx.Context) error {
+	out, err := vmplush.Render(renderHoles6Source, ctx)
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderHoles7Source is the template RenderHoles7 was generated from.
+const renderHoles7Source = "<PLUSH_HOLE_0><%H \"start\" %><%H \"end\" %>"
+
+// RenderHoles7 renders parity_holes_test.go to w.
+//
+// The template is rendered by the VM: it has punch holes.
+func RenderHoles7(w io.Writer, ctx hctx.Context) error {
+	out, err := vmplush.Render(renderHoles7Source, ctx)
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderHoles8Source is the template RenderHoles8 was generated from.
+const renderHoles8Source = "<%H \"x\" %><%H \"x\" %><%H \"x\" %><%H \"x\" %><%H \"x\" %><%H \"x\" %><%H \"x\" %><%H \"x\" %><%H \"x\" %><%H \"x\" %><%H \"x\" %><%H \"x\" %><%H \"x\" %><%H \"x\" %><%H \"x\" %><%H \"x\" %><%H \"x\" %><%H \"x\" %><%H \"x\" %><%H \"x\" %><%H \"x\" %><%H \"x\" %><%H \"x\" %><%H \"x\" %><%H \"x\" %><%H \"x\" %><%H \"x\" %><%H \"x\" %><%H \"x\" %><%H \"x\" %><%H \"x\" %><%H \"x\" %><%H \"x\" %><%H \"x\" %><%H \"x\" %><%H \"x\" %><%H \"x\" %><%H \"x\" %><%H \"x\" %><%H \"x\" %><%H \"x\" %><%H \"x\" %><%H \"x\" %><%H \"x\" %><%H \"x\" %><%H \"x\" %><%H \"x\" %><%H \"x\" %><%H \"x\" %><%H \"x\" %><%H \"x\" %><%H \"x\" %><%H \"x\" %><%H \"x\" %><%H \"x\" %><%H \"x\" %><%H \"x\" %><%H \"x\" %><%H \"x\" %><%H \"x\" %><%H \"x\" %><%H \"x\" %><%H \"x\" %><%H \"x\" %><%H \"x\" %><%H \"x\" %><%H \"x\" %><%H \"x\" %><%H \"x\" %><%H \"x\" %><%H \"x\" %><%H \"x\" %><%H \"x\" %><%H \"x\" %><%H \"x\" %><%H \"x\" %><%H \"x\" %><%H \"x\" %><%H \"x\" %><%H \"x\" %><%H \"x\" %><%H \"x\" %><%H \"x\" %><%H \"x\" %><%H \"x\" %><%H \"x\" %><%H \"x\" %><%H \"x\" %><%H \"x\" %><%H \"x\" %><%H \"x\" %><%H \"x\" %><%H \"x\" %><%H \"x\" %><%H \"x\" %><%H \"x\" %><%H \"x\" %><%H \"x\" %><%H \"x\" %><%H \"x\" %>"
+
+// RenderHoles8 renders parity_holes_test.go to w.
+//
+// The template is rendered by the VM: it has punch holes.
+func RenderHoles8(w io.Writer, ctx hctx.Context) error {
+	out, err := vmplush.Render(renderHoles8Source, ctx)
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderHoles9Source is the template RenderHoles9 was generated from.
+const renderHoles9Source = "<%= if (a == \"22\") { %><%H \"testing\" %><% } else { %><%H \"dddd\" %><% } %>"
+
+// RenderHoles9 renders parity_holes_test.go to w.
+//
+// The template is rendered by the VM: it has punch holes.
+func RenderHoles9(w io.Writer, ctx hctx.Context) error {
+	out, err := vmplush.Render(renderHoles9Source, ctx)
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderHoles10Source is the template RenderHoles10 was generated from.
+const renderHoles10Source = "<%H if (number > 0){ %><%= \"NUMBER\" %><% } else { %><%= number %><%  }%>"
+
+// RenderHoles10 renders parity_holes_test.go to w.
+//
+// The template is rendered by the VM: it has punch holes.
+func RenderHoles10(w io.Writer, ctx hctx.Context) error {
+	out, err := vmplush.Render(renderHoles10Source, ctx)
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderHoles11Source is the template RenderHoles11 was generated from.
+const renderHoles11Source = "<%= for (i,v) in items { %><%H \"testing\" %><%= v %><% } %>"
+
+// RenderHoles11 renders parity_holes_test.go to w.
+//
+// The template is rendered by the VM: it has punch holes.
+func RenderHoles11(w io.Writer, ctx hctx.Context) error {
+	out, err := vmplush.Render(renderHoles11Source, ctx)
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderHoles12Source is the template RenderHoles12 was generated from.
+const renderHoles12Source = "<%H for (i,v) in items { %><%= v %><% } %>"
+
+// RenderHoles12 renders parity_holes_test.go to w.
+//
+// The template is rendered by the VM: it has punch holes.
+func RenderHoles12(w io.Writer, ctx hctx.Context) error {
+	out, err := vmplush.Render(renderHoles12Source, ctx)
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderHoles13Source is the template RenderHoles13 was generated from.
+const renderHoles13Source = "<%= wrap() { %><%H \"inside\" %><% } %>"
+
+// RenderHoles13 renders parity_holes_test.go to w.
+//
+// The template is rendered by the VM: it calls wrap with a block.
+func RenderHoles13(w io.Writer, ctx hctx.Context) error {
+	out, err := vmplush.Render(renderHoles13Source, ctx)
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderHoles14Source is the template RenderHoles14 was generated from.
+const renderHoles14Source = "<%= partial(\"hole.plush\") %>"
+
+// renderHoles14Fragment0 compiles the statement on line 1 for the VM.
+var renderHoles14Fragment0 = sync.OnceValues(func() (*vmplush.Template, error) {
+	return vmplush.CompileAt("<%= partial(\"hole.plush\") %>", 1)
+})
+
+// RenderHoles14 renders parity_holes_test.go to w.
+func RenderHoles14(w io.Writer, ctx hctx.Context) error {
+	out, ok, err := renderHoles14Static(ctx)
+	if !ok {
+		out, err = vmplush.Render(renderHoles14Source, ctx)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderHoles14Static renders the template with the declared types. It reports false
+// when the context does not hold them or holds nil pointers.
+func renderHoles14Static(ctx hctx.Context) (string, bool, error) {
+	var b bytes.Buffer
+	if t, err := renderHoles14Fragment0(); err != nil {
+		return "", true, err
+	} else if s, err := t.Render(ctx); err != nil {
+		return "", true, err
+	} else {
+		b.WriteString(s)
+	}
+	return b.String(), true, nil
+}
+
+// renderHoles15Source is the template RenderHoles15 was generated from.
+const renderHoles15Source = "<%= partial(\"index.plush\") %>"
+
+// renderHoles15Fragment0 compiles the statement on line 1 for the VM.
+var renderHoles15Fragment0 = sync.OnceValues(func() (*vmplush.Template, error) {
+	return vmplush.CompileAt("<%= partial(\"index.plush\") %>", 1)
+})
+
+// RenderHoles15 renders parity_holes_test.go to w.
+func RenderHoles15(w io.Writer, ctx hctx.Context) error {
+	out, ok, err := renderHoles15Static(ctx)
+	if !ok {
+		out, err = vmplush.Render(renderHoles15Source, ctx)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderHoles15Static renders the template with the declared types. It reports false
+// when the context does not hold them or holds nil pointers.
+func renderHoles15Static(ctx hctx.Context) (string, bool, error) {
+	var b bytes.Buffer
+	if t, err := renderHoles15Fragment0(); err != nil {
+		return "", true, err
+	} else if s, err := t.Render(ctx); err != nil {
+		return "", true, err
+	} else {
+		b.WriteString(s)
+	}
+	return b.String(), true, nil
+}
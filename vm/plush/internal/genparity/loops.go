@@ -0,0 +1,987 @@
+// Code generated by go test ./vm/plush -update-gen. DO NOT EDIT.
+
+package genparity
+
+import (
+	"bytes"
+	"github.com/gobuffalo/plush/v5/helpers/hctx"
+	vmplush "github.com/gobuffalo/plush/v5/vm/plush"
+	"html/template"
+	"io"
+	"strconv"
+	"sync"
+)
+
+// renderLoops1Source is the template RenderLoops1 was generated from.
+const renderLoops1Source = "<%= for (i,v) in [\"a\", \"b\", \"c\"] { return v } %>"
+
+// RenderLoops1 renders parity_loops_test.go to w.
+//
+// The template is rendered by the VM: it returns early.
+func RenderLoops1(w io.Writer, ctx hctx.Context) error {
+	out, err := vmplush.Render(renderLoops1Source, ctx)
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderLoops2Source is the template RenderLoops2 was generated from.
+const renderLoops2Source = "<%= for (i,v) in items { %><%= i %>:<%= v %>;<% } %>"
+
+// RenderLoops2 renders parity_loops_test.go to w.
+func RenderLoops2(w io.Writer, ctx hctx.Context) error {
+	out, ok, err := renderLoops2Static(ctx)
+	if !ok {
+		out, err = vmplush.Render(renderLoops2Source, ctx)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderLoops2Static renders the template with the declared types. It reports false
+// when the context does not hold them or holds nil pointers.
+func renderLoops2Static(ctx hctx.Context) (string, bool, error) {
+	v_items, ok := ctx.Value("items").([]string)
+	if !ok {
+		return "", false, nil
+	}
+	var b bytes.Buffer
+	for v_i, v_v := range v_items {
+		b.WriteString(strconv.Itoa(v_i))
+		b.WriteString(":")
+		b.WriteString(template.HTMLEscapeString(v_v))
+		b.WriteString(";")
+	}
+	return b.String(), true, nil
+}
+
+// renderLoops3Source is the template RenderLoops3 was generated from.
+const renderLoops3Source = "<%= for (v) in [\"a\", \"b\", \"c\"] {%><%=v%><%} %>"
+
+// renderLoops3Fragment0 compiles the statement on line 1 for the VM.
+var renderLoops3Fragment0 = sync.OnceValues(func() (*vmplush.Template, error) {
+	return vmplush.CompileAt("<%= for (v) in [\"a\", \"b\", \"c\"] {%><%=v%><%} %>", 1)
+})
+
+// RenderLoops3 renders parity_loops_test.go to w.
+func RenderLoops3(w io.Writer, ctx hctx.Context) error {
+	out, ok, err := renderLoops3Static(ctx)
+	if !ok {
+		out, err = vmplush.Render(renderLoops3Source, ctx)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderLoops3Static renders the template with the declared types. It reports false
+// when the context does not hold them or holds nil pointers.
+func renderLoops3Static(ctx hctx.Context) (string, bool, error) {
+	var b bytes.Buffer
+	if t, err := renderLoops3Fragment0(); err != nil {
+		return "", true, err
+	} else if s, err := t.Render(ctx); err != nil {
+		return "", true, err
+	} else {
+		b.WriteString(s)
+	}
+	return b.String(), true, nil
+}
+
+// renderLoops4Source is the template RenderLoops4 was generated from.
+const renderLoops4Source = "<%= for (i,v) in [\"a\", \"b\", \"c\"] {%><%=i%><%=v%><%} %>"
+
+// renderLoops4Fragment0 compiles the statement on line 1 for the VM.
+var renderLoops4Fragment0 = sync.OnceValues(func() (*vmplush.Template, error) {
+	return vmplush.CompileAt("<%= for (i,v) in [\"a\", \"b\", \"c\"] {%><%=i%><%=v%><%} %>", 1)
+})
+
+// RenderLoops4 renders parity_loops_test.go to w.
+func RenderLoops4(w io.Writer, ctx hctx.Context) error {
+	out, ok, err := renderLoops4Static(ctx)
+	if !ok {
+		out, err = vmplush.Render(renderLoops4Source, ctx)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderLoops4Static renders the template with the declared types. It reports false
+// when the context does not hold them or holds nil pointers.
+func renderLoops4Static(ctx hctx.Context) (string, bool, error) {
+	var b bytes.Buffer
+	if t, err := renderLoops4Fragment0(); err != nil {
+		return "", true, err
+	} else if s, err := t.Render(ctx); err != nil {
+		return "", true, err
+	} else {
+		b.WriteString(s)
+	}
+	return b.String(), true, nil
+}
+
+// renderLoops5Source is the template RenderLoops5 was generated from.
+const renderLoops5Source = "<% let i = 10000 %><%= for (i,v) in [\"a\", \"b\", \"c\"] {%><%=i%><%=v%><%} %><%= i %>"
+
+// RenderLoops5 renders parity_loops_test.go to w.
+//
+// The template is rendered by the VM: it declares variables with let.
+func RenderLoops5(w io.Writer, ctx hctx.Context) error {
+	out, err := vmplush.Render(renderLoops5Source, ctx)
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderLoops6Source is the template RenderLoops6 was generated from.
+const renderLoops6Source = "<% let varTest = \"\" %><% for (i,v) in [\"a\", \"b\", \"c\"] {varTest = v} %><%= varTest %>"
+
+// RenderLoops6 renders parity_loops_test.go to w.
+//
+// The template is rendered by the VM: it declares variables with let.
+func RenderLoops6(w io.Writer, ctx hctx.Context) error {
+	out, err := vmplush.Render(renderLoops6Source, ctx)
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderLoops7Source is the template RenderLoops7 was generated from.
+const renderLoops7Source = "<% let selected = entries[0] %>\n<%= if (input[\"target_id\"] != \"\" && count(entries) > 0) { %>\n\t<%= for (i, entry) in entries { %>\n\t\t<%= if (entry.ID == input[\"target_id\"]) { %>\n\t\t\t<% selected = entry %>\n\t\t<% } %>\n\t<% } %>\n<% } %>\n<%= selected.ID %>"
+
+// RenderLoops7 renders parity_loops_test.go to w.
+//
+// The template is rendered by the VM: it declares variables with let.
+func RenderLoops7(w io.Writer, ctx hctx.Context) error {
+	out, err := vmplush.Render(renderLoops7Source, ctx)
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderLoops8Source is the template RenderLoops8 was generated from.
+const renderLoops8Source = "<% let handlers = [] %>\n<% let items = [1,2,3,4,5,6,7,8,9,0,10] %>\n<%= for (index, item) in items { %>\n\t<%= if(true) { %>\n\t\t<% let handle = \"test\" + to_string(index) %>\n\t\t<% handlers = handlers + handle %>\n\t\t<script>console.log(<%= json_encode(handlers) %>)</script>\n\t<% } %>\n<% } %>\n<%= json_encode(handlers) %>"
+
+// RenderLoops8 renders parity_loops_test.go to w.
+//
+// The template is rendered by the VM: it declares variables with let.
+func RenderLoops8(w io.Writer, ctx hctx.Context) error {
+	out, err := vmplush.Render(renderLoops8Source, ctx)
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderLoops9Source is the template RenderLoops9 was generated from.
+const renderLoops9Source = "<% let result = [] %><% if (true) { %>discarded<% let local = \"A\" %><% result = result + local %><% } %><%= result[0] %>"
+
+// RenderLoops9 renders parity_loops_test.go to w.
+//
+// The template is rendered by the VM: it declares variables with let.
+func RenderLoops9(w io.Writer, ctx hctx.Context) error {
+	out, err := vmplush.Render(renderLoops9Source, ctx)
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderLoops10Source is the template RenderLoops10 was generated from.
+const renderLoops10Source = "<% let result = [] %><%= if (false) { %><% let local = \"wrong\" %><% result = result + local %><% } else { %><% let local = \"B\" %><% result = result + local %><% } %><%= result[0] %>"
+
+// RenderLoops10 renders parity_loops_test.go to w.
+//
+// The template is rendered by the VM: it declares variables with let.
+func RenderLoops10(w io.Writer, ctx hctx.Context) error {
+	out, err := vmplush.Render(renderLoops10Source, ctx)
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderLoops11Source is the template RenderLoops11 was generated from.
+const renderLoops11Source = "<% let result = [] %><%= if (true) { %><% let prefix = \"A\" %><%= if (true) { %><% let suffix = \"B\" %><% result = result + (prefix + suffix) %><% } %><% } %><%= result[0] %>"
+
+// RenderLoops11 renders parity_loops_test.go to w.
+//
+// The template is rendered by the VM: it declares variables with let.
+func RenderLoops11(w io.Writer, ctx hctx.Context) error {
+	out, err := vmplush.Render(renderLoops11Source, ctx)
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderLoops12Source is the template RenderLoops12 was generated from.
+const renderLoops12Source = "<% let result = \"start\" %><%= if (false) { %><% result = \"wrong\" %><% } else if (true) { %><% let local = \"selected\" %><% result = local %><% } else { %><% result = \"also-wrong\" %><% } %><%= result %>"
+
+// RenderLoops12 renders parity_loops_test.go to w.
+//
+// The template is rendered by the VM: it declares variables with let.
+func RenderLoops12(w io.Writer, ctx hctx.Context) error {
+	out, err := vmplush.Render(renderLoops12Source, ctx)
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderLoops13Source is the template RenderLoops13 was generated from.
+const renderLoops13Source = "<% let result = [] %><%= for (_, item) in [1,2,3] { %><% if (item > 1) { %>discarded<% let local = item %><% result = result + local %><% } %><% } %><%= result[0] %><%= result[1] %>"
+
+// RenderLoops13 renders parity_loops_test.go to w.
+//
+// The template is rendered by the VM: it declares variables with let.
+func RenderLoops13(w io.Writer, ctx hctx.Context) error {
+	out, err := vmplush.Render(renderLoops13Source, ctx)
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderLoops14Source is the template RenderLoops14 was generated from.
+const renderLoops14Source = "<% let result = [] %><%= for (_, row) in [[1,2],[3]] { %><%= for (_, item) in row { %><%= if (true) { %><% let local = item %><% result = result + local %><% } %><% } %><% } %><%= result[0] %><%= result[1] %><%= result[2] %>"
+
+// RenderLoops14 renders parity_loops_test.go to w.
+//
+// The template is rendered by the VM: it declares variables with let.
+func RenderLoops14(w io.Writer, ctx hctx.Context) error {
+	out, err := vmplush.Render(renderLoops14Source, ctx)
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderLoops15Source is the template RenderLoops15 was generated from.
+const renderLoops15Source = "<% let result = \"\" %><%= for (_, row) in [[\"A\",\"B\"],[\"C\"]] { %><%= for (_, item) in row { %><% let local = item %><% result = result + local %><% } %><% result = result + \"|\" %><% } %><%= result %>"
+
+// RenderLoops15 renders parity_loops_test.go to w.
+//
+// The template is rendered by the VM: it declares variables with let.
+func RenderLoops15(w io.Writer, ctx hctx.Context) error {
+	out, err := vmplush.Render(renderLoops15Source, ctx)
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderLoops16Source is the template RenderLoops16 was generated from.
+const renderLoops16Source = "<% let result = \"\" %><%= if (true) { %><% let prefix = \"x\" %><%= for (_, item) in [\"A\",\"B\"] { %><% let local = prefix + item %><% result = result + local %><% } %><% } %><%= result %>"
+
+// RenderLoops16 renders parity_loops_test.go to w.
+//
+// The template is rendered by the VM: it declares variables with let.
+func RenderLoops16(w io.Writer, ctx hctx.Context) error {
+	out, err := vmplush.Render(renderLoops16Source, ctx)
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderLoops17Source is the template RenderLoops17 was generated from.
+const renderLoops17Source = "<% let label = \"outer\" %><% let result = \"\" %><%= for (_, row) in [[\"A\",\"B\"]] { %><%= for (_, item) in row { %><% let label = item %><% label = label + \"!\" %><% result = result + label %><% } %><% } %><%= result %>|<%= label %>"
+
+// RenderLoops17 renders parity_loops_test.go to w.
+//
+// The template is rendered by the VM: it declares variables with let.
+func RenderLoops17(w io.Writer, ctx hctx.Context) error {
+	out, err := vmplush.Render(renderLoops17Source, ctx)
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderLoops18Source is the template RenderLoops18 was generated from.
+const renderLoops18Source = "<% let result = [] %><%= for (_, item) in [1,2,3] { if (item == 2) { let local = item; result = result + local; continue }; result = result + item } %><%= result[0] %><%= result[1] %><%= result[2] %>"
+
+// RenderLoops18 renders parity_loops_test.go to w.
+//
+// The template is rendered by the VM: it declares variables with let.
+func RenderLoops18(w io.Writer, ctx hctx.Context) error {
+	out, err := vmplush.Render(renderLoops18Source, ctx)
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderLoops19Source is the template RenderLoops19 was generated from.
+const renderLoops19Source = "<% let result = [] %><%= for (_, item) in [1,2,3] { if (item == 2) { let local = item; result = result + local; break }; result = result + item } %><%= result[0] %><%= result[1] %>"
+
+// RenderLoops19 renders parity_loops_test.go to w.
+//
+// The template is rendered by the VM: it declares variables with let.
+func RenderLoops19(w io.Writer, ctx hctx.Context) error {
+	out, err := vmplush.Render(renderLoops19Source, ctx)
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderLoops20Source is the template RenderLoops20 was generated from.
+const renderLoops20Source = "<% let left = \"\" %><% let right = \"\" %><%= for (_, item) in [\"A\",\"B\"] { %><%= if (true) { %><% let local = item %><% left = left + local %><% right = local + right %><% } %><% } %><%= left %>|<%= right %>"
+
+// RenderLoops20 renders parity_loops_test.go to w.
+//
+// The template is rendered by the VM: it declares variables with let.
+func RenderLoops20(w io.Writer, ctx hctx.Context) error {
+	out, err := vmplush.Render(renderLoops20Source, ctx)
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderLoops21Source is the template RenderLoops21 was generated from.
+const renderLoops21Source = "<% let label = \"outer\" %><%= if (true) { %><% let label = \"inner\" %><% label = label + \"!\" %><%= label %><% } %>|<%= label %>"
+
+// RenderLoops21 renders parity_loops_test.go to w.
+//
+// The template is rendered by the VM: it declares variables with let.
+func RenderLoops21(w io.Writer, ctx hctx.Context) error {
+	out, err := vmplush.Render(renderLoops21Source, ctx)
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderLoops22Source is the template RenderLoops22 was generated from.
+const renderLoops22Source = "<% let result = \"start\" %><%= if (false) { %><% let result = \"shadow\" %><% result = \"wrong\" %><% } else { %><% result = \"selected\" %><% } %><%= result %>"
+
+// RenderLoops22 renders parity_loops_test.go to w.
+//
+// The template is rendered by the VM: it declares variables with let.
+func RenderLoops22(w io.Writer, ctx hctx.Context) error {
+	out, err := vmplush.Render(renderLoops22Source, ctx)
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderLoops23Source is the template RenderLoops23 was generated from.
+const renderLoops23Source = "<% let result = \"\" %><%= for (_, item) in items { %><%= if (true) { %><% let local = item %><% result = result + local %><% } %><% } %><%= result %>"
+
+// RenderLoops23 renders parity_loops_test.go to w.
+//
+// The template is rendered by the VM: it declares variables with let.
+func RenderLoops23(w io.Writer, ctx hctx.Context) error {
+	out, err := vmplush.Render(renderLoops23Source, ctx)
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderLoops24Source is the template RenderLoops24 was generated from.
+const renderLoops24Source = "<% let result = \"\" %><%= for (_, item) in items { %><%= if (true) { %><% let local = item.Name %><% result = result + local %><% } %><% } %><%= result %>"
+
+// RenderLoops24 renders parity_loops_test.go to w.
+//
+// The template is rendered by the VM: it declares variables with let.
+func RenderLoops24(w io.Writer, ctx hctx.Context) error {
+	out, err := vmplush.Render(renderLoops24Source, ctx)
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderLoops25Source is the template RenderLoops25 was generated from.
+const renderLoops25Source = "<% let result = 0 %><%= for (_, item) in items { %><%= if (true) { %><% let local = item %><% result = result + local %><% } %><% } %><%= result %>"
+
+// RenderLoops25 renders parity_loops_test.go to w.
+//
+// The template is rendered by the VM: it declares variables with let.
+func RenderLoops25(w io.Writer, ctx hctx.Context) error {
+	out, err := vmplush.Render(renderLoops25Source, ctx)
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderLoops26Source is the template RenderLoops26 was generated from.
+const renderLoops26Source = "<%= for (_, item) in [\"A\",\"B\"] { %><%= if (true) { %><% let local = item %><% result = result + local %><% } %><% } %><%= result %>"
+
+// RenderLoops26 renders parity_loops_test.go to w.
+//
+// The template is rendered by the VM: it declares variables with let.
+func RenderLoops26(w io.Writer, ctx hctx.Context) error {
+	out, err := vmplush.Render(renderLoops26Source, ctx)
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderLoops27Source is the template RenderLoops27 was generated from.
+const renderLoops27Source = "<% let result = {} %><%= for (_, item) in [\"A\",\"B\"] { %><%= if (true) { %><% let local = item %><% result[item] = local %><% } %><% } %><%= result[\"A\"] %><%= result[\"B\"] %>"
+
+// RenderLoops27 renders parity_loops_test.go to w.
+//
+// The template is rendered by the VM: it declares variables with let.
+func RenderLoops27(w io.Writer, ctx hctx.Context) error {
+	out, err := vmplush.Render(renderLoops27Source, ctx)
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderLoops28Source is the template RenderLoops28 was generated from.
+const renderLoops28Source = "<% let item = \"outer\" %><%= for (_, item) in [\"A\"] { %><% item = item + \"!\" %><%= item %><% } %>|<%= item %>"
+
+// RenderLoops28 renders parity_loops_test.go to w.
+//
+// The template is rendered by the VM: it declares variables with let.
+func RenderLoops28(w io.Writer, ctx hctx.Context) error {
+	out, err := vmplush.Render(renderLoops28Source, ctx)
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderLoops29Source is the template RenderLoops29 was generated from.
+const renderLoops29Source = "<%= if (len(record.Assets) > 0 && record.Assets[0].URL) { %>\n\t<%= for (index, asset) in record.Assets { %>\n\t\t<link href=\"<%= asset.URL %>\" data-alt=\"<%= asset.Alt %>\" data-index=\"<%= index %>\" />\n\t<% } %>\n<% } %>"
+
+// renderLoops29Fragment0 compiles the statement on line 1 for the VM.
+var renderLoops29Fragment0 = sync.OnceValues(func() (*vmplush.Template, error) {
+	return vmplush.CompileAt("<%= if (len(record.Assets) > 0 && record.Assets[0].URL) { %>\n\t<%= for (index, asset) in record.Assets { %>\n\t\t<link href=\"<%= asset.URL %>\" data-alt=\"<%= asset.Alt %>\" data-index=\"<%= index %>\" />\n\t<% } %>\n<% } %>", 1)
+})
+
+// RenderLoops29 renders parity_loops_test.go to w.
+func RenderLoops29(w io.Writer, ctx hctx.Context) error {
+	out, ok, err := renderLoops29Static(ctx)
+	if !ok {
+		out, err = vmplush.Render(renderLoops29Source, ctx)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderLoops29Static renders the template with the declared types. It reports false
+// when the context does not hold them or holds nil pointers.
+func renderLoops29Static(ctx hctx.Context) (string, bool, error) {
+	var b bytes.Buffer
+	if t, err := renderLoops29Fragment0(); err != nil {
+		return "", true, err
+	} else if s, err := t.Render(ctx); err != nil {
+		return "", true, err
+	} else {
+		b.WriteString(s)
+	}
+	return b.String(), true, nil
+}
+
+// renderLoops30Source is the template RenderLoops30 was generated from.
+const renderLoops30Source = "<%= for (i,v) in [1, 2, 3] { if (v == 2) { continue } return v } %>"
+
+// RenderLoops30 renders parity_loops_test.go to w.
+//
+// The template is rendered by the VM: it uses break or continue.
+func RenderLoops30(w io.Writer, ctx hctx.Context) error {
+	out, err := vmplush.Render(renderLoops30Source, ctx)
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderLoops31Source is the template RenderLoops31 was generated from.
+const renderLoops31Source = "<%= for (i,v) in [1, 2, 3] { if (v == 2) { break } return v } %>"
+
+// RenderLoops31 renders parity_loops_test.go to w.
+//
+// The template is rendered by the VM: it uses break or continue.
+func RenderLoops31(w io.Writer, ctx hctx.Context) error {
+	out, err := vmplush.Render(renderLoops31Source, ctx)
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderLoops32Source is the template RenderLoops32 was generated from.
+const renderLoops32Source = "<%= for (i,v) in [1, 2, 3, 4] {\n\t\t%>Start<%\n\t\tif (v == 1 || v == 3) {\n\t\t\t%>Odd<%\n\t\t\tcontinue\n\t\t}\n\t\treturn v\n\t} %>"
+
+// RenderLoops32 renders parity_loops_test.go to w.
+//
+// The template is rendered by the VM: it uses break or continue.
+func RenderLoops32(w io.Writer, ctx hctx.Context) error {
+	out, err := vmplush.Render(renderLoops32Source, ctx)
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderLoops33Source is the template RenderLoops33 was generated from.
+const renderLoops33Source = "<%= for (i,v) in [1, 2, 3] {\n\t\tcontinue\n\t\treturn v\n\t} %>"
+
+// RenderLoops33 renders parity_loops_test.go to w.
+//
+// The template is rendered by the VM: it uses break or continue.
+func RenderLoops33(w io.Writer, ctx hctx.Context) error {
+	out, err := vmplush.Render(renderLoops33Source, ctx)
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderLoops34Source is the template RenderLoops34 was generated from.
+const renderLoops34Source = "<%= for (i,v) in [1, 2, 3, 4] {\n\t\t%>Start<%\n\t\tif (v == 3) {\n\t\t\t%>Stop<%\n\t\t\tbreak\n\t\t}\n\t\treturn v\n\t} %>"
+
+// RenderLoops34 renders parity_loops_test.go to w.
+//
+// The template is rendered by the VM: it uses break or continue.
+func RenderLoops34(w io.Writer, ctx hctx.Context) error {
+	out, err := vmplush.Render(renderLoops34Source, ctx)
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderLoops35Source is the template RenderLoops35 was generated from.
+const renderLoops35Source = "<%= for (i,v) in [1, 2, 3] {\n\t\tif (v == 1) {\n\t\t\t%><%=v%><%\n\t\t\tbreak\n\t\t}\n\t\treturn v\n\t} %>"
+
+// RenderLoops35 renders parity_loops_test.go to w.
+//
+// The template is rendered by the VM: it uses break or continue.
+func RenderLoops35(w io.Writer, ctx hctx.Context) error {
+	out, err := vmplush.Render(renderLoops35Source, ctx)
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderLoops36Source is the template RenderLoops36 was generated from.
+const renderLoops36Source = "<%= for (k,v) in myMap { %><%= k + \":\" + v%><% } %>"
+
+// renderLoops36Fragment0 compiles the statement on line 1 for the VM.
+var renderLoops36Fragment0 = sync.OnceValues(func() (*vmplush.Template, error) {
+	return vmplush.CompileAt("<%= for (k,v) in myMap { %><%= k + \":\" + v%><% } %>", 1)
+})
+
+// RenderLoops36 renders parity_loops_test.go to w.
+func RenderLoops36(w io.Writer, ctx hctx.Context) error {
+	out, ok, err := renderLoops36Static(ctx)
+	if !ok {
+		out, err = vmplush.Render(renderLoops36Source, ctx)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderLoops36Static renders the template with the declared types. It reports false
+// when the context does not hold them or holds nil pointers.
+func renderLoops36Static(ctx hctx.Context) (string, bool, error) {
+	var b bytes.Buffer
+	if t, err := renderLoops36Fragment0(); err != nil {
+		return "", true, err
+	} else if s, err := t.Render(ctx); err != nil {
+		return "", true, err
+	} else {
+		b.WriteString(s)
+	}
+	return b.String(), true, nil
+}
+
+// renderLoops37Source is the template RenderLoops37 was generated from.
+const renderLoops37Source = "<%= for (i,row) in rows { %><%= for (j,col) in row { %><%= i %>,<%= j %>:<%= col %>;<% } %><% } %>"
+
+// RenderLoops37 renders parity_loops_test.go to w.
+func RenderLoops37(w io.Writer, ctx hctx.Context) error {
+	out, ok, err := renderLoops37Static(ctx)
+	if !ok {
+		out, err = vmplush.Render(renderLoops37Source, ctx)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderLoops37Static renders the template with the declared types. It reports false
+// when the context does not hold them or holds nil pointers.
+func renderLoops37Static(ctx hctx.Context) (string, bool, error) {
+	v_rows, ok := ctx.Value("rows").([][]string)
+	if !ok {
+		return "", false, nil
+	}
+	var b bytes.Buffer
+	for v_i, v_row := range v_rows {
+		for v_j, v_col := range v_row {
+			b.WriteString(strconv.Itoa(v_i))
+			b.WriteString(",")
+			b.WriteString(strconv.Itoa(v_j))
+			b.WriteString(":")
+			b.WriteString(template.HTMLEscapeString(v_col))
+			b.WriteString(";")
+		}
+	}
+	return b.String(), true, nil
+}
+
+// renderLoops38Source is the template RenderLoops38 was generated from.
+const renderLoops38Source = "<%= for (k, messages) in flash { %><%= for (msg) in messages { %><%= if (len(messages) && messages[0] != \"skip\") { %><%= k %>:<%= msg %>;<% } %><% } %><% } %>"
+
+// renderLoops38Fragment0 compiles the statement on line 1 for the VM.
+var renderLoops38Fragment0 = sync.OnceValues(func() (*vmplush.Template, error) {
+	return vmplush.CompileAt("<%= for (k, messages) in flash { %><%= for (msg) in messages { %><%= if (len(messages) && messages[0] != \"skip\") { %><%= k %>:<%= msg %>;<% } %><% } %><% } %>", 1)
+})
+
+// RenderLoops38 renders parity_loops_test.go to w.
+func RenderLoops38(w io.Writer, ctx hctx.Context) error {
+	out, ok, err := renderLoops38Static(ctx)
+	if !ok {
+		out, err = vmplush.Render(renderLoops38Source, ctx)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderLoops38Static renders the template with the declared types. It reports false
+// when the context does not hold them or holds nil pointers.
+func renderLoops38Static(ctx hctx.Context) (string, bool, error) {
+	var b bytes.Buffer
+	if t, err := renderLoops38Fragment0(); err != nil {
+		return "", true, err
+	} else if s, err := t.Render(ctx); err != nil {
+		return "", true, err
+	} else {
+		b.WriteString(s)
+	}
+	return b.String(), true, nil
+}
+
+// renderLoops39Source is the template RenderLoops39 was generated from.
+const renderLoops39Source = "<%= for (v) in range(3,5) { %><%=v%><% } %>|<%= for (v) in between(3,6) { %><%=v%><% } %>|<%= for (v) in until(3) { %><%=v%><% } %>"
+
+// renderLoops39Fragment0 compiles the statement on line 1 for the VM.
+var renderLoops39Fragment0 = sync.OnceValues(func() (*vmplush.Template, error) {
+	return vmplush.CompileAt("<%= for (v) in range(3,5) { %><%=v%><% } %>", 1)
+})
+
+// renderLoops39Fragment1 compiles the statement on line 1 for the VM.
+var renderLoops39Fragment1 = sync.OnceValues(func() (*vmplush.Template, error) {
+	return vmplush.CompileAt("<%= for (v) in between(3,6) { %><%=v%><% } %>", 1)
+})
+
+// renderLoops39Fragment2 compiles the statement on line 1 for the VM.
+var renderLoops39Fragment2 = sync.OnceValues(func() (*vmplush.Template, error) {
+	return vmplush.CompileAt("<%= for (v) in until(3) { %><%=v%><% } %>", 1)
+})
+
+// RenderLoops39 renders parity_loops_test.go to w.
+func RenderLoops39(w io.Writer, ctx hctx.Context) error {
+	out, ok, err := renderLoops39Static(ctx)
+	if !ok {
+		out, err = vmplush.Render(renderLoops39Source, ctx)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderLoops39Static renders the template with the declared types. It reports false
+// when the context does not hold them or holds nil pointers.
+func renderLoops39Static(ctx hctx.Context) (string, bool, error) {
+	var b bytes.Buffer
+	if t, err := renderLoops39Fragment0(); err != nil {
+		return "", true, err
+	} else if s, err := t.Render(ctx); err != nil {
+		return "", true, err
+	} else {
+		b.WriteString(s)
+	}
+	b.WriteString("|")
+	if t, err := renderLoops39Fragment1(); err != nil {
+		return "", true, err
+	} else if s, err := t.Render(ctx); err != nil {
+		return "", true, err
+	} else {
+		b.WriteString(s)
+	}
+	b.WriteString("|")
+	if t, err := renderLoops39Fragment2(); err != nil {
+		return "", true, err
+	} else if s, err := t.Render(ctx); err != nil {
+		return "", true, err
+	} else {
+		b.WriteString(s)
+	}
+	return b.String(), true, nil
+}
+
+// renderLoops40Source is the template RenderLoops40 was generated from.
+const renderLoops40Source = "<%= for (i,v) in nil { return v } %>"
+
+// RenderLoops40 renders parity_loops_test.go to w.
+//
+// The template is rendered by the VM: it returns early.
+func RenderLoops40(w io.Writer, ctx hctx.Context) error {
+	out, err := vmplush.Render(renderLoops40Source, ctx)
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderLoops41Source is the template RenderLoops41 was generated from.
+const renderLoops41Source = "<%= for (i,v) in nilValue { return v } %>"
+
+// RenderLoops41 renders parity_loops_test.go to w.
+//
+// The template is rendered by the VM: it returns early.
+func RenderLoops41(w io.Writer, ctx hctx.Context) error {
+	out, err := vmplush.Render(renderLoops41Source, ctx)
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderLoops42Source is the template RenderLoops42 was generated from.
+const renderLoops42Source = "<%= for (k, v) in flash[\"errors\"] { %><%= k %>:<%= v %><% } %>"
+
+// renderLoops42Fragment0 compiles the statement on line 1 for the VM.
+var renderLoops42Fragment0 = sync.OnceValues(func() (*vmplush.Template, error) {
+	return vmplush.CompileAt("<%= for (k, v) in flash[\"errors\"] { %><%= k %>:<%= v %><% } %>", 1)
+})
+
+// RenderLoops42 renders parity_loops_test.go to w.
+func RenderLoops42(w io.Writer, ctx hctx.Context) error {
+	out, ok, err := renderLoops42Static(ctx)
+	if !ok {
+		out, err = vmplush.Render(renderLoops42Source, ctx)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderLoops42Static renders the template with the declared types. It reports false
+// when the context does not hold them or holds nil pointers.
+func renderLoops42Static(ctx hctx.Context) (string, bool, error) {
+	var b bytes.Buffer
+	if t, err := renderLoops42Fragment0(); err != nil {
+		return "", true, err
+	} else if s, err := t.Render(ctx); err != nil {
+		return "", true, err
+	} else {
+		b.WriteString(s)
+	}
+	return b.String(), true, nil
+}
+
+// renderLoops43Source is the template RenderLoops43 was generated from.
+const renderLoops43Source = "<%= if (!userSignedIn) { %>Guest<% } else { %>User<% } %><%= for (item) in menu.Items { %><%= item.Name + \" x \" + item.Count %>;<% } %>"
+
+// renderLoops43Fragment0 compiles the statement on line 1 for the VM.
+var renderLoops43Fragment0 = sync.OnceValues(func() (*vmplush.Template, error) {
+	return vmplush.CompileAt("<%= for (item) in menu.Items { %><%= item.Name + \" x \" + item.Count %>;<% } %>", 1)
+})
+
+// RenderLoops43 renders parity_loops_test.go to w.
+func RenderLoops43(w io.Writer, ctx hctx.Context) error {
+	out, ok, err := renderLoops43Static(ctx)
+	if !ok {
+		out, err = vmplush.Render(renderLoops43Source, ctx)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderLoops43Static renders the template with the declared types. It reports false
+// when the context does not hold them or holds nil pointers.
+func renderLoops43Static(ctx hctx.Context) (string, bool, error) {
+	v_userSignedIn, ok := ctx.Value("userSignedIn").(bool)
+	if !ok {
+		return "", false, nil
+	}
+	var b bytes.Buffer
+	if !(v_userSignedIn) {
+		b.WriteString("Guest")
+	} else {
+		b.WriteString("User")
+	}
+	if t, err := renderLoops43Fragment0(); err != nil {
+		return "", true, err
+	} else if s, err := t.Render(ctx); err != nil {
+		return "", true, err
+	} else {
+		b.WriteString(s)
+	}
+	return b.String(), true, nil
+}
+
+// renderLoops44Source is the template RenderLoops44 was generated from.
+const renderLoops44Source = "<%= for (i,v) in [\"a\"] { %><%= i %>:<%= v %><% } %><%= i %>"
+
+// renderLoops44Fragment0 compiles the statement on line 1 for the VM.
+var renderLoops44Fragment0 = sync.OnceValues(func() (*vmplush.Template, error) {
+	return vmplush.CompileAt("<%= for (i,v) in [\"a\"] { %><%= i %>:<%= v %><% } %>", 1)
+})
+
+// renderLoops44Fragment1 compiles the statement on line 1 for the VM.
+var renderLoops44Fragment1 = sync.OnceValues(func() (*vmplush.Template, error) {
+	return vmplush.CompileAt("<%= i %>", 1)
+})
+
+// RenderLoops44 renders parity_loops_test.go to w.
+func RenderLoops44(w io.Writer, ctx hctx.Context) error {
+	out, ok, err := renderLoops44Static(ctx)
+	if !ok {
+		out, err = vmplush.Render(renderLoops44Source, ctx)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderLoops44Static renders the template with the declared types. It reports false
+// when the context does not hold them or holds nil pointers.
+func renderLoops44Static(ctx hctx.Context) (string, bool, error) {
+	var b bytes.Buffer
+	if t, err := renderLoops44Fragment0(); err != nil {
+		return "", true, err
+	} else if s, err := t.Render(ctx); err != nil {
+		return "", true, err
+	} else {
+		b.WriteString(s)
+	}
+	if t, err := renderLoops44Fragment1(); err != nil {
+		return "", true, err
+	} else if s, err := t.Render(ctx); err != nil {
+		return "", true, err
+	} else {
+		b.WriteString(s)
+	}
+	return b.String(), true, nil
+}
+
+// renderLoops45Source is the template RenderLoops45 was generated from.
+const renderLoops45Source = "<%= for (i,v) in [\"a\"] { %><%= i %>:<%= v %><% } %><%= v %>"
+
+// renderLoops45Fragment0 compiles the statement on line 1 for the VM.
+var renderLoops45Fragment0 = sync.OnceValues(func() (*vmplush.Template, error) {
+	return vmplush.CompileAt("<%= for (i,v) in [\"a\"] { %><%= i %>:<%= v %><% } %>", 1)
+})
+
+// renderLoops45Fragment1 compiles the statement on line 1 for the VM.
+var renderLoops45Fragment1 = sync.OnceValues(func() (*vmplush.Template, error) {
+	return vmplush.CompileAt("<%= v %>", 1)
+})
+
+// RenderLoops45 renders parity_loops_test.go to w.
+func RenderLoops45(w io.Writer, ctx hctx.Context) error {
+	out, ok, err := renderLoops45Static(ctx)
+	if !ok {
+		out, err = vmplush.Render(renderLoops45Source, ctx)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderLoops45Static renders the template with the declared types. It reports false
+// when the context does not hold them or holds nil pointers.
+func renderLoops45Static(ctx hctx.Context) (string, bool, error) {
+	var b bytes.Buffer
+	if t, err := renderLoops45Fragment0(); err != nil {
+		return "", true, err
+	} else if s, err := t.Render(ctx); err != nil {
+		return "", true, err
+	} else {
+		b.WriteString(s)
+	}
+	if t, err := renderLoops45Fragment1(); err != nil {
+		return "", true, err
+	} else if s, err := t.Render(ctx); err != nil {
+		return "", true, err
+	} else {
+		b.WriteString(s)
+	}
+	return b.String(), true, nil
+}
@@ -0,0 +1,1229 @@
+// Code generated by go test ./vm/plush -update-gen. DO NOT EDIT.
+
+package genparity
+
+import (
+	"bytes"
+	"fmt"
+	"github.com/gobuffalo/plush/v5/helpers/hctx"
+	vmplush "github.com/gobuffalo/plush/v5/vm/plush"
+	"html/template"
+	"io"
+	"strconv"
+	"sync"
+)
+
+// renderMath1Source is the template RenderMath1 was generated from.
+const renderMath1Source = "<%= 1 + 3 %>"
+
+// RenderMath1 renders parity_math_test.go to w.
+func RenderMath1(w io.Writer, ctx hctx.Context) error {
+	out, ok, err := renderMath1Static(ctx)
+	if !ok {
+		out, err = vmplush.Render(renderMath1Source, ctx)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderMath1Static renders the template with the declared types. It reports false
+// when the context does not hold them or holds nil pointers.
+func renderMath1Static(ctx hctx.Context) (string, bool, error) {
+	var b bytes.Buffer
+	b.WriteString(strconv.Itoa((1 + 3)))
+	return b.String(), true, nil
+}
+
+// renderMath2Source is the template RenderMath2 was generated from.
+const renderMath2Source = "<%= 3 - 1 %>"
+
+// RenderMath2 renders parity_math_test.go to w.
+func RenderMath2(w io.Writer, ctx hctx.Context) error {
+	out, ok, err := renderMath2Static(ctx)
+	if !ok {
+		out, err = vmplush.Render(renderMath2Source, ctx)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderMath2Static renders the template with the declared types. It reports false
+// when the context does not hold them or holds nil pointers.
+func renderMath2Static(ctx hctx.Context) (string, bool, error) {
+	var b bytes.Buffer
+	b.WriteString(strconv.Itoa((3 - 1)))
+	return b.String(), true, nil
+}
+
+// renderMath3Source is the template RenderMath3 was generated from.
+const renderMath3Source = "<%= 10 / 2 %>"
+
+// renderMath3Fragment0 compiles the statement on line 1 for the VM.
+var renderMath3Fragment0 = sync.OnceValues(func() (*vmplush.Template, error) {
+	return vmplush.CompileAt("<%= 10 / 2 %>", 1)
+})
+
+// RenderMath3 renders parity_math_test.go to w.
+func RenderMath3(w io.Writer, ctx hctx.Context) error {
+	out, ok, err := renderMath3Static(ctx)
+	if !ok {
+		out, err = vmplush.Render(renderMath3Source, ctx)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderMath3Static renders the template with the declared types. It reports false
+// when the context does not hold them or holds nil pointers.
+func renderMath3Static(ctx hctx.Context) (string, bool, error) {
+	var b bytes.Buffer
+	if t, err := renderMath3Fragment0(); err != nil {
+		return "", true, err
+	} else if s, err := t.Render(ctx); err != nil {
+		return "", true, err
+	} else {
+		b.WriteString(s)
+	}
+	return b.String(), true, nil
+}
+
+// renderMath4Source is the template RenderMath4 was generated from.
+const renderMath4Source = "<%= 10 * 2 %>"
+
+// RenderMath4 renders parity_math_test.go to w.
+func RenderMath4(w io.Writer, ctx hctx.Context) error {
+	out, ok, err := renderMath4Static(ctx)
+	if !ok {
+		out, err = vmplush.Render(renderMath4Source, ctx)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderMath4Static renders the template with the declared types. It reports false
+// when the context does not hold them or holds nil pointers.
+func renderMath4Static(ctx hctx.Context) (string, bool, error) {
+	var b bytes.Buffer
+	b.WriteString(strconv.Itoa((10 * 2)))
+	return b.String(), true, nil
+}
+
+// renderMath5Source is the template RenderMath5 was generated from.
+const renderMath5Source = "<%= 10 > 2 %>"
+
+// RenderMath5 renders parity_math_test.go to w.
+func RenderMath5(w io.Writer, ctx hctx.Context) error {
+	out, ok, err := renderMath5Static(ctx)
+	if !ok {
+		out, err = vmplush.Render(renderMath5Source, ctx)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderMath5Static renders the template with the declared types. It reports false
+// when the context does not hold them or holds nil pointers.
+func renderMath5Static(ctx hctx.Context) (string, bool, error) {
+	var b bytes.Buffer
+	b.WriteString(strconv.FormatBool((10 > 2)))
+	return b.String(), true, nil
+}
+
+// renderMath6Source is the template RenderMath6 was generated from.
+const renderMath6Source = "<%= 10 >= 2 %>"
+
+// RenderMath6 renders parity_math_test.go to w.
+func RenderMath6(w io.Writer, ctx hctx.Context) error {
+	out, ok, err := renderMath6Static(ctx)
+	if !ok {
+		out, err = vmplush.Render(renderMath6Source, ctx)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderMath6Static renders the template with the declared types. It reports false
+// when the context does not hold them or holds nil pointers.
+func renderMath6Static(ctx hctx.Context) (string, bool, error) {
+	var b bytes.Buffer
+	b.WriteString(strconv.FormatBool((10 >= 2)))
+	return b.String(), true, nil
+}
+
+// renderMath7Source is the template RenderMath7 was generated from.
+const renderMath7Source = "<%= 10 >= 10 %>"
+
+// RenderMath7 renders parity_math_test.go to w.
+func RenderMath7(w io.Writer, ctx hctx.Context) error {
+	out, ok, err := renderMath7Static(ctx)
+	if !ok {
+		out, err = vmplush.Render(renderMath7Source, ctx)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderMath7Static renders the template with the declared types. It reports false
+// when the context does not hold them or holds nil pointers.
+func renderMath7Static(ctx hctx.Context) (string, bool, error) {
+	var b bytes.Buffer
+	b.WriteString(strconv.FormatBool((10 >= 10)))
+	return b.String(), true, nil
+}
+
+// renderMath8Source is the template RenderMath8 was generated from.
+const renderMath8Source = "<%= 2 <= 2 %>"
+
+// RenderMath8 renders parity_math_test.go to w.
+func RenderMath8(w io.Writer, ctx hctx.Context) error {
+	out, ok, err := renderMath8Static(ctx)
+	if !ok {
+		out, err = vmplush.Render(renderMath8Source, ctx)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderMath8Static renders the template with the declared types. It reports false
+// when the context does not hold them or holds nil pointers.
+func renderMath8Static(ctx hctx.Context) (string, bool, error) {
+	var b bytes.Buffer
+	b.WriteString(strconv.FormatBool((2 <= 2)))
+	return b.String(), true, nil
+}
+
+// renderMath9Source is the template RenderMath9 was generated from.
+const renderMath9Source = "<%= 10 < 2 %>"
+
+// RenderMath9 renders parity_math_test.go to w.
+func RenderMath9(w io.Writer, ctx hctx.Context) error {
+	out, ok, err := renderMath9Static(ctx)
+	if !ok {
+		out, err = vmplush.Render(renderMath9Source, ctx)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderMath9Static renders the template with the declared types. It reports false
+// when the context does not hold them or holds nil pointers.
+func renderMath9Static(ctx hctx.Context) (string, bool, error) {
+	var b bytes.Buffer
+	b.WriteString(strconv.FormatBool((10 < 2)))
+	return b.String(), true, nil
+}
+
+// renderMath10Source is the template RenderMath10 was generated from.
+const renderMath10Source = "<%= 10 <= 2 %>"
+
+// RenderMath10 renders parity_math_test.go to w.
+func RenderMath10(w io.Writer, ctx hctx.Context) error {
+	out, ok, err := renderMath10Static(ctx)
+	if !ok {
+		out, err = vmplush.Render(renderMath10Source, ctx)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderMath10Static renders the template with the declared types. It reports false
+// when the context does not hold them or holds nil pointers.
+func renderMath10Static(ctx hctx.Context) (string, bool, error) {
+	var b bytes.Buffer
+	b.WriteString(strconv.FormatBool((10 <= 2)))
+	return b.String(), true, nil
+}
+
+// renderMath11Source is the template RenderMath11 was generated from.
+const renderMath11Source = "<%= 2 == 2 %>"
+
+// RenderMath11 renders parity_math_test.go to w.
+func RenderMath11(w io.Writer, ctx hctx.Context) error {
+	out, ok, err := renderMath11Static(ctx)
+	if !ok {
+		out, err = vmplush.Render(renderMath11Source, ctx)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderMath11Static renders the template with the declared types. It reports false
+// when the context does not hold them or holds nil pointers.
+func renderMath11Static(ctx hctx.Context) (string, bool, error) {
+	var b bytes.Buffer
+	b.WriteString(strconv.FormatBool((2 == 2)))
+	return b.String(), true, nil
+}
+
+// renderMath12Source is the template RenderMath12 was generated from.
+const renderMath12Source = "<%= 1 != 2 %>"
+
+// RenderMath12 renders parity_math_test.go to w.
+func RenderMath12(w io.Writer, ctx hctx.Context) error {
+	out, ok, err := renderMath12Static(ctx)
+	if !ok {
+		out, err = vmplush.Render(renderMath12Source, ctx)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderMath12Static renders the template with the declared types. It reports false
+// when the context does not hold them or holds nil pointers.
+func renderMath12Static(ctx hctx.Context) (string, bool, error) {
+	var b bytes.Buffer
+	b.WriteString(strconv.FormatBool((1 != 2)))
+	return b.String(), true, nil
+}
+
+// renderMath13Source is the template RenderMath13 was generated from.
+const renderMath13Source = "<%= 1.0 + 3.0 %>"
+
+// RenderMath13 renders parity_math_test.go to w.
+func RenderMath13(w io.Writer, ctx hctx.Context) error {
+	out, ok, err := renderMath13Static(ctx)
+	if !ok {
+		out, err = vmplush.Render(renderMath13Source, ctx)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderMath13Static renders the template with the declared types. It reports false
+// when the context does not hold them or holds nil pointers.
+func renderMath13Static(ctx hctx.Context) (string, bool, error) {
+	var b bytes.Buffer
+	b.WriteString(fmt.Sprint((float64(1) + float64(3))))
+	return b.String(), true, nil
+}
+
+// renderMath14Source is the template RenderMath14 was generated from.
+const renderMath14Source = "<%= 3.0 - 1.0 %>"
+
+// RenderMath14 renders parity_math_test.go to w.
+func RenderMath14(w io.Writer, ctx hctx.Context) error {
+	out, ok, err := renderMath14Static(ctx)
+	if !ok {
+		out, err = vmplush.Render(renderMath14Source, ctx)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderMath14Static renders the template with the declared types. It reports false
+// when the context does not hold them or holds nil pointers.
+func renderMath14Static(ctx hctx.Context) (string, bool, error) {
+	var b bytes.Buffer
+	b.WriteString(fmt.Sprint((float64(3) - float64(1))))
+	return b.String(), true, nil
+}
+
+// renderMath15Source is the template RenderMath15 was generated from.
+const renderMath15Source = "<%= 10.0 / 2.0 %>"
+
+// renderMath15Fragment0 compiles the statement on line 1 for the VM.
+var renderMath15Fragment0 = sync.OnceValues(func() (*vmplush.Template, error) {
+	return vmplush.CompileAt("<%= 10.0 / 2.0 %>", 1)
+})
+
+// RenderMath15 renders parity_math_test.go to w.
+func RenderMath15(w io.Writer, ctx hctx.Context) error {
+	out, ok, err := renderMath15Static(ctx)
+	if !ok {
+		out, err = vmplush.Render(renderMath15Source, ctx)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderMath15Static renders the template with the declared types. It reports false
+// when the context does not hold them or holds nil pointers.
+func renderMath15Static(ctx hctx.Context) (string, bool, error) {
+	var b bytes.Buffer
+	if t, err := renderMath15Fragment0(); err != nil {
+		return "", true, err
+	} else if s, err := t.Render(ctx); err != nil {
+		return "", true, err
+	} else {
+		b.WriteString(s)
+	}
+	return b.String(), true, nil
+}
+
+// renderMath16Source is the template RenderMath16 was generated from.
+const renderMath16Source = "<%= 10.0 * 2.0 %>"
+
+// RenderMath16 renders parity_math_test.go to w.
+func RenderMath16(w io.Writer, ctx hctx.Context) error {
+	out, ok, err := renderMath16Static(ctx)
+	if !ok {
+		out, err = vmplush.Render(renderMath16Source, ctx)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderMath16Static renders the template with the declared types. It reports false
+// when the context does not hold them or holds nil pointers.
+func renderMath16Static(ctx hctx.Context) (string, bool, error) {
+	var b bytes.Buffer
+	b.WriteString(fmt.Sprint((float64(10) * float64(2))))
+	return b.String(), true, nil
+}
+
+// renderMath17Source is the template RenderMath17 was generated from.
+const renderMath17Source = "<%= 10.0 > 2.0 %>"
+
+// RenderMath17 renders parity_math_test.go to w.
+func RenderMath17(w io.Writer, ctx hctx.Context) error {
+	out, ok, err := renderMath17Static(ctx)
+	if !ok {
+		out, err = vmplush.Render(renderMath17Source, ctx)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderMath17Static renders the template with the declared types. It reports false
+// when the context does not hold them or holds nil pointers.
+func renderMath17Static(ctx hctx.Context) (string, bool, error) {
+	var b bytes.Buffer
+	b.WriteString(strconv.FormatBool((float64(10) > float64(2))))
+	return b.String(), true, nil
+}
+
+// renderMath18Source is the template RenderMath18 was generated from.
+const renderMath18Source = "<%= 10.0 >= 2.0 %>"
+
+// RenderMath18 renders parity_math_test.go to w.
+func RenderMath18(w io.Writer, ctx hctx.Context) error {
+	out, ok, err := renderMath18Static(ctx)
+	if !ok {
+		out, err = vmplush.Render(renderMath18Source, ctx)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderMath18Static renders the template with the declared types. It reports false
+// when the context does not hold them or holds nil pointers.
+func renderMath18Static(ctx hctx.Context) (string, bool, error) {
+	var b bytes.Buffer
+	b.WriteString(strconv.FormatBool((float64(10) >= float64(2))))
+	return b.String(), true, nil
+}
+
+// renderMath19Source is the template RenderMath19 was generated from.
+const renderMath19Source = "<%= 10.0 >= 10.0 %>"
+
+// RenderMath19 renders parity_math_test.go to w.
+func RenderMath19(w io.Writer, ctx hctx.Context) error {
+	out, ok, err := renderMath19Static(ctx)
+	if !ok {
+		out, err = vmplush.Render(renderMath19Source, ctx)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderMath19Static renders the template with the declared types. It reports false
+// when the context does not hold them or holds nil pointers.
+func renderMath19Static(ctx hctx.Context) (string, bool, error) {
+	var b bytes.Buffer
+	b.WriteString(strconv.FormatBool((float64(10) >= float64(10))))
+	return b.String(), true, nil
+}
+
+// renderMath20Source is the template RenderMath20 was generated from.
+const renderMath20Source = "<%= 2.0 <= 2.0 %>"
+
+// RenderMath20 renders parity_math_test.go to w.
+func RenderMath20(w io.Writer, ctx hctx.Context) error {
+	out, ok, err := renderMath20Static(ctx)
+	if !ok {
+		out, err = vmplush.Render(renderMath20Source, ctx)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderMath20Static renders the template with the declared types. It reports false
+// when the context does not hold them or holds nil pointers.
+func renderMath20Static(ctx hctx.Context) (string, bool, error) {
+	var b bytes.Buffer
+	b.WriteString(strconv.FormatBool((float64(2) <= float64(2))))
+	return b.String(), true, nil
+}
+
+// renderMath21Source is the template RenderMath21 was generated from.
+const renderMath21Source = "<%= 10.0 < 2.0 %>"
+
+// RenderMath21 renders parity_math_test.go to w.
+func RenderMath21(w io.Writer, ctx hctx.Context) error {
+	out, ok, err := renderMath21Static(ctx)
+	if !ok {
+		out, err = vmplush.Render(renderMath21Source, ctx)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderMath21Static renders the template with the declared types. It reports false
+// when the context does not hold them or holds nil pointers.
+func renderMath21Static(ctx hctx.Context) (string, bool, error) {
+	var b bytes.Buffer
+	b.WriteString(strconv.FormatBool((float64(10) < float64(2))))
+	return b.String(), true, nil
+}
+
+// renderMath22Source is the template RenderMath22 was generated from.
+const renderMath22Source = "<%= 10.0 <= 2.0 %>"
+
+// RenderMath22 renders parity_math_test.go to w.
+func RenderMath22(w io.Writer, ctx hctx.Context) error {
+	out, ok, err := renderMath22Static(ctx)
+	if !ok {
+		out, err = vmplush.Render(renderMath22Source, ctx)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderMath22Static renders the template with the declared types. It reports false
+// when the context does not hold them or holds nil pointers.
+func renderMath22Static(ctx hctx.Context) (string, bool, error) {
+	var b bytes.Buffer
+	b.WriteString(strconv.FormatBool((float64(10) <= float64(2))))
+	return b.String(), true, nil
+}
+
+// renderMath23Source is the template RenderMath23 was generated from.
+const renderMath23Source = "<%= 2.0 == 2.0 %>"
+
+// RenderMath23 renders parity_math_test.go to w.
+func RenderMath23(w io.Writer, ctx hctx.Context) error {
+	out, ok, err := renderMath23Static(ctx)
+	if !ok {
+		out, err = vmplush.Render(renderMath23Source, ctx)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderMath23Static renders the template with the declared types. It reports false
+// when the context does not hold them or holds nil pointers.
+func renderMath23Static(ctx hctx.Context) (string, bool, error) {
+	var b bytes.Buffer
+	b.WriteString(strconv.FormatBool((float64(2) == float64(2))))
+	return b.String(), true, nil
+}
+
+// renderMath24Source is the template RenderMath24 was generated from.
+const renderMath24Source = "<%= 1.0 != 2.0 %>"
+
+// RenderMath24 renders parity_math_test.go to w.
+func RenderMath24(w io.Writer, ctx hctx.Context) error {
+	out, ok, err := renderMath24Static(ctx)
+	if !ok {
+		out, err = vmplush.Render(renderMath24Source, ctx)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderMath24Static renders the template with the declared types. It reports false
+// when the context does not hold them or holds nil pointers.
+func renderMath24Static(ctx hctx.Context) (string, bool, error) {
+	var b bytes.Buffer
+	b.WriteString(strconv.FormatBool((float64(1) != float64(2))))
+	return b.String(), true, nil
+}
+
+// renderMath25Source is the template RenderMath25 was generated from.
+const renderMath25Source = "<%= \"a\" + \"b\" %>"
+
+// RenderMath25 renders parity_math_test.go to w.
+func RenderMath25(w io.Writer, ctx hctx.Context) error {
+	out, ok, err := renderMath25Static(ctx)
+	if !ok {
+		out, err = vmplush.Render(renderMath25Source, ctx)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderMath25Static renders the template with the declared types. It reports false
+// when the context does not hold them or holds nil pointers.
+func renderMath25Static(ctx hctx.Context) (string, bool, error) {
+	var b bytes.Buffer
+	b.WriteString(template.HTMLEscapeString(("a" + "b")))
+	return b.String(), true, nil
+}
+
+// renderMath26Source is the template RenderMath26 was generated from.
+const renderMath26Source = "<%= \"a\" + \"b\" + \"c\" %>"
+
+// RenderMath26 renders parity_math_test.go to w.
+func RenderMath26(w io.Writer, ctx hctx.Context) error {
+	out, ok, err := renderMath26Static(ctx)
+	if !ok {
+		out, err = vmplush.Render(renderMath26Source, ctx)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderMath26Static renders the template with the declared types. It reports false
+// when the context does not hold them or holds nil pointers.
+func renderMath26Static(ctx hctx.Context) (string, bool, error) {
+	var b bytes.Buffer
+	b.WriteString(template.HTMLEscapeString((("a" + "b") + "c")))
+	return b.String(), true, nil
+}
+
+// renderMath27Source is the template RenderMath27 was generated from.
+const renderMath27Source = "<%= \"a\" != \"b\" %>"
+
+// RenderMath27 renders parity_math_test.go to w.
+func RenderMath27(w io.Writer, ctx hctx.Context) error {
+	out, ok, err := renderMath27Static(ctx)
+	if !ok {
+		out, err = vmplush.Render(renderMath27Source, ctx)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderMath27Static renders the template with the declared types. It reports false
+// when the context does not hold them or holds nil pointers.
+func renderMath27Static(ctx hctx.Context) (string, bool, error) {
+	var b bytes.Buffer
+	b.WriteString(strconv.FormatBool(("a" != "b")))
+	return b.String(), true, nil
+}
+
+// renderMath28Source is the template RenderMath28 was generated from.
+const renderMath28Source = "<%= \"a\" == \"a\" %>"
+
+// RenderMath28 renders parity_math_test.go to w.
+func RenderMath28(w io.Writer, ctx hctx.Context) error {
+	out, ok, err := renderMath28Static(ctx)
+	if !ok {
+		out, err = vmplush.Render(renderMath28Source, ctx)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderMath28Static renders the template with the declared types. It reports false
+// when the context does not hold them or holds nil pointers.
+func renderMath28Static(ctx hctx.Context) (string, bool, error) {
+	var b bytes.Buffer
+	b.WriteString(strconv.FormatBool(("a" == "a")))
+	return b.String(), true, nil
+}
+
+// renderMath29Source is the template RenderMath29 was generated from.
+const renderMath29Source = "<%= \"a\" == \"b\" %>"
+
+// RenderMath29 renders parity_math_test.go to w.
+func RenderMath29(w io.Writer, ctx hctx.Context) error {
+	out, ok, err := renderMath29Static(ctx)
+	if !ok {
+		out, err = vmplush.Render(renderMath29Source, ctx)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderMath29Static renders the template with the declared types. It reports false
+// when the context does not hold them or holds nil pointers.
+func renderMath29Static(ctx hctx.Context) (string, bool, error) {
+	var b bytes.Buffer
+	b.WriteString(strconv.FormatBool(("a" == "b")))
+	return b.String(), true, nil
+}
+
+// renderMath30Source is the template RenderMath30 was generated from.
+const renderMath30Source = "<%= \"a\" > \"b\" %>"
+
+// RenderMath30 renders parity_math_test.go to w.
+func RenderMath30(w io.Writer, ctx hctx.Context) error {
+	out, ok, err := renderMath30Static(ctx)
+	if !ok {
+		out, err = vmplush.Render(renderMath30Source, ctx)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderMath30Static renders the template with the declared types. It reports false
+// when the context does not hold them or holds nil pointers.
+func renderMath30Static(ctx hctx.Context) (string, bool, error) {
+	var b bytes.Buffer
+	b.WriteString(strconv.FormatBool(("a" > "b")))
+	return b.String(), true, nil
+}
+
+// renderMath31Source is the template RenderMath31 was generated from.
+const renderMath31Source = "<%= \"a\" >= \"b\" %>"
+
+// RenderMath31 renders parity_math_test.go to w.
+func RenderMath31(w io.Writer, ctx hctx.Context) error {
+	out, ok, err := renderMath31Static(ctx)
+	if !ok {
+		out, err = vmplush.Render(renderMath31Source, ctx)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderMath31Static renders the template with the declared types. It reports false
+// when the context does not hold them or holds nil pointers.
+func renderMath31Static(ctx hctx.Context) (string, bool, error) {
+	var b bytes.Buffer
+	b.WriteString(strconv.FormatBool(("a" >= "b")))
+	return b.String(), true, nil
+}
+
+// renderMath32Source is the template RenderMath32 was generated from.
+const renderMath32Source = "<%= \"a\" <= \"b\" %>"
+
+// RenderMath32 renders parity_math_test.go to w.
+func RenderMath32(w io.Writer, ctx hctx.Context) error {
+	out, ok, err := renderMath32Static(ctx)
+	if !ok {
+		out, err = vmplush.Render(renderMath32Source, ctx)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderMath32Static renders the template with the declared types. It reports false
+// when the context does not hold them or holds nil pointers.
+func renderMath32Static(ctx hctx.Context) (string, bool, error) {
+	var b bytes.Buffer
+	b.WriteString(strconv.FormatBool(("a" <= "b")))
+	return b.String(), true, nil
+}
+
+// renderMath33Source is the template RenderMath33 was generated from.
+const renderMath33Source = "<%= undefined == 3 %>"
+
+// renderMath33Fragment0 compiles the statement on line 1 for the VM.
+var renderMath33Fragment0 = sync.OnceValues(func() (*vmplush.Template, error) {
+	return vmplush.CompileAt("<%= undefined == 3 %>", 1)
+})
+
+// RenderMath33 renders parity_math_test.go to w.
+func RenderMath33(w io.Writer, ctx hctx.Context) error {
+	out, ok, err := renderMath33Static(ctx)
+	if !ok {
+		out, err = vmplush.Render(renderMath33Source, ctx)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderMath33Static renders the template with the declared types. It reports false
+// when the context does not hold them or holds nil pointers.
+func renderMath33Static(ctx hctx.Context) (string, bool, error) {
+	var b bytes.Buffer
+	if t, err := renderMath33Fragment0(); err != nil {
+		return "", true, err
+	} else if s, err := t.Render(ctx); err != nil {
+		return "", true, err
+	} else {
+		b.WriteString(s)
+	}
+	return b.String(), true, nil
+}
+
+// renderMath34Source is the template RenderMath34 was generated from.
+const renderMath34Source = "<%= undefined != 3 %>"
+
+// renderMath34Fragment0 compiles the statement on line 1 for the VM.
+var renderMath34Fragment0 = sync.OnceValues(func() (*vmplush.Template, error) {
+	return vmplush.CompileAt("<%= undefined != 3 %>", 1)
+})
+
+// RenderMath34 renders parity_math_test.go to w.
+func RenderMath34(w io.Writer, ctx hctx.Context) error {
+	out, ok, err := renderMath34Static(ctx)
+	if !ok {
+		out, err = vmplush.Render(renderMath34Source, ctx)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderMath34Static renders the template with the declared types. It reports false
+// when the context does not hold them or holds nil pointers.
+func renderMath34Static(ctx hctx.Context) (string, bool, error) {
+	var b bytes.Buffer
+	if t, err := renderMath34Fragment0(); err != nil {
+		return "", true, err
+	} else if s, err := t.Render(ctx); err != nil {
+		return "", true, err
+	} else {
+		b.WriteString(s)
+	}
+	return b.String(), true, nil
+}
+
+// renderMath35Source is the template RenderMath35 was generated from.
+const renderMath35Source = "<%= 3 == unknown %>"
+
+// renderMath35Fragment0 compiles the statement on line 1 for the VM.
+var renderMath35Fragment0 = sync.OnceValues(func() (*vmplush.Template, error) {
+	return vmplush.CompileAt("<%= 3 == unknown %>", 1)
+})
+
+// RenderMath35 renders parity_math_test.go to w.
+func RenderMath35(w io.Writer, ctx hctx.Context) error {
+	out, ok, err := renderMath35Static(ctx)
+	if !ok {
+		out, err = vmplush.Render(renderMath35Source, ctx)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderMath35Static renders the template with the declared types. It reports false
+// when the context does not hold them or holds nil pointers.
+func renderMath35Static(ctx hctx.Context) (string, bool, error) {
+	var b bytes.Buffer
+	if t, err := renderMath35Fragment0(); err != nil {
+		return "", true, err
+	} else if s, err := t.Render(ctx); err != nil {
+		return "", true, err
+	} else {
+		b.WriteString(s)
+	}
+	return b.String(), true, nil
+}
+
+// renderMath36Source is the template RenderMath36 was generated from.
+const renderMath36Source = "<%= 3 != unknown %>"
+
+// renderMath36Fragment0 compiles the statement on line 1 for the VM.
+var renderMath36Fragment0 = sync.OnceValues(func() (*vmplush.Template, error) {
+	return vmplush.CompileAt("<%= 3 != unknown %>", 1)
+})
+
+// RenderMath36 renders parity_math_test.go to w.
+func RenderMath36(w io.Writer, ctx hctx.Context) error {
+	out, ok, err := renderMath36Static(ctx)
+	if !ok {
+		out, err = vmplush.Render(renderMath36Source, ctx)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderMath36Static renders the template with the declared types. It reports false
+// when the context does not hold them or holds nil pointers.
+func renderMath36Static(ctx hctx.Context) (string, bool, error) {
+	var b bytes.Buffer
+	if t, err := renderMath36Fragment0(); err != nil {
+		return "", true, err
+	} else if s, err := t.Render(ctx); err != nil {
+		return "", true, err
+	} else {
+		b.WriteString(s)
+	}
+	return b.String(), true, nil
+}
+
+// renderMath37Source is the template RenderMath37 was generated from.
+const renderMath37Source = "<%= undefined + 3 %>"
+
+// renderMath37Fragment0 compiles the statement on line 1 for the VM.
+var renderMath37Fragment0 = sync.OnceValues(func() (*vmplush.Template, error) {
+	return vmplush.CompileAt("<%= undefined + 3 %>", 1)
+})
+
+// RenderMath37 renders parity_math_test.go to w.
+func RenderMath37(w io.Writer, ctx hctx.Context) error {
+	out, ok, err := renderMath37Static(ctx)
+	if !ok {
+		out, err = vmplush.Render(renderMath37Source, ctx)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderMath37Static renders the template with the declared types. It reports false
+// when the context does not hold them or holds nil pointers.
+func renderMath37Static(ctx hctx.Context) (string, bool, error) {
+	var b bytes.Buffer
+	if t, err := renderMath37Fragment0(); err != nil {
+		return "", true, err
+	} else if s, err := t.Render(ctx); err != nil {
+		return "", true, err
+	} else {
+		b.WriteString(s)
+	}
+	return b.String(), true, nil
+}
+
+// renderMath38Source is the template RenderMath38 was generated from.
+const renderMath38Source = "<%= 3 + unknown %>"
+
+// renderMath38Fragment0 compiles the statement on line 1 for the VM.
+var renderMath38Fragment0 = sync.OnceValues(func() (*vmplush.Template, error) {
+	return vmplush.CompileAt("<%= 3 + unknown %>", 1)
+})
+
+// RenderMath38 renders parity_math_test.go to w.
+func RenderMath38(w io.Writer, ctx hctx.Context) error {
+	out, ok, err := renderMath38Static(ctx)
+	if !ok {
+		out, err = vmplush.Render(renderMath38Source, ctx)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderMath38Static renders the template with the declared types. It reports false
+// when the context does not hold them or holds nil pointers.
+func renderMath38Static(ctx hctx.Context) (string, bool, error) {
+	var b bytes.Buffer
+	if t, err := renderMath38Fragment0(); err != nil {
+		return "", true, err
+	} else if s, err := t.Render(ctx); err != nil {
+		return "", true, err
+	} else {
+		b.WriteString(s)
+	}
+	return b.String(), true, nil
+}
+
+// renderMath39Source is the template RenderMath39 was generated from.
+const renderMath39Source = "<%= undefined > 3 %>"
+
+// renderMath39Fragment0 compiles the statement on line 1 for the VM.
+var renderMath39Fragment0 = sync.OnceValues(func() (*vmplush.Template, error) {
+	return vmplush.CompileAt("<%= undefined > 3 %>", 1)
+})
+
+// RenderMath39 renders parity_math_test.go to w.
+func RenderMath39(w io.Writer, ctx hctx.Context) error {
+	out, ok, err := renderMath39Static(ctx)
+	if !ok {
+		out, err = vmplush.Render(renderMath39Source, ctx)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderMath39Static renders the template with the declared types. It reports false
+// when the context does not hold them or holds nil pointers.
+func renderMath39Static(ctx hctx.Context) (string, bool, error) {
+	var b bytes.Buffer
+	if t, err := renderMath39Fragment0(); err != nil {
+		return "", true, err
+	} else if s, err := t.Render(ctx); err != nil {
+		return "", true, err
+	} else {
+		b.WriteString(s)
+	}
+	return b.String(), true, nil
+}
+
+// renderMath40Source is the template RenderMath40 was generated from.
+const renderMath40Source = "<%= \"a\" + 1 %>"
+
+// renderMath40Fragment0 compiles the statement on line 1 for the VM.
+var renderMath40Fragment0 = sync.OnceValues(func() (*vmplush.Template, error) {
+	return vmplush.CompileAt("<%= \"a\" + 1 %>", 1)
+})
+
+// RenderMath40 renders parity_math_test.go to w.
+func RenderMath40(w io.Writer, ctx hctx.Context) error {
+	out, ok, err := renderMath40Static(ctx)
+	if !ok {
+		out, err = vmplush.Render(renderMath40Source, ctx)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderMath40Static renders the template with the declared types. It reports false
+// when the context does not hold them or holds nil pointers.
+func renderMath40Static(ctx hctx.Context) (string, bool, error) {
+	var b bytes.Buffer
+	if t, err := renderMath40Fragment0(); err != nil {
+		return "", true, err
+	} else if s, err := t.Render(ctx); err != nil {
+		return "", true, err
+	} else {
+		b.WriteString(s)
+	}
+	return b.String(), true, nil
+}
+
+// renderMath41Source is the template RenderMath41 was generated from.
+const renderMath41Source = "<%= true + 1 %>"
+
+// renderMath41Fragment0 compiles the statement on line 1 for the VM.
+var renderMath41Fragment0 = sync.OnceValues(func() (*vmplush.Template, error) {
+	return vmplush.CompileAt("<%= true + 1 %>", 1)
+})
+
+// RenderMath41 renders parity_math_test.go to w.
+func RenderMath41(w io.Writer, ctx hctx.Context) error {
+	out, ok, err := renderMath41Static(ctx)
+	if !ok {
+		out, err = vmplush.Render(renderMath41Source, ctx)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderMath41Static renders the template with the declared types. It reports false
+// when the context does not hold them or holds nil pointers.
+func renderMath41Static(ctx hctx.Context) (string, bool, error) {
+	var b bytes.Buffer
+	if t, err := renderMath41Fragment0(); err != nil {
+		return "", true, err
+	} else if s, err := t.Render(ctx); err != nil {
+		return "", true, err
+	} else {
+		b.WriteString(s)
+	}
+	return b.String(), true, nil
+}
+
+// renderMath42Source is the template RenderMath42 was generated from.
+const renderMath42Source = "<%= 10 / 0 %>"
+
+// renderMath42Fragment0 compiles the statement on line 1 for the VM.
+var renderMath42Fragment0 = sync.OnceValues(func() (*vmplush.Template, error) {
+	return vmplush.CompileAt("<%= 10 / 0 %>", 1)
+})
+
+// RenderMath42 renders parity_math_test.go to w.
+func RenderMath42(w io.Writer, ctx hctx.Context) error {
+	out, ok, err := renderMath42Static(ctx)
+	if !ok {
+		out, err = vmplush.Render(renderMath42Source, ctx)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderMath42Static renders the template with the declared types. It reports false
+// when the context does not hold them or holds nil pointers.
+func renderMath42Static(ctx hctx.Context) (string, bool, error) {
+	var b bytes.Buffer
+	if t, err := renderMath42Fragment0(); err != nil {
+		return "", true, err
+	} else if s, err := t.Render(ctx); err != nil {
+		return "", true, err
+	} else {
+		b.WriteString(s)
+	}
+	return b.String(), true, nil
+}
+
+// renderMath43Source is the template RenderMath43 was generated from.
+const renderMath43Source = "<%= 10.5 / 0.0 %>"
+
+// renderMath43Fragment0 compiles the statement on line 1 for the VM.
+var renderMath43Fragment0 = sync.OnceValues(func() (*vmplush.Template, error) {
+	return vmplush.CompileAt("<%= 10.5 / 0.0 %>", 1)
+})
+
+// RenderMath43 renders parity_math_test.go to w.
+func RenderMath43(w io.Writer, ctx hctx.Context) error {
+	out, ok, err := renderMath43Static(ctx)
+	if !ok {
+		out, err = vmplush.Render(renderMath43Source, ctx)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderMath43Static renders the template with the declared types. It reports false
+// when the context does not hold them or holds nil pointers.
+func renderMath43Static(ctx hctx.Context) (string, bool, error) {
+	var b bytes.Buffer
+	if t, err := renderMath43Fragment0(); err != nil {
+		return "", true, err
+	} else if s, err := t.Render(ctx); err != nil {
+		return "", true, err
+	} else {
+		b.WriteString(s)
+	}
+	return b.String(), true, nil
+}
+
+// renderMath44Source is the template RenderMath44 was generated from.
+const renderMath44Source = "<%= i32 %> <%= u32 %> <%= i8 %>"
+
+// RenderMath44 renders parity_math_test.go to w.
+func RenderMath44(w io.Writer, ctx hctx.Context) error {
+	out, ok, err := renderMath44Static(ctx)
+	if !ok {
+		out, err = vmplush.Render(renderMath44Source, ctx)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderMath44Static renders the template with the declared types. It reports false
+// when the context does not hold them or holds nil pointers.
+func renderMath44Static(ctx hctx.Context) (string, bool, error) {
+	v_i32, ok := ctx.Value("i32").(int32)
+	if !ok {
+		return "", false, nil
+	}
+	v_i8, ok := ctx.Value("i8").(int8)
+	if !ok {
+		return "", false, nil
+	}
+	v_u32, ok := ctx.Value("u32").(uint32)
+	if !ok {
+		return "", false, nil
+	}
+	var b bytes.Buffer
+	b.WriteString(strconv.FormatInt(int64(v_i32), 10))
+	b.WriteString(" ")
+	b.WriteString(strconv.FormatUint(uint64(v_u32), 10))
+	b.WriteString(" ")
+	b.WriteString(strconv.FormatInt(int64(v_i8), 10))
+	return b.String(), true, nil
+}
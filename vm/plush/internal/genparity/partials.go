@@ -0,0 +1,574 @@
+// Code generated by go test ./vm/plush -update-gen. DO NOT EDIT.
+
+package genparity
+
+import (
+	"bytes"
+	"github.com/gobuffalo/plush/v5/helpers/hctx"
+	vmplush "github.com/gobuffalo/plush/v5/vm/plush"
+	"html/template"
+	"io"
+	"strconv"
+	"sync"
+)
+
+// renderPartials1Source is the template RenderPartials1 was generated from.
+const renderPartials1Source = "<%= partial(\"hello.plush\") %>"
+
+// renderPartials1Fragment0 compiles the statement on line 1 for the VM.
+var renderPartials1Fragment0 = sync.OnceValues(func() (*vmplush.Template, error) {
+	return vmplush.CompileAt("<%= partial(\"hello.plush\") %>", 1)
+})
+
+// RenderPartials1 renders parity_partials_test.go to w.
+func RenderPartials1(w io.Writer, ctx hctx.Context) error {
+	out, ok, err := renderPartials1Static(ctx)
+	if !ok {
+		out, err = vmplush.Render(renderPartials1Source, ctx)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderPartials1Static renders the template with the declared types. It reports false
+// when the context does not hold them or holds nil pointers.
+func renderPartials1Static(ctx hctx.Context) (string, bool, error) {
+	var b bytes.Buffer
+	if t, err := renderPartials1Fragment0(); err != nil {
+		return "", true, err
+	} else if s, err := t.Render(ctx); err != nil {
+		return "", true, err
+	} else {
+		b.WriteString(s)
+	}
+	return b.String(), true, nil
+}
+
+// renderPartials2Source is the template RenderPartials2 was generated from.
+const renderPartials2Source = "<%= partial(\"partials/code-1.plush.html\") %>"
+
+// renderPartials2Fragment0 compiles the statement on line 1 for the VM.
+var renderPartials2Fragment0 = sync.OnceValues(func() (*vmplush.Template, error) {
+	return vmplush.CompileAt("<%= partial(\"partials/code-1.plush.html\") %>", 1)
+})
+
+// RenderPartials2 renders parity_partials_test.go to w.
+func RenderPartials2(w io.Writer, ctx hctx.Context) error {
+	out, ok, err := renderPartials2Static(ctx)
+	if !ok {
+		out, err = vmplush.Render(renderPartials2Source, ctx)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderPartials2Static renders the template with the declared types. It reports false
+// when the context does not hold them or holds nil pointers.
+func renderPartials2Static(ctx hctx.Context) (string, bool, error) {
+	var b bytes.Buffer
+	if t, err := renderPartials2Fragment0(); err != nil {
+		return "", true, err
+	} else if s, err := t.Render(ctx); err != nil {
+		return "", true, err
+	} else {
+		b.WriteString(s)
+	}
+	return b.String(), true, nil
+}
+
+// renderPartials3Source is the template RenderPartials3 was generated from.
+const renderPartials3Source = "<%= partial(\"card\", {name: \"Mido\", layout: \"shell\"}) %>"
+
+// renderPartials3Fragment0 compiles the statement on line 1 for the VM.
+var renderPartials3Fragment0 = sync.OnceValues(func() (*vmplush.Template, error) {
+	return vmplush.CompileAt("<%= partial(\"card\", {name: \"Mido\", layout: \"shell\"}) %>", 1)
+})
+
+// RenderPartials3 renders parity_partials_test.go to w.
+func RenderPartials3(w io.Writer, ctx hctx.Context) error {
+	out, ok, err := renderPartials3Static(ctx)
+	if !ok {
+		out, err = vmplush.Render(renderPartials3Source, ctx)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderPartials3Static renders the template with the declared types. It reports false
+// when the context does not hold them or holds nil pointers.
+func renderPartials3Static(ctx hctx.Context) (string, bool, error) {
+	var b bytes.Buffer
+	if t, err := renderPartials3Fragment0(); err != nil {
+		return "", true, err
+	} else if s, err := t.Render(ctx); err != nil {
+		return "", true, err
+	} else {
+		b.WriteString(s)
+	}
+	return b.String(), true, nil
+}
+
+// renderPartials4Source is the template RenderPartials4 was generated from.
+const renderPartials4Source = "<%= partial(\"count\") %><%= number %>"
+
+// renderPartials4Fragment0 compiles the statement on line 1 for the VM.
+var renderPartials4Fragment0 = sync.OnceValues(func() (*vmplush.Template, error) {
+	return vmplush.CompileAt("<%= partial(\"count\") %>", 1)
+})
+
+// RenderPartials4 renders parity_partials_test.go to w.
+func RenderPartials4(w io.Writer, ctx hctx.Context) error {
+	out, ok, err := renderPartials4Static(ctx)
+	if !ok {
+		out, err = vmplush.Render(renderPartials4Source, ctx)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderPartials4Static renders the template with the declared types. It reports false
+// when the context does not hold them or holds nil pointers.
+func renderPartials4Static(ctx hctx.Context) (string, bool, error) {
+	v_number, ok := ctx.Value("number").(int)
+	if !ok {
+		return "", false, nil
+	}
+	var b bytes.Buffer
+	if t, err := renderPartials4Fragment0(); err != nil {
+		return "", true, err
+	} else if s, err := t.Render(ctx); err != nil {
+		return "", true, err
+	} else {
+		b.WriteString(s)
+	}
+	b.WriteString(strconv.Itoa(v_number))
+	return b.String(), true, nil
+}
+
+// renderPartials5Source is the template RenderPartials5 was generated from.
+const renderPartials5Source = "<% let collected = [] %><%= partial(\"tree.plush.html\", {nodes: nodes}) %><%= json_encode(collected) %>"
+
+// RenderPartials5 renders parity_partials_test.go to w.
+//
+// The template is rendered by the VM: it declares variables with let.
+func RenderPartials5(w io.Writer, ctx hctx.Context) error {
+	out, err := vmplush.Render(renderPartials5Source, ctx)
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderPartials6Source is the template RenderPartials6 was generated from.
+const renderPartials6Source = "<%= partial(\"row\", {name: first, title: robot.Name}) %>|<%= partial(\"row\", {name: second, title: \"literal\"}) %>|<%= name %>"
+
+// renderPartials6Fragment0 compiles the statement on line 1 for the VM.
+var renderPartials6Fragment0 = sync.OnceValues(func() (*vmplush.Template, error) {
+	return vmplush.CompileAt("<%= partial(\"row\", {name: first, title: robot.Name}) %>", 1)
+})
+
+// renderPartials6Fragment1 compiles the statement on line 1 for the VM.
+var renderPartials6Fragment1 = sync.OnceValues(func() (*vmplush.Template, error) {
+	return vmplush.CompileAt("<%= partial(\"row\", {name: second, title: \"literal\"}) %>", 1)
+})
+
+// RenderPartials6 renders parity_partials_test.go to w.
+func RenderPartials6(w io.Writer, ctx hctx.Context) error {
+	out, ok, err := renderPartials6Static(ctx)
+	if !ok {
+		out, err = vmplush.Render(renderPartials6Source, ctx)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderPartials6Static renders the template with the declared types. It reports false
+// when the context does not hold them or holds nil pointers.
+func renderPartials6Static(ctx hctx.Context) (string, bool, error) {
+	v_name, ok := ctx.Value("name").(string)
+	if !ok {
+		return "", false, nil
+	}
+	var b bytes.Buffer
+	if t, err := renderPartials6Fragment0(); err != nil {
+		return "", true, err
+	} else if s, err := t.Render(ctx); err != nil {
+		return "", true, err
+	} else {
+		b.WriteString(s)
+	}
+	b.WriteString("|")
+	if t, err := renderPartials6Fragment1(); err != nil {
+		return "", true, err
+	} else if s, err := t.Render(ctx); err != nil {
+		return "", true, err
+	} else {
+		b.WriteString(s)
+	}
+	b.WriteString("|")
+	b.WriteString(template.HTMLEscapeString(v_name))
+	return b.String(), true, nil
+}
+
+// renderPartials7Source is the template RenderPartials7 was generated from.
+const renderPartials7Source = "<%= partial(\"row\", {label: label(product.Name, prefix)}) %>|<%= product.Name %>"
+
+// renderPartials7Fragment0 compiles the statement on line 1 for the VM.
+var renderPartials7Fragment0 = sync.OnceValues(func() (*vmplush.Template, error) {
+	return vmplush.CompileAt("<%= partial(\"row\", {label: label(product.Name, prefix)}) %>", 1)
+})
+
+// renderPartials7Fragment1 compiles the statement on line 1 for the VM.
+var renderPartials7Fragment1 = sync.OnceValues(func() (*vmplush.Template, error) {
+	return vmplush.CompileAt("<%= product.Name %>", 1)
+})
+
+// RenderPartials7 renders parity_partials_test.go to w.
+func RenderPartials7(w io.Writer, ctx hctx.Context) error {
+	out, ok, err := renderPartials7Static(ctx)
+	if !ok {
+		out, err = vmplush.Render(renderPartials7Source, ctx)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderPartials7Static renders the template with the declared types. It reports false
+// when the context does not hold them or holds nil pointers.
+func renderPartials7Static(ctx hctx.Context) (string, bool, error) {
+	var b bytes.Buffer
+	if t, err := renderPartials7Fragment0(); err != nil {
+		return "", true, err
+	} else if s, err := t.Render(ctx); err != nil {
+		return "", true, err
+	} else {
+		b.WriteString(s)
+	}
+	b.WriteString("|")
+	if t, err := renderPartials7Fragment1(); err != nil {
+		return "", true, err
+	} else if s, err := t.Render(ctx); err != nil {
+		return "", true, err
+	} else {
+		b.WriteString(s)
+	}
+	return b.String(), true, nil
+}
+
+// renderPartials8Source is the template RenderPartials8 was generated from.
+const renderPartials8Source = "<%= if (currentRoute.PathName == \"target\") { %><% let lookup = {} %><%= partial(\"partials/lookup-file.html\") %><%= lookup[\"primary\"] %><% } %>"
+
+// RenderPartials8 renders parity_partials_test.go to w.
+//
+// The template is rendered by the VM: it declares variables with let.
+func RenderPartials8(w io.Writer, ctx hctx.Context) error {
+	out, err := vmplush.Render(renderPartials8Source, ctx)
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderPartials9Source is the template RenderPartials9 was generated from.
+const renderPartials9Source = "<%= partial(\"index.html\") %>|<%= partial(\"index.js\") %>|<%= partial(\"index\") %>"
+
+// renderPartials9Fragment0 compiles the statement on line 1 for the VM.
+var renderPartials9Fragment0 = sync.OnceValues(func() (*vmplush.Template, error) {
+	return vmplush.CompileAt("<%= partial(\"index.html\") %>", 1)
+})
+
+// renderPartials9Fragment1 compiles the statement on line 1 for the VM.
+var renderPartials9Fragment1 = sync.OnceValues(func() (*vmplush.Template, error) {
+	return vmplush.CompileAt("<%= partial(\"index.js\") %>", 1)
+})
+
+// renderPartials9Fragment2 compiles the statement on line 1 for the VM.
+var renderPartials9Fragment2 = sync.OnceValues(func() (*vmplush.Template, error) {
+	return vmplush.CompileAt("<%= partial(\"index\") %>", 1)
+})
+
+// RenderPartials9 renders parity_partials_test.go to w.
+func RenderPartials9(w io.Writer, ctx hctx.Context) error {
+	out, ok, err := renderPartials9Static(ctx)
+	if !ok {
+		out, err = vmplush.Render(renderPartials9Source, ctx)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderPartials9Static renders the template with the declared types. It reports false
+// when the context does not hold them or holds nil pointers.
+func renderPartials9Static(ctx hctx.Context) (string, bool, error) {
+	var b bytes.Buffer
+	if t, err := renderPartials9Fragment0(); err != nil {
+		return "", true, err
+	} else if s, err := t.Render(ctx); err != nil {
+		return "", true, err
+	} else {
+		b.WriteString(s)
+	}
+	b.WriteString("|")
+	if t, err := renderPartials9Fragment1(); err != nil {
+		return "", true, err
+	} else if s, err := t.Render(ctx); err != nil {
+		return "", true, err
+	} else {
+		b.WriteString(s)
+	}
+	b.WriteString("|")
+	if t, err := renderPartials9Fragment2(); err != nil {
+		return "", true, err
+	} else if s, err := t.Render(ctx); err != nil {
+		return "", true, err
+	} else {
+		b.WriteString(s)
+	}
+	return b.String(), true, nil
+}
+
+// renderPartials10Source is the template RenderPartials10 was generated from.
+const renderPartials10Source = "<%= partial(\"js_having_html_partial.js\") %>|<%= partial(\"js_having_js_partial.js\") %>"
+
+// renderPartials10Fragment0 compiles the statement on line 1 for the VM.
+var renderPartials10Fragment0 = sync.OnceValues(func() (*vmplush.Template, error) {
+	return vmplush.CompileAt("<%= partial(\"js_having_html_partial.js\") %>", 1)
+})
+
+// renderPartials10Fragment1 compiles the statement on line 1 for the VM.
+var renderPartials10Fragment1 = sync.OnceValues(func() (*vmplush.Template, error) {
+	return vmplush.CompileAt("<%= partial(\"js_having_js_partial.js\") %>", 1)
+})
+
+// RenderPartials10 renders parity_partials_test.go to w.
+func RenderPartials10(w io.Writer, ctx hctx.Context) error {
+	out, ok, err := renderPartials10Static(ctx)
+	if !ok {
+		out, err = vmplush.Render(renderPartials10Source, ctx)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderPartials10Static renders the template with the declared types. It reports false
+// when the context does not hold them or holds nil pointers.
+func renderPartials10Static(ctx hctx.Context) (string, bool, error) {
+	var b bytes.Buffer
+	if t, err := renderPartials10Fragment0(); err != nil {
+		return "", true, err
+	} else if s, err := t.Render(ctx); err != nil {
+		return "", true, err
+	} else {
+		b.WriteString(s)
+	}
+	b.WriteString("|")
+	if t, err := renderPartials10Fragment1(); err != nil {
+		return "", true, err
+	} else if s, err := t.Render(ctx); err != nil {
+		return "", true, err
+	} else {
+		b.WriteString(s)
+	}
+	return b.String(), true, nil
+}
+
+// renderPartials11Source is the template RenderPartials11 was generated from.
+const renderPartials11Source = "<%= partial(\"index\") %>"
+
+// renderPartials11Fragment0 compiles the statement on line 1 for the VM.
+var renderPartials11Fragment0 = sync.OnceValues(func() (*vmplush.Template, error) {
+	return vmplush.CompileAt("<%= partial(\"index\") %>", 1)
+})
+
+// RenderPartials11 renders parity_partials_test.go to w.
+func RenderPartials11(w io.Writer, ctx hctx.Context) error {
+	out, ok, err := renderPartials11Static(ctx)
+	if !ok {
+		out, err = vmplush.Render(renderPartials11Source, ctx)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderPartials11Static renders the template with the declared types. It reports false
+// when the context does not hold them or holds nil pointers.
+func renderPartials11Static(ctx hctx.Context) (string, bool, error) {
+	var b bytes.Buffer
+	if t, err := renderPartials11Fragment0(); err != nil {
+		return "", true, err
+	} else if s, err := t.Render(ctx); err != nil {
+		return "", true, err
+	} else {
+		b.WriteString(s)
+	}
+	return b.String(), true, nil
+}
+
+// renderPartials12Source is the template RenderPartials12 was generated from.
+const renderPartials12Source = "<%= partial(\"missing\") %>"
+
+// renderPartials12Fragment0 compiles the statement on line 1 for the VM.
+var renderPartials12Fragment0 = sync.OnceValues(func() (*vmplush.Template, error) {
+	return vmplush.CompileAt("<%= partial(\"missing\") %>", 1)
+})
+
+// RenderPartials12 renders parity_partials_test.go to w.
+func RenderPartials12(w io.Writer, ctx hctx.Context) error {
+	out, ok, err := renderPartials12Static(ctx)
+	if !ok {
+		out, err = vmplush.Render(renderPartials12Source, ctx)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderPartials12Static renders the template with the declared types. It reports false
+// when the context does not hold them or holds nil pointers.
+func renderPartials12Static(ctx hctx.Context) (string, bool, error) {
+	var b bytes.Buffer
+	if t, err := renderPartials12Fragment0(); err != nil {
+		return "", true, err
+	} else if s, err := t.Render(ctx); err != nil {
+		return "", true, err
+	} else {
+		b.WriteString(s)
+	}
+	return b.String(), true, nil
+}
+
+// renderPartials13Source is the template RenderPartials13 was generated from.
+const renderPartials13Source = "<%= partial(\"bad\") %>"
+
+// renderPartials13Fragment0 compiles the statement on line 1 for the VM.
+var renderPartials13Fragment0 = sync.OnceValues(func() (*vmplush.Template, error) {
+	return vmplush.CompileAt("<%= partial(\"bad\") %>", 1)
+})
+
+// RenderPartials13 renders parity_partials_test.go to w.
+func RenderPartials13(w io.Writer, ctx hctx.Context) error {
+	out, ok, err := renderPartials13Static(ctx)
+	if !ok {
+		out, err = vmplush.Render(renderPartials13Source, ctx)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderPartials13Static renders the template with the declared types. It reports false
+// when the context does not hold them or holds nil pointers.
+func renderPartials13Static(ctx hctx.Context) (string, bool, error) {
+	var b bytes.Buffer
+	if t, err := renderPartials13Fragment0(); err != nil {
+		return "", true, err
+	} else if s, err := t.Render(ctx); err != nil {
+		return "", true, err
+	} else {
+		b.WriteString(s)
+	}
+	return b.String(), true, nil
+}
+
+// renderPartials14Source is the template RenderPartials14 was generated from.
+const renderPartials14Source = "<%= partial(\"child.plush.html\") %>"
+
+// renderPartials14Fragment0 compiles the statement on line 1 for the VM.
+var renderPartials14Fragment0 = sync.OnceValues(func() (*vmplush.Template, error) {
+	return vmplush.CompileAt("<%= partial(\"child.plush.html\") %>", 1)
+})
+
+// RenderPartials14 renders parity_partials_test.go to w.
+func RenderPartials14(w io.Writer, ctx hctx.Context) error {
+	out, ok, err := renderPartials14Static(ctx)
+	if !ok {
+		out, err = vmplush.Render(renderPartials14Source, ctx)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderPartials14Static renders the template with the declared types. It reports false
+// when the context does not hold them or holds nil pointers.
+func renderPartials14Static(ctx hctx.Context) (string, bool, error) {
+	var b bytes.Buffer
+	if t, err := renderPartials14Fragment0(); err != nil {
+		return "", true, err
+	} else if s, err := t.Render(ctx); err != nil {
+		return "", true, err
+	} else {
+		b.WriteString(s)
+	}
+	return b.String(), true, nil
+}
+
+// renderPartials15Source is the template RenderPartials15 was generated from.
+const renderPartials15Source = "<%= partial(\"body\", {layout: \"layout\"}) %>"
+
+// renderPartials15Fragment0 compiles the statement on line 1 for the VM.
+var renderPartials15Fragment0 = sync.OnceValues(func() (*vmplush.Template, error) {
+	return vmplush.CompileAt("<%= partial(\"body\", {layout: \"layout\"}) %>", 1)
+})
+
+// RenderPartials15 renders parity_partials_test.go to w.
+func RenderPartials15(w io.Writer, ctx hctx.Context) error {
+	out, ok, err := renderPartials15Static(ctx)
+	if !ok {
+		out, err = vmplush.Render(renderPartials15Source, ctx)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderPartials15Static renders the template with the declared types. It reports false
+// when the context does not hold them or holds nil pointers.
+func renderPartials15Static(ctx hctx.Context) (string, bool, error) {
+	var b bytes.Buffer
+	if t, err := renderPartials15Fragment0(); err != nil {
+		return "", true, err
+	} else if s, err := t.Render(ctx); err != nil {
+		return "", true, err
+	} else {
+		b.WriteString(s)
+	}
+	return b.String(), true, nil
+}
@@ -0,0 +1,2743 @@
+// Code generated by go test ./vm/plush -update-gen. DO NOT EDIT.
+
+package genparity
+
+import (
+	"bytes"
+	"github.com/gobuffalo/plush/v5/helpers/hctx"
+	vmplush "github.com/gobuffalo/plush/v5/vm/plush"
+	"html/template"
+	"io"
+	"sync"
+)
+
+// renderRootTests1Source is the template RenderRootTests1 was generated from.
+const renderRootTests1Source = "<p>Hi</p>"
+
+// RenderRootTests1 renders parity_root_tests_test.go to w.
+func RenderRootTests1(w io.Writer, ctx hctx.Context) error {
+	out, ok, err := renderRootTests1Static(ctx)
+	if !ok {
+		out, err = vmplush.Render(renderRootTests1Source, ctx)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderRootTests1Static renders the template with the declared types. It reports false
+// when the context does not hold them or holds nil pointers.
+func renderRootTests1Static(ctx hctx.Context) (string, bool, error) {
+	var b bytes.Buffer
+	b.WriteString("<p>Hi</p>")
+	return b.String(), true, nil
+}
+
+// renderRootTests2Source is the template RenderRootTests2 was generated from.
+const renderRootTests2Source = "<p><%= \"mark\" %></p>"
+
+// RenderRootTests2 renders parity_root_tests_test.go to w.
+func RenderRootTests2(w io.Writer, ctx hctx.Context) error {
+	out, ok, err := renderRootTests2Static(ctx)
+	if !ok {
+		out, err = vmplush.Render(renderRootTests2Source, ctx)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderRootTests2Static renders the template with the declared types. It reports false
+// when the context does not hold them or holds nil pointers.
+func renderRootTests2Static(ctx hctx.Context) (string, bool, error) {
+	var b bytes.Buffer
+	b.WriteString("<p>")
+	b.WriteString(template.HTMLEscapeString("mark"))
+	b.WriteString("</p>")
+	return b.String(), true, nil
+}
+
+// renderRootTests3Source is the template RenderRootTests3 was generated from.
+const renderRootTests3Source = "<p><%= name %></p>"
+
+// RenderRootTests3 renders parity_root_tests_test.go to w.
+func RenderRootTests3(w io.Writer, ctx hctx.Context) error {
+	out, ok, err := renderRootTests3Static(ctx)
+	if !ok {
+		out, err = vmplush.Render(renderRootTests3Source, ctx)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderRootTests3Static renders the template with the declared types. It reports false
+// when the context does not hold them or holds nil pointers.
+func renderRootTests3Static(ctx hctx.Context) (string, bool, error) {
+	v_name, ok := ctx.Value("name").(string)
+	if !ok {
+		return "", false, nil
+	}
+	var b bytes.Buffer
+	b.WriteString("<p>")
+	b.WriteString(template.HTMLEscapeString(v_name))
+	b.WriteString("</p>")
+	return b.String(), true, nil
+}
+
+// renderRootTests4Source is the template RenderRootTests4 was generated from.
+const renderRootTests4Source = "<% let add = fn(x) { return x + 2; }; %><%= add(2) %>"
+
+// RenderRootTests4 renders parity_root_tests_test.go to w.
+//
+// The template is rendered by the VM: it declares variables with let.
+func RenderRootTests4(w io.Writer, ctx hctx.Context) error {
+	out, err := vmplush.Render(renderRootTests4Source, ctx)
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderRootTests5Source is the template RenderRootTests5 was generated from.
+const renderRootTests5Source = "<%= foo(1, 2, 3) %>"
+
+// renderRootTests5Fragment0 compiles the statement on line 1 for the VM.
+var renderRootTests5Fragment0 = sync.OnceValues(func() (*vmplush.Template, error) {
+	return vmplush.CompileAt("<%= foo(1, 2, 3) %>", 1)
+})
+
+// RenderRootTests5 renders parity_root_tests_test.go to w.
+func RenderRootTests5(w io.Writer, ctx hctx.Context) error {
+	out, ok, err := renderRootTests5Static(ctx)
+	if !ok {
+		out, err = vmplush.Render(renderRootTests5Source, ctx)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderRootTests5Static renders the template with the declared types. It reports false
+// when the context does not hold them or holds nil pointers.
+func renderRootTests5Static(ctx hctx.Context) (string, bool, error) {
+	var b bytes.Buffer
+	if t, err := renderRootTests5Fragment0(); err != nil {
+		return "", true, err
+	} else if s, err := t.Render(ctx); err != nil {
+		return "", true, err
+	} else {
+		b.WriteString(s)
+	}
+	return b.String(), true, nil
+}
+
+// renderRootTests6Source is the template RenderRootTests6 was generated from.
+const renderRootTests6Source = "<%= foo(\"hello\") %>"
+
+// renderRootTests6Fragment0 compiles the statement on line 1 for the VM.
+var renderRootTests6Fragment0 = sync.OnceValues(func() (*vmplush.Template, error) {
+	return vmplush.CompileAt("<%= foo(\"hello\") %>", 1)
+})
+
+// RenderRootTests6 renders parity_root_tests_test.go to w.
+func RenderRootTests6(w io.Writer, ctx hctx.Context) error {
+	out, ok, err := renderRootTests6Static(ctx)
+	if !ok {
+		out, err = vmplush.Render(renderRootTests6Source, ctx)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderRootTests6Static renders the template with the declared types. It reports false
+// when the context does not hold them or holds nil pointers.
+func renderRootTests6Static(ctx hctx.Context) (string, bool, error) {
+	var b bytes.Buffer
+	if t, err := renderRootTests6Fragment0(); err != nil {
+		return "", true, err
+	} else if s, err := t.Render(ctx); err != nil {
+		return "", true, err
+	} else {
+		b.WriteString(s)
+	}
+	return b.String(), true, nil
+}
+
+// renderRootTests7Source is the template RenderRootTests7 was generated from.
+const renderRootTests7Source = "<%= foo() %>"
+
+// renderRootTests7Fragment0 compiles the statement on line 1 for the VM.
+var renderRootTests7Fragment0 = sync.OnceValues(func() (*vmplush.Template, error) {
+	return vmplush.CompileAt("<%= foo() %>", 1)
+})
+
+// RenderRootTests7 renders parity_root_tests_test.go to w.
+func RenderRootTests7(w io.Writer, ctx hctx.Context) error {
+	out, ok, err := renderRootTests7Static(ctx)
+	if !ok {
+		out, err = vmplush.Render(renderRootTests7Source, ctx)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderRootTests7Static renders the template with the declared types. It reports false
+// when the context does not hold them or holds nil pointers.
+func renderRootTests7Static(ctx hctx.Context) (string, bool, error) {
+	var b bytes.Buffer
+	if t, err := renderRootTests7Fragment0(); err != nil {
+		return "", true, err
+	} else if s, err := t.Render(ctx); err != nil {
+		return "", true, err
+	} else {
+		b.WriteString(s)
+	}
+	return b.String(), true, nil
+}
+
+// renderRootTests8Source is the template RenderRootTests8 was generated from.
+const renderRootTests8Source = "<%= foo(1) %>"
+
+// renderRootTests8Fragment0 compiles the statement on line 1 for the VM.
+var renderRootTests8Fragment0 = sync.OnceValues(func() (*vmplush.Template, error) {
+	return vmplush.CompileAt("<%= foo(1) %>", 1)
+})
+
+// RenderRootTests8 renders parity_root_tests_test.go to w.
+func RenderRootTests8(w io.Writer, ctx hctx.Context) error {
+	out, ok, err := renderRootTests8Static(ctx)
+	if !ok {
+		out, err = vmplush.Render(renderRootTests8Source, ctx)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderRootTests8Static renders the template with the declared types. It reports false
+// when the context does not hold them or holds nil pointers.
+func renderRootTests8Static(ctx hctx.Context) (string, bool, error) {
+	var b bytes.Buffer
+	if t, err := renderRootTests8Fragment0(); err != nil {
+		return "", true, err
+	} else if s, err := t.Render(ctx); err != nil {
+		return "", true, err
+	} else {
+		b.WriteString(s)
+	}
+	return b.String(), true, nil
+}
+
+// renderRootTests9Source is the template RenderRootTests9 was generated from.
+const renderRootTests9Source = "<%= m[\"first\"]%>"
+
+// renderRootTests9Fragment0 compiles the statement on line 1 for the VM.
+var renderRootTests9Fragment0 = sync.OnceValues(func() (*vmplush.Template, error) {
+	return vmplush.CompileAt("<%= m[\"first\"] %>", 1)
+})
+
+// RenderRootTests9 renders parity_root_tests_test.go to w.
+func RenderRootTests9(w io.Writer, ctx hctx.Context) error {
+	out, ok, err := renderRootTests9Static(ctx)
+	if !ok {
+		out, err = vmplush.Render(renderRootTests9Source, ctx)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderRootTests9Static renders the template with the declared types. It reports false
+// when the context does not hold them or holds nil pointers.
+func renderRootTests9Static(ctx hctx.Context) (string, bool, error) {
+	var b bytes.Buffer
+	if t, err := renderRootTests9Fragment0(); err != nil {
+		return "", true, err
+	} else if s, err := t.Render(ctx); err != nil {
+		return "", true, err
+	} else {
+		b.WriteString(s)
+	}
+	return b.String(), true, nil
+}
+
+// renderRootTests10Source is the template RenderRootTests10 was generated from.
+const renderRootTests10Source = "<%= m[\"first\"] + \" \" + m[\"last\"] %>|<%= a[0+1] %>"
+
+// renderRootTests10Fragment0 compiles the statement on line 1 for the VM.
+var renderRootTests10Fragment0 = sync.OnceValues(func() (*vmplush.Template, error) {
+	return vmplush.CompileAt("<%= m[\"first\"] + \" \" + m[\"last\"] %>", 1)
+})
+
+// renderRootTests10Fragment1 compiles the statement on line 1 for the VM.
+var renderRootTests10Fragment1 = sync.OnceValues(func() (*vmplush.Template, error) {
+	return vmplush.CompileAt("<%= a[0+1] %>", 1)
+})
+
+// RenderRootTests10 renders parity_root_tests_test.go to w.
+func RenderRootTests10(w io.Writer, ctx hctx.Context) error {
+	out, ok, err := renderRootTests10Static(ctx)
+	if !ok {
+		out, err = vmplush.Render(renderRootTests10Source, ctx)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderRootTests10Static renders the template with the declared types. It reports false
+// when the context does not hold them or holds nil pointers.
+func renderRootTests10Static(ctx hctx.Context) (string, bool, error) {
+	var b bytes.Buffer
+	if t, err := renderRootTests10Fragment0(); err != nil {
+		return "", true, err
+	} else if s, err := t.Render(ctx); err != nil {
+		return "", true, err
+	} else {
+		b.WriteString(s)
+	}
+	b.WriteString("|")
+	if t, err := renderRootTests10Fragment1(); err != nil {
+		return "", true, err
+	} else if s, err := t.Render(ctx); err != nil {
+		return "", true, err
+	} else {
+		b.WriteString(s)
+	}
+	return b.String(), true, nil
+}
+
+// renderRootTests11Source is the template RenderRootTests11 was generated from.
+const renderRootTests11Source = "<%= m[\"a\"] %>"
+
+// renderRootTests11Fragment0 compiles the statement on line 1 for the VM.
+var renderRootTests11Fragment0 = sync.OnceValues(func() (*vmplush.Template, error) {
+	return vmplush.CompileAt("<%= m[\"a\"] %>", 1)
+})
+
+// RenderRootTests11 renders parity_root_tests_test.go to w.
+func RenderRootTests11(w io.Writer, ctx hctx.Context) error {
+	out, ok, err := renderRootTests11Static(ctx)
+	if !ok {
+		out, err = vmplush.Render(renderRootTests11Source, ctx)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderRootTests11Static renders the template with the declared types. It reports false
+// when the context does not hold them or holds nil pointers.
+func renderRootTests11Static(ctx hctx.Context) (string, bool, error) {
+	var b bytes.Buffer
+	if t, err := renderRootTests11Fragment0(); err != nil {
+		return "", true, err
+	} else if s, err := t.Render(ctx); err != nil {
+		return "", true, err
+	} else {
+		b.WriteString(s)
+	}
+	return b.String(), true, nil
+}
+
+// renderRootTests12Source is the template RenderRootTests12 was generated from.
+const renderRootTests12Source = "<%= m.MyMap[key] %>"
+
+// renderRootTests12Fragment0 compiles the statement on line 1 for the VM.
+var renderRootTests12Fragment0 = sync.OnceValues(func() (*vmplush.Template, error) {
+	return vmplush.CompileAt("<%= m.MyMap[key] %>", 1)
+})
+
+// RenderRootTests12 renders parity_root_tests_test.go to w.
+func RenderRootTests12(w io.Writer, ctx hctx.Context) error {
+	out, ok, err := renderRootTests12Static(ctx)
+	if !ok {
+		out, err = vmplush.Render(renderRootTests12Source, ctx)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderRootTests12Static renders the template with the declared types. It reports false
+// when the context does not hold them or holds nil pointers.
+func renderRootTests12Static(ctx hctx.Context) (string, bool, error) {
+	var b bytes.Buffer
+	if t, err := renderRootTests12Fragment0(); err != nil {
+		return "", true, err
+	} else if s, err := t.Render(ctx); err != nil {
+		return "", true, err
+	} else {
+		b.WriteString(s)
+	}
+	return b.String(), true, nil
+}
+
+// renderRootTests13Source is the template RenderRootTests13 was generated from.
+const renderRootTests13Source = "<%= debug(m.MyMap[key]) %>"
+
+// renderRootTests13Fragment0 compiles the statement on line 1 for the VM.
+var renderRootTests13Fragment0 = sync.OnceValues(func() (*vmplush.Template, error) {
+	return vmplush.CompileAt("<%= debug(m.MyMap[key]) %>", 1)
+})
+
+// RenderRootTests13 renders parity_root_tests_test.go to w.
+func RenderRootTests13(w io.Writer, ctx hctx.Context) error {
+	out, ok, err := renderRootTests13Static(ctx)
+	if !ok {
+		out, err = vmplush.Render(renderRootTests13Source, ctx)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderRootTests13Static renders the template with the declared types. It reports false
+// when the context does not hold them or holds nil pointers.
+func renderRootTests13Static(ctx hctx.Context) (string, bool, error) {
+	var b bytes.Buffer
+	if t, err := renderRootTests13Fragment0(); err != nil {
+		return "", true, err
+	} else if s, err := t.Render(ctx); err != nil {
+		return "", true, err
+	} else {
+		b.WriteString(s)
+	}
+	return b.String(), true, nil
+}
+
+// renderRootTests14Source is the template RenderRootTests14 was generated from.
+const renderRootTests14Source = "<%= if (true) { return \"good\"} else { return \"bad\"} %>"
+
+// RenderRootTests14 renders parity_root_tests_test.go to w.
+//
+// The template is rendered by the VM: it returns early.
+func RenderRootTests14(w io.Writer, ctx hctx.Context) error {
+	out, err := vmplush.Render(renderRootTests14Source, ctx)
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderRootTests15Source is the template RenderRootTests15 was generated from.
+const renderRootTests15Source = "<%= if (false) { return \"good\"} else { return \"bad\"} %>"
+
+// RenderRootTests15 renders parity_root_tests_test.go to w.
+//
+// The template is rendered by the VM: it returns early.
+func RenderRootTests15(w io.Writer, ctx hctx.Context) error {
+	out, err := vmplush.Render(renderRootTests15Source, ctx)
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderRootTests16Source is the template RenderRootTests16 was generated from.
+const renderRootTests16Source = "<%  if (true) { return \"good\"} else { return \"bad\"} %>"
+
+// RenderRootTests16 renders parity_root_tests_test.go to w.
+//
+// The template is rendered by the VM: it returns early.
+func RenderRootTests16(w io.Writer, ctx hctx.Context) error {
+	out, err := vmplush.Render(renderRootTests16Source, ctx)
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderRootTests17Source is the template RenderRootTests17 was generated from.
+const renderRootTests17Source = "<%= if (true) { %>good<% } %>"
+
+// RenderRootTests17 renders parity_root_tests_test.go to w.
+func RenderRootTests17(w io.Writer, ctx hctx.Context) error {
+	out, ok, err := renderRootTests17Static(ctx)
+	if !ok {
+		out, err = vmplush.Render(renderRootTests17Source, ctx)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderRootTests17Static renders the template with the declared types. It reports false
+// when the context does not hold them or holds nil pointers.
+func renderRootTests17Static(ctx hctx.Context) (string, bool, error) {
+	var b bytes.Buffer
+	if true {
+		b.WriteString("good")
+	}
+	return b.String(), true, nil
+}
+
+// renderRootTests18Source is the template RenderRootTests18 was generated from.
+const renderRootTests18Source = "<%= if (false) { return \"good\"} %>"
+
+// RenderRootTests18 renders parity_root_tests_test.go to w.
+//
+// The template is rendered by the VM: it returns early.
+func RenderRootTests18(w io.Writer, ctx hctx.Context) error {
+	out, err := vmplush.Render(renderRootTests18Source, ctx)
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderRootTests19Source is the template RenderRootTests19 was generated from.
+const renderRootTests19Source = "<%= if (!false) { return \"good\"} %>"
+
+// RenderRootTests19 renders parity_root_tests_test.go to w.
+//
+// The template is rendered by the VM: it returns early.
+func RenderRootTests19(w io.Writer, ctx hctx.Context) error {
+	out, err := vmplush.Render(renderRootTests19Source, ctx)
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderRootTests20Source is the template RenderRootTests20 was generated from.
+const renderRootTests20Source = "<%= if (true == true) { return \"good\"} else { return \"bad\"} %>"
+
+// RenderRootTests20 renders parity_root_tests_test.go to w.
+//
+// The template is rendered by the VM: it returns early.
+func RenderRootTests20(w io.Writer, ctx hctx.Context) error {
+	out, err := vmplush.Render(renderRootTests20Source, ctx)
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderRootTests21Source is the template RenderRootTests21 was generated from.
+const renderRootTests21Source = "<%= if (true != true) { return \"good\"} else { return \"bad\"} %>"
+
+// RenderRootTests21 renders parity_root_tests_test.go to w.
+//
+// The template is rendered by the VM: it returns early.
+func RenderRootTests21(w io.Writer, ctx hctx.Context) error {
+	out, err := vmplush.Render(renderRootTests21Source, ctx)
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderRootTests22Source is the template RenderRootTests22 was generated from.
+const renderRootTests22Source = "<% let test = true %><%= if (test == false) { return \"good\"} %>"
+
+// RenderRootTests22 renders parity_root_tests_test.go to w.
+//
+// The template is rendered by the VM: it declares variables with let.
+func RenderRootTests22(w io.Writer, ctx hctx.Context) error {
+	out, err := vmplush.Render(renderRootTests22Source, ctx)
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderRootTests23Source is the template RenderRootTests23 was generated from.
+const renderRootTests23Source = "<% let test = true %><%= if (test == true) { return \"good\"} %>"
+
+// RenderRootTests23 renders parity_root_tests_test.go to w.
+//
+// The template is rendered by the VM: it declares variables with let.
+func RenderRootTests23(w io.Writer, ctx hctx.Context) error {
+	out, err := vmplush.Render(renderRootTests23Source, ctx)
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderRootTests24Source is the template RenderRootTests24 was generated from.
+const renderRootTests24Source = "<% let test = true %><%= if (test != true) { return \"good\"} %>"
+
+// RenderRootTests24 renders parity_root_tests_test.go to w.
+//
+// The template is rendered by the VM: it declares variables with let.
+func RenderRootTests24(w io.Writer, ctx hctx.Context) error {
+	out, err := vmplush.Render(renderRootTests24Source, ctx)
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderRootTests25Source is the template RenderRootTests25 was generated from.
+const renderRootTests25Source = "<% let test = 1 %><%= if (test == 1) { return \"good\"} %>"
+
+// RenderRootTests25 renders parity_root_tests_test.go to w.
+//
+// The template is rendered by the VM: it declares variables with let.
+func RenderRootTests25(w io.Writer, ctx hctx.Context) error {
+	out, err := vmplush.Render(renderRootTests25Source, ctx)
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderRootTests26Source is the template RenderRootTests26 was generated from.
+const renderRootTests26Source = "<%= if (false && true) { %>good<% } %>"
+
+// RenderRootTests26 renders parity_root_tests_test.go to w.
+func RenderRootTests26(w io.Writer, ctx hctx.Context) error {
+	out, ok, err := renderRootTests26Static(ctx)
+	if !ok {
+		out, err = vmplush.Render(renderRootTests26Source, ctx)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderRootTests26Static renders the template with the declared types. It reports false
+// when the context does not hold them or holds nil pointers.
+func renderRootTests26Static(ctx hctx.Context) (string, bool, error) {
+	var b bytes.Buffer
+	if false && true {
+		b.WriteString("good")
+	}
+	return b.String(), true, nil
+}
+
+// renderRootTests27Source is the template RenderRootTests27 was generated from.
+const renderRootTests27Source = "<%= if (2 == 2 && 1 == 1) { %>good<% } %>"
+
+// RenderRootTests27 renders parity_root_tests_test.go to w.
+func RenderRootTests27(w io.Writer, ctx hctx.Context) error {
+	out, ok, err := renderRootTests27Static(ctx)
+	if !ok {
+		out, err = vmplush.Render(renderRootTests27Source, ctx)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderRootTests27Static renders the template with the declared types. It reports false
+// when the context does not hold them or holds nil pointers.
+func renderRootTests27Static(ctx hctx.Context) (string, bool, error) {
+	var b bytes.Buffer
+	if (2 == 2) && (1 == 1) {
+		b.WriteString("good")
+	}
+	return b.String(), true, nil
+}
+
+// renderRootTests28Source is the template RenderRootTests28 was generated from.
+const renderRootTests28Source = "<%= if (false || true) { %>good<% } %>"
+
+// RenderRootTests28 renders parity_root_tests_test.go to w.
+func RenderRootTests28(w io.Writer, ctx hctx.Context) error {
+	out, ok, err := renderRootTests28Static(ctx)
+	if !ok {
+		out, err = vmplush.Render(renderRootTests28Source, ctx)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderRootTests28Static renders the template with the declared types. It reports false
+// when the context does not hold them or holds nil pointers.
+func renderRootTests28Static(ctx hctx.Context) (string, bool, error) {
+	var b bytes.Buffer
+	if false || true {
+		b.WriteString("good")
+	}
+	return b.String(), true, nil
+}
+
+// renderRootTests29Source is the template RenderRootTests29 was generated from.
+const renderRootTests29Source = "<%= if (1 == 2 || 2 == 1) { %>good<% } %>"
+
+// RenderRootTests29 renders parity_root_tests_test.go to w.
+func RenderRootTests29(w io.Writer, ctx hctx.Context) error {
+	out, ok, err := renderRootTests29Static(ctx)
+	if !ok {
+		out, err = vmplush.Render(renderRootTests29Source, ctx)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderRootTests29Static renders the template with the declared types. It reports false
+// when the context does not hold them or holds nil pointers.
+func renderRootTests29Static(ctx hctx.Context) (string, bool, error) {
+	var b bytes.Buffer
+	if (1 == 2) || (2 == 1) {
+		b.WriteString("good")
+	}
+	return b.String(), true, nil
+}
+
+// renderRootTests30Source is the template RenderRootTests30 was generated from.
+const renderRootTests30Source = "<%= if (names && len(names) >= 1) { %>good<% } %>"
+
+// renderRootTests30Fragment0 compiles the statement on line 1 for the VM.
+var renderRootTests30Fragment0 = sync.OnceValues(func() (*vmplush.Template, error) {
+	return vmplush.CompileAt("<%= if (names && len(names) >= 1) { %>good<% } %>", 1)
+})
+
+// RenderRootTests30 renders parity_root_tests_test.go to w.
+func RenderRootTests30(w io.Writer, ctx hctx.Context) error {
+	out, ok, err := renderRootTests30Static(ctx)
+	if !ok {
+		out, err = vmplush.Render(renderRootTests30Source, ctx)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderRootTests30Static renders the template with the declared types. It reports false
+// when the context does not hold them or holds nil pointers.
+func renderRootTests30Static(ctx hctx.Context) (string, bool, error) {
+	var b bytes.Buffer
+	if t, err := renderRootTests30Fragment0(); err != nil {
+		return "", true, err
+	} else if s, err := t.Render(ctx); err != nil {
+		return "", true, err
+	} else {
+		b.WriteString(s)
+	}
+	return b.String(), true, nil
+}
+
+// renderRootTests31Source is the template RenderRootTests31 was generated from.
+const renderRootTests31Source = "<%= if (names && len(names) >= 1) { %>good<% } else { %>else<% } %>"
+
+// renderRootTests31Fragment0 compiles the statement on line 1 for the VM.
+var renderRootTests31Fragment0 = sync.OnceValues(func() (*vmplush.Template, error) {
+	return vmplush.CompileAt("<%= if (names && len(names) >= 1) { %>good<% } else { %>else<% } %>", 1)
+})
+
+// RenderRootTests31 renders parity_root_tests_test.go to w.
+func RenderRootTests31(w io.Writer, ctx hctx.Context) error {
+	out, ok, err := renderRootTests31Static(ctx)
+	if !ok {
+		out, err = vmplush.Render(renderRootTests31Source, ctx)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderRootTests31Static renders the template with the declared types. It reports false
+// when the context does not hold them or holds nil pointers.
+func renderRootTests31Static(ctx hctx.Context) (string, bool, error) {
+	var b bytes.Buffer
+	if t, err := renderRootTests31Fragment0(); err != nil {
+		return "", true, err
+	} else if s, err := t.Render(ctx); err != nil {
+		return "", true, err
+	} else {
+		b.WriteString(s)
+	}
+	return b.String(), true, nil
+}
+
+// renderRootTests32Source is the template RenderRootTests32 was generated from.
+const renderRootTests32Source = "<%= if (names) { %>good<% } %>"
+
+// renderRootTests32Fragment0 compiles the statement on line 1 for the VM.
+var renderRootTests32Fragment0 = sync.OnceValues(func() (*vmplush.Template, error) {
+	return vmplush.CompileAt("<%= if (names) { %>good<% } %>", 1)
+})
+
+// RenderRootTests32 renders parity_root_tests_test.go to w.
+func RenderRootTests32(w io.Writer, ctx hctx.Context) error {
+	out, ok, err := renderRootTests32Static(ctx)
+	if !ok {
+		out, err = vmplush.Render(renderRootTests32Source, ctx)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderRootTests32Static renders the template with the declared types. It reports false
+// when the context does not hold them or holds nil pointers.
+func renderRootTests32Static(ctx hctx.Context) (string, bool, error) {
+	var b bytes.Buffer
+	if t, err := renderRootTests32Fragment0(); err != nil {
+		return "", true, err
+	} else if s, err := t.Render(ctx); err != nil {
+		return "", true, err
+	} else {
+		b.WriteString(s)
+	}
+	return b.String(), true, nil
+}
+
+// renderRootTests33Source is the template RenderRootTests33 was generated from.
+const renderRootTests33Source = "<%= if (!names) { %>good<% } %>"
+
+// renderRootTests33Fragment0 compiles the statement on line 1 for the VM.
+var renderRootTests33Fragment0 = sync.OnceValues(func() (*vmplush.Template, error) {
+	return vmplush.CompileAt("<%= if (!names) { %>good<% } %>", 1)
+})
+
+// RenderRootTests33 renders parity_root_tests_test.go to w.
+func RenderRootTests33(w io.Writer, ctx hctx.Context) error {
+	out, ok, err := renderRootTests33Static(ctx)
+	if !ok {
+		out, err = vmplush.Render(renderRootTests33Source, ctx)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderRootTests33Static renders the template with the declared types. It reports false
+// when the context does not hold them or holds nil pointers.
+func renderRootTests33Static(ctx hctx.Context) (string, bool, error) {
+	var b bytes.Buffer
+	if t, err := renderRootTests33Fragment0(); err != nil {
+		return "", true, err
+	} else if s, err := t.Render(ctx); err != nil {
+		return "", true, err
+	} else {
+		b.WriteString(s)
+	}
+	return b.String(), true, nil
+}
+
+// renderRootTests34Source is the template RenderRootTests34 was generated from.
+const renderRootTests34Source = "<%= if (1 == 2) { %>good<% } %>"
+
+// RenderRootTests34 renders parity_root_tests_test.go to w.
+func RenderRootTests34(w io.Writer, ctx hctx.Context) error {
+	out, ok, err := renderRootTests34Static(ctx)
+	if !ok {
+		out, err = vmplush.Render(renderRootTests34Source, ctx)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderRootTests34Static renders the template with the declared types. It reports false
+// when the context does not hold them or holds nil pointers.
+func renderRootTests34Static(ctx hctx.Context) (string, bool, error) {
+	var b bytes.Buffer
+	if 1 == 2 {
+		b.WriteString("good")
+	}
+	return b.String(), true, nil
+}
+
+// renderRootTests35Source is the template RenderRootTests35 was generated from.
+const renderRootTests35Source = "<%= if (1 != 2) { %>good<% } %>"
+
+// RenderRootTests35 renders parity_root_tests_test.go to w.
+func RenderRootTests35(w io.Writer, ctx hctx.Context) error {
+	out, ok, err := renderRootTests35Static(ctx)
+	if !ok {
+		out, err = vmplush.Render(renderRootTests35Source, ctx)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderRootTests35Static renders the template with the declared types. It reports false
+// when the context does not hold them or holds nil pointers.
+func renderRootTests35Static(ctx hctx.Context) (string, bool, error) {
+	var b bytes.Buffer
+	if 1 != 2 {
+		b.WriteString("good")
+	}
+	return b.String(), true, nil
+}
+
+// renderRootTests36Source is the template RenderRootTests36 was generated from.
+const renderRootTests36Source = "<%= if (1 < 2) { %>good<% } %>"
+
+// RenderRootTests36 renders parity_root_tests_test.go to w.
+func RenderRootTests36(w io.Writer, ctx hctx.Context) error {
+	out, ok, err := renderRootTests36Static(ctx)
+	if !ok {
+		out, err = vmplush.Render(renderRootTests36Source, ctx)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderRootTests36Static renders the template with the declared types. It reports false
+// when the context does not hold them or holds nil pointers.
+func renderRootTests36Static(ctx hctx.Context) (string, bool, error) {
+	var b bytes.Buffer
+	if 1 < 2 {
+		b.WriteString("good")
+	}
+	return b.String(), true, nil
+}
+
+// renderRootTests37Source is the template RenderRootTests37 was generated from.
+const renderRootTests37Source = "<%= if (1 <= 2) { %>good<% } %>"
+
+// RenderRootTests37 renders parity_root_tests_test.go to w.
+func RenderRootTests37(w io.Writer, ctx hctx.Context) error {
+	out, ok, err := renderRootTests37Static(ctx)
+	if !ok {
+		out, err = vmplush.Render(renderRootTests37Source, ctx)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderRootTests37Static renders the template with the declared types. It reports false
+// when the context does not hold them or holds nil pointers.
+func renderRootTests37Static(ctx hctx.Context) (string, bool, error) {
+	var b bytes.Buffer
+	if 1 <= 2 {
+		b.WriteString("good")
+	}
+	return b.String(), true, nil
+}
+
+// renderRootTests38Source is the template RenderRootTests38 was generated from.
+const renderRootTests38Source = "<%= if (1 > 2) { %>good<% } %>"
+
+// RenderRootTests38 renders parity_root_tests_test.go to w.
+func RenderRootTests38(w io.Writer, ctx hctx.Context) error {
+	out, ok, err := renderRootTests38Static(ctx)
+	if !ok {
+		out, err = vmplush.Render(renderRootTests38Source, ctx)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderRootTests38Static renders the template with the declared types. It reports false
+// when the context does not hold them or holds nil pointers.
+func renderRootTests38Static(ctx hctx.Context) (string, bool, error) {
+	var b bytes.Buffer
+	if 1 > 2 {
+		b.WriteString("good")
+	}
+	return b.String(), true, nil
+}
+
+// renderRootTests39Source is the template RenderRootTests39 was generated from.
+const renderRootTests39Source = "<%= if (1 >= 2) { %>good<% } %>"
+
+// RenderRootTests39 renders parity_root_tests_test.go to w.
+func RenderRootTests39(w io.Writer, ctx hctx.Context) error {
+	out, ok, err := renderRootTests39Static(ctx)
+	if !ok {
+		out, err = vmplush.Render(renderRootTests39Source, ctx)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderRootTests39Static renders the template with the declared types. It reports false
+// when the context does not hold them or holds nil pointers.
+func renderRootTests39Static(ctx hctx.Context) (string, bool, error) {
+	var b bytes.Buffer
+	if 1 >= 2 {
+		b.WriteString("good")
+	}
+	return b.String(), true, nil
+}
+
+// renderRootTests40Source is the template RenderRootTests40 was generated from.
+const renderRootTests40Source = "<%= if (\"foo\" ~= \"bar\") { %>good<% } %>"
+
+// renderRootTests40Fragment0 compiles the statement on line 1 for the VM.
+var renderRootTests40Fragment0 = sync.OnceValues(func() (*vmplush.Template, error) {
+	return vmplush.CompileAt("<%= if (\"foo\" ~= \"bar\") { %>good<% } %>", 1)
+})
+
+// RenderRootTests40 renders parity_root_tests_test.go to w.
+func RenderRootTests40(w io.Writer, ctx hctx.Context) error {
+	out, ok, err := renderRootTests40Static(ctx)
+	if !ok {
+		out, err = vmplush.Render(renderRootTests40Source, ctx)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderRootTests40Static renders the template with the declared types. It reports false
+// when the context does not hold them or holds nil pointers.
+func renderRootTests40Static(ctx hctx.Context) (string, bool, error) {
+	var b bytes.Buffer
+	if t, err := renderRootTests40Fragment0(); err != nil {
+		return "", true, err
+	} else if s, err := t.Render(ctx); err != nil {
+		return "", true, err
+	} else {
+		b.WriteString(s)
+	}
+	return b.String(), true, nil
+}
+
+// renderRootTests41Source is the template RenderRootTests41 was generated from.
+const renderRootTests41Source = "<%= if (\"foo\" ~= \"^fo\") { %>good<% } %>"
+
+// renderRootTests41Fragment0 compiles the statement on line 1 for the VM.
+var renderRootTests41Fragment0 = sync.OnceValues(func() (*vmplush.Template, error) {
+	return vmplush.CompileAt("<%= if (\"foo\" ~= \"^fo\") { %>good<% } %>", 1)
+})
+
+// RenderRootTests41 renders parity_root_tests_test.go to w.
+func RenderRootTests41(w io.Writer, ctx hctx.Context) error {
+	out, ok, err := renderRootTests41Static(ctx)
+	if !ok {
+		out, err = vmplush.Render(renderRootTests41Source, ctx)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderRootTests41Static renders the template with the declared types. It reports false
+// when the context does not hold them or holds nil pointers.
+func renderRootTests41Static(ctx hctx.Context) (string, bool, error) {
+	var b bytes.Buffer
+	if t, err := renderRootTests41Fragment0(); err != nil {
+		return "", true, err
+	} else if s, err := t.Render(ctx); err != nil {
+		return "", true, err
+	} else {
+		b.WriteString(s)
+	}
+	return b.String(), true, nil
+}
+
+// renderRootTests42Source is the template RenderRootTests42 was generated from.
+const renderRootTests42Source = "<%= !pages %>"
+
+// renderRootTests42Fragment0 compiles the statement on line 1 for the VM.
+var renderRootTests42Fragment0 = sync.OnceValues(func() (*vmplush.Template, error) {
+	return vmplush.CompileAt("<%= !pages %>", 1)
+})
+
+// RenderRootTests42 renders parity_root_tests_test.go to w.
+func RenderRootTests42(w io.Writer, ctx hctx.Context) error {
+	out, ok, err := renderRootTests42Static(ctx)
+	if !ok {
+		out, err = vmplush.Render(renderRootTests42Source, ctx)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderRootTests42Static renders the template with the declared types. It reports false
+// when the context does not hold them or holds nil pointers.
+func renderRootTests42Static(ctx hctx.Context) (string, bool, error) {
+	var b bytes.Buffer
+	if t, err := renderRootTests42Fragment0(); err != nil {
+		return "", true, err
+	} else if s, err := t.Render(ctx); err != nil {
+		return "", true, err
+	} else {
+		b.WriteString(s)
+	}
+	return b.String(), true, nil
+}
+
+// renderRootTests43Source is the template RenderRootTests43 was generated from.
+const renderRootTests43Source = "<%= paths || pages == \"cart\" %>"
+
+// renderRootTests43Fragment0 compiles the statement on line 1 for the VM.
+var renderRootTests43Fragment0 = sync.OnceValues(func() (*vmplush.Template, error) {
+	return vmplush.CompileAt("<%= paths || pages == \"cart\" %>", 1)
+})
+
+// RenderRootTests43 renders parity_root_tests_test.go to w.
+func RenderRootTests43(w io.Writer, ctx hctx.Context) error {
+	out, ok, err := renderRootTests43Static(ctx)
+	if !ok {
+		out, err = vmplush.Render(renderRootTests43Source, ctx)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderRootTests43Static renders the template with the declared types. It reports false
+// when the context does not hold them or holds nil pointers.
+func renderRootTests43Static(ctx hctx.Context) (string, bool, error) {
+	var b bytes.Buffer
+	if t, err := renderRootTests43Fragment0(); err != nil {
+		return "", true, err
+	} else if s, err := t.Render(ctx); err != nil {
+		return "", true, err
+	} else {
+		b.WriteString(s)
+	}
+	return b.String(), true, nil
+}
+
+// renderRootTests44Source is the template RenderRootTests44 was generated from.
+const renderRootTests44Source = "<%= pages == \"cart\" || paths %>"
+
+// renderRootTests44Fragment0 compiles the statement on line 1 for the VM.
+var renderRootTests44Fragment0 = sync.OnceValues(func() (*vmplush.Template, error) {
+	return vmplush.CompileAt("<%= pages == \"cart\" || paths %>", 1)
+})
+
+// RenderRootTests44 renders parity_root_tests_test.go to w.
+func RenderRootTests44(w io.Writer, ctx hctx.Context) error {
+	out, ok, err := renderRootTests44Static(ctx)
+	if !ok {
+		out, err = vmplush.Render(renderRootTests44Source, ctx)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderRootTests44Static renders the template with the declared types. It reports false
+// when the context does not hold them or holds nil pointers.
+func renderRootTests44Static(ctx hctx.Context) (string, bool, error) {
+	var b bytes.Buffer
+	if t, err := renderRootTests44Fragment0(); err != nil {
+		return "", true, err
+	} else if s, err := t.Render(ctx); err != nil {
+		return "", true, err
+	} else {
+		b.WriteString(s)
+	}
+	return b.String(), true, nil
+}
+
+// renderRootTests45Source is the template RenderRootTests45 was generated from.
+const renderRootTests45Source = "<%= paths == \"cart\" || pages %>"
+
+// renderRootTests45Fragment0 compiles the statement on line 1 for the VM.
+var renderRootTests45Fragment0 = sync.OnceValues(func() (*vmplush.Template, error) {
+	return vmplush.CompileAt("<%= paths == \"cart\" || pages %>", 1)
+})
+
+// RenderRootTests45 renders parity_root_tests_test.go to w.
+func RenderRootTests45(w io.Writer, ctx hctx.Context) error {
+	out, ok, err := renderRootTests45Static(ctx)
+	if !ok {
+		out, err = vmplush.Render(renderRootTests45Source, ctx)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderRootTests45Static renders the template with the declared types. It reports false
+// when the context does not hold them or holds nil pointers.
+func renderRootTests45Static(ctx hctx.Context) (string, bool, error) {
+	var b bytes.Buffer
+	if t, err := renderRootTests45Fragment0(); err != nil {
+		return "", true, err
+	} else if s, err := t.Render(ctx); err != nil {
+		return "", true, err
+	} else {
+		b.WriteString(s)
+	}
+	return b.String(), true, nil
+}
+
+// renderRootTests46Source is the template RenderRootTests46 was generated from.
+const renderRootTests46Source = "<%= pages || paths == \"cart\" %>"
+
+// renderRootTests46Fragment0 compiles the statement on line 1 for the VM.
+var renderRootTests46Fragment0 = sync.OnceValues(func() (*vmplush.Template, error) {
+	return vmplush.CompileAt("<%= pages || paths == \"cart\" %>", 1)
+})
+
+// RenderRootTests46 renders parity_root_tests_test.go to w.
+func RenderRootTests46(w io.Writer, ctx hctx.Context) error {
+	out, ok, err := renderRootTests46Static(ctx)
+	if !ok {
+		out, err = vmplush.Render(renderRootTests46Source, ctx)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderRootTests46Static renders the template with the declared types. It reports false
+// when the context does not hold them or holds nil pointers.
+func renderRootTests46Static(ctx hctx.Context) (string, bool, error) {
+	var b bytes.Buffer
+	if t, err := renderRootTests46Fragment0(); err != nil {
+		return "", true, err
+	} else if s, err := t.Render(ctx); err != nil {
+		return "", true, err
+	} else {
+		b.WriteString(s)
+	}
+	return b.String(), true, nil
+}
+
+// renderRootTests47Source is the template RenderRootTests47 was generated from.
+const renderRootTests47Source = "<%= paths == \"cart\" || pages == \"cart\" %>"
+
+// renderRootTests47Fragment0 compiles the statement on line 1 for the VM.
+var renderRootTests47Fragment0 = sync.OnceValues(func() (*vmplush.Template, error) {
+	return vmplush.CompileAt("<%= paths == \"cart\" || pages == \"cart\" %>", 1)
+})
+
+// RenderRootTests47 renders parity_root_tests_test.go to w.
+func RenderRootTests47(w io.Writer, ctx hctx.Context) error {
+	out, ok, err := renderRootTests47Static(ctx)
+	if !ok {
+		out, err = vmplush.Render(renderRootTests47Source, ctx)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderRootTests47Static renders the template with the declared types. It reports false
+// when the context does not hold them or holds nil pointers.
+func renderRootTests47Static(ctx hctx.Context) (string, bool, error) {
+	var b bytes.Buffer
+	if t, err := renderRootTests47Fragment0(); err != nil {
+		return "", true, err
+	} else if s, err := t.Render(ctx); err != nil {
+		return "", true, err
+	} else {
+		b.WriteString(s)
+	}
+	return b.String(), true, nil
+}
+
+// renderRootTests48Source is the template RenderRootTests48 was generated from.
+const renderRootTests48Source = "<%= pages == \"cart\" || paths == \"cart\" %>"
+
+// renderRootTests48Fragment0 compiles the statement on line 1 for the VM.
+var renderRootTests48Fragment0 = sync.OnceValues(func() (*vmplush.Template, error) {
+	return vmplush.CompileAt("<%= pages == \"cart\" || paths == \"cart\" %>", 1)
+})
+
+// RenderRootTests48 renders parity_root_tests_test.go to w.
+func RenderRootTests48(w io.Writer, ctx hctx.Context) error {
+	out, ok, err := renderRootTests48Static(ctx)
+	if !ok {
+		out, err = vmplush.Render(renderRootTests48Source, ctx)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderRootTests48Static renders the template with the declared types. It reports false
+// when the context does not hold them or holds nil pointers.
+func renderRootTests48Static(ctx hctx.Context) (string, bool, error) {
+	var b bytes.Buffer
+	if t, err := renderRootTests48Fragment0(); err != nil {
+		return "", true, err
+	} else if s, err := t.Render(ctx); err != nil {
+		return "", true, err
+	} else {
+		b.WriteString(s)
+	}
+	return b.String(), true, nil
+}
+
+// renderRootTests49Source is the template RenderRootTests49 was generated from.
+const renderRootTests49Source = "<%= pages && paths %>"
+
+// renderRootTests49Fragment0 compiles the statement on line 1 for the VM.
+var renderRootTests49Fragment0 = sync.OnceValues(func() (*vmplush.Template, error) {
+	return vmplush.CompileAt("<%= pages && paths %>", 1)
+})
+
+// RenderRootTests49 renders parity_root_tests_test.go to w.
+func RenderRootTests49(w io.Writer, ctx hctx.Context) error {
+	out, ok, err := renderRootTests49Static(ctx)
+	if !ok {
+		out, err = vmplush.Render(renderRootTests49Source, ctx)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderRootTests49Static renders the template with the declared types. It reports false
+// when the context does not hold them or holds nil pointers.
+func renderRootTests49Static(ctx hctx.Context) (string, bool, error) {
+	var b bytes.Buffer
+	if t, err := renderRootTests49Fragment0(); err != nil {
+		return "", true, err
+	} else if s, err := t.Render(ctx); err != nil {
+		return "", true, err
+	} else {
+		b.WriteString(s)
+	}
+	return b.String(), true, nil
+}
+
+// renderRootTests50Source is the template RenderRootTests50 was generated from.
+const renderRootTests50Source = "<%= paths && pages == \"cart\" %>"
+
+// renderRootTests50Fragment0 compiles the statement on line 1 for the VM.
+var renderRootTests50Fragment0 = sync.OnceValues(func() (*vmplush.Template, error) {
+	return vmplush.CompileAt("<%= paths && pages == \"cart\" %>", 1)
+})
+
+// RenderRootTests50 renders parity_root_tests_test.go to w.
+func RenderRootTests50(w io.Writer, ctx hctx.Context) error {
+	out, ok, err := renderRootTests50Static(ctx)
+	if !ok {
+		out, err = vmplush.Render(renderRootTests50Source, ctx)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderRootTests50Static renders the template with the declared types. It reports false
+// when the context does not hold them or holds nil pointers.
+func renderRootTests50Static(ctx hctx.Context) (string, bool, error) {
+	var b bytes.Buffer
+	if t, err := renderRootTests50Fragment0(); err != nil {
+		return "", true, err
+	} else if s, err := t.Render(ctx); err != nil {
+		return "", true, err
+	} else {
+		b.WriteString(s)
+	}
+	return b.String(), true, nil
+}
+
+// renderRootTests51Source is the template RenderRootTests51 was generated from.
+const renderRootTests51Source = "<%= pages == \"cart\" && paths %>"
+
+// renderRootTests51Fragment0 compiles the statement on line 1 for the VM.
+var renderRootTests51Fragment0 = sync.OnceValues(func() (*vmplush.Template, error) {
+	return vmplush.CompileAt("<%= pages == \"cart\" && paths %>", 1)
+})
+
+// RenderRootTests51 renders parity_root_tests_test.go to w.
+func RenderRootTests51(w io.Writer, ctx hctx.Context) error {
+	out, ok, err := renderRootTests51Static(ctx)
+	if !ok {
+		out, err = vmplush.Render(renderRootTests51Source, ctx)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderRootTests51Static renders the template with the declared types. It reports false
+// when the context does not hold them or holds nil pointers.
+func renderRootTests51Static(ctx hctx.Context) (string, bool, error) {
+	var b bytes.Buffer
+	if t, err := renderRootTests51Fragment0(); err != nil {
+		return "", true, err
+	} else if s, err := t.Render(ctx); err != nil {
+		return "", true, err
+	} else {
+		b.WriteString(s)
+	}
+	return b.String(), true, nil
+}
+
+// renderRootTests52Source is the template RenderRootTests52 was generated from.
+const renderRootTests52Source = "<%= paths == \"cart\" && pages %>"
+
+// renderRootTests52Fragment0 compiles the statement on line 1 for the VM.
+var renderRootTests52Fragment0 = sync.OnceValues(func() (*vmplush.Template, error) {
+	return vmplush.CompileAt("<%= paths == \"cart\" && pages %>", 1)
+})
+
+// RenderRootTests52 renders parity_root_tests_test.go to w.
+func RenderRootTests52(w io.Writer, ctx hctx.Context) error {
+	out, ok, err := renderRootTests52Static(ctx)
+	if !ok {
+		out, err = vmplush.Render(renderRootTests52Source, ctx)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderRootTests52Static renders the template with the declared types. It reports false
+// when the context does not hold them or holds nil pointers.
+func renderRootTests52Static(ctx hctx.Context) (string, bool, error) {
+	var b bytes.Buffer
+	if t, err := renderRootTests52Fragment0(); err != nil {
+		return "", true, err
+	} else if s, err := t.Render(ctx); err != nil {
+		return "", true, err
+	} else {
+		b.WriteString(s)
+	}
+	return b.String(), true, nil
+}
+
+// renderRootTests53Source is the template RenderRootTests53 was generated from.
+const renderRootTests53Source = "<%= pages && paths == \"cart\" %>"
+
+// renderRootTests53Fragment0 compiles the statement on line 1 for the VM.
+var renderRootTests53Fragment0 = sync.OnceValues(func() (*vmplush.Template, error) {
+	return vmplush.CompileAt("<%= pages && paths == \"cart\" %>", 1)
+})
+
+// RenderRootTests53 renders parity_root_tests_test.go to w.
+func RenderRootTests53(w io.Writer, ctx hctx.Context) error {
+	out, ok, err := renderRootTests53Static(ctx)
+	if !ok {
+		out, err = vmplush.Render(renderRootTests53Source, ctx)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderRootTests53Static renders the template with the declared types. It reports false
+// when the context does not hold them or holds nil pointers.
+func renderRootTests53Static(ctx hctx.Context) (string, bool, error) {
+	var b bytes.Buffer
+	if t, err := renderRootTests53Fragment0(); err != nil {
+		return "", true, err
+	} else if s, err := t.Render(ctx); err != nil {
+		return "", true, err
+	} else {
+		b.WriteString(s)
+	}
+	return b.String(), true, nil
+}
+
+// renderRootTests54Source is the template RenderRootTests54 was generated from.
+const renderRootTests54Source = "<%= paths == \"cart\" && pages == \"cart\" %>"
+
+// renderRootTests54Fragment0 compiles the statement on line 1 for the VM.
+var renderRootTests54Fragment0 = sync.OnceValues(func() (*vmplush.Template, error) {
+	return vmplush.CompileAt("<%= paths == \"cart\" && pages == \"cart\" %>", 1)
+})
+
+// RenderRootTests54 renders parity_root_tests_test.go to w.
+func RenderRootTests54(w io.Writer, ctx hctx.Context) error {
+	out, ok, err := renderRootTests54Static(ctx)
+	if !ok {
+		out, err = vmplush.Render(renderRootTests54Source, ctx)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderRootTests54Static renders the template with the declared types. It reports false
+// when the context does not hold them or holds nil pointers.
+func renderRootTests54Static(ctx hctx.Context) (string, bool, error) {
+	var b bytes.Buffer
+	if t, err := renderRootTests54Fragment0(); err != nil {
+		return "", true, err
+	} else if s, err := t.Render(ctx); err != nil {
+		return "", true, err
+	} else {
+		b.WriteString(s)
+	}
+	return b.String(), true, nil
+}
+
+// renderRootTests55Source is the template RenderRootTests55 was generated from.
+const renderRootTests55Source = "<%= pages == \"cart\" && paths == \"cart\" %>"
+
+// renderRootTests55Fragment0 compiles the statement on line 1 for the VM.
+var renderRootTests55Fragment0 = sync.OnceValues(func() (*vmplush.Template, error) {
+	return vmplush.CompileAt("<%= pages == \"cart\" && paths == \"cart\" %>", 1)
+})
+
+// RenderRootTests55 renders parity_root_tests_test.go to w.
+func RenderRootTests55(w io.Writer, ctx hctx.Context) error {
+	out, ok, err := renderRootTests55Static(ctx)
+	if !ok {
+		out, err = vmplush.Render(renderRootTests55Source, ctx)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderRootTests55Static renders the template with the declared types. It reports false
+// when the context does not hold them or holds nil pointers.
+func renderRootTests55Static(ctx hctx.Context) (string, bool, error) {
+	var b bytes.Buffer
+	if t, err := renderRootTests55Fragment0(); err != nil {
+		return "", true, err
+	} else if s, err := t.Render(ctx); err != nil {
+		return "", true, err
+	} else {
+		b.WriteString(s)
+	}
+	return b.String(), true, nil
+}
+
+// renderRootTests56Source is the template RenderRootTests56 was generated from.
+const renderRootTests56Source = "<p><%= if (state == \"foo\") { %>hi foo<% } else if (state == \"bar\") { %>hi bar<% } else if (state == \"fizz\") { %>hi fizz<% } else { %>hi buzz<% } %></p>"
+
+// RenderRootTests56 renders parity_root_tests_test.go to w.
+func RenderRootTests56(w io.Writer, ctx hctx.Context) error {
+	out, ok, err := renderRootTests56Static(ctx)
+	if !ok {
+		out, err = vmplush.Render(renderRootTests56Source, ctx)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderRootTests56Static renders the template with the declared types. It reports false
+// when the context does not hold them or holds nil pointers.
+func renderRootTests56Static(ctx hctx.Context) (string, bool, error) {
+	v_state, ok := ctx.Value("state").(string)
+	if !ok {
+		return "", false, nil
+	}
+	var b bytes.Buffer
+	b.WriteString("<p>")
+	if v_state == "foo" {
+		b.WriteString("hi foo")
+	} else if v_state == "bar" {
+		b.WriteString("hi bar")
+	} else if v_state == "fizz" {
+		b.WriteString("hi fizz")
+	} else {
+		b.WriteString("hi buzz")
+	}
+	b.WriteString("</p>")
+	return b.String(), true, nil
+}
+
+// renderRootTests57Source is the template RenderRootTests57 was generated from.
+const renderRootTests57Source = "<p><%= if (username && username != \"\") { return \"hi\" } else { return \"bye\" } %></p>"
+
+// RenderRootTests57 renders parity_root_tests_test.go to w.
+//
+// The template is rendered by the VM: it returns early.
+func RenderRootTests57(w io.Writer, ctx hctx.Context) error {
+	out, err := vmplush.Render(renderRootTests57Source, ctx)
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderRootTests58Source is the template RenderRootTests58 was generated from.
+const renderRootTests58Source = "<p><%= if (username && username != \"\") { username = \"hi\" } else { username= \"bye\" } %><%= username %></p>"
+
+// RenderRootTests58 renders parity_root_tests_test.go to w.
+//
+// The template is rendered by the VM: it assigns variables.
+func RenderRootTests58(w io.Writer, ctx hctx.Context) error {
+	out, err := vmplush.Render(renderRootTests58Source, ctx)
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderRootTests59Source is the template RenderRootTests59 was generated from.
+const renderRootTests59Source = "<p><% let username = \"Hello World\" %><%= if (username && username != \"\") {\n\t\tlet username = \"hi\"\n\t\tusername = \"hi\"\n\t} else {\n\t\tlet username = \"bye\"\n\t\tusername = \"1\"\n\t }%><%= username %></p>"
+
+// RenderRootTests59 renders parity_root_tests_test.go to w.
+//
+// The template is rendered by the VM: it declares variables with let.
+func RenderRootTests59(w io.Writer, ctx hctx.Context) error {
+	out, err := vmplush.Render(renderRootTests59Source, ctx)
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderRootTests60Source is the template RenderRootTests60 was generated from.
+const renderRootTests60Source = "<p><% let username = \"Hello World\" %><%= if (username && username != \"\") {\n\t\tlet username = \"hi\"\n\t\tusername = \"hi\"\n\t\tif (username == \"hi\"){\n\t\t\tusername = \"hi2\"\n\t\t}\n\t} else {\n\t\tlet username = \"bye\"\n\t\tusername = \"1\"\n\t }%><%= username %></p>"
+
+// RenderRootTests60 renders parity_root_tests_test.go to w.
+//
+// The template is rendered by the VM: it declares variables with let.
+func RenderRootTests60(w io.Writer, ctx hctx.Context) error {
+	out, err := vmplush.Render(renderRootTests60Source, ctx)
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderRootTests61Source is the template RenderRootTests61 was generated from.
+const renderRootTests61Source = "<p><% let username = \"Hello World\" %><%= if (username && username != \"\") {\n\t\tusername = \"hi\"\n\t\tif (username == \"hi\"){\n\t\t\tusername = \"hi2\"\n\t\t}\n\t} else {\n\t\tlet username = \"bye\"\n\t\tusername = \"1\"\n\t }%><%= username %></p>"
+
+// RenderRootTests61 renders parity_root_tests_test.go to w.
+//
+// The template is rendered by the VM: it declares variables with let.
+func RenderRootTests61(w io.Writer, ctx hctx.Context) error {
+	out, err := vmplush.Render(renderRootTests61Source, ctx)
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderRootTests62Source is the template RenderRootTests62 was generated from.
+const renderRootTests62Source = "<%= if ( paths == \"cart\" || (page && page.PageTitle != \"cafe\") || paths == \"cart\") { %>hi<%} %>"
+
+// renderRootTests62Fragment0 compiles the statement on line 1 for the VM.
+var renderRootTests62Fragment0 = sync.OnceValues(func() (*vmplush.Template, error) {
+	return vmplush.CompileAt("<%= if ( paths == \"cart\" || (page && page.PageTitle != \"cafe\") || paths == \"cart\") { %>hi<%} %>", 1)
+})
+
+// RenderRootTests62 renders parity_root_tests_test.go to w.
+func RenderRootTests62(w io.Writer, ctx hctx.Context) error {
+	out, ok, err := renderRootTests62Static(ctx)
+	if !ok {
+		out, err = vmplush.Render(renderRootTests62Source, ctx)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderRootTests62Static renders the template with the declared types. It reports false
+// when the context does not hold them or holds nil pointers.
+func renderRootTests62Static(ctx hctx.Context) (string, bool, error) {
+	var b bytes.Buffer
+	if t, err := renderRootTests62Fragment0(); err != nil {
+		return "", true, err
+	} else if s, err := t.Render(ctx); err != nil {
+		return "", true, err
+	} else {
+		b.WriteString(s)
+	}
+	return b.String(), true, nil
+}
+
+// renderRootTests63Source is the template RenderRootTests63 was generated from.
+const renderRootTests63Source = "<% let foo = \"bar\" %>\n  <%= for (a) in myArray { %>\n<%= foo %>\n    <% if (foo != \"baz\") { %>\n      <% foo = \"baz\" %>\n    <% } %>\n  <% } %>\n<% } %>"
+
+// RenderRootTests63 renders parity_root_tests_test.go to w.
+//
+// The template is rendered by the VM: it declares variables with let.
+func RenderRootTests63(w io.Writer, ctx hctx.Context) error {
+	out, err := vmplush.Render(renderRootTests63Source, ctx)
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderRootTests64Source is the template RenderRootTests64 was generated from.
+const renderRootTests64Source = "<p><% let h = {\"a\": \"A\"} %><%= h[\"a\"] %></p>"
+
+// RenderRootTests64 renders parity_root_tests_test.go to w.
+//
+// The template is rendered by the VM: it declares variables with let.
+func RenderRootTests64(w io.Writer, ctx hctx.Context) error {
+	out, err := vmplush.Render(renderRootTests64Source, ctx)
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderRootTests65Source is the template RenderRootTests65 was generated from.
+const renderRootTests65Source = "<p><% let h = {\"a\": \"A\"} %><% h[\"a\"] = \"C\" %><%= h[\"a\"] %></p>"
+
+// RenderRootTests65 renders parity_root_tests_test.go to w.
+//
+// The template is rendered by the VM: it declares variables with let.
+func RenderRootTests65(w io.Writer, ctx hctx.Context) error {
+	out, err := vmplush.Render(renderRootTests65Source, ctx)
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderRootTests66Source is the template RenderRootTests66 was generated from.
+const renderRootTests66Source = "<p><% let h = {\"a\": \"A\"} %><% h[\"b\"] = \"D\" %><%= h[\"b\"] %></p>"
+
+// RenderRootTests66 renders parity_root_tests_test.go to w.
+//
+// The template is rendered by the VM: it declares variables with let.
+func RenderRootTests66(w io.Writer, ctx hctx.Context) error {
+	out, err := vmplush.Render(renderRootTests66Source, ctx)
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderRootTests67Source is the template RenderRootTests67 was generated from.
+const renderRootTests67Source = "<p><% let h = {\"a\": \"A\"} %><% h[\"b\"] = 3 %><%= h[\"b\"] %></p>"
+
+// RenderRootTests67 renders parity_root_tests_test.go to w.
+//
+// The template is rendered by the VM: it declares variables with let.
+func RenderRootTests67(w io.Writer, ctx hctx.Context) error {
+	out, err := vmplush.Render(renderRootTests67Source, ctx)
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderRootTests68Source is the template RenderRootTests68 was generated from.
+const renderRootTests68Source = "<p><% let h = {\"a\": \"A\"} %><% h[\"b\"] = 3 %><%= h[\"c\"] %></p>"
+
+// RenderRootTests68 renders parity_root_tests_test.go to w.
+//
+// The template is rendered by the VM: it declares variables with let.
+func RenderRootTests68(w io.Writer, ctx hctx.Context) error {
+	out, err := vmplush.Render(renderRootTests68Source, ctx)
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderRootTests69Source is the template RenderRootTests69 was generated from.
+const renderRootTests69Source = "<p><% let a = [1, 2, \"three\", \"four\", 3.75] %><% a[0] = 3 %><%= a[0] %></p>"
+
+// RenderRootTests69 renders parity_root_tests_test.go to w.
+//
+// The template is rendered by the VM: it declares variables with let.
+func RenderRootTests69(w io.Writer, ctx hctx.Context) error {
+	out, err := vmplush.Render(renderRootTests69Source, ctx)
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderRootTests70Source is the template RenderRootTests70 was generated from.
+const renderRootTests70Source = "<p><% let a = [1, 2, \"three\", \"four\", 3.75] %><% a[4] = 3 %><%= a[4] + 2 %></p>"
+
+// RenderRootTests70 renders parity_root_tests_test.go to w.
+//
+// The template is rendered by the VM: it declares variables with let.
+func RenderRootTests70(w io.Writer, ctx hctx.Context) error {
+	out, err := vmplush.Render(renderRootTests70Source, ctx)
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderRootTests71Source is the template RenderRootTests71 was generated from.
+const renderRootTests71Source = "<% let a = [1,\"22\",33] %><% a = a + 1 %><%= a %>"
+
+// RenderRootTests71 renders parity_root_tests_test.go to w.
+//
+// The template is rendered by the VM: it declares variables with let.
+func RenderRootTests71(w io.Writer, ctx hctx.Context) error {
+	out, err := vmplush.Render(renderRootTests71Source, ctx)
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderRootTests72Source is the template RenderRootTests72 was generated from.
+const renderRootTests72Source = "<% let a = [1,2,\"HelloWorld\"] %><% a = a + 2.2 %><%= a %>"
+
+// RenderRootTests72 renders parity_root_tests_test.go to w.
+//
+// The template is rendered by the VM: it declares variables with let.
+func RenderRootTests72(w io.Writer, ctx hctx.Context) error {
+	out, err := vmplush.Render(renderRootTests72Source, ctx)
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderRootTests73Source is the template RenderRootTests73 was generated from.
+const renderRootTests73Source = "<p><% let a = [1, 2, \"three\", \"four\", 3.75] %><% a[\"b\"] = 3 %><%= a[\"c\"] %></p>"
+
+// RenderRootTests73 renders parity_root_tests_test.go to w.
+//
+// The template is rendered by the VM: it declares variables with let.
+func RenderRootTests73(w io.Writer, ctx hctx.Context) error {
+	out, err := vmplush.Render(renderRootTests73Source, ctx)
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderRootTests74Source is the template RenderRootTests74 was generated from.
+const renderRootTests74Source = "<p><% let a = [1, 2, \"three\", \"four\", 3.75] %><% a[5] = 3 %><%= a[4] + 2 %></p>"
+
+// RenderRootTests74 renders parity_root_tests_test.go to w.
+//
+// The template is rendered by the VM: it declares variables with let.
+func RenderRootTests74(w io.Writer, ctx hctx.Context) error {
+	out, err := vmplush.Render(renderRootTests74Source, ctx)
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderRootTests75Source is the template RenderRootTests75 was generated from.
+const renderRootTests75Source = "<p><% let a = [1, 2, \"three\", \"four\", 3.75] %><%= a[5] %></p>"
+
+// RenderRootTests75 renders parity_root_tests_test.go to w.
+//
+// The template is rendered by the VM: it declares variables with let.
+func RenderRootTests75(w io.Writer, ctx hctx.Context) error {
+	out, err := vmplush.Render(renderRootTests75Source, ctx)
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderRootTests76Source is the template RenderRootTests76 was generated from.
+const renderRootTests76Source = "<p><% let a = myArray %></p><% a[0] = \"HELLO WORLD\" %>"
+
+// RenderRootTests76 renders parity_root_tests_test.go to w.
+//
+// The template is rendered by the VM: it declares variables with let.
+func RenderRootTests76(w io.Writer, ctx hctx.Context) error {
+	out, err := vmplush.Render(renderRootTests76Source, ctx)
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderRootTests77Source is the template RenderRootTests77 was generated from.
+const renderRootTests77Source = "<% let a = myArray %><% a = a + 1 %><%= a %>"
+
+// RenderRootTests77 renders parity_root_tests_test.go to w.
+//
+// The template is rendered by the VM: it declares variables with let.
+func RenderRootTests77(w io.Writer, ctx hctx.Context) error {
+	out, err := vmplush.Render(renderRootTests77Source, ctx)
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderRootTests78Source is the template RenderRootTests78 was generated from.
+const renderRootTests78Source = "<p><%= f(\"mark\") %></p>"
+
+// renderRootTests78Fragment0 compiles the statement on line 1 for the VM.
+var renderRootTests78Fragment0 = sync.OnceValues(func() (*vmplush.Template, error) {
+	return vmplush.CompileAt("<%= f(\"mark\") %>", 1)
+})
+
+// RenderRootTests78 renders parity_root_tests_test.go to w.
+func RenderRootTests78(w io.Writer, ctx hctx.Context) error {
+	out, ok, err := renderRootTests78Static(ctx)
+	if !ok {
+		out, err = vmplush.Render(renderRootTests78Source, ctx)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderRootTests78Static renders the template with the declared types. It reports false
+// when the context does not hold them or holds nil pointers.
+func renderRootTests78Static(ctx hctx.Context) (string, bool, error) {
+	var b bytes.Buffer
+	b.WriteString("<p>")
+	if t, err := renderRootTests78Fragment0(); err != nil {
+		return "", true, err
+	} else if s, err := t.Render(ctx); err != nil {
+		return "", true, err
+	} else {
+		b.WriteString(s)
+	}
+	b.WriteString("</p>")
+	return b.String(), true, nil
+}
+
+// renderRootTests79Source is the template RenderRootTests79 was generated from.
+const renderRootTests79Source = "<p><%= f(name) %></p>"
+
+// renderRootTests79Fragment0 compiles the statement on line 1 for the VM.
+var renderRootTests79Fragment0 = sync.OnceValues(func() (*vmplush.Template, error) {
+	return vmplush.CompileAt("<%= f(name) %>", 1)
+})
+
+// RenderRootTests79 renders parity_root_tests_test.go to w.
+func RenderRootTests79(w io.Writer, ctx hctx.Context) error {
+	out, ok, err := renderRootTests79Static(ctx)
+	if !ok {
+		out, err = vmplush.Render(renderRootTests79Source, ctx)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderRootTests79Static renders the template with the declared types. It reports false
+// when the context does not hold them or holds nil pointers.
+func renderRootTests79Static(ctx hctx.Context) (string, bool, error) {
+	var b bytes.Buffer
+	b.WriteString("<p>")
+	if t, err := renderRootTests79Fragment0(); err != nil {
+		return "", true, err
+	} else if s, err := t.Render(ctx); err != nil {
+		return "", true, err
+	} else {
+		b.WriteString(s)
+	}
+	b.WriteString("</p>")
+	return b.String(), true, nil
+}
+
+// renderRootTests80Source is the template RenderRootTests80 was generated from.
+const renderRootTests80Source = "<p><%= f({name: name}) %></p>"
+
+// renderRootTests80Fragment0 compiles the statement on line 1 for the VM.
+var renderRootTests80Fragment0 = sync.OnceValues(func() (*vmplush.Template, error) {
+	return vmplush.CompileAt("<%= f({name: name}) %>", 1)
+})
+
+// RenderRootTests80 renders parity_root_tests_test.go to w.
+func RenderRootTests80(w io.Writer, ctx hctx.Context) error {
+	out, ok, err := renderRootTests80Static(ctx)
+	if !ok {
+		out, err = vmplush.Render(renderRootTests80Source, ctx)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderRootTests80Static renders the template with the declared types. It reports false
+// when the context does not hold them or holds nil pointers.
+func renderRootTests80Static(ctx hctx.Context) (string, bool, error) {
+	var b bytes.Buffer
+	b.WriteString("<p>")
+	if t, err := renderRootTests80Fragment0(); err != nil {
+		return "", true, err
+	} else if s, err := t.Render(ctx); err != nil {
+		return "", true, err
+	} else {
+		b.WriteString(s)
+	}
+	b.WriteString("</p>")
+	return b.String(), true, nil
+}
+
+// renderRootTests82Source is the template RenderRootTests82 was generated from.
+const renderRootTests82Source = "<p><%= f() { %>hello<% } %></p>"
+
+// RenderRootTests82 renders parity_root_tests_test.go to w.
+//
+// The template is rendered by the VM: it calls f with a block.
+func RenderRootTests82(w io.Writer, ctx hctx.Context) error {
+	out, err := vmplush.Render(renderRootTests82Source, ctx)
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderRootTests83Source is the template RenderRootTests83 was generated from.
+const renderRootTests83Source = "<p><% let i = \"hello-world\" %><%= f() { i = \"bye\" } %><%= i %></p>"
+
+// RenderRootTests83 renders parity_root_tests_test.go to w.
+//
+// The template is rendered by the VM: it declares variables with let.
+func RenderRootTests83(w io.Writer, ctx hctx.Context) error {
+	out, err := vmplush.Render(renderRootTests83Source, ctx)
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderRootTests84Source is the template RenderRootTests84 was generated from.
+const renderRootTests84Source = "<p><%= f() { let i = \"hello-world\" } %><%= i %></p>"
+
+// RenderRootTests84 renders parity_root_tests_test.go to w.
+//
+// The template is rendered by the VM: it calls f with a block.
+func RenderRootTests84(w io.Writer, ctx hctx.Context) error {
+	out, err := vmplush.Render(renderRootTests84Source, ctx)
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderRootTests85Source is the template RenderRootTests85 was generated from.
+const renderRootTests85Source = "<%= foo() {return bar + name} %>"
+
+// RenderRootTests85 renders parity_root_tests_test.go to w.
+//
+// The template is rendered by the VM: it calls foo with a block.
+func RenderRootTests85(w io.Writer, ctx hctx.Context) error {
+	out, err := vmplush.Render(renderRootTests85Source, ctx)
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderRootTests86Source is the template RenderRootTests86 was generated from.
+const renderRootTests86Source = "<%= foo({name: \"mark\"}) {return bar + name} %>"
+
+// RenderRootTests86 renders parity_root_tests_test.go to w.
+//
+// The template is rendered by the VM: it calls foo with a block.
+func RenderRootTests86(w io.Writer, ctx hctx.Context) error {
+	out, err := vmplush.Render(renderRootTests86Source, ctx)
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderRootTests87Source is the template RenderRootTests87 was generated from.
+const renderRootTests87Source = "<% for (i,v) in [\"a\", \"b\", \"c\"] {return v} %>"
+
+// RenderRootTests87 renders parity_root_tests_test.go to w.
+//
+// The template is rendered by the VM: it returns early.
+func RenderRootTests87(w io.Writer, ctx hctx.Context) error {
+	out, err := vmplush.Render(renderRootTests87Source, ctx)
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderRootTests88Source is the template RenderRootTests88 was generated from.
+const renderRootTests88Source = "<% let varTest = \"\" %><% for (i,v) in [\"a\", \"b\", \"c\"] {varTest =  v} %><%= varTest %>"
+
+// RenderRootTests88 renders parity_root_tests_test.go to w.
+//
+// The template is rendered by the VM: it declares variables with let.
+func RenderRootTests88(w io.Writer, ctx hctx.Context) error {
+	out, err := vmplush.Render(renderRootTests88Source, ctx)
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderRootTests89Source is the template RenderRootTests89 was generated from.
+const renderRootTests89Source = "<%= for (k,v) in myMap { %><%= k + \":\" + v%><% } %> <%= k %>"
+
+// renderRootTests89Fragment0 compiles the statement on line 1 for the VM.
+var renderRootTests89Fragment0 = sync.OnceValues(func() (*vmplush.Template, error) {
+	return vmplush.CompileAt("<%= for (k,v) in myMap { %><%= k + \":\" + v%><% } %>", 1)
+})
+
+// renderRootTests89Fragment1 compiles the statement on line 1 for the VM.
+var renderRootTests89Fragment1 = sync.OnceValues(func() (*vmplush.Template, error) {
+	return vmplush.CompileAt("<%= k %>", 1)
+})
+
+// RenderRootTests89 renders parity_root_tests_test.go to w.
+func RenderRootTests89(w io.Writer, ctx hctx.Context) error {
+	out, ok, err := renderRootTests89Static(ctx)
+	if !ok {
+		out, err = vmplush.Render(renderRootTests89Source, ctx)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderRootTests89Static renders the template with the declared types. It reports false
+// when the context does not hold them or holds nil pointers.
+func renderRootTests89Static(ctx hctx.Context) (string, bool, error) {
+	var b bytes.Buffer
+	if t, err := renderRootTests89Fragment0(); err != nil {
+		return "", true, err
+	} else if s, err := t.Render(ctx); err != nil {
+		return "", true, err
+	} else {
+		b.WriteString(s)
+	}
+	b.WriteString(" ")
+	if t, err := renderRootTests89Fragment1(); err != nil {
+		return "", true, err
+	} else if s, err := t.Render(ctx); err != nil {
+		return "", true, err
+	} else {
+		b.WriteString(s)
+	}
+	return b.String(), true, nil
+}
+
+// renderRootTests90Source is the template RenderRootTests90 was generated from.
+const renderRootTests90Source = "<%= for (k,v) in myMap { %><%= k + \":\" + v%><% } %> <%= v %>"
+
+// renderRootTests90Fragment0 compiles the statement on line 1 for the VM.
+var renderRootTests90Fragment0 = sync.OnceValues(func() (*vmplush.Template, error) {
+	return vmplush.CompileAt("<%= for (k,v) in myMap { %><%= k + \":\" + v%><% } %>", 1)
+})
+
+// renderRootTests90Fragment1 compiles the statement on line 1 for the VM.
+var renderRootTests90Fragment1 = sync.OnceValues(func() (*vmplush.Template, error) {
+	return vmplush.CompileAt("<%= v %>", 1)
+})
+
+// RenderRootTests90 renders parity_root_tests_test.go to w.
+func RenderRootTests90(w io.Writer, ctx hctx.Context) error {
+	out, ok, err := renderRootTests90Static(ctx)
+	if !ok {
+		out, err = vmplush.Render(renderRootTests90Source, ctx)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderRootTests90Static renders the template with the declared types. It reports false
+// when the context does not hold them or holds nil pointers.
+func renderRootTests90Static(ctx hctx.Context) (string, bool, error) {
+	var b bytes.Buffer
+	if t, err := renderRootTests90Fragment0(); err != nil {
+		return "", true, err
+	} else if s, err := t.Render(ctx); err != nil {
+		return "", true, err
+	} else {
+		b.WriteString(s)
+	}
+	b.WriteString(" ")
+	if t, err := renderRootTests90Fragment1(); err != nil {
+		return "", true, err
+	} else if s, err := t.Render(ctx); err != nil {
+		return "", true, err
+	} else {
+		b.WriteString(s)
+	}
+	return b.String(), true, nil
+}
+
+// renderRootTests91Source is the template RenderRootTests91 was generated from.
+const renderRootTests91Source = "<%= for (i,v) in [\"a\", \"b\", \"c\"] {return v} %>"
+
+// RenderRootTests91 renders parity_root_tests_test.go to w.
+//
+// The template is rendered by the VM: it returns early.
+func RenderRootTests91(w io.Writer, ctx hctx.Context) error {
+	out, err := vmplush.Render(renderRootTests91Source, ctx)
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderRootTests92Source is the template RenderRootTests92 was generated from.
+const renderRootTests92Source = "<%= for (i,v) in [1, 2, 3,4,5,6,7,8,9,10] {\n\t\tcontinue\n\t\treturn v\n\t\t} %>"
+
+// RenderRootTests92 renders parity_root_tests_test.go to w.
+//
+// The template is rendered by the VM: it uses break or continue.
+func RenderRootTests92(w io.Writer, ctx hctx.Context) error {
+	out, err := vmplush.Render(renderRootTests92Source, ctx)
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderRootTests93Source is the template RenderRootTests93 was generated from.
+const renderRootTests93Source = "<%= for (i,v) in [1, 2, 3,4,5,6,7,8,9,10] {\n\t\tbreak\n\t\treturn v\n\t\t} %>"
+
+// RenderRootTests93 renders parity_root_tests_test.go to w.
+//
+// The template is rendered by the VM: it uses break or continue.
+func RenderRootTests93(w io.Writer, ctx hctx.Context) error {
+	out, err := vmplush.Render(renderRootTests93Source, ctx)
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderRootTests94Source is the template RenderRootTests94 was generated from.
+const renderRootTests94Source = "<%= for (v) in range(3,5) { %><%=v%><% } %>"
+
+// renderRootTests94Fragment0 compiles the statement on line 1 for the VM.
+var renderRootTests94Fragment0 = sync.OnceValues(func() (*vmplush.Template, error) {
+	return vmplush.CompileAt("<%= for (v) in range(3,5) { %><%=v%><% } %>", 1)
+})
+
+// RenderRootTests94 renders parity_root_tests_test.go to w.
+func RenderRootTests94(w io.Writer, ctx hctx.Context) error {
+	out, ok, err := renderRootTests94Static(ctx)
+	if !ok {
+		out, err = vmplush.Render(renderRootTests94Source, ctx)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderRootTests94Static renders the template with the declared types. It reports false
+// when the context does not hold them or holds nil pointers.
+func renderRootTests94Static(ctx hctx.Context) (string, bool, error) {
+	var b bytes.Buffer
+	if t, err := renderRootTests94Fragment0(); err != nil {
+		return "", true, err
+	} else if s, err := t.Render(ctx); err != nil {
+		return "", true, err
+	} else {
+		b.WriteString(s)
+	}
+	return b.String(), true, nil
+}
+
+// renderRootTests95Source is the template RenderRootTests95 was generated from.
+const renderRootTests95Source = "<%= for (v) in between(3,6) { %><%=v%><% } %>"
+
+// renderRootTests95Fragment0 compiles the statement on line 1 for the VM.
+var renderRootTests95Fragment0 = sync.OnceValues(func() (*vmplush.Template, error) {
+	return vmplush.CompileAt("<%= for (v) in between(3,6) { %><%=v%><% } %>", 1)
+})
+
+// RenderRootTests95 renders parity_root_tests_test.go to w.
+func RenderRootTests95(w io.Writer, ctx hctx.Context) error {
+	out, ok, err := renderRootTests95Static(ctx)
+	if !ok {
+		out, err = vmplush.Render(renderRootTests95Source, ctx)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderRootTests95Static renders the template with the declared types. It reports false
+// when the context does not hold them or holds nil pointers.
+func renderRootTests95Static(ctx hctx.Context) (string, bool, error) {
+	var b bytes.Buffer
+	if t, err := renderRootTests95Fragment0(); err != nil {
+		return "", true, err
+	} else if s, err := t.Render(ctx); err != nil {
+		return "", true, err
+	} else {
+		b.WriteString(s)
+	}
+	return b.String(), true, nil
+}
+
+// renderRootTests96Source is the template RenderRootTests96 was generated from.
+const renderRootTests96Source = "<%= for (v) in until(3) { %><%=v%><% } %>"
+
+// renderRootTests96Fragment0 compiles the statement on line 1 for the VM.
+var renderRootTests96Fragment0 = sync.OnceValues(func() (*vmplush.Template, error) {
+	return vmplush.CompileAt("<%= for (v) in until(3) { %><%=v%><% } %>", 1)
+})
+
+// RenderRootTests96 renders parity_root_tests_test.go to w.
+func RenderRootTests96(w io.Writer, ctx hctx.Context) error {
+	out, ok, err := renderRootTests96Static(ctx)
+	if !ok {
+		out, err = vmplush.Render(renderRootTests96Source, ctx)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderRootTests96Static renders the template with the declared types. It reports false
+// when the context does not hold them or holds nil pointers.
+func renderRootTests96Static(ctx hctx.Context) (string, bool, error) {
+	var b bytes.Buffer
+	if t, err := renderRootTests96Fragment0(); err != nil {
+		return "", true, err
+	} else if s, err := t.Render(ctx); err != nil {
+		return "", true, err
+	} else {
+		b.WriteString(s)
+	}
+	return b.String(), true, nil
+}
+
+// renderRootTests97Source is the template RenderRootTests97 was generated from.
+const renderRootTests97Source = "<%   let i = 10000 %><%= for (i,v) in [\"a\", \"b\", \"c\"] {%><%=i%><%=v%><%} %><%= i %>"
+
+// RenderRootTests97 renders parity_root_tests_test.go to w.
+//
+// The template is rendered by the VM: it declares variables with let.
+func RenderRootTests97(w io.Writer, ctx hctx.Context) error {
+	out, err := vmplush.Render(renderRootTests97Source, ctx)
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderRootTests98Source is the template RenderRootTests98 was generated from.
+const renderRootTests98Source = "<%= for (i,v) in [\"a\", \"b\", \"c\"] {%><%=i%><%=v%><%} %><%= i %>"
+
+// renderRootTests98Fragment0 compiles the statement on line 1 for the VM.
+var renderRootTests98Fragment0 = sync.OnceValues(func() (*vmplush.Template, error) {
+	return vmplush.CompileAt("<%= for (i,v) in [\"a\", \"b\", \"c\"] {%><%=i%><%=v%><%} %>", 1)
+})
+
+// renderRootTests98Fragment1 compiles the statement on line 1 for the VM.
+var renderRootTests98Fragment1 = sync.OnceValues(func() (*vmplush.Template, error) {
+	return vmplush.CompileAt("<%= i %>", 1)
+})
+
+// RenderRootTests98 renders parity_root_tests_test.go to w.
+func RenderRootTests98(w io.Writer, ctx hctx.Context) error {
+	out, ok, err := renderRootTests98Static(ctx)
+	if !ok {
+		out, err = vmplush.Render(renderRootTests98Source, ctx)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderRootTests98Static renders the template with the declared types. It reports false
+// when the context does not hold them or holds nil pointers.
+func renderRootTests98Static(ctx hctx.Context) (string, bool, error) {
+	var b bytes.Buffer
+	if t, err := renderRootTests98Fragment0(); err != nil {
+		return "", true, err
+	} else if s, err := t.Render(ctx); err != nil {
+		return "", true, err
+	} else {
+		b.WriteString(s)
+	}
+	if t, err := renderRootTests98Fragment1(); err != nil {
+		return "", true, err
+	} else if s, err := t.Render(ctx); err != nil {
+		return "", true, err
+	} else {
+		b.WriteString(s)
+	}
+	return b.String(), true, nil
+}
+
+// renderRootTests99Source is the template RenderRootTests99 was generated from.
+const renderRootTests99Source = " <%= for (i,v) in [\"a\", \"b\", \"c\"] {%><%=i%><%=v%><%} %><%= v %>"
+
+// renderRootTests99Fragment0 compiles the statement on line 1 for the VM.
+var renderRootTests99Fragment0 = sync.OnceValues(func() (*vmplush.Template, error) {
+	return vmplush.CompileAt("<%= for (i,v) in [\"a\", \"b\", \"c\"] {%><%=i%><%=v%><%} %>", 1)
+})
+
+// renderRootTests99Fragment1 compiles the statement on line 1 for the VM.
+var renderRootTests99Fragment1 = sync.OnceValues(func() (*vmplush.Template, error) {
+	return vmplush.CompileAt("<%= v %>", 1)
+})
+
+// RenderRootTests99 renders parity_root_tests_test.go to w.
+func RenderRootTests99(w io.Writer, ctx hctx.Context) error {
+	out, ok, err := renderRootTests99Static(ctx)
+	if !ok {
+		out, err = vmplush.Render(renderRootTests99Source, ctx)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderRootTests99Static renders the template with the declared types. It reports false
+// when the context does not hold them or holds nil pointers.
+func renderRootTests99Static(ctx hctx.Context) (string, bool, error) {
+	var b bytes.Buffer
+	b.WriteString(" ")
+	if t, err := renderRootTests99Fragment0(); err != nil {
+		return "", true, err
+	} else if s, err := t.Render(ctx); err != nil {
+		return "", true, err
+	} else {
+		b.WriteString(s)
+	}
+	if t, err := renderRootTests99Fragment1(); err != nil {
+		return "", true, err
+	} else if s, err := t.Render(ctx); err != nil {
+		return "", true, err
+	} else {
+		b.WriteString(s)
+	}
+	return b.String(), true, nil
+}
+
+// renderRootTests100Source is the template RenderRootTests100 was generated from.
+const renderRootTests100Source = "<%\n\t\tlet numberify = fn(arg) {\n\t\t\tif (arg == \"one\") {\n\t\t\t\treturn 1+1;\n\t\t\t}\n\t\t\tif (arg == \"two\") {\n\t\t\t\treturn 44;\n\t\t\t}\n\t\t\tif (arg == \"three\") {\n\t\t\t\treturn 2;\n\t\t\t}\n\t\t\treturn \"unsupported\"\n\t\t} %>\n\t\t<%= numberify(\"one\") %>"
+
+// RenderRootTests100 renders parity_root_tests_test.go to w.
+//
+// The template is rendered by the VM: it declares variables with let.
+func RenderRootTests100(w io.Writer, ctx hctx.Context) error {
+	out, err := vmplush.Render(renderRootTests100Source, ctx)
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderRootTests101Source is the template RenderRootTests101 was generated from.
+const renderRootTests101Source = "<%\n\t\tlet numberify = fn(arg) {\n\t\t\tif (arg == \"one\") {\n\t\t\t\treturn 1;\n\t\t\t}\n\t\t\tif (arg == \"two\") {\n\t\t\t\treturn 445;\n\t\t\t}\n\t\t\tif (arg == \"three\") {\n\t\t\t\treturn 3;\n\t\t\t}\n\t\t\treturn \"unsupported\"\n\t\t} %>\n\t\t<%= numberify(\"two\") %>"
+
+// RenderRootTests101 renders parity_root_tests_test.go to w.
+//
+// The template is rendered by the VM: it declares variables with let.
+func RenderRootTests101(w io.Writer, ctx hctx.Context) error {
+	out, err := vmplush.Render(renderRootTests101Source, ctx)
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderRootTests102Source is the template RenderRootTests102 was generated from.
+const renderRootTests102Source = "<%\n\t\tlet numberify = fn(arg) {\n\t\t\tif (arg == \"one\") {\n\t\t\t\treturn 1;\n\t\t\t}\n\t\t\tif (arg == \"two\") {\n\t\t\t\treturn 445;\n\t\t\t}\n\t\t\tif (arg == \"three\") {\n\t\t\t\treturn 3;\n\t\t\t}\n\t\t\treturn \"default value\"\n\t\t} %>\n\t\t<%= numberify(\"six\") %>"
+
+// RenderRootTests102 renders parity_root_tests_test.go to w.
+//
+// The template is rendered by the VM: it declares variables with let.
+func RenderRootTests102(w io.Writer, ctx hctx.Context) error {
+	out, err := vmplush.Render(renderRootTests102Source, ctx)
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderRootTests103Source is the template RenderRootTests103 was generated from.
+const renderRootTests103Source = "Current number is <%= p.N %>.<%= if (p.Next) { %>  Next up is <%= p.Next.N %>.<% } %>"
+
+// renderRootTests103Fragment0 compiles the statement on line 1 for the VM.
+var renderRootTests103Fragment0 = sync.OnceValues(func() (*vmplush.Template, error) {
+	return vmplush.CompileAt("<%= p.N %>", 1)
+})
+
+// renderRootTests103Fragment1 compiles the statement on line 1 for the VM.
+var renderRootTests103Fragment1 = sync.OnceValues(func() (*vmplush.Template, error) {
+	return vmplush.CompileAt("<%= if (p.Next) { %>  Next up is <%= p.Next.N %>.<% } %>", 1)
+})
+
+// RenderRootTests103 renders parity_root_tests_test.go to w.
+func RenderRootTests103(w io.Writer, ctx hctx.Context) error {
+	out, ok, err := renderRootTests103Static(ctx)
+	if !ok {
+		out, err = vmplush.Render(renderRootTests103Source, ctx)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderRootTests103Static renders the template with the declared types. It reports false
+// when the context does not hold them or holds nil pointers.
+func renderRootTests103Static(ctx hctx.Context) (string, bool, error) {
+	var b bytes.Buffer
+	b.WriteString("Current number is ")
+	if t, err := renderRootTests103Fragment0(); err != nil {
+		return "", true, err
+	} else if s, err := t.Render(ctx); err != nil {
+		return "", true, err
+	} else {
+		b.WriteString(s)
+	}
+	b.WriteString(".")
+	if t, err := renderRootTests103Fragment1(); err != nil {
+		return "", true, err
+	} else if s, err := t.Render(ctx); err != nil {
+		return "", true, err
+	} else {
+		b.WriteString(s)
+	}
+	return b.String(), true, nil
+}
+
+// renderRootTests104Source is the template RenderRootTests104 was generated from.
+const renderRootTests104Source = "<%= user.Name %>: <%= user.Image %>"
+
+// renderRootTests104Fragment0 compiles the statement on line 1 for the VM.
+var renderRootTests104Fragment0 = sync.OnceValues(func() (*vmplush.Template, error) {
+	return vmplush.CompileAt("<%= user.Name %>", 1)
+})
+
+// renderRootTests104Fragment1 compiles the statement on line 1 for the VM.
+var renderRootTests104Fragment1 = sync.OnceValues(func() (*vmplush.Template, error) {
+	return vmplush.CompileAt("<%= user.Image %>", 1)
+})
+
+// RenderRootTests104 renders parity_root_tests_test.go to w.
+func RenderRootTests104(w io.Writer, ctx hctx.Context) error {
+	out, ok, err := renderRootTests104Static(ctx)
+	if !ok {
+		out, err = vmplush.Render(renderRootTests104Source, ctx)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderRootTests104Static renders the template with the declared types. It reports false
+// when the context does not hold them or holds nil pointers.
+func renderRootTests104Static(ctx hctx.Context) (string, bool, error) {
+	var b bytes.Buffer
+	if t, err := renderRootTests104Fragment0(); err != nil {
+		return "", true, err
+	} else if s, err := t.Render(ctx); err != nil {
+		return "", true, err
+	} else {
+		b.WriteString(s)
+	}
+	b.WriteString(": ")
+	if t, err := renderRootTests104Fragment1(); err != nil {
+		return "", true, err
+	} else if s, err := t.Render(ctx); err != nil {
+		return "", true, err
+	} else {
+		b.WriteString(s)
+	}
+	return b.String(), true, nil
+}
+
+// renderRootTests105Source is the template RenderRootTests105 was generated from.
+const renderRootTests105Source = "<%= nour.GetBorn().Format(\"Jan 2, 2006\") %>"
+
+// renderRootTests105Fragment0 compiles the statement on line 1 for the VM.
+var renderRootTests105Fragment0 = sync.OnceValues(func() (*vmplush.Template, error) {
+	return vmplush.CompileAt("<%= nour.GetBorn().Format(\"Jan 2, 2006\") %>", 1)
+})
+
+// RenderRootTests105 renders parity_root_tests_test.go to w.
+func RenderRootTests105(w io.Writer, ctx hctx.Context) error {
+	out, ok, err := renderRootTests105Static(ctx)
+	if !ok {
+		out, err = vmplush.Render(renderRootTests105Source, ctx)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderRootTests105Static renders the template with the declared types. It reports false
+// when the context does not hold them or holds nil pointers.
+func renderRootTests105Static(ctx hctx.Context) (string, bool, error) {
+	var b bytes.Buffer
+	if t, err := renderRootTests105Fragment0(); err != nil {
+		return "", true, err
+	} else if s, err := t.Render(ctx); err != nil {
+		return "", true, err
+	} else {
+		b.WriteString(s)
+	}
+	return b.String(), true, nil
+}
+
+// renderRootTests109Source is the template RenderRootTests109 was generated from.
+const renderRootTests109Source = "<%= raw(`CREATE MATERIALIZED VIEW view_papers AS\n\tSELECT papers.created_at,\n\t   papers.updated_at,\n\t   papers.id,\n\t   papers.name,\n\t   (   setweight(to_tsvector(papers.name::text), 'A'::\"char\") ||\n\t\t   setweight(to_tsvector(papers.author_name), 'B'::\"char\")\n\t   ) || setweight(to_tsvector(papers.description), 'C'::\"char\")\n\t   AS paper_vector\n\t  FROM\n\t  ( SELECT papers.id, string_agg(categories.code, ',') as categories\n\t   FROM papers\n\t   LEFT JOIN paper_categories ON paper_categories.paper_id=papers.id LEFT JOIN (select * from categories order by weight asc) categories ON categories.id=paper_categories.category_id\n\t   GROUP BY papers.id\n\t  ) a\n\t  LEFT JOIN papers on a.id=papers.id\n\t WHERE (papers.doc_status = ANY (ARRAY[1, 3])) AND papers.status = 1\n   WITH DATA`) %>"
+
+// renderRootTests109Fragment0 compiles the statement on line 1 for the VM.
+var renderRootTests109Fragment0 = sync.OnceValues(func() (*vmplush.Template, error) {
+	return vmplush.CompileAt("<%= raw(`CREATE MATERIALIZED VIEW view_papers AS\n\tSELECT papers.created_at,\n\t   papers.updated_at,\n\t   papers.id,\n\t   papers.name,\n\t   (   setweight(to_tsvector(papers.name::text), 'A'::\"char\") ||\n\t\t   setweight(to_tsvector(papers.author_name), 'B'::\"char\")\n\t   ) || setweight(to_tsvector(papers.description), 'C'::\"char\")\n\t   AS paper_vector\n\t  FROM\n\t  ( SELECT papers.id, string_agg(categories.code, ',') as categories\n\t   FROM papers\n\t   LEFT JOIN paper_categories ON paper_categories.paper_id=papers.id LEFT JOIN (select * from categories order by weight asc) categories ON categories.id=paper_categories.category_id\n\t   GROUP BY papers.id\n\t  ) a\n\t  LEFT JOIN papers on a.id=papers.id\n\t WHERE (papers.doc_status = ANY (ARRAY[1, 3])) AND papers.status = 1\n   WITH DATA`) %>", 1)
+})
+
+// RenderRootTests109 renders parity_root_tests_test.go to w.
+func RenderRootTests109(w io.Writer, ctx hctx.Context) error {
+	out, ok, err := renderRootTests109Static(ctx)
+	if !ok {
+		out, err = vmplush.Render(renderRootTests109Source, ctx)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderRootTests109Static renders the template with the declared types. It reports false
+// when the context does not hold them or holds nil pointers.
+func renderRootTests109Static(ctx hctx.Context) (string, bool, error) {
+	var b bytes.Buffer
+	if t, err := renderRootTests109Fragment0(); err != nil {
+		return "", true, err
+	} else if s, err := t.Render(ctx); err != nil {
+		return "", true, err
+	} else {
+		b.WriteString(s)
+	}
+	return b.String(), true, nil
+}
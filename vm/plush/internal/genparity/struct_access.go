@@ -0,0 +1,466 @@
+// Code generated by go test ./vm/plush -update-gen. DO NOT EDIT.
+
+package genparity
+
+import (
+	"bytes"
+	"github.com/gobuffalo/plush/v5/helpers/hctx"
+	vmplush "github.com/gobuffalo/plush/v5/vm/plush"
+	"io"
+	"sync"
+)
+
+// renderStructAccess1Source is the template RenderStructAccess1 was generated from.
+const renderStructAccess1Source = "<%= robot.Name %>"
+
+// renderStructAccess1Fragment0 compiles the statement on line 1 for the VM.
+var renderStructAccess1Fragment0 = sync.OnceValues(func() (*vmplush.Template, error) {
+	return vmplush.CompileAt("<%= robot.Name %>", 1)
+})
+
+// RenderStructAccess1 renders parity_struct_access_test.go to w.
+func RenderStructAccess1(w io.Writer, ctx hctx.Context) error {
+	out, ok, err := renderStructAccess1Static(ctx)
+	if !ok {
+		out, err = vmplush.Render(renderStructAccess1Source, ctx)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderStructAccess1Static renders the template with the declared types. It reports false
+// when the context does not hold them or holds nil pointers.
+func renderStructAccess1Static(ctx hctx.Context) (string, bool, error) {
+	var b bytes.Buffer
+	if t, err := renderStructAccess1Fragment0(); err != nil {
+		return "", true, err
+	} else if s, err := t.Render(ctx); err != nil {
+		return "", true, err
+	} else {
+		b.WriteString(s)
+	}
+	return b.String(), true, nil
+}
+
+// renderStructAccess2Source is the template RenderStructAccess2 was generated from.
+const renderStructAccess2Source = "<%= robot.Name.Echo() %>"
+
+// renderStructAccess2Fragment0 compiles the statement on line 1 for the VM.
+var renderStructAccess2Fragment0 = sync.OnceValues(func() (*vmplush.Template, error) {
+	return vmplush.CompileAt("<%= robot.Name.Echo() %>", 1)
+})
+
+// RenderStructAccess2 renders parity_struct_access_test.go to w.
+func RenderStructAccess2(w io.Writer, ctx hctx.Context) error {
+	out, ok, err := renderStructAccess2Static(ctx)
+	if !ok {
+		out, err = vmplush.Render(renderStructAccess2Source, ctx)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderStructAccess2Static renders the template with the declared types. It reports false
+// when the context does not hold them or holds nil pointers.
+func renderStructAccess2Static(ctx hctx.Context) (string, bool, error) {
+	var b bytes.Buffer
+	if t, err := renderStructAccess2Fragment0(); err != nil {
+		return "", true, err
+	} else if s, err := t.Render(ctx); err != nil {
+		return "", true, err
+	} else {
+		b.WriteString(s)
+	}
+	return b.String(), true, nil
+}
+
+// renderStructAccess3Source is the template RenderStructAccess3 was generated from.
+const renderStructAccess3Source = "<%= robots[0].Stats[1].Label %>"
+
+// renderStructAccess3Fragment0 compiles the statement on line 1 for the VM.
+var renderStructAccess3Fragment0 = sync.OnceValues(func() (*vmplush.Template, error) {
+	return vmplush.CompileAt("<%= robots[0].Stats[1].Label %>", 1)
+})
+
+// RenderStructAccess3 renders parity_struct_access_test.go to w.
+func RenderStructAccess3(w io.Writer, ctx hctx.Context) error {
+	out, ok, err := renderStructAccess3Static(ctx)
+	if !ok {
+		out, err = vmplush.Render(renderStructAccess3Source, ctx)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderStructAccess3Static renders the template with the declared types. It reports false
+// when the context does not hold them or holds nil pointers.
+func renderStructAccess3Static(ctx hctx.Context) (string, bool, error) {
+	var b bytes.Buffer
+	if t, err := renderStructAccess3Fragment0(); err != nil {
+		return "", true, err
+	} else if s, err := t.Render(ctx); err != nil {
+		return "", true, err
+	} else {
+		b.WriteString(s)
+	}
+	return b.String(), true, nil
+}
+
+// renderStructAccess4Source is the template RenderStructAccess4 was generated from.
+const renderStructAccess4Source = "<%= robot.Avatar.URL() %>"
+
+// renderStructAccess4Fragment0 compiles the statement on line 1 for the VM.
+var renderStructAccess4Fragment0 = sync.OnceValues(func() (*vmplush.Template, error) {
+	return vmplush.CompileAt("<%= robot.Avatar.URL() %>", 1)
+})
+
+// RenderStructAccess4 renders parity_struct_access_test.go to w.
+func RenderStructAccess4(w io.Writer, ctx hctx.Context) error {
+	out, ok, err := renderStructAccess4Static(ctx)
+	if !ok {
+		out, err = vmplush.Render(renderStructAccess4Source, ctx)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderStructAccess4Static renders the template with the declared types. It reports false
+// when the context does not hold them or holds nil pointers.
+func renderStructAccess4Static(ctx hctx.Context) (string, bool, error) {
+	var b bytes.Buffer
+	if t, err := renderStructAccess4Fragment0(); err != nil {
+		return "", true, err
+	} else if s, err := t.Render(ctx); err != nil {
+		return "", true, err
+	} else {
+		b.WriteString(s)
+	}
+	return b.String(), true, nil
+}
+
+// renderStructAccess5Source is the template RenderStructAccess5 was generated from.
+const renderStructAccess5Source = "<%= lookup[\"bad\"] %>"
+
+// renderStructAccess5Fragment0 compiles the statement on line 1 for the VM.
+var renderStructAccess5Fragment0 = sync.OnceValues(func() (*vmplush.Template, error) {
+	return vmplush.CompileAt("<%= lookup[\"bad\"] %>", 1)
+})
+
+// RenderStructAccess5 renders parity_struct_access_test.go to w.
+func RenderStructAccess5(w io.Writer, ctx hctx.Context) error {
+	out, ok, err := renderStructAccess5Static(ctx)
+	if !ok {
+		out, err = vmplush.Render(renderStructAccess5Source, ctx)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderStructAccess5Static renders the template with the declared types. It reports false
+// when the context does not hold them or holds nil pointers.
+func renderStructAccess5Static(ctx hctx.Context) (string, bool, error) {
+	var b bytes.Buffer
+	if t, err := renderStructAccess5Fragment0(); err != nil {
+		return "", true, err
+	} else if s, err := t.Render(ctx); err != nil {
+		return "", true, err
+	} else {
+		b.WriteString(s)
+	}
+	return b.String(), true, nil
+}
+
+// renderStructAccess6Source is the template RenderStructAccess6 was generated from.
+const renderStructAccess6Source = "<%= items[\"bad\"].Name %>"
+
+// renderStructAccess6Fragment0 compiles the statement on line 1 for the VM.
+var renderStructAccess6Fragment0 = sync.OnceValues(func() (*vmplush.Template, error) {
+	return vmplush.CompileAt("<%= items[\"bad\"].Name %>", 1)
+})
+
+// RenderStructAccess6 renders parity_struct_access_test.go to w.
+func RenderStructAccess6(w io.Writer, ctx hctx.Context) error {
+	out, ok, err := renderStructAccess6Static(ctx)
+	if !ok {
+		out, err = vmplush.Render(renderStructAccess6Source, ctx)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderStructAccess6Static renders the template with the declared types. It reports false
+// when the context does not hold them or holds nil pointers.
+func renderStructAccess6Static(ctx hctx.Context) (string, bool, error) {
+	var b bytes.Buffer
+	if t, err := renderStructAccess6Fragment0(); err != nil {
+		return "", true, err
+	} else if s, err := t.Render(ctx); err != nil {
+		return "", true, err
+	} else {
+		b.WriteString(s)
+	}
+	return b.String(), true, nil
+}
+
+// renderStructAccess7Source is the template RenderStructAccess7 was generated from.
+const renderStructAccess7Source = "<%= items[2].Name %>"
+
+// renderStructAccess7Fragment0 compiles the statement on line 1 for the VM.
+var renderStructAccess7Fragment0 = sync.OnceValues(func() (*vmplush.Template, error) {
+	return vmplush.CompileAt("<%= items[2].Name %>", 1)
+})
+
+// RenderStructAccess7 renders parity_struct_access_test.go to w.
+func RenderStructAccess7(w io.Writer, ctx hctx.Context) error {
+	out, ok, err := renderStructAccess7Static(ctx)
+	if !ok {
+		out, err = vmplush.Render(renderStructAccess7Source, ctx)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderStructAccess7Static renders the template with the declared types. It reports false
+// when the context does not hold them or holds nil pointers.
+func renderStructAccess7Static(ctx hctx.Context) (string, bool, error) {
+	var b bytes.Buffer
+	if t, err := renderStructAccess7Fragment0(); err != nil {
+		return "", true, err
+	} else if s, err := t.Render(ctx); err != nil {
+		return "", true, err
+	} else {
+		b.WriteString(s)
+	}
+	return b.String(), true, nil
+}
+
+// renderStructAccess8Source is the template RenderStructAccess8 was generated from.
+const renderStructAccess8Source = "<%= departments[\"HR\"].employee[0].FirstName %>"
+
+// renderStructAccess8Fragment0 compiles the statement on line 1 for the VM.
+var renderStructAccess8Fragment0 = sync.OnceValues(func() (*vmplush.Template, error) {
+	return vmplush.CompileAt("<%= departments[\"HR\"].employee[0].FirstName %>", 1)
+})
+
+// RenderStructAccess8 renders parity_struct_access_test.go to w.
+func RenderStructAccess8(w io.Writer, ctx hctx.Context) error {
+	out, ok, err := renderStructAccess8Static(ctx)
+	if !ok {
+		out, err = vmplush.Render(renderStructAccess8Source, ctx)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderStructAccess8Static renders the template with the declared types. It reports false
+// when the context does not hold them or holds nil pointers.
+func renderStructAccess8Static(ctx hctx.Context) (string, bool, error) {
+	var b bytes.Buffer
+	if t, err := renderStructAccess8Fragment0(); err != nil {
+		return "", true, err
+	} else if s, err := t.Render(ctx); err != nil {
+		return "", true, err
+	} else {
+		b.WriteString(s)
+	}
+	return b.String(), true, nil
+}
+
+// renderStructAccess9Source is the template RenderStructAccess9 was generated from.
+const renderStructAccess9Source = "<%= robot.Missing %>"
+
+// renderStructAccess9Fragment0 compiles the statement on line 1 for the VM.
+var renderStructAccess9Fragment0 = sync.OnceValues(func() (*vmplush.Template, error) {
+	return vmplush.CompileAt("<%= robot.Missing %>", 1)
+})
+
+// RenderStructAccess9 renders parity_struct_access_test.go to w.
+func RenderStructAccess9(w io.Writer, ctx hctx.Context) error {
+	out, ok, err := renderStructAccess9Static(ctx)
+	if !ok {
+		out, err = vmplush.Render(renderStructAccess9Source, ctx)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderStructAccess9Static renders the template with the declared types. It reports false
+// when the context does not hold them or holds nil pointers.
+func renderStructAccess9Static(ctx hctx.Context) (string, bool, error) {
+	var b bytes.Buffer
+	if t, err := renderStructAccess9Fragment0(); err != nil {
+		return "", true, err
+	} else if s, err := t.Render(ctx); err != nil {
+		return "", true, err
+	} else {
+		b.WriteString(s)
+	}
+	return b.String(), true, nil
+}
+
+// renderStructAccess10Source is the template RenderStructAccess10 was generated from.
+const renderStructAccess10Source = "<%= robot.Missing() %>"
+
+// renderStructAccess10Fragment0 compiles the statement on line 1 for the VM.
+var renderStructAccess10Fragment0 = sync.OnceValues(func() (*vmplush.Template, error) {
+	return vmplush.CompileAt("<%= robot.Missing() %>", 1)
+})
+
+// RenderStructAccess10 renders parity_struct_access_test.go to w.
+func RenderStructAccess10(w io.Writer, ctx hctx.Context) error {
+	out, ok, err := renderStructAccess10Static(ctx)
+	if !ok {
+		out, err = vmplush.Render(renderStructAccess10Source, ctx)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderStructAccess10Static renders the template with the declared types. It reports false
+// when the context does not hold them or holds nil pointers.
+func renderStructAccess10Static(ctx hctx.Context) (string, bool, error) {
+	var b bytes.Buffer
+	if t, err := renderStructAccess10Fragment0(); err != nil {
+		return "", true, err
+	} else if s, err := t.Render(ctx); err != nil {
+		return "", true, err
+	} else {
+		b.WriteString(s)
+	}
+	return b.String(), true, nil
+}
+
+// renderStructAccess11Source is the template RenderStructAccess11 was generated from.
+const renderStructAccess11Source = "<%= robot.Name() %>"
+
+// renderStructAccess11Fragment0 compiles the statement on line 1 for the VM.
+var renderStructAccess11Fragment0 = sync.OnceValues(func() (*vmplush.Template, error) {
+	return vmplush.CompileAt("<%= robot.Name() %>", 1)
+})
+
+// RenderStructAccess11 renders parity_struct_access_test.go to w.
+func RenderStructAccess11(w io.Writer, ctx hctx.Context) error {
+	out, ok, err := renderStructAccess11Static(ctx)
+	if !ok {
+		out, err = vmplush.Render(renderStructAccess11Source, ctx)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderStructAccess11Static renders the template with the declared types. It reports false
+// when the context does not hold them or holds nil pointers.
+func renderStructAccess11Static(ctx hctx.Context) (string, bool, error) {
+	var b bytes.Buffer
+	if t, err := renderStructAccess11Fragment0(); err != nil {
+		return "", true, err
+	} else if s, err := t.Render(ctx); err != nil {
+		return "", true, err
+	} else {
+		b.WriteString(s)
+	}
+	return b.String(), true, nil
+}
+
+// renderStructAccess12Source is the template RenderStructAccess12 was generated from.
+const renderStructAccess12Source = "<%= robot.Name.Missing() %>"
+
+// renderStructAccess12Fragment0 compiles the statement on line 1 for the VM.
+var renderStructAccess12Fragment0 = sync.OnceValues(func() (*vmplush.Template, error) {
+	return vmplush.CompileAt("<%= robot.Name.Missing() %>", 1)
+})
+
+// RenderStructAccess12 renders parity_struct_access_test.go to w.
+func RenderStructAccess12(w io.Writer, ctx hctx.Context) error {
+	out, ok, err := renderStructAccess12Static(ctx)
+	if !ok {
+		out, err = vmplush.Render(renderStructAccess12Source, ctx)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderStructAccess12Static renders the template with the declared types. It reports false
+// when the context does not hold them or holds nil pointers.
+func renderStructAccess12Static(ctx hctx.Context) (string, bool, error) {
+	var b bytes.Buffer
+	if t, err := renderStructAccess12Fragment0(); err != nil {
+		return "", true, err
+	} else if s, err := t.Render(ctx); err != nil {
+		return "", true, err
+	} else {
+		b.WriteString(s)
+	}
+	return b.String(), true, nil
+}
+
+// renderStructAccess13Source is the template RenderStructAccess13 was generated from.
+const renderStructAccess13Source = "<%= robot.GetFriends()[9].Name %>"
+
+// renderStructAccess13Fragment0 compiles the statement on line 1 for the VM.
+var renderStructAccess13Fragment0 = sync.OnceValues(func() (*vmplush.Template, error) {
+	return vmplush.CompileAt("<%= robot.GetFriends()[9].Name %>", 1)
+})
+
+// RenderStructAccess13 renders parity_struct_access_test.go to w.
+func RenderStructAccess13(w io.Writer, ctx hctx.Context) error {
+	out, ok, err := renderStructAccess13Static(ctx)
+	if !ok {
+		out, err = vmplush.Render(renderStructAccess13Source, ctx)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderStructAccess13Static renders the template with the declared types. It reports false
+// when the context does not hold them or holds nil pointers.
+func renderStructAccess13Static(ctx hctx.Context) (string, bool, error) {
+	var b bytes.Buffer
+	if t, err := renderStructAccess13Fragment0(); err != nil {
+		return "", true, err
+	} else if s, err := t.Render(ctx); err != nil {
+		return "", true, err
+	} else {
+		b.WriteString(s)
+	}
+	return b.String(), true, nil
+}
@@ -0,0 +1,370 @@
+// Code generated by go test ./vm/plush -update-gen. DO NOT EDIT.
+
+// Package genparity holds the code plush gen generates for the templates
+// of the VM parity tests.
+package genparity
+
+import (
+	"github.com/gobuffalo/plush/v5/helpers/hctx"
+	"io"
+)
+
+// Templates maps the source of each parity template to its generated
+// render function.
+var Templates = map[string]func(io.Writer, hctx.Context) error{
+	"<p>Hello <%= name %></p>":           RenderBasic1,
+	"<%= greet %> <%= name %>":           RenderBasic2,
+	"<%= \"shown\" %><% \"notshown\" %>": RenderBasic3,
+	"<%= html %>":                        RenderBasic4,
+	"<%= escapedHTML() %>|<%= unescapedHTML() %>|<%= raw(\"<b>unsafe</b>\") %>": RenderBasic5,
+	"C:\\\\<%= \"temp\" %>":                      RenderBasic6,
+	"<%= for (i,v) in items { %><%= v %><% } %>": RenderBudget1,
+	"<% let f = fn(n) { if (n > 0) { return f(n - 1) } return n } %><%= f(depth) %>":                    RenderBudget2,
+	"<% let f = fn(n) { for (i) in [1] { if (n > 0) { return f(n - 1) } } return n } %><%= f(depth) %>": RenderBudget3,
+	"<% let f = fn(n) { return f(n + 1) } %><%= f(0) %>":                                                RenderBudget4,
+	"<% let f = fn(n) { if (n > 0) { return f(n - 1) } return \"done\" } %><%= f(depth) %>":             RenderBudget5,
+	"<%= partial(\"self.plush\") %>":                                                                    RenderBudget6,
+	"<%= partial(\"a.plush\") %>":                                                                       RenderBudget7,
+	"<%= partial(\"a.plush\", {n: 1}) %>":                                                               RenderBudget8,
+	"<%= partial(\"leaf.plush\") %>":                                                                    RenderBudget9,
+	"<%= partial(\"up.plush\") %>":                                                                      RenderBudget10,
+	"<%= partial(\"count.plush\") %>":                                                                   RenderBudget11,
+	"<%= partial(\"node.html\", {\"node\": list}) %>":                                                   RenderBudget12,
+	"<%= paths == nil %>":                                                                               RenderConditions1,
+	"<%= nil == paths %>":                                                                               RenderConditions2,
+	"<%= !paths %>":                                                                                     RenderConditions3,
+	"<%= if (paths) { %>yes<% } else { %>no<% } %>":                                                     RenderConditions4,
+	"<%= if (username && username != \"\") { return \"hi\" } else { return \"bye\" } %>":                RenderConditions5,
+	"<p><%= if (username && username != \"\") { username = \"hi\" } else { username = \"bye\" } %><%= username %></p>":                                                                                                                                               RenderConditions6,
+	"<p><% let username = \"Hello World\" %><%= if (username && username != \"\") {\n\t\tlet username = \"hi\"\n\t\tusername = \"hi\"\n\t} else {\n\t\tlet username = \"bye\"\n\t\tusername = \"1\"\n\t} %><%= username %></p>":                                      RenderConditions7,
+	"<p><% let username = \"Hello World\" %><%= if (username && username != \"\") {\n\t\tusername = \"hi\"\n\t\tif (username == \"hi\") {\n\t\t\tusername = \"hi2\"\n\t\t}\n\t} else {\n\t\tlet username = \"bye\"\n\t\tusername = \"1\"\n\t} %><%= username %></p>": RenderConditions8,
+	"<%= user.Name %>:<%= if (user.Image) { return \"has image\" } else { return \"no image\" } %>":                                                                                                                                                                  RenderConditions9,
+	"<%= if (len(record.Nodes) > 0 && record.Nodes[0].Label) { %>present<% } else { %>empty<% } %>":                                                                                                                                                                  RenderConditions10,
+	"<%= if (names && len(names) >= 1) { %>yes<% } else { %>no<% } %>":                                                                                                                                                                                               RenderConditions11,
+	"<%= paths || pages %>": RenderConditions12,
+	"<%= pages || paths %>": RenderConditions13,
+	"<%= paths && pages %>": RenderConditions14,
+	"<%= if (paths == \"cart\" || (page && page.PageTitle != \"cafe\") || paths == \"cart\") { %>hi<% } %>": RenderConditions15,
+	"<%= greet(name) %>":                                  RenderFunctions1,
+	"<%= render_section(section) %>":                      RenderFunctions2,
+	"<% let add = fn(x) { return x + 2 } %><%= add(3) %>": RenderFunctions3,
+	"<p><%= f() %></p>":                                   RenderFunctions4,
+	"<p><%= fail() %></p>":                                RenderFunctions5,
+	"<%= lookup(nil, \"k\") %><%= lookup(one, \"k\") %>":  RenderFunctions6,
+	"<%= foo(bar) %>":                                     RenderFunctions7,
+	"<%\nlet numberify = fn(arg) {\n\tif (arg == \"one\") {\n\t\treturn 1 + 1\n\t}\n\tif (arg == \"two\") {\n\t\treturn 44\n\t}\n\treturn \"unsupported\"\n} %><%= numberify(\"one\") %>":                                                    RenderFunctions8,
+	"<%\nlet print = fn(obj) {\n\tif (obj.Secret) {\n\t\tif (obj.GiveHint) {\n\t\t\treturn truncate(obj.String, {size: 12, trail: \"****\"})\n\t\t}\n\t\treturn \"**********\"\n\t}\n\treturn obj.String\n}\n%>You are: <%= print(data) %>.": RenderFunctions9,
+	"<p><%= g.Greet(\"mark\") %></p>":     RenderFunctions10,
+	"<%= foo() %>|<%= bar({a: \"A\"}) %>": RenderFunctions11,
+	"<%= my-helper() %>":                  RenderFunctions12,
+	"<% let countdown = fn(x) { if (x == 0) { return 0 } return countdown(x - 1) } %><%= countdown(3) %>": RenderFunctions13,
+	"<%= raw(`CREATE VIEW x AS SELECT \"name\" FROM things`) %>":                                          RenderFunctions14,
+	"<%= greet(first + last) %>": RenderHelpers1,
+	"<%= count(1, 2, 3) %>|<%= prefix(\"a\", \"b\", \"c\") %>|<%= empty() %>|<%= fixed(4) %>": RenderHelpers2,
+	"<%= count(1, 2, \"bad\") %>":                                  RenderHelpers3,
+	"<%= remember(\"x\") %><%= value %>":                           RenderHelpers4,
+	"<%= greet() %>":                                               RenderHelpers5,
+	"<%= fail() %>":                                                RenderHelpers6,
+	"<%= foo() %>|<%= bar({name: \"mark\"}) %>":                    RenderHelpers7,
+	"<%= blockCheck() { return \"block\" } %>|<%= blockCheck() %>": RenderHelpers8,
+	"<%= join_values(\"left\", \"right\") %>|<%= join_values(\"left\", \"right\", \"wide\") %>": RenderHelpers9,
+	"<%= format_count(\"items\") %>|<%= format_count(\"items\", 7) %>":                          RenderHelpers10,
+	"<%= wrap({name: \"mark\"}) { return prefix + name } %><%= name %>":                         RenderHelpers11,
+	"<%= renderSnippet() %>":                       RenderHelpers12,
+	"<% seed() %><%= label %>|<%= widget.Label %>": RenderHelpers13,
+	"<%= seed() { %><%= label %>|<%= widget.Label %><% } %>-><%= label %>|<%= widget.Label %>":               RenderHelpers14,
+	"<%= scope() { %><%= label %><% label = \"updated\" %>|<%= label %><% } %>":                              RenderHelpers15,
+	"<%= for (_, block) in blocks { %><%= render(block.Type + \".plush.html\", {settings: block}) %><% } %>": RenderHelpers16,
+	"<% let value = \"before\" %><%= run() { value = \"after\" } %><%= value %>":                             RenderHelpers17,
+	"<%= run() { let inner = \"secret\" } %><%= inner %>":                                                    RenderHelpers18,
+	"<%= greeter.Greet(\"mark\") %>|<%= makeGreeter().Greet(\"mido\") %>|<%= makeName().Echo() %>":           RenderHelpers19,
+	"<%= missingHelper() %>":                           RenderHelpers20,
+	"<%H \"hello\" %>":                                 RenderHoles1,
+	"<%H missing_helper\" %><%H \"ok\" %>":             RenderHoles2,
+	"<%= \"x\" %><%H \"a\" %><%H \"b\" %><%H \"c\" %>": RenderHoles3,
+	"<%H \"a\" %><%H \"b\" %><%= \"x\" %>":             RenderHoles4,
+	"<%H \"start\" %>middle<%H \"end\" %>":             RenderHoles5,
+	"<%H \"\" %>foo<%H  %>":                            RenderHoles6,
+	"<PLUSH_HOLE_0><%H \"start\" %><%H \"end\" %>":     RenderHoles7,
+	"<%H \"x\" %><%H \"x\" %><%H \"x\" %><%H \"x\" %><%H \"x\" %><%H \"x\" %><%H \"x\" %><%H \"x\" %><%H \"x\" %><%H \"x\" %><%H \"x\" %><%H \"x\" %><%H \"x\" %><%H \"x\" %><%H \"x\" %><%H \"x\" %><%H \"x\" %><%H \"x\" %><%H \"x\" %><%H \"x\" %><%H \"x\" %><%H \"x\" %><%H \"x\" %><%H \"x\" %><%H \"x\" %><%H \"x\" %><%H \"x\" %><%H \"x\" %><%H \"x\" %><%H \"x\" %><%H \"x\" %><%H \"x\" %><%H \"x\" %><%H \"x\" %><%H \"x\" %><%H \"x\" %><%H \"x\" %><%H \"x\" %><%H \"x\" %><%H \"x\" %><%H \"x\" %><%H \"x\" %><%H \"x\" %><%H \"x\" %><%H \"x\" %><%H \"x\" %><%H \"x\" %><%H \"x\" %><%H \"x\" %><%H \"x\" %><%H \"x\" %><%H \"x\" %><%H \"x\" %><%H \"x\" %><%H \"x\" %><%H \"x\" %><%H \"x\" %><%H \"x\" %><%H \"x\" %><%H \"x\" %><%H \"x\" %><%H \"x\" %><%H \"x\" %><%H \"x\" %><%H \"x\" %><%H \"x\" %><%H \"x\" %><%H \"x\" %><%H \"x\" %><%H \"x\" %><%H \"x\" %><%H \"x\" %><%H \"x\" %><%H \"x\" %><%H \"x\" %><%H \"x\" %><%H \"x\" %><%H \"x\" %><%H \"x\" %><%H \"x\" %><%H \"x\" %><%H \"x\" %><%H \"x\" %><%H \"x\" %><%H \"x\" %><%H \"x\" %><%H \"x\" %><%H \"x\" %><%H \"x\" %><%H \"x\" %><%H \"x\" %><%H \"x\" %><%H \"x\" %><%H \"x\" %><%H \"x\" %><%H \"x\" %><%H \"x\" %><%H \"x\" %><%H \"x\" %><%H \"x\" %>": RenderHoles8,
+	"<%= if (a == \"22\") { %><%H \"testing\" %><% } else { %><%H \"dddd\" %><% } %>":              RenderHoles9,
+	"<%H if (number > 0){ %><%= \"NUMBER\" %><% } else { %><%= number %><%  }%>":                   RenderHoles10,
+	"<%= for (i,v) in items { %><%H \"testing\" %><%= v %><% } %>":                                 RenderHoles11,
+	"<%H for (i,v) in items { %><%= v %><% } %>":                                                   RenderHoles12,
+	"<%= wrap() { %><%H \"inside\" %><% } %>":                                                      RenderHoles13,
+	"<%= partial(\"hole.plush\") %>":                                                               RenderHoles14,
+	"<%= partial(\"index.plush\") %>":                                                              RenderHoles15,
+	"<%= for (i,v) in [\"a\", \"b\", \"c\"] { return v } %>":                                       RenderLoops1,
+	"<%= for (i,v) in items { %><%= i %>:<%= v %>;<% } %>":                                         RenderLoops2,
+	"<%= for (v) in [\"a\", \"b\", \"c\"] {%><%=v%><%} %>":                                         RenderLoops3,
+	"<%= for (i,v) in [\"a\", \"b\", \"c\"] {%><%=i%><%=v%><%} %>":                                 RenderLoops4,
+	"<% let i = 10000 %><%= for (i,v) in [\"a\", \"b\", \"c\"] {%><%=i%><%=v%><%} %><%= i %>":      RenderLoops5,
+	"<% let varTest = \"\" %><% for (i,v) in [\"a\", \"b\", \"c\"] {varTest = v} %><%= varTest %>": RenderLoops6,
+	"<% let selected = entries[0] %>\n<%= if (input[\"target_id\"] != \"\" && count(entries) > 0) { %>\n\t<%= for (i, entry) in entries { %>\n\t\t<%= if (entry.ID == input[\"target_id\"]) { %>\n\t\t\t<% selected = entry %>\n\t\t<% } %>\n\t<% } %>\n<% } %>\n<%= selected.ID %>":                                                              RenderLoops7,
+	"<% let handlers = [] %>\n<% let items = [1,2,3,4,5,6,7,8,9,0,10] %>\n<%= for (index, item) in items { %>\n\t<%= if(true) { %>\n\t\t<% let handle = \"test\" + to_string(index) %>\n\t\t<% handlers = handlers + handle %>\n\t\t<script>console.log(<%= json_encode(handlers) %>)</script>\n\t<% } %>\n<% } %>\n<%= json_encode(handlers) %>": RenderLoops8,
+	"<% let result = [] %><% if (true) { %>discarded<% let local = \"A\" %><% result = result + local %><% } %><%= result[0] %>":                                                                                                                                                                                                                  RenderLoops9,
+	"<% let result = [] %><%= if (false) { %><% let local = \"wrong\" %><% result = result + local %><% } else { %><% let local = \"B\" %><% result = result + local %><% } %><%= result[0] %>":                                                                                                                                                   RenderLoops10,
+	"<% let result = [] %><%= if (true) { %><% let prefix = \"A\" %><%= if (true) { %><% let suffix = \"B\" %><% result = result + (prefix + suffix) %><% } %><% } %><%= result[0] %>":                                                                                                                                                            RenderLoops11,
+	"<% let result = \"start\" %><%= if (false) { %><% result = \"wrong\" %><% } else if (true) { %><% let local = \"selected\" %><% result = local %><% } else { %><% result = \"also-wrong\" %><% } %><%= result %>":                                                                                                                            RenderLoops12,
+	"<% let result = [] %><%= for (_, item) in [1,2,3] { %><% if (item > 1) { %>discarded<% let local = item %><% result = result + local %><% } %><% } %><%= result[0] %><%= result[1] %>":                                                                                                                                                       RenderLoops13,
+	"<% let result = [] %><%= for (_, row) in [[1,2],[3]] { %><%= for (_, item) in row { %><%= if (true) { %><% let local = item %><% result = result + local %><% } %><% } %><% } %><%= result[0] %><%= result[1] %><%= result[2] %>":                                                                                                            RenderLoops14,
+	"<% let result = \"\" %><%= for (_, row) in [[\"A\",\"B\"],[\"C\"]] { %><%= for (_, item) in row { %><% let local = item %><% result = result + local %><% } %><% result = result + \"|\" %><% } %><%= result %>":                                                                                                                             RenderLoops15,
+	"<% let result = \"\" %><%= if (true) { %><% let prefix = \"x\" %><%= for (_, item) in [\"A\",\"B\"] { %><% let local = prefix + item %><% result = result + local %><% } %><% } %><%= result %>":                                                                                                                                             RenderLoops16,
+	"<% let label = \"outer\" %><% let result = \"\" %><%= for (_, row) in [[\"A\",\"B\"]] { %><%= for (_, item) in row { %><% let label = item %><% label = label + \"!\" %><% result = result + label %><% } %><% } %><%= result %>|<%= label %>":                                                                                               RenderLoops17,
+	"<% let result = [] %><%= for (_, item) in [1,2,3] { if (item == 2) { let local = item; result = result + local; continue }; result = result + item } %><%= result[0] %><%= result[1] %><%= result[2] %>":                                                                                                                                     RenderLoops18,
+	"<% let result = [] %><%= for (_, item) in [1,2,3] { if (item == 2) { let local = item; result = result + local; break }; result = result + item } %><%= result[0] %><%= result[1] %>":                                                                                                                                                        RenderLoops19,
+	"<% let left = \"\" %><% let right = \"\" %><%= for (_, item) in [\"A\",\"B\"] { %><%= if (true) { %><% let local = item %><% left = left + local %><% right = local + right %><% } %><% } %><%= left %>|<%= right %>":                                                                                                                        RenderLoops20,
+	"<% let label = \"outer\" %><%= if (true) { %><% let label = \"inner\" %><% label = label + \"!\" %><%= label %><% } %>|<%= label %>":                                                                                                                                                                                                         RenderLoops21,
+	"<% let result = \"start\" %><%= if (false) { %><% let result = \"shadow\" %><% result = \"wrong\" %><% } else { %><% result = \"selected\" %><% } %><%= result %>":                                                                                                                                                                           RenderLoops22,
+	"<% let result = \"\" %><%= for (_, item) in items { %><%= if (true) { %><% let local = item %><% result = result + local %><% } %><% } %><%= result %>":                                                                                                                                                                                      RenderLoops23,
+	"<% let result = \"\" %><%= for (_, item) in items { %><%= if (true) { %><% let local = item.Name %><% result = result + local %><% } %><% } %><%= result %>":                                                                                                                                                                                 RenderLoops24,
+	"<% let result = 0 %><%= for (_, item) in items { %><%= if (true) { %><% let local = item %><% result = result + local %><% } %><% } %><%= result %>":                                                                                                                                                                                         RenderLoops25,
+	"<%= for (_, item) in [\"A\",\"B\"] { %><%= if (true) { %><% let local = item %><% result = result + local %><% } %><% } %><%= result %>":                                                                                                                                                                                                     RenderLoops26,
+	"<% let result = {} %><%= for (_, item) in [\"A\",\"B\"] { %><%= if (true) { %><% let local = item %><% result[item] = local %><% } %><% } %><%= result[\"A\"] %><%= result[\"B\"] %>":                                                                                                                                                        RenderLoops27,
+	"<% let item = \"outer\" %><%= for (_, item) in [\"A\"] { %><% item = item + \"!\" %><%= item %><% } %>|<%= item %>":                                                                                                                                                                                                                          RenderLoops28,
+	"<%= if (len(record.Assets) > 0 && record.Assets[0].URL) { %>\n\t<%= for (index, asset) in record.Assets { %>\n\t\t<link href=\"<%= asset.URL %>\" data-alt=\"<%= asset.Alt %>\" data-index=\"<%= index %>\" />\n\t<% } %>\n<% } %>":                                                                                                          RenderLoops29,
+	"<%= for (i,v) in [1, 2, 3] { if (v == 2) { continue } return v } %>":                                                                                              RenderLoops30,
+	"<%= for (i,v) in [1, 2, 3] { if (v == 2) { break } return v } %>":                                                                                                 RenderLoops31,
+	"<%= for (i,v) in [1, 2, 3, 4] {\n\t\t%>Start<%\n\t\tif (v == 1 || v == 3) {\n\t\t\t%>Odd<%\n\t\t\tcontinue\n\t\t}\n\t\treturn v\n\t} %>":                          RenderLoops32,
+	"<%= for (i,v) in [1, 2, 3] {\n\t\tcontinue\n\t\treturn v\n\t} %>":                                                                                                 RenderLoops33,
+	"<%= for (i,v) in [1, 2, 3, 4] {\n\t\t%>Start<%\n\t\tif (v == 3) {\n\t\t\t%>Stop<%\n\t\t\tbreak\n\t\t}\n\t\treturn v\n\t} %>":                                      RenderLoops34,
+	"<%= for (i,v) in [1, 2, 3] {\n\t\tif (v == 1) {\n\t\t\t%><%=v%><%\n\t\t\tbreak\n\t\t}\n\t\treturn v\n\t} %>":                                                      RenderLoops35,
+	"<%= for (k,v) in myMap { %><%= k + \":\" + v%><% } %>":                                                                                                            RenderLoops36,
+	"<%= for (i,row) in rows { %><%= for (j,col) in row { %><%= i %>,<%= j %>:<%= col %>;<% } %><% } %>":                                                               RenderLoops37,
+	"<%= for (k, messages) in flash { %><%= for (msg) in messages { %><%= if (len(messages) && messages[0] != \"skip\") { %><%= k %>:<%= msg %>;<% } %><% } %><% } %>": RenderLoops38,
+	"<%= for (v) in range(3,5) { %><%=v%><% } %>|<%= for (v) in between(3,6) { %><%=v%><% } %>|<%= for (v) in until(3) { %><%=v%><% } %>":                              RenderLoops39,
+	"<%= for (i,v) in nil { return v } %>":                             RenderLoops40,
+	"<%= for (i,v) in nilValue { return v } %>":                        RenderLoops41,
+	"<%= for (k, v) in flash[\"errors\"] { %><%= k %>:<%= v %><% } %>": RenderLoops42,
+	"<%= if (!userSignedIn) { %>Guest<% } else { %>User<% } %><%= for (item) in menu.Items { %><%= item.Name + \" x \" + item.Count %>;<% } %>": RenderLoops43,
+	"<%= for (i,v) in [\"a\"] { %><%= i %>:<%= v %><% } %><%= i %>":                                                                             RenderLoops44,
+	"<%= for (i,v) in [\"a\"] { %><%= i %>:<%= v %><% } %><%= v %>":                                                                             RenderLoops45,
+	"<%= 1 + 3 %>":                                   RenderMath1,
+	"<%= 3 - 1 %>":                                   RenderMath2,
+	"<%= 10 / 2 %>":                                  RenderMath3,
+	"<%= 10 * 2 %>":                                  RenderMath4,
+	"<%= 10 > 2 %>":                                  RenderMath5,
+	"<%= 10 >= 2 %>":                                 RenderMath6,
+	"<%= 10 >= 10 %>":                                RenderMath7,
+	"<%= 2 <= 2 %>":                                  RenderMath8,
+	"<%= 10 < 2 %>":                                  RenderMath9,
+	"<%= 10 <= 2 %>":                                 RenderMath10,
+	"<%= 2 == 2 %>":                                  RenderMath11,
+	"<%= 1 != 2 %>":                                  RenderMath12,
+	"<%= 1.0 + 3.0 %>":                               RenderMath13,
+	"<%= 3.0 - 1.0 %>":                               RenderMath14,
+	"<%= 10.0 / 2.0 %>":                              RenderMath15,
+	"<%= 10.0 * 2.0 %>":                              RenderMath16,
+	"<%= 10.0 > 2.0 %>":                              RenderMath17,
+	"<%= 10.0 >= 2.0 %>":                             RenderMath18,
+	"<%= 10.0 >= 10.0 %>":                            RenderMath19,
+	"<%= 2.0 <= 2.0 %>":                              RenderMath20,
+	"<%= 10.0 < 2.0 %>":                              RenderMath21,
+	"<%= 10.0 <= 2.0 %>":                             RenderMath22,
+	"<%= 2.0 == 2.0 %>":                              RenderMath23,
+	"<%= 1.0 != 2.0 %>":                              RenderMath24,
+	"<%= \"a\" + \"b\" %>":                           RenderMath25,
+	"<%= \"a\" + \"b\" + \"c\" %>":                   RenderMath26,
+	"<%= \"a\" != \"b\" %>":                          RenderMath27,
+	"<%= \"a\" == \"a\" %>":                          RenderMath28,
+	"<%= \"a\" == \"b\" %>":                          RenderMath29,
+	"<%= \"a\" > \"b\" %>":                           RenderMath30,
+	"<%= \"a\" >= \"b\" %>":                          RenderMath31,
+	"<%= \"a\" <= \"b\" %>":                          RenderMath32,
+	"<%= undefined == 3 %>":                          RenderMath33,
+	"<%= undefined != 3 %>":                          RenderMath34,
+	"<%= 3 == unknown %>":                            RenderMath35,
+	"<%= 3 != unknown %>":                            RenderMath36,
+	"<%= undefined + 3 %>":                           RenderMath37,
+	"<%= 3 + unknown %>":                             RenderMath38,
+	"<%= undefined > 3 %>":                           RenderMath39,
+	"<%= \"a\" + 1 %>":                               RenderMath40,
+	"<%= true + 1 %>":                                RenderMath41,
+	"<%= 10 / 0 %>":                                  RenderMath42,
+	"<%= 10.5 / 0.0 %>":                              RenderMath43,
+	"<%= i32 %> <%= u32 %> <%= i8 %>":                RenderMath44,
+	"<%= partial(\"hello.plush\") %>":                RenderPartials1,
+	"<%= partial(\"partials/code-1.plush.html\") %>": RenderPartials2,
+	"<%= partial(\"card\", {name: \"Mido\", layout: \"shell\"}) %>":                                                                                        RenderPartials3,
+	"<%= partial(\"count\") %><%= number %>":                                                                                                               RenderPartials4,
+	"<% let collected = [] %><%= partial(\"tree.plush.html\", {nodes: nodes}) %><%= json_encode(collected) %>":                                             RenderPartials5,
+	"<%= partial(\"row\", {name: first, title: robot.Name}) %>|<%= partial(\"row\", {name: second, title: \"literal\"}) %>|<%= name %>":                    RenderPartials6,
+	"<%= partial(\"row\", {label: label(product.Name, prefix)}) %>|<%= product.Name %>":                                                                    RenderPartials7,
+	"<%= if (currentRoute.PathName == \"target\") { %><% let lookup = {} %><%= partial(\"partials/lookup-file.html\") %><%= lookup[\"primary\"] %><% } %>": RenderPartials8,
+	"<%= partial(\"index.html\") %>|<%= partial(\"index.js\") %>|<%= partial(\"index\") %>":                                                                RenderPartials9,
+	"<%= partial(\"js_having_html_partial.js\") %>|<%= partial(\"js_having_js_partial.js\") %>":                                                            RenderPartials10,
+	"<%= partial(\"index\") %>":                      RenderPartials11,
+	"<%= partial(\"missing\") %>":                    RenderPartials12,
+	"<%= partial(\"bad\") %>":                        RenderPartials13,
+	"<%= partial(\"child.plush.html\") %>":           RenderPartials14,
+	"<%= partial(\"body\", {layout: \"layout\"}) %>": RenderPartials15,
+	"<% let collected = [] %><%= partial(\"level-one.plush.html\", {nodes: nodes}) %><%= json_encode(collected) %>":                                                                                                                                                                           RenderRecursivePartials1,
+	"<% let collected = [\"main\"] %><%= partial(\"append.plush.html\", {label: \"inherited\"}) %>|<%= partial(\"append.plush.html\", {label: \"local\", collected: []}) %>|<%= json_encode(collected) %>":                                                                                    RenderRecursivePartials2,
+	"<% let collected = [] %><%= partial(\"control-tree.plush.html\", {nodes: nodes}) %><%= json_encode(collected) %>":                                                                                                                                                                        RenderRecursivePartials3,
+	"<% let collected = {} %><%= partial(\"indexed-tree.plush.html\", {nodes: nodes}) %><%= json_encode(collected) %>":                                                                                                                                                                        RenderRecursivePartials4,
+	"<% let collected = [] %><% let count = 0 %><% let lookup = {} %><%= partial(\"multi-tree.plush.html\", {nodes: nodes}) %><%= json_encode(collected) %>|<%= count %>|<%= lookup[\"enter:root\"] %>|<%= lookup[\"enter:tip\"] %>|<%= lookup[\"exit:tip\"] %>|<%= lookup[\"exit:root\"] %>": RenderRecursivePartials5,
+	"<% let collected = [] %><% let calls = 0 %><%= partial(\"children-tree.plush.html\", {nodes: nodes}) %><%= json_encode(collected) %>|<%= calls %>":                                                                                                                                       RenderRecursivePartials6,
+	"<% let collected = [] %><%= scope() { %><%= partial(\"helper-tree.plush.html\", {nodes: nodes}) %><%= helperLabel %>:<%= json_encode(collected) %><% } %>|<%= json_encode(collected) %>":                                                                                                 RenderRecursivePartials7,
+	"<p>Hi</p>":              RenderRootTests1,
+	"<p><%= \"mark\" %></p>": RenderRootTests2,
+	"<p><%= name %></p>":     RenderRootTests3,
+	"<% let add = fn(x) { return x + 2; }; %><%= add(2) %>": RenderRootTests4,
+	"<%= foo(1, 2, 3) %>":   RenderRootTests5,
+	"<%= foo(\"hello\") %>": RenderRootTests6,
+	"<%= foo() %>":          RenderRootTests7,
+	"<%= foo(1) %>":         RenderRootTests8,
+	"<%= m[\"first\"]%>":    RenderRootTests9,
+	"<%= m[\"first\"] + \" \" + m[\"last\"] %>|<%= a[0+1] %>": RenderRootTests10,
+	"<%= m[\"a\"] %>":            RenderRootTests11,
+	"<%= m.MyMap[key] %>":        RenderRootTests12,
+	"<%= debug(m.MyMap[key]) %>": RenderRootTests13,
+	"<%= if (true) { return \"good\"} else { return \"bad\"} %>":          RenderRootTests14,
+	"<%= if (false) { return \"good\"} else { return \"bad\"} %>":         RenderRootTests15,
+	"<%  if (true) { return \"good\"} else { return \"bad\"} %>":          RenderRootTests16,
+	"<%= if (true) { %>good<% } %>":                                       RenderRootTests17,
+	"<%= if (false) { return \"good\"} %>":                                RenderRootTests18,
+	"<%= if (!false) { return \"good\"} %>":                               RenderRootTests19,
+	"<%= if (true == true) { return \"good\"} else { return \"bad\"} %>":  RenderRootTests20,
+	"<%= if (true != true) { return \"good\"} else { return \"bad\"} %>":  RenderRootTests21,
+	"<% let test = true %><%= if (test == false) { return \"good\"} %>":   RenderRootTests22,
+	"<% let test = true %><%= if (test == true) { return \"good\"} %>":    RenderRootTests23,
+	"<% let test = true %><%= if (test != true) { return \"good\"} %>":    RenderRootTests24,
+	"<% let test = 1 %><%= if (test == 1) { return \"good\"} %>":          RenderRootTests25,
+	"<%= if (false && true) { %>good<% } %>":                              RenderRootTests26,
+	"<%= if (2 == 2 && 1 == 1) { %>good<% } %>":                           RenderRootTests27,
+	"<%= if (false || true) { %>good<% } %>":                              RenderRootTests28,
+	"<%= if (1 == 2 || 2 == 1) { %>good<% } %>":                           RenderRootTests29,
+	"<%= if (names && len(names) >= 1) { %>good<% } %>":                   RenderRootTests30,
+	"<%= if (names && len(names) >= 1) { %>good<% } else { %>else<% } %>": RenderRootTests31,
+	"<%= if (names) { %>good<% } %>":                                      RenderRootTests32,
+	"<%= if (!names) { %>good<% } %>":                                     RenderRootTests33,
+	"<%= if (1 == 2) { %>good<% } %>":                                     RenderRootTests34,
+	"<%= if (1 != 2) { %>good<% } %>":                                     RenderRootTests35,
+	"<%= if (1 < 2) { %>good<% } %>":                                      RenderRootTests36,
+	"<%= if (1 <= 2) { %>good<% } %>":                                     RenderRootTests37,
+	"<%= if (1 > 2) { %>good<% } %>":                                      RenderRootTests38,
+	"<%= if (1 >= 2) { %>good<% } %>":                                     RenderRootTests39,
+	"<%= if (\"foo\" ~= \"bar\") { %>good<% } %>":                         RenderRootTests40,
+	"<%= if (\"foo\" ~= \"^fo\") { %>good<% } %>":                         RenderRootTests41,
+	"<%= !pages %>":                                 RenderRootTests42,
+	"<%= paths || pages == \"cart\" %>":             RenderRootTests43,
+	"<%= pages == \"cart\" || paths %>":             RenderRootTests44,
+	"<%= paths == \"cart\" || pages %>":             RenderRootTests45,
+	"<%= pages || paths == \"cart\" %>":             RenderRootTests46,
+	"<%= paths == \"cart\" || pages == \"cart\" %>": RenderRootTests47,
+	"<%= pages == \"cart\" || paths == \"cart\" %>": RenderRootTests48,
+	"<%= pages && paths %>":                         RenderRootTests49,
+	"<%= paths && pages == \"cart\" %>":             RenderRootTests50,
+	"<%= pages == \"cart\" && paths %>":             RenderRootTests51,
+	"<%= paths == \"cart\" && pages %>":             RenderRootTests52,
+	"<%= pages && paths == \"cart\" %>":             RenderRootTests53,
+	"<%= paths == \"cart\" && pages == \"cart\" %>": RenderRootTests54,
+	"<%= pages == \"cart\" && paths == \"cart\" %>": RenderRootTests55,
+	"<p><%= if (state == \"foo\") { %>hi foo<% } else if (state == \"bar\") { %>hi bar<% } else if (state == \"fizz\") { %>hi fizz<% } else { %>hi buzz<% } %></p>":                                                                                                                            RenderRootTests56,
+	"<p><%= if (username && username != \"\") { return \"hi\" } else { return \"bye\" } %></p>":                                                                                                                                                                                                RenderRootTests57,
+	"<p><%= if (username && username != \"\") { username = \"hi\" } else { username= \"bye\" } %><%= username %></p>":                                                                                                                                                                          RenderRootTests58,
+	"<p><% let username = \"Hello World\" %><%= if (username && username != \"\") {\n\t\tlet username = \"hi\"\n\t\tusername = \"hi\"\n\t} else {\n\t\tlet username = \"bye\"\n\t\tusername = \"1\"\n\t }%><%= username %></p>":                                                                RenderRootTests59,
+	"<p><% let username = \"Hello World\" %><%= if (username && username != \"\") {\n\t\tlet username = \"hi\"\n\t\tusername = \"hi\"\n\t\tif (username == \"hi\"){\n\t\t\tusername = \"hi2\"\n\t\t}\n\t} else {\n\t\tlet username = \"bye\"\n\t\tusername = \"1\"\n\t }%><%= username %></p>": RenderRootTests60,
+	"<p><% let username = \"Hello World\" %><%= if (username && username != \"\") {\n\t\tusername = \"hi\"\n\t\tif (username == \"hi\"){\n\t\t\tusername = \"hi2\"\n\t\t}\n\t} else {\n\t\tlet username = \"bye\"\n\t\tusername = \"1\"\n\t }%><%= username %></p>":                            RenderRootTests61,
+	"<%= if ( paths == \"cart\" || (page && page.PageTitle != \"cafe\") || paths == \"cart\") { %>hi<%} %>":                                                                                                                                                                                    RenderRootTests62,
+	"<% let foo = \"bar\" %>\n  <%= for (a) in myArray { %>\n<%= foo %>\n    <% if (foo != \"baz\") { %>\n      <% foo = \"baz\" %>\n    <% } %>\n  <% } %>\n<% } %>":                                                                                                                          RenderRootTests63,
+	"<p><% let h = {\"a\": \"A\"} %><%= h[\"a\"] %></p>":                                            RenderRootTests64,
+	"<p><% let h = {\"a\": \"A\"} %><% h[\"a\"] = \"C\" %><%= h[\"a\"] %></p>":                      RenderRootTests65,
+	"<p><% let h = {\"a\": \"A\"} %><% h[\"b\"] = \"D\" %><%= h[\"b\"] %></p>":                      RenderRootTests66,
+	"<p><% let h = {\"a\": \"A\"} %><% h[\"b\"] = 3 %><%= h[\"b\"] %></p>":                          RenderRootTests67,
+	"<p><% let h = {\"a\": \"A\"} %><% h[\"b\"] = 3 %><%= h[\"c\"] %></p>":                          RenderRootTests68,
+	"<p><% let a = [1, 2, \"three\", \"four\", 3.75] %><% a[0] = 3 %><%= a[0] %></p>":               RenderRootTests69,
+	"<p><% let a = [1, 2, \"three\", \"four\", 3.75] %><% a[4] = 3 %><%= a[4] + 2 %></p>":           RenderRootTests70,
+	"<% let a = [1,\"22\",33] %><% a = a + 1 %><%= a %>":                                            RenderRootTests71,
+	"<% let a = [1,2,\"HelloWorld\"] %><% a = a + 2.2 %><%= a %>":                                   RenderRootTests72,
+	"<p><% let a = [1, 2, \"three\", \"four\", 3.75] %><% a[\"b\"] = 3 %><%= a[\"c\"] %></p>":       RenderRootTests73,
+	"<p><% let a = [1, 2, \"three\", \"four\", 3.75] %><% a[5] = 3 %><%= a[4] + 2 %></p>":           RenderRootTests74,
+	"<p><% let a = [1, 2, \"three\", \"four\", 3.75] %><%= a[5] %></p>":                             RenderRootTests75,
+	"<p><% let a = myArray %></p><% a[0] = \"HELLO WORLD\" %>":                                      RenderRootTests76,
+	"<% let a = myArray %><% a = a + 1 %><%= a %>":                                                  RenderRootTests77,
+	"<p><%= f(\"mark\") %></p>":                                                                     RenderRootTests78,
+	"<p><%= f(name) %></p>":                                                                         RenderRootTests79,
+	"<p><%= f({name: name}) %></p>":                                                                 RenderRootTests80,
+	"<p><%= f() { %>hello<% } %></p>":                                                               RenderRootTests82,
+	"<p><% let i = \"hello-world\" %><%= f() { i = \"bye\" } %><%= i %></p>":                        RenderRootTests83,
+	"<p><%= f() { let i = \"hello-world\" } %><%= i %></p>":                                         RenderRootTests84,
+	"<%= foo() {return bar + name} %>":                                                              RenderRootTests85,
+	"<%= foo({name: \"mark\"}) {return bar + name} %>":                                              RenderRootTests86,
+	"<% for (i,v) in [\"a\", \"b\", \"c\"] {return v} %>":                                           RenderRootTests87,
+	"<% let varTest = \"\" %><% for (i,v) in [\"a\", \"b\", \"c\"] {varTest =  v} %><%= varTest %>": RenderRootTests88,
+	"<%= for (k,v) in myMap { %><%= k + \":\" + v%><% } %> <%= k %>":                                RenderRootTests89,
+	"<%= for (k,v) in myMap { %><%= k + \":\" + v%><% } %> <%= v %>":                                RenderRootTests90,
+	"<%= for (i,v) in [\"a\", \"b\", \"c\"] {return v} %>":                                          RenderRootTests91,
+	"<%= for (i,v) in [1, 2, 3,4,5,6,7,8,9,10] {\n\t\tcontinue\n\t\treturn v\n\t\t} %>":             RenderRootTests92,
+	"<%= for (i,v) in [1, 2, 3,4,5,6,7,8,9,10] {\n\t\tbreak\n\t\treturn v\n\t\t} %>":                RenderRootTests93,
+	"<%= for (v) in range(3,5) { %><%=v%><% } %>":                                                   RenderRootTests94,
+	"<%= for (v) in between(3,6) { %><%=v%><% } %>":                                                 RenderRootTests95,
+	"<%= for (v) in until(3) { %><%=v%><% } %>":                                                     RenderRootTests96,
+	"<%   let i = 10000 %><%= for (i,v) in [\"a\", \"b\", \"c\"] {%><%=i%><%=v%><%} %><%= i %>":     RenderRootTests97,
+	"<%= for (i,v) in [\"a\", \"b\", \"c\"] {%><%=i%><%=v%><%} %><%= i %>":                          RenderRootTests98,
+	" <%= for (i,v) in [\"a\", \"b\", \"c\"] {%><%=i%><%=v%><%} %><%= v %>":                         RenderRootTests99,
+	"<%\n\t\tlet numberify = fn(arg) {\n\t\t\tif (arg == \"one\") {\n\t\t\t\treturn 1+1;\n\t\t\t}\n\t\t\tif (arg == \"two\") {\n\t\t\t\treturn 44;\n\t\t\t}\n\t\t\tif (arg == \"three\") {\n\t\t\t\treturn 2;\n\t\t\t}\n\t\t\treturn \"unsupported\"\n\t\t} %>\n\t\t<%= numberify(\"one\") %>":  RenderRootTests100,
+	"<%\n\t\tlet numberify = fn(arg) {\n\t\t\tif (arg == \"one\") {\n\t\t\t\treturn 1;\n\t\t\t}\n\t\t\tif (arg == \"two\") {\n\t\t\t\treturn 445;\n\t\t\t}\n\t\t\tif (arg == \"three\") {\n\t\t\t\treturn 3;\n\t\t\t}\n\t\t\treturn \"unsupported\"\n\t\t} %>\n\t\t<%= numberify(\"two\") %>":   RenderRootTests101,
+	"<%\n\t\tlet numberify = fn(arg) {\n\t\t\tif (arg == \"one\") {\n\t\t\t\treturn 1;\n\t\t\t}\n\t\t\tif (arg == \"two\") {\n\t\t\t\treturn 445;\n\t\t\t}\n\t\t\tif (arg == \"three\") {\n\t\t\t\treturn 3;\n\t\t\t}\n\t\t\treturn \"default value\"\n\t\t} %>\n\t\t<%= numberify(\"six\") %>": RenderRootTests102,
+	"Current number is <%= p.N %>.<%= if (p.Next) { %>  Next up is <%= p.Next.N %>.<% } %>": RenderRootTests103,
+	"<%= user.Name %>: <%= user.Image %>":                                                   RenderRootTests104,
+	"<%= nour.GetBorn().Format(\"Jan 2, 2006\") %>":                                         RenderRootTests105,
+	"<%= raw(`CREATE MATERIALIZED VIEW view_papers AS\n\tSELECT papers.created_at,\n\t   papers.updated_at,\n\t   papers.id,\n\t   papers.name,\n\t   (   setweight(to_tsvector(papers.name::text), 'A'::\"char\") ||\n\t\t   setweight(to_tsvector(papers.author_name), 'B'::\"char\")\n\t   ) || setweight(to_tsvector(papers.description), 'C'::\"char\")\n\t   AS paper_vector\n\t  FROM\n\t  ( SELECT papers.id, string_agg(categories.code, ',') as categories\n\t   FROM papers\n\t   LEFT JOIN paper_categories ON paper_categories.paper_id=papers.id LEFT JOIN (select * from categories order by weight asc) categories ON categories.id=paper_categories.category_id\n\t   GROUP BY papers.id\n\t  ) a\n\t  LEFT JOIN papers on a.id=papers.id\n\t WHERE (papers.doc_status = ANY (ARRAY[1, 3])) AND papers.status = 1\n   WITH DATA`) %>": RenderRootTests109,
+	"<%= robot.Name %>":                                RenderStructAccess1,
+	"<%= robot.Name.Echo() %>":                         RenderStructAccess2,
+	"<%= robots[0].Stats[1].Label %>":                  RenderStructAccess3,
+	"<%= robot.Avatar.URL() %>":                        RenderStructAccess4,
+	"<%= lookup[\"bad\"] %>":                           RenderStructAccess5,
+	"<%= items[\"bad\"].Name %>":                       RenderStructAccess6,
+	"<%= items[2].Name %>":                             RenderStructAccess7,
+	"<%= departments[\"HR\"].employee[0].FirstName %>": RenderStructAccess8,
+	"<%= robot.Missing %>":                             RenderStructAccess9,
+	"<%= robot.Missing() %>":                           RenderStructAccess10,
+	"<%= robot.Name() %>":                              RenderStructAccess11,
+	"<%= robot.Name.Missing() %>":                      RenderStructAccess12,
+	"<%= robot.GetFriends()[9].Name %>":                RenderStructAccess13,
+	"<%= if (enabled) { %>yes<% } else { %>no<% } %>":  RenderSyntax1,
+	"<%= if (first) { %>first<% } else if (second) { %>second<% } else { %>none<% } %>": RenderSyntax2,
+	"<%= first %>|<%= if (first) { %>yes<% } else { %>no<% } %>":                        RenderSyntax3,
+	"\n\t\t<%# this is a comment %>\n\t\tHi\n\t\t":                                      RenderSyntax4,
+	"\n\t\t<% <%# this is a comment %> %>\n\t\tHi\n\t\t":                                RenderSyntax5,
+	"\n\t\t<%# this is\n\t\ta block comment %>\n\t\tHi\n\t\t":                           RenderSyntax6,
+	"\n\t\t<% <%# this is\n\t\ta block comment %> %>\n\t\tHi":                           RenderSyntax7,
+	"<%= if (\"foo\" ~= \"^fo\") { %>good<% } else { %>bad<% } %>":                      RenderSyntax8,
+	"<p>こんにちは</p><%= let 名前 = \"太郎\" %><%= 名前 %>さん":                                     RenderUnicode1,
+	"<% let größe = 3 %><%= größe * 2 %> Straße":                                        RenderUnicode2,
+	"<%= if (ñandú == \"sí\") { %>¡sí!<% } else { %>no<% } %>":                          RenderUnicode3,
+	"<ul><%= for (i, 値) in 一覧 { %><li><%= i %>: <%= 値 %></li><% } %></ul>":              RenderUnicode4,
+	"<% let grüße = fn(wer) { return \"Grüß \" + wer } %><%= grüße(\"Gott\") %>":        RenderUnicode5,
+	"<% # コメント\n%>Ende: <%= ñandú %>":                                                   RenderUnicode6,
+	"<%= \"Ünïcödé \\\"quoted\\\" 文字列\" %>":                                             RenderUnicode7,
+	"<% let message = \"hello\" %><% message = \"bye\" %><%= message %>":                RenderVariables1,
+	"<% let value = \"fallback\"; value = record.Fields[\"shade\"]; %><%= value %>":     RenderVariables2,
+	"<%= partial(\"details.plush.html\") %>":                                            RenderVariables3,
+	"<%= partial(\"details.plush.html\", {record: record}) %>":                          RenderVariables4,
+	"<% let message = greet(name) %><%= wrap(message) %>|<%= message %>":                RenderVariables5,
+	"<% foo = \"baz\" %>":                   RenderVariables6,
+	"<%= my123greet %> <%= name3 %>":        RenderVariables7,
+	"<%= for (n) in myvar1 { return n } %>": RenderVariables8,
+	"<% let h = {\"name\": \"mark\"} %><% let a = [\"m\", \"e\"] %><%= h[\"name\"] %><%= a[1] %>":    RenderVariables9,
+	"<p><% let h = {\"a\": \"A\"} %><% h[\"a\"] = \"C\" %><%= h[\"a\"] %>:<%= h[\"missing\"] %></p>": RenderVariables10,
+	"<p><% let a = [1, 2, \"three\"] %><% a[0] = 3 %><%= a[0] %></p>":                                RenderVariables11,
+	"<% let a = [1, \"two\"] %><% a = a + 3 %><%= a %>":                                              RenderVariables12,
+	"<% let a = myArray %><% a[0] = \"HELLO WORLD\" %>":                                              RenderVariables13,
+	"<% let a = myArray %><% a[0] = \"HELLO WORLD\" %><%= a %>":                                      RenderVariables14,
+	"<% let a = myInts %><% a = a + 3 %><%= a[2] %>":                                                 RenderVariables15,
+	"<% let a = myStrings %><% a = a + \"c\" %><%= a[2] %>":                                          RenderVariables16,
+	"<% let a = product_listing.Products[0].Name[0] %><%= a %>":                                      RenderVariables17,
+}
+
+// Rejected maps the source of each parity template plush gen refused to
+// the error it returned.
+var Rejected = map[string]string{
+	"<%= if true %>bad<% } %>":                                     "line 1: expected next token to be {, got %> instead",
+	"<%= foo(\"unterminated) %>":                                   "line 1: expected next token to be ), got EOF instead",
+	"<%= if { %>bad<% } %>":                                        "line 1: syntax error: missing if condition\nline 1: expected next token to be :, got HTML instead",
+	"<p><%= f({name: name) %></p>":                                 "line 1: expected next token to be ,, got ) instead",
+	"<%= foo(\"asdf) %>":                                           "line 1: expected next token to be ), got EOF instead",
+	"<%= foo(\"test) %>\".":                                        "line 1: expected next token to be ), got EOF instead",
+	"<%= title(\"Running Migrations) %>(default \"./migrations\")": "line 1: expected next token to be ), got DOT instead\nline 1: no prefix parse function for / found\nline 1: syntax error: invalid callee assignment with nil values",
+}
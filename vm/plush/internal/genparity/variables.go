@@ -0,0 +1,326 @@
+// Code generated by go test ./vm/plush -update-gen. DO NOT EDIT.
+
+package genparity
+
+import (
+	"bytes"
+	"github.com/gobuffalo/plush/v5/helpers/hctx"
+	vmplush "github.com/gobuffalo/plush/v5/vm/plush"
+	"html/template"
+	"io"
+	"sync"
+)
+
+// renderVariables1Source is the template RenderVariables1 was generated from.
+const renderVariables1Source = "<% let message = \"hello\" %><% message = \"bye\" %><%= message %>"
+
+// RenderVariables1 renders parity_variables_test.go to w.
+//
+// The template is rendered by the VM: it declares variables with let.
+func RenderVariables1(w io.Writer, ctx hctx.Context) error {
+	out, err := vmplush.Render(renderVariables1Source, ctx)
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderVariables2Source is the template RenderVariables2 was generated from.
+const renderVariables2Source = "<% let value = \"fallback\"; value = record.Fields[\"shade\"]; %><%= value %>"
+
+// RenderVariables2 renders parity_variables_test.go to w.
+//
+// The template is rendered by the VM: it declares variables with let.
+func RenderVariables2(w io.Writer, ctx hctx.Context) error {
+	out, err := vmplush.Render(renderVariables2Source, ctx)
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderVariables3Source is the template RenderVariables3 was generated from.
+const renderVariables3Source = "<%= partial(\"details.plush.html\") %>"
+
+// renderVariables3Fragment0 compiles the statement on line 1 for the VM.
+var renderVariables3Fragment0 = sync.OnceValues(func() (*vmplush.Template, error) {
+	return vmplush.CompileAt("<%= partial(\"details.plush.html\") %>", 1)
+})
+
+// RenderVariables3 renders parity_variables_test.go to w.
+func RenderVariables3(w io.Writer, ctx hctx.Context) error {
+	out, ok, err := renderVariables3Static(ctx)
+	if !ok {
+		out, err = vmplush.Render(renderVariables3Source, ctx)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderVariables3Static renders the template with the declared types. It reports false
+// when the context does not hold them or holds nil pointers.
+func renderVariables3Static(ctx hctx.Context) (string, bool, error) {
+	var b bytes.Buffer
+	if t, err := renderVariables3Fragment0(); err != nil {
+		return "", true, err
+	} else if s, err := t.Render(ctx); err != nil {
+		return "", true, err
+	} else {
+		b.WriteString(s)
+	}
+	return b.String(), true, nil
+}
+
+// renderVariables4Source is the template RenderVariables4 was generated from.
+const renderVariables4Source = "<%= partial(\"details.plush.html\", {record: record}) %>"
+
+// renderVariables4Fragment0 compiles the statement on line 1 for the VM.
+var renderVariables4Fragment0 = sync.OnceValues(func() (*vmplush.Template, error) {
+	return vmplush.CompileAt("<%= partial(\"details.plush.html\", {record: record}) %>", 1)
+})
+
+// RenderVariables4 renders parity_variables_test.go to w.
+func RenderVariables4(w io.Writer, ctx hctx.Context) error {
+	out, ok, err := renderVariables4Static(ctx)
+	if !ok {
+		out, err = vmplush.Render(renderVariables4Source, ctx)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderVariables4Static renders the template with the declared types. It reports false
+// when the context does not hold them or holds nil pointers.
+func renderVariables4Static(ctx hctx.Context) (string, bool, error) {
+	var b bytes.Buffer
+	if t, err := renderVariables4Fragment0(); err != nil {
+		return "", true, err
+	} else if s, err := t.Render(ctx); err != nil {
+		return "", true, err
+	} else {
+		b.WriteString(s)
+	}
+	return b.String(), true, nil
+}
+
+// renderVariables5Source is the template RenderVariables5 was generated from.
+const renderVariables5Source = "<% let message = greet(name) %><%= wrap(message) %>|<%= message %>"
+
+// RenderVariables5 renders parity_variables_test.go to w.
+//
+// The template is rendered by the VM: it declares variables with let.
+func RenderVariables5(w io.Writer, ctx hctx.Context) error {
+	out, err := vmplush.Render(renderVariables5Source, ctx)
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderVariables6Source is the template RenderVariables6 was generated from.
+const renderVariables6Source = "<% foo = \"baz\" %>"
+
+// RenderVariables6 renders parity_variables_test.go to w.
+//
+// The template is rendered by the VM: it assigns variables.
+func RenderVariables6(w io.Writer, ctx hctx.Context) error {
+	out, err := vmplush.Render(renderVariables6Source, ctx)
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderVariables7Source is the template RenderVariables7 was generated from.
+const renderVariables7Source = "<%= my123greet %> <%= name3 %>"
+
+// RenderVariables7 renders parity_variables_test.go to w.
+func RenderVariables7(w io.Writer, ctx hctx.Context) error {
+	out, ok, err := renderVariables7Static(ctx)
+	if !ok {
+		out, err = vmplush.Render(renderVariables7Source, ctx)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderVariables7Static renders the template with the declared types. It reports false
+// when the context does not hold them or holds nil pointers.
+func renderVariables7Static(ctx hctx.Context) (string, bool, error) {
+	v_my123greet, ok := ctx.Value("my123greet").(string)
+	if !ok {
+		return "", false, nil
+	}
+	v_name3, ok := ctx.Value("name3").(string)
+	if !ok {
+		return "", false, nil
+	}
+	var b bytes.Buffer
+	b.WriteString(template.HTMLEscapeString(v_my123greet))
+	b.WriteString(" ")
+	b.WriteString(template.HTMLEscapeString(v_name3))
+	return b.String(), true, nil
+}
+
+// renderVariables8Source is the template RenderVariables8 was generated from.
+const renderVariables8Source = "<%= for (n) in myvar1 { return n } %>"
+
+// RenderVariables8 renders parity_variables_test.go to w.
+//
+// The template is rendered by the VM: it returns early.
+func RenderVariables8(w io.Writer, ctx hctx.Context) error {
+	out, err := vmplush.Render(renderVariables8Source, ctx)
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderVariables9Source is the template RenderVariables9 was generated from.
+const renderVariables9Source = "<% let h = {\"name\": \"mark\"} %><% let a = [\"m\", \"e\"] %><%= h[\"name\"] %><%= a[1] %>"
+
+// RenderVariables9 renders parity_variables_test.go to w.
+//
+// The template is rendered by the VM: it declares variables with let.
+func RenderVariables9(w io.Writer, ctx hctx.Context) error {
+	out, err := vmplush.Render(renderVariables9Source, ctx)
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderVariables10Source is the template RenderVariables10 was generated from.
+const renderVariables10Source = "<p><% let h = {\"a\": \"A\"} %><% h[\"a\"] = \"C\" %><%= h[\"a\"] %>:<%= h[\"missing\"] %></p>"
+
+// RenderVariables10 renders parity_variables_test.go to w.
+//
+// The template is rendered by the VM: it declares variables with let.
+func RenderVariables10(w io.Writer, ctx hctx.Context) error {
+	out, err := vmplush.Render(renderVariables10Source, ctx)
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderVariables11Source is the template RenderVariables11 was generated from.
+const renderVariables11Source = "<p><% let a = [1, 2, \"three\"] %><% a[0] = 3 %><%= a[0] %></p>"
+
+// RenderVariables11 renders parity_variables_test.go to w.
+//
+// The template is rendered by the VM: it declares variables with let.
+func RenderVariables11(w io.Writer, ctx hctx.Context) error {
+	out, err := vmplush.Render(renderVariables11Source, ctx)
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderVariables12Source is the template RenderVariables12 was generated from.
+const renderVariables12Source = "<% let a = [1, \"two\"] %><% a = a + 3 %><%= a %>"
+
+// RenderVariables12 renders parity_variables_test.go to w.
+//
+// The template is rendered by the VM: it declares variables with let.
+func RenderVariables12(w io.Writer, ctx hctx.Context) error {
+	out, err := vmplush.Render(renderVariables12Source, ctx)
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderVariables13Source is the template RenderVariables13 was generated from.
+const renderVariables13Source = "<% let a = myArray %><% a[0] = \"HELLO WORLD\" %>"
+
+// RenderVariables13 renders parity_variables_test.go to w.
+//
+// The template is rendered by the VM: it declares variables with let.
+func RenderVariables13(w io.Writer, ctx hctx.Context) error {
+	out, err := vmplush.Render(renderVariables13Source, ctx)
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderVariables14Source is the template RenderVariables14 was generated from.
+const renderVariables14Source = "<% let a = myArray %><% a[0] = \"HELLO WORLD\" %><%= a %>"
+
+// RenderVariables14 renders parity_variables_test.go to w.
+//
+// The template is rendered by the VM: it declares variables with let.
+func RenderVariables14(w io.Writer, ctx hctx.Context) error {
+	out, err := vmplush.Render(renderVariables14Source, ctx)
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderVariables15Source is the template RenderVariables15 was generated from.
+const renderVariables15Source = "<% let a = myInts %><% a = a + 3 %><%= a[2] %>"
+
+// RenderVariables15 renders parity_variables_test.go to w.
+//
+// The template is rendered by the VM: it declares variables with let.
+func RenderVariables15(w io.Writer, ctx hctx.Context) error {
+	out, err := vmplush.Render(renderVariables15Source, ctx)
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderVariables16Source is the template RenderVariables16 was generated from.
+const renderVariables16Source = "<% let a = myStrings %><% a = a + \"c\" %><%= a[2] %>"
+
+// RenderVariables16 renders parity_variables_test.go to w.
+//
+// The template is rendered by the VM: it declares variables with let.
+func RenderVariables16(w io.Writer, ctx hctx.Context) error {
+	out, err := vmplush.Render(renderVariables16Source, ctx)
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// renderVariables17Source is the template RenderVariables17 was generated from.
+const renderVariables17Source = "<% let a = product_listing.Products[0].Name[0] %><%= a %>"
+
+// RenderVariables17 renders parity_variables_test.go to w.
+//
+// The template is rendered by the VM: it declares variables with let.
+func RenderVariables17(w io.Writer, ctx hctx.Context) error {
+	out, err := vmplush.Render(renderVariables17Source, ctx)
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
@@ -2,7 +2,9 @@ package plush_test
 
 import (
 	"errors"
+	"strings"
 	"testing"
+	"time"
 
 	rootplush "github.com/gobuffalo/plush/v5"
 	"github.com/gobuffalo/plush/v5/helpers/hctx"
@@ -186,3 +188,186 @@ func renderVMWithBudget(t *testing.T, input string, limit int64, costs rootplush
 	out, err := renderVMContext(t, input, ctx)
 	return out, err, budget.Stats()
 }
+
+func Test_VM_Budget_Max_Duration_Exceeded(t *testing.T) {
+	for _, input := range []string{
+		`<%= for (i, v) in rows { %><%= slow(i) %><% } %>`,
+		`<%= for (v) in users { %><%= slow(0) %><%= v.Name %><% } %>`,
+		`<% let f = fn(x) { return slow(x) } %><%= for (i, v) in rows { %><%= f(i) %><% } %>`,
+	} {
+		t.Run(input, func(t *testing.T) {
+			r := require.New(t)
+			var calls int
+			ctx := rootplush.NewContext()
+			ctx.Set("rows", make([]int, 100))
+			ctx.Set("users", make([]cancelRow, 100))
+			ctx.Set("slow", func(i int) int {
+				calls++
+				time.Sleep(10 * time.Millisecond)
+				return i
+			})
+			ctx.WithBudget(rootplush.NewTimeBudget(20 * time.Millisecond))
+
+			_, err := renderVMContext(t, input, ctx)
+			r.True(errors.Is(err, rootplush.ErrRenderTimeout), "got %v", err)
+			r.Less(calls, 10)
+		})
+	}
+}
+
+func Test_VM_Budget_Stats_Time_By_Category(t *testing.T) {
+	r := require.New(t)
+	b := rootplush.NewBudget(1000).WithTiming()
+	ctx := rootplush.NewContext()
+	ctx.Set("rows", []int{1, 2, 3})
+	ctx.Set("slow", func(i int) int {
+		time.Sleep(5 * time.Millisecond)
+		return i
+	})
+	ctx.WithBudget(b)
+
+	out, err := renderVMContext(t, `<%= for (i, v) in rows { %><%= slow(v) %><% } %>`, ctx)
+	r.NoError(err)
+	r.Equal("123", out)
+	s := b.Stats()
+	r.GreaterOrEqual(s.FunctionTime, 10*time.Millisecond)
+	r.Less(s.LoopTime, s.FunctionTime)
+}
+
+func Test_VM_Budget_Max_Output_Exceeded(t *testing.T) {
+	for _, input := range []string{
+		`<%= for (i, v) in rows { %>0123456789<% } %>`,
+		`<%= for (i, v) in rows { %><%= i %>:<%= v %>;<% } %>`,
+		`<%= for (v) in users { %><p><%= v.Name %></p><% } %>`,
+		`<% let f = fn(x) { return x } %><%= for (i, v) in rows { %><%= f(i) %>0123456789<% } %>`,
+		`<%= big %>`,
+		`<%= partial("big.html") %>`,
+	} {
+		t.Run(input, func(t *testing.T) {
+			r := require.New(t)
+			ctx := rootplush.NewContext()
+			ctx.Set("rows", make([]string, 1000))
+			ctx.Set("users", make([]cancelRow, 1000))
+			ctx.Set("big", strings.Repeat("x", 200))
+			ctx.Set("partialFeeder", func(string) (string, error) {
+				return `<%= big %>`, nil
+			})
+			ctx.WithBudget(rootplush.NewBudget(1_000_000).WithMaxOutput(100))
+
+			_, err := renderVMContext(t, input, ctx)
+			r.True(errors.Is(err, rootplush.ErrOutputLimitExceeded), "got %v", err)
+		})
+	}
+}
+
+func Test_VM_Budget_Max_Output_Within_Limit(t *testing.T) {
+	r := require.New(t)
+	ctx := rootplush.NewContext()
+	ctx.Set("rows", make([]string, 10))
+	ctx.WithBudget(rootplush.NewBudget(1_000).WithMaxOutput(100))
+
+	out, err := renderVMContext(t, `<%= for (i, v) in rows { %>0123456789<% } %>`, ctx)
+	r.NoError(err)
+	r.Len(out, 100)
+}
+
+func Test_Parity_Budget_Max_Call_Depth(t *testing.T) {
+	for _, input := range []string{
+		`<% let f = fn(n) { if (n > 0) { return f(n - 1) } return n } %><%= f(depth) %>`,
+		`<% let f = fn(n) { for (i) in [1] { if (n > 0) { return f(n - 1) } } return n } %><%= f(depth) %>`,
+		`<% let f = fn(n) { return f(n + 1) } %><%= f(0) %>`,
+	} {
+		for _, depth := range []int{4, 5} {
+			compareRender(t, input, func() hctx.Context {
+				ctx := rootplush.NewContextWith(map[string]interface{}{"depth": depth})
+				ctx.WithBudget(rootplush.NewBudget(1_000).WithMaxCallDepth(5))
+				return ctx
+			})
+		}
+	}
+}
+
+func Test_VM_Budget_Max_Call_Depth_Default(t *testing.T) {
+	r := require.New(t)
+	_, err := renderVMContext(t, `<% let f = fn(n) { return f(n + 1) } %><%= f(0) %>`, rootplush.NewContext())
+	r.True(errors.Is(err, rootplush.ErrCallDepthExceeded), "got %v", err)
+}
+
+func Test_Parity_Budget_Max_Call_Depth_Above_Frames(t *testing.T) {
+	for _, depth := range []int{2_500, 3_000} {
+		compareRender(t, `<% let f = fn(n) { if (n > 0) { return f(n - 1) } return "done" } %><%= f(depth) %>`, func() hctx.Context {
+			ctx := rootplush.NewContextWith(map[string]interface{}{"depth": depth})
+			ctx.WithBudget(rootplush.NewBudget(1_000_000).WithMaxCallDepth(3_000))
+			return ctx
+		})
+	}
+}
+
+func Test_Parity_Budget_Partial_Cycle(t *testing.T) {
+	for _, input := range []string{
+		`<%= partial("self.plush") %>`,
+		`<%= partial("a.plush") %>`,
+		`<%= partial("a.plush", {n: 1}) %>`,
+		`<%= partial("leaf.plush") %>`,
+		`<%= partial("up.plush") %>`,
+		`<%= partial("count.plush") %>`,
+	} {
+		compareRender(t, input, func() hctx.Context {
+			ctx := rootplush.NewContextWith(map[string]interface{}{
+				"n": 0,
+				"partialFeeder": func(name string) (string, error) {
+					switch name {
+					case "up.plush":
+						return `<%= partial("up.plush", {n: n + 1}) %>`, nil
+					case "count.plush":
+						return `<%= if (n < 3) { %><% let n = n + 1 %><%= partial("count.plush") %><%= n %><% } %>`, nil
+					case "a.plush":
+						return `<%= partial("b.plush", {n: 2}) %>`, nil
+					case "b.plush":
+						return "\n" + `<%= partial("a.plush") %>`, nil
+					case "leaf.plush":
+						return `leaf`, nil
+					}
+					return `<%= partial("self.plush") %>`, nil
+				},
+			})
+			ctx.WithBudget(rootplush.NewBudget(1_000).WithMaxPartialDepth(4))
+			return ctx
+		})
+	}
+}
+
+type parityListNode struct {
+	Name  string
+	Child *parityListNode
+}
+
+func Test_Parity_Budget_Partial_Recursive_Data(t *testing.T) {
+	input := `<%= partial("node.html", {"node": list}) %>`
+	factory := func() hctx.Context {
+		return rootplush.NewContextWith(map[string]interface{}{
+			"list": &parityListNode{Name: "a", Child: &parityListNode{Name: "b", Child: &parityListNode{Name: "c", Child: &parityListNode{Name: "d"}}}},
+			"partialFeeder": func(string) (string, error) {
+				return `<%= node.Name %>;<%= if (node.Child) { %><%= partial("node.html", {"node": node.Child}) %><% } %>`, nil
+			},
+		})
+	}
+	compareRender(t, input, factory)
+
+	out, err := renderVM(t, input, factory)
+	require.NoError(t, err)
+	require.Equal(t, "a;b;c;d;", out)
+}
+
+func Test_VM_Budget_Partial_Cycle(t *testing.T) {
+	r := require.New(t)
+	ctx := rootplush.NewContext()
+	ctx.Set("partialFeeder", func(string) (string, error) {
+		return `<%= partial("self.plush") %>`, nil
+	})
+
+	_, err := renderVMContext(t, `<%= partial("self.plush") %>`, ctx)
+	r.True(errors.Is(err, rootplush.ErrPartialCycle), "got %v", err)
+	r.True(errors.Is(err, rootplush.ErrPartialDepthExceeded), "got %v", err)
+	r.Contains(err.Error(), "partial cycle: self.plush -> self.plush: partial depth limit exceeded (100)")
+}
@@ -0,0 +1,251 @@
+package plush_test
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"go/types"
+	"os"
+	"path/filepath"
+	"reflect"
+	"runtime"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/gobuffalo/plush/v5/ast"
+	"github.com/gobuffalo/plush/v5/parser"
+	"github.com/gobuffalo/plush/v5/vm/gen"
+	"github.com/gobuffalo/plush/v5/vm/plush/internal/genparity"
+	"github.com/stretchr/testify/require"
+)
+
+var updateGen = flag.Bool("update-gen", false, "regenerate internal/genparity from the parity templates")
+
+const genParityDir = "internal/genparity"
+
+// genTemplate is a parity template recorded for code generation, with the
+// Go types of the context values it reads.
+type genTemplate struct {
+	group string
+	input string
+	types map[string]string
+}
+
+var genCorpus = struct {
+	sync.Mutex
+	templates map[string]*genTemplate
+	order     []string
+}{templates: map[string]*genTemplate{}}
+
+func TestMain(m *testing.M) {
+	flag.Parse()
+	code := m.Run()
+	if *updateGen && code == 0 {
+		if err := writeGenParity(); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			code = 1
+		}
+	}
+	os.Exit(code)
+}
+
+// compareGenerated renders input with the code plush gen generated for it
+// and requires the output and error the VM rendered. With -update-gen it
+// records input instead.
+func compareGenerated(t *testing.T, input string, factory contextFactory, vmOut string, vmErr error) {
+	t.Helper()
+
+	if *updateGen {
+		recordGenTemplate(input, factory)
+		return
+	}
+	render, ok := genparity.Templates[input]
+	if !ok {
+		if _, rejected := genparity.Rejected[input]; rejected {
+			require.Error(t, vmErr, "plush gen rejected a template the VM renders")
+			return
+		}
+		t.Fatalf("no code generated for %q; run go test ./vm/plush -update-gen", input)
+	}
+
+	var out bytes.Buffer
+	err := render(&out, factory())
+	require.Equalf(t, errorString(vmErr), errorString(err), "generated error mismatch\nvm:        %q\ngenerated: %q", errorString(vmErr), errorString(err))
+	if vmErr == nil {
+		require.Equalf(t, vmOut, out.String(), "generated output mismatch\nvm:        %q\ngenerated: %q", vmOut, out.String())
+	}
+}
+
+// recordGenTemplate records input under the parity test file it comes
+// from, declaring the context values of factory it reads that plush gen can
+// type.
+func recordGenTemplate(input string, factory contextFactory) {
+	group := "harness"
+	for skip := 2; ; skip++ {
+		_, file, _, ok := runtime.Caller(skip)
+		if !ok {
+			break
+		}
+		base := filepath.Base(file)
+		if strings.HasPrefix(base, "parity_") && base != "parity_harness_test.go" && base != "parity_gen_test.go" {
+			group = strings.TrimSuffix(strings.TrimPrefix(base, "parity_"), "_test.go")
+			break
+		}
+	}
+
+	genCorpus.Lock()
+	defer genCorpus.Unlock()
+	if _, ok := genCorpus.templates[input]; ok {
+		return
+	}
+	tmpl := &genTemplate{group: group, input: input, types: map[string]string{}}
+	if program, err := parser.Parse(input); err == nil {
+		ctx := factory()
+		ast.Inspect(program, func(n ast.Node) bool {
+			if id, ok := n.(*ast.Identifier); ok {
+				if expr, ok := goTypeExpr(reflect.TypeOf(ctx.Value(id.Value))); ok {
+					tmpl.types[id.Value] = expr
+				}
+			}
+			return true
+		})
+	}
+	genCorpus.templates[input] = tmpl
+	genCorpus.order = append(genCorpus.order, input)
+}
+
+// goTypeExpr returns the gen.TypeLoader expression of t, when t is built
+// from basic types and named types of packages other than tests.
+func goTypeExpr(t reflect.Type) (string, bool) {
+	if t == nil {
+		return "", false
+	}
+	if t.Name() != "" {
+		if t.PkgPath() == "" {
+			return t.Name(), t.Kind() != reflect.Interface
+		}
+		if strings.HasSuffix(t.PkgPath(), "_test") || t.PkgPath() == "main" {
+			return "", false
+		}
+		return t.PkgPath() + "." + t.Name(), true
+	}
+	switch t.Kind() {
+	case reflect.Pointer:
+		elem, ok := goTypeExpr(t.Elem())
+		return "*" + elem, ok
+	case reflect.Slice:
+		if t.Elem().Kind() == reflect.Interface && t.Elem().NumMethod() == 0 {
+			return "[]any", true
+		}
+		elem, ok := goTypeExpr(t.Elem())
+		return "[]" + elem, ok
+	}
+	return "", false
+}
+
+// writeGenParity generates the code of every recorded template into one
+// file per parity test file, and the registry of their render functions.
+func writeGenParity() error {
+	loader := gen.NewTypeLoader("")
+	groups := map[string][]*genTemplate{}
+	for _, input := range genCorpus.order {
+		tmpl := genCorpus.templates[input]
+		groups[tmpl.group] = append(groups[tmpl.group], tmpl)
+	}
+	names := make([]string, 0, len(groups))
+	for group := range groups {
+		names = append(names, group)
+	}
+	sort.Strings(names)
+
+	old, err := filepath.Glob(filepath.Join(genParityDir, "*.go"))
+	if err != nil {
+		return err
+	}
+	for _, f := range old {
+		if err := os.Remove(f); err != nil {
+			return err
+		}
+	}
+
+	registry := &bytes.Buffer{}
+	rejected := &bytes.Buffer{}
+	for _, group := range names {
+		imports := map[string]bool{}
+		body := &bytes.Buffer{}
+		for i, tmpl := range groups[group] {
+			decl := map[string]types.Type{}
+			for name, expr := range tmpl.types {
+				if typ, err := loader.Load(expr); err == nil {
+					decl[name] = typ
+				}
+			}
+			fn := fmt.Sprintf("Render%s%d", exportedName(group), i+1)
+			out, err := gen.Generate(tmpl.input, gen.Config{Package: "genparity", Func: fn, File: "parity_" + group + "_test.go", Types: decl})
+			if err != nil {
+				fmt.Fprintf(rejected, "%q: %q,\n", tmpl.input, err.Error())
+				continue
+			}
+			src := string(out)
+			start := strings.Index(src, "import (\n")
+			end := strings.Index(src[start:], "\n)\n") + start
+			for _, line := range strings.Split(src[start+len("import (\n"):end], "\n") {
+				if line = strings.TrimSpace(line); line != "" {
+					imports[line] = true
+				}
+			}
+			body.WriteString(src[end+len("\n)\n"):])
+			fmt.Fprintf(registry, "%q: %s,\n", tmpl.input, fn)
+		}
+		if body.Len() == 0 {
+			continue
+		}
+		if err := writeGenParityFile(group+".go", imports, body.String()); err != nil {
+			return err
+		}
+	}
+
+	src := "// Package genparity holds the code plush gen generates for the templates\n// of the VM parity tests.\npackage genparity\n\n" +
+		"// Templates maps the source of each parity template to its generated\n// render function.\n" +
+		"var Templates = map[string]func(io.Writer, hctx.Context) error{\n" + registry.String() + "}\n\n" +
+		"// Rejected maps the source of each parity template plush gen refused to\n// the error it returned.\n" +
+		"var Rejected = map[string]string{\n" + rejected.String() + "}\n"
+	return writeGenParityFile("templates.go", map[string]bool{`"io"`: true, `"github.com/gobuffalo/plush/v5/helpers/hctx"`: true}, src)
+}
+
+func writeGenParityFile(name string, imports map[string]bool, body string) error {
+	lines := make([]string, 0, len(imports))
+	for line := range imports {
+		lines = append(lines, line)
+	}
+	sort.Strings(lines)
+
+	src := "// Code generated by go test ./vm/plush -update-gen. DO NOT EDIT.\n\n"
+	if !strings.HasPrefix(body, "// Package") {
+		src += "package genparity\n"
+	}
+	imp := "\nimport (\n" + strings.Join(lines, "\n") + "\n)\n"
+	if i := strings.Index(body, "package genparity\n"); i >= 0 {
+		body = body[:i+len("package genparity\n")] + imp + body[i+len("package genparity\n"):]
+	} else {
+		src += imp
+	}
+	out, err := format.Source([]byte(src + body))
+	if err != nil {
+		return fmt.Errorf("%s: %w", name, err)
+	}
+	return os.WriteFile(filepath.Join(genParityDir, name), out, 0o644)
+}
+
+func exportedName(group string) string {
+	var sb strings.Builder
+	for _, part := range strings.Split(group, "_") {
+		if part != "" {
+			sb.WriteString(strings.ToUpper(part[:1]) + part[1:])
+		}
+	}
+	return sb.String()
+}
@@ -29,6 +29,7 @@ func compareRender(t *testing.T, input string, factory contextFactory) {
 
 	require.Equalf(t, errorString(interpreterErr), errorString(vmErr), "error mismatch\ninterpreter: %q\nvm:          %q", errorString(interpreterErr), errorString(vmErr))
 	require.Equalf(t, interpreterOut, vmOut, "output mismatch\ninterpreter: %q\nvm:          %q", interpreterOut, vmOut)
+	compareGenerated(t, input, factory, vmOut, vmErr)
 }
 
 func comparePlannedRender(t *testing.T, input string, factory contextFactory, expected string) {
@@ -47,6 +48,7 @@ func comparePlannedRender(t *testing.T, input string, factory contextFactory, ex
 	require.True(t, ok, "expected VM render diagnostics")
 	require.NotEqual(t, rootplush.RenderFastPathInterpreterFallback, diagnostics.FastPath, "template fell back to the interpreter: %s", diagnostics.FastReject)
 	require.Empty(t, diagnostics.FastReject)
+	compareGenerated(t, input, factory, vmOut, vmErr)
 }
 
 func compareRenderError(t *testing.T, input string, factory contextFactory) {
@@ -59,6 +61,7 @@ func compareRenderError(t *testing.T, input string, factory contextFactory) {
 	require.Error(t, vmErr, "expected VM error, got output %q", vmOut)
 	require.Equalf(t, interpreterOut, vmOut, "error output mismatch\ninterpreter: %q\nvm:          %q", interpreterOut, vmOut)
 	require.Equalf(t, interpreterErr.Error(), vmErr.Error(), "error mismatch\ninterpreter: %q\nvm:          %q", interpreterErr.Error(), vmErr.Error())
+	compareGenerated(t, input, factory, vmOut, vmErr)
 }
 
 func compareBothRenderError(t *testing.T, input string, factory contextFactory) {
@@ -69,6 +72,7 @@ func compareBothRenderError(t *testing.T, input string, factory contextFactory)
 
 	require.Error(t, interpreterErr, "expected interpreter error, got output %q", interpreterOut)
 	require.Error(t, vmErr, "expected VM error, got output %q", vmOut)
+	compareGenerated(t, input, factory, vmOut, vmErr)
 }
 
 func renderInterpreter(input string, factory contextFactory) (string, error) {
@@ -0,0 +1,123 @@
+package plush_test
+
+import (
+	"fmt"
+	"testing"
+
+	rootplush "github.com/gobuffalo/plush/v5"
+	"github.com/gobuffalo/plush/v5/helpers/hctx"
+	"github.com/gobuffalo/plush/v5/helpers/meta"
+	"github.com/stretchr/testify/require"
+)
+
+// byteSources expands a source map to the "file:line" of every output byte.
+func byteSources(out string, m *rootplush.SourceMap) []string {
+	sources := make([]string, len(out))
+	for _, seg := range m.Segments {
+		for i := seg.Start; i < seg.End && i < len(out); i++ {
+			sources[i] = fmt.Sprintf("%s:%d", seg.File, seg.Line)
+		}
+	}
+	return sources
+}
+
+func compareSourceMap(t *testing.T, input string, factory contextFactory) {
+	t.Helper()
+
+	interpreterOut, interpreterMap, interpreterErr := rootplush.RenderWithSourceMap(input, factory())
+
+	previous := rootplush.SetRenderMode(rootplush.RenderModeVM)
+	defer rootplush.SetRenderMode(previous)
+	vmOut, vmMap, vmErr := rootplush.RenderWithSourceMap(input, factory())
+
+	require.NoError(t, interpreterErr)
+	require.NoError(t, vmErr)
+	require.Equal(t, interpreterOut, vmOut)
+	require.Equal(t, byteSources(interpreterOut, interpreterMap), byteSources(vmOut, vmMap))
+	for i, source := range byteSources(vmOut, vmMap) {
+		require.NotEmptyf(t, source, "byte %d of %q is not mapped", i, vmOut)
+	}
+}
+
+func sourceMapContext(data map[string]interface{}) contextFactory {
+	return func() hctx.Context {
+		ctx := rootplush.NewContextWith(data)
+		ctx.Set(meta.TemplateFileKey, "index.plush.html")
+		ctx.Set("partialFeeder", func(name string) (string, error) {
+			switch name {
+			case "_row.html":
+				return "<tr>\n<td><%= n %></td>\n</tr>", nil
+			case "_layout.html":
+				return "<table>\n<%= yield %>\n</table>", nil
+			case "_same.html":
+				return "<b>a</b>", nil
+			}
+			return "", fmt.Errorf("unknown partial %s", name)
+		})
+		return ctx
+	}
+}
+
+func Test_VMParity_SourceMap_Loops_And_Conditions(t *testing.T) {
+	compareSourceMap(t, `<ul>
+<%= for (i, u) in users { %>
+  <%= if (i == 0) { %>
+    <li class="first"><%= u %></li>
+  <% } else { %>
+    <li><%= u %></li>
+  <% } %>
+<% } %>
+</ul>`, sourceMapContext(map[string]interface{}{"users": []string{"mark", "ringo"}}))
+}
+
+func Test_VMParity_SourceMap_Partials_And_Layouts(t *testing.T) {
+	compareSourceMap(t, `<h1>Rows</h1>
+<%= for (n) in names { %>
+<%= partial("_row.html", {n: n}) %>
+<% } %>
+<%= partial("_row.html", {n: "last", layout: "_layout.html"}) %>`, sourceMapContext(map[string]interface{}{"names": []string{"a", "b"}}))
+}
+
+func Test_VMParity_SourceMap_Output_Equal_To_Partial(t *testing.T) {
+	compareSourceMap(t, `<%= partial("_same.html") %>
+<%= raw(v) %>
+<%= partial("_same.html") %>`, sourceMapContext(map[string]interface{}{"v": "<b>a</b>"}))
+}
+
+func Test_VMParity_SourceMap_Helper_Blocks(t *testing.T) {
+	compareSourceMap(t, `<% contentFor("title") { %>
+<title>Home</title>
+<% } %>
+<head>
+<%= contentOf("title") %>
+</head>`, sourceMapContext(nil))
+}
+
+func Test_VMParity_SourceMap_Punch_Holes(t *testing.T) {
+	compareSourceMap(t, "<div>\n<%H name %>\n</div>", sourceMapContext(map[string]interface{}{"name": "mark"}))
+}
+
+func Test_VMParity_SourceMap_With_Coverage(t *testing.T) {
+	r := require.New(t)
+
+	// compareSourceMap renders with the interpreter first, then the VM.
+	covs := []*rootplush.Coverage{rootplush.NewCoverage(), rootplush.NewCoverage()}
+	next := 0
+	factory := sourceMapContext(map[string]interface{}{"users": []string{"mark", "ringo"}})
+	compareSourceMap(t, "<ul>\n<%= for (u) in users { %>\n<li><%= u %></li>\n<% } %>\n</ul>", func() hctx.Context {
+		ctx := factory().(*rootplush.Context)
+		ctx.WithCoverage(covs[next])
+		next++
+		return ctx
+	})
+
+	ran := func(cov *rootplush.Coverage) map[int]bool {
+		lines := map[int]bool{}
+		for line, hits := range cov.Profile().Files["index.plush.html"].Lines {
+			lines[line] = hits > 0
+		}
+		return lines
+	}
+	r.NotEmpty(ran(covs[1]))
+	r.Equal(ran(covs[0]), ran(covs[1]))
+}
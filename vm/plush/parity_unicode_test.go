@@ -0,0 +1,67 @@
+package plush_test
+
+import (
+	"html/template"
+	"strings"
+	"testing"
+	"unicode"
+	"unicode/utf8"
+)
+
+var mixedScriptTemplates = []string{
+	`<p>こんにちは</p><%= let 名前 = "太郎" %><%= 名前 %>さん`,
+	`<% let größe = 3 %><%= größe * 2 %> Straße`,
+	`<%= if (ñandú == "sí") { %>¡sí!<% } else { %>no<% } %>`,
+	`<ul><%= for (i, 値) in 一覧 { %><li><%= i %>: <%= 値 %></li><% } %></ul>`,
+	`<% let grüße = fn(wer) { return "Grüß " + wer } %><%= grüße("Gott") %>`,
+	"<% # コメント\n%>Ende: <%= ñandú %>",
+	`<%= "Ünïcödé \"quoted\" 文字列" %>`,
+}
+
+func mixedScriptContext() contextFactory {
+	return contextWith(map[string]interface{}{
+		"ñandú": "sí",
+		"一覧":    []string{"りんご", "Äpfel", "ябоки"},
+	})
+}
+
+func Test_Parity_Unicode_Identifiers(t *testing.T) {
+	for _, input := range mixedScriptTemplates {
+		compareRender(t, input, mixedScriptContext())
+	}
+}
+
+func Fuzz_Parity_Mixed_Script_Templates(f *testing.F) {
+	f.Add("こんにちは", "名前")
+	f.Add("Grüße aus Köln", "größe")
+	f.Add("¡Hola, señor!", "ñandú")
+	f.Add("Привет мир", "привет")
+	f.Add("مرحبا", "اسم")
+
+	f.Fuzz(func(t *testing.T, text string, name string) {
+		if !utf8.ValidString(text) || !utf8.ValidString(name) {
+			t.Skip()
+		}
+		text = strings.NewReplacer("<%", "", "%>", "", `"`, "", `\`, "", "\x00", "").Replace(text)
+		ident := "v_" + strings.Map(func(r rune) rune {
+			if unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_' {
+				return r
+			}
+			return -1
+		}, name)
+
+		input := "<p>" + text + "</p><% let " + ident + ` = "` + text + `" %><%= ` + ident + ` %>|` +
+			`<%= for (i, v) in [` + ident + `, "` + text + `"] { %><%= i %>:<%= v %>;<% } %>`
+		escaped := template.HTMLEscapeString(text)
+		expected := "<p>" + text + "</p>" + escaped + "|0:" + escaped + ";1:" + escaped + ";"
+
+		interpreterOut, interpreterErr := renderInterpreter(input, emptyContext)
+		vmOut, vmErr := renderVM(t, input, emptyContext)
+		if interpreterErr != nil || vmErr != nil {
+			t.Fatalf("render failed for %q\ninterpreter: %v\nvm:          %v", input, interpreterErr, vmErr)
+		}
+		if interpreterOut != expected || vmOut != expected {
+			t.Fatalf("output mismatch for %q\nexpected:    %q\ninterpreter: %q\nvm:          %q", input, expected, interpreterOut, vmOut)
+		}
+	})
+}
@@ -2,9 +2,11 @@ package plush
 
 import (
 	"fmt"
+	"io"
 
 	rootplush "github.com/gobuffalo/plush/v5"
 	"github.com/gobuffalo/plush/v5/helpers/hctx"
+	"github.com/gobuffalo/plush/v5/vm/compiler"
 	"github.com/gobuffalo/plush/v5/vm/vm"
 )
 
@@ -12,9 +14,16 @@ type Template = vm.Template
 type FastWriter = vm.FastWriter
 type FastArgs = vm.FastArgs
 type FastHelperFunc = vm.FastHelperFunc
+type BundleTemplate = vm.BundleTemplate
+type FastPathReport = vm.FastPathReport
+type Expr = vm.Expr
 
 var ErrFastUnsupported = vm.ErrFastUnsupported
 
+// ErrEngineVersion is returned by LoadBundle for bundles written by
+// another version of the VM.
+var ErrEngineVersion = compiler.ErrEngineVersion
+
 func init() {
 	rootplush.RegisterVMRenderer(Render)
 }
@@ -23,6 +32,33 @@ func Compile(input string) (*Template, error) {
 	return vm.Compile(input)
 }
 
+// CompileAt compiles input like Compile, numbering its first line line.
+// Code generators use it for statements cut from a larger template, so
+// errors report the lines of that template.
+func CompileAt(input string, line int) (*Template, error) {
+	return vm.CompileAt(input, line)
+}
+
+// CompileExpr compiles a single Plush expression, to be evaluated with
+// Expr.Eval for its Go value instead of rendered. It is the compiled
+// counterpart of plush.Eval.
+func CompileExpr(expr string) (*Expr, error) {
+	return vm.CompileExpr(expr, rootplush.EvalOptions{})
+}
+
+// CompilePureExpr compiles expr like CompileExpr, rejecting let
+// statements and assignments with plush.ErrSideEffect.
+func CompilePureExpr(expr string) (*Expr, error) {
+	return vm.CompileExpr(expr, rootplush.EvalOptions{Pure: true})
+}
+
+// CallFunction calls the function a template rendered with ctx bound to
+// name with let. It is the VM counterpart of plush.CallFunction and also
+// calls functions defined by the interpreter.
+func CallFunction(ctx hctx.Context, name string, args ...interface{}) (interface{}, error) {
+	return vm.CallFunction(ctx, name, args...)
+}
+
 // Render renders a Plush template through the compiled VM path.
 //
 // The root github.com/gobuffalo/plush/v5.Render function remains
@@ -31,6 +67,31 @@ func Render(input string, ctx hctx.Context) (string, error) {
 	return vm.Render(input, ctx)
 }
 
+// WriteBundle compiles templates and writes their bytecode to w, to be
+// loaded with LoadBundle when the application starts.
+func WriteBundle(w io.Writer, templates []BundleTemplate) error {
+	return vm.WriteBundle(w, templates)
+}
+
+// LoadBundle stores the bytecode of a bundle written by WriteBundle in the
+// template cache set up with plush.PlushCacheSetup, and returns the number
+// of templates loaded.
+func LoadBundle(r io.Reader) (int, error) {
+	return vm.LoadBundle(r)
+}
+
+// Disassemble writes the annotated bytecode and fast render plan of a
+// template, with the reasons it can't use the fast path.
+func Disassemble(w io.Writer, input string) error {
+	return vm.Disassemble(w, input)
+}
+
+// ExplainFastPath reports the path the VM renders a template with, and
+// the constructs that forced the generic or interpreter-fallback path.
+func ExplainFastPath(input string) (FastPathReport, error) {
+	return vm.ExplainFastPath(input)
+}
+
 // RunScript executes a pure Plush script through the compiled VM path.
 func RunScript(input string, ctx hctx.Context) error {
 	ctx = ctx.New()
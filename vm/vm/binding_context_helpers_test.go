@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/gobuffalo/plush/v5"
@@ -259,17 +260,23 @@ func Test_VM_Fast_Line_And_Budget_Helper_Branches(t *testing.T) {
 	require.EqualError(t, fastLineError(0, errors.New("boom")), "line 1: boom")
 
 	require.NoError(t, spendFastTraversal(nil, 2))
-	require.NoError(t, spendFastLoop(nil, 2))
+	require.NoError(t, spendFastLoop(nil, nil, 2))
 	require.NoError(t, spendFastCondition(nil, 2))
 	require.NoError(t, spendFastFunctionCall(nil, "helper", 2))
 	require.NoError(t, spendFastSubRender(nil, 2))
 
 	require.ErrorContains(t, spendFastTraversal(plush.NewContext().WithBudget(plush.NewBudget(0)), 3), "line 3")
-	require.ErrorContains(t, spendFastLoop(plush.NewContext().WithBudget(plush.NewBudget(0)), 4), "line 4")
+	require.ErrorContains(t, spendFastLoop(nil, plush.NewContext().WithBudget(plush.NewBudget(0)), 4), "line 4")
 	require.ErrorContains(t, spendFastCondition(plush.NewContext().WithBudget(plush.NewBudget(0)), 5), "line 5")
 	require.ErrorContains(t, spendFastFunctionCall(plush.NewContext().WithBudget(plush.NewBudget(0)), "helper", 6), "line 6")
 	require.ErrorContains(t, spendFastSubRender(plush.NewContext().WithBudget(plush.NewBudget(0)), 7), "line 7")
 
+	var out strings.Builder
+	out.WriteString("12345")
+	require.NoError(t, checkFastOutput(nil, nil, 8))
+	require.NoError(t, checkFastOutput(&out, plush.NewContext().WithBudget(plush.NewBudget(10).WithMaxOutput(5)), 8))
+	require.ErrorIs(t, spendFastLoop(&out, plush.NewContext().WithBudget(plush.NewBudget(10).WithMaxOutput(4)), 8), plush.ErrOutputLimitExceeded)
+
 	noBudget := newLookupTestContext(map[string]interface{}{})
 	require.Nil(t, fastBudget(noBudget))
 }
@@ -1,18 +1,30 @@
 package vm
 
-import "github.com/gobuffalo/plush/v5"
+import (
+	"fmt"
+
+	"github.com/gobuffalo/plush/v5"
+	"github.com/gobuffalo/plush/v5/helpers/hctx"
+)
 
 func (vm *VM) budget() *plush.Budget {
-	if vm.ctx == nil {
+	return contextBudget(vm.ctx)
+}
+
+func contextBudget(ctx hctx.Context) *plush.Budget {
+	if ctx == nil {
 		return nil
 	}
-	if provider, ok := vm.ctx.(interface{ Budget() *plush.Budget }); ok {
+	if provider, ok := ctx.(interface{ Budget() *plush.Budget }); ok {
 		return provider.Budget()
 	}
 	return nil
 }
 
 func (vm *VM) spendLoop() error {
+	if err := plush.CheckContext(vm.ctx); err != nil {
+		return err
+	}
 	return vm.budget().SpendLoop()
 }
 
@@ -28,9 +40,33 @@ func (vm *VM) spendFunctionCall(name string) error {
 	if name == "" {
 		name = anonymousCallName
 	}
+	if err := plush.CheckContext(vm.ctx); err != nil {
+		return err
+	}
 	return vm.budget().SpendFunctionCall(name)
 }
 
 func (vm *VM) spendTraversal(segments int) error {
 	return vm.budget().SpendObjectTraversal(segments)
 }
+
+// calls returns the number of template function calls vm runs in,
+// counting those of the VMs that started it.
+func (vm *VM) calls() int {
+	return vm.depth + vm.framesIndex - 1
+}
+
+// checkCallDepth returns an error wrapping plush.ErrCallDepthExceeded when
+// a call from vm would nest deeper than the maximum call depth of its
+// budget. Otherwise it makes sure vm has a frame for the call, doubling its
+// frames when they are all in use.
+func (vm *VM) checkCallDepth() error {
+	max := vm.budget().MaxCallDepth()
+	if vm.calls() >= max {
+		return fmt.Errorf("%w (%d)", plush.ErrCallDepthExceeded, max)
+	}
+	if vm.framesIndex >= len(vm.frames) {
+		vm.frames = append(vm.frames, make([]*Frame, len(vm.frames))...)
+	}
+	return nil
+}
@@ -0,0 +1,138 @@
+package vm
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/gobuffalo/plush/v5"
+	"github.com/gobuffalo/plush/v5/parser"
+	"github.com/gobuffalo/plush/v5/vm/compiler"
+)
+
+const bundleMagic = "plushbn\x00"
+
+// maxBundleField bounds the size of a single name, source or bytecode
+// read from a bundle, so that corrupt bundles fail instead of allocating.
+const maxBundleField = 1 << 30
+
+// BundleTemplate is a template written to a bytecode bundle.
+type BundleTemplate struct {
+	// Name is the file name renders look the template up by: the
+	// meta.TemplateFileKey value set on the context, or the resolved name
+	// of a partial.
+	Name string
+	// Source is the template source.
+	Source string
+}
+
+// WriteBundle compiles templates and writes their bytecode to w. Bundles
+// record the compiler.EngineVersion they were written by.
+func WriteBundle(w io.Writer, templates []BundleTemplate) error {
+	bw := bufio.NewWriter(w)
+	bw.WriteString(bundleMagic)
+	writeBundleField(bw, []byte(compiler.EngineVersion))
+	writeBundleUint(bw, uint64(len(templates)))
+	for _, t := range templates {
+		if !plush.IsVMBytecodeCacheableTemplateFile(t.Name) {
+			return fmt.Errorf("%s: only .plush and .html templates are cached", t.Name)
+		}
+		source := preprocessTrimTags(t.Source)
+		program, err := parser.Parse(source)
+		if err != nil {
+			return fmt.Errorf("%s: %w", t.Name, err)
+		}
+		bytecode, err := compileProgramBytecode(program)
+		if err != nil {
+			return fmt.Errorf("%s: %w", t.Name, err)
+		}
+		data, err := bytecode.MarshalBinary()
+		if err != nil {
+			return fmt.Errorf("%s: %w", t.Name, err)
+		}
+		writeBundleField(bw, []byte(t.Name))
+		writeBundleField(bw, []byte(source))
+		writeBundleField(bw, data)
+	}
+	return bw.Flush()
+}
+
+func writeBundleUint(w *bufio.Writer, v uint64) {
+	w.Write(binary.AppendUvarint(nil, v))
+}
+
+func writeBundleField(w *bufio.Writer, b []byte) {
+	writeBundleUint(w, uint64(len(b)))
+	w.Write(b)
+}
+
+// LoadBundle reads a bundle written by WriteBundle and stores its bytecode
+// in the template cache, so the first render of each template skips
+// parsing and compiling. It returns the number of templates loaded.
+//
+// The cache must have been set up with plush.PlushCacheSetup. Bundles
+// written by another engine version are rejected with an error wrapping
+// compiler.ErrEngineVersion, and nothing is loaded from them.
+func LoadBundle(r io.Reader) (int, error) {
+	if !plush.TemplateCacheEnabled() {
+		return 0, errors.New("loading bundle: the template cache is not set up")
+	}
+	br := bufio.NewReader(r)
+	magic := make([]byte, len(bundleMagic))
+	if _, err := io.ReadFull(br, magic); err != nil || string(magic) != bundleMagic {
+		return 0, errors.New("loading bundle: not a plush bytecode bundle")
+	}
+	version, err := readBundleField(br)
+	if err != nil {
+		return 0, err
+	}
+	if string(version) != compiler.EngineVersion {
+		return 0, fmt.Errorf("loading bundle: %w: got %s, want %s", compiler.ErrEngineVersion, version, compiler.EngineVersion)
+	}
+	count, err := binary.ReadUvarint(br)
+	if err != nil {
+		return 0, fmt.Errorf("loading bundle: %w", err)
+	}
+
+	type entry struct {
+		name     string
+		source   string
+		bytecode *compiler.Bytecode
+	}
+	var entries []entry
+	for i := uint64(0); i < count; i++ {
+		var fields [3][]byte
+		for j := range fields {
+			if fields[j], err = readBundleField(br); err != nil {
+				return 0, err
+			}
+		}
+		bytecode, err := compiler.UnmarshalBytecode(fields[2])
+		if err != nil {
+			return 0, fmt.Errorf("loading bundle: %s: %w", fields[0], err)
+		}
+		entries = append(entries, entry{name: string(fields[0]), source: string(fields[1]), bytecode: bytecode})
+	}
+
+	for _, e := range entries {
+		plush.CacheVMBytecodeForFilenameWithSource(e.name, nil, e.bytecode, e.source)
+	}
+	return len(entries), nil
+}
+
+func readBundleField(r *bufio.Reader) ([]byte, error) {
+	n, err := binary.ReadUvarint(r)
+	if err == nil && n > maxBundleField {
+		err = fmt.Errorf("field of %d bytes is too large", n)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("loading bundle: %w", err)
+	}
+	b := make([]byte, n)
+	if _, err := io.ReadFull(r, b); err != nil {
+		return nil, fmt.Errorf("loading bundle: %w", err)
+	}
+	return b, nil
+}
@@ -0,0 +1,139 @@
+package vm
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/gobuffalo/plush/v5"
+	"github.com/gobuffalo/plush/v5/helpers/meta"
+	"github.com/gobuffalo/plush/v5/templatecache/inmemory"
+	"github.com/gobuffalo/plush/v5/vm/compiler"
+	"github.com/stretchr/testify/require"
+)
+
+var bundleTemplates = []BundleTemplate{
+	{Name: "static.plush.html", Source: `<h1>Hello</h1>`},
+	{Name: "users/index.plush.html", Source: `<ul><%= for (u) in users { %><li><%= partial("users/row.html", {user: u}) %></li><% } %></ul>`},
+	{Name: "users/row.html", Source: `<%= if (user == "paul") { %><b><%= user %></b><% } else { %><%= upcase(user) %><% } %>`},
+	{Name: "layout.plush.html", Source: `<% let double = fn(x) { return x * 2 } %><title>T<%= double(21) %></title>`},
+	{Name: "trim.plush", Source: "<p>\n  <%- name %>\n</p>"},
+}
+
+func setupBundleCache(t *testing.T) {
+	t.Helper()
+	plush.PlushCacheSetup(inmemory.NewMemoryCache())
+	t.Cleanup(func() {
+		plush.ClearTemplateCache()
+		plush.PlushCacheSetup(nil)
+	})
+}
+
+func bundleContext(file string) *plush.Context {
+	ctx := plush.NewContextWith(map[string]interface{}{
+		"users": []string{"john", "paul"},
+		"name":  "mark",
+		"user":  "ringo",
+	})
+	ctx.Set(meta.TemplateFileKey, file)
+	return ctx
+}
+
+func Test_Bundle_Load(t *testing.T) {
+	r := require.New(t)
+	var buf bytes.Buffer
+	r.NoError(WriteBundle(&buf, bundleTemplates))
+
+	sources := map[string]string{}
+	for _, tmpl := range bundleTemplates {
+		sources[tmpl.Name] = tmpl.Source
+	}
+	partialFeeder := func(name string) (string, error) {
+		return sources[name], nil
+	}
+
+	want := map[string]string{}
+	for _, tmpl := range bundleTemplates {
+		ctx := bundleContext(tmpl.Name)
+		ctx.Set("partialFeeder", partialFeeder)
+		out, err := Render(tmpl.Source, ctx)
+		r.NoError(err)
+		want[tmpl.Name] = out
+	}
+
+	setupBundleCache(t)
+	n, err := LoadBundle(&buf)
+	r.NoError(err)
+	r.Equal(len(bundleTemplates), n)
+
+	for _, tmpl := range bundleTemplates {
+		_, ok := plush.CachedVMBytecodeForFilenameWithSource(tmpl.Name, preprocessTrimTags(tmpl.Source))
+		r.True(ok, tmpl.Name)
+
+		ctx := bundleContext(tmpl.Name)
+		ctx.Set("partialFeeder", partialFeeder)
+		out, err := Render(tmpl.Source, ctx)
+		r.NoError(err)
+		r.Equal(want[tmpl.Name], out, tmpl.Name)
+
+		diagnostics, ok := plush.RenderDiagnosticsFromContext(ctx)
+		r.True(ok)
+		r.Contains([]string{plush.VMBytecodeCacheHit, plush.VMBytecodeCacheHitStatic}, diagnostics.VMBytecodeCache, tmpl.Name)
+	}
+}
+
+func Test_Bundle_Load_Changed_Source(t *testing.T) {
+	r := require.New(t)
+	var buf bytes.Buffer
+	r.NoError(WriteBundle(&buf, []BundleTemplate{{Name: "a.plush", Source: `old <%= name %>`}}))
+
+	setupBundleCache(t)
+	_, err := LoadBundle(&buf)
+	r.NoError(err)
+
+	ctx := bundleContext("a.plush")
+	out, err := Render(`new <%= name %>`, ctx)
+	r.NoError(err)
+	r.Equal("new mark", out)
+}
+
+func Test_Bundle_Load_Rejects_Engine_Version(t *testing.T) {
+	r := require.New(t)
+	var buf bytes.Buffer
+	r.NoError(WriteBundle(&buf, bundleTemplates))
+	data := bytes.Replace(buf.Bytes(), []byte(compiler.EngineVersion), []byte(strings.Repeat("0", len(compiler.EngineVersion))), 1)
+
+	setupBundleCache(t)
+	n, err := LoadBundle(bytes.NewReader(data))
+	r.Error(err)
+	r.True(errors.Is(err, compiler.ErrEngineVersion))
+	r.Zero(n)
+	_, ok := plush.CachedVMBytecodeForFilename("static.plush.html")
+	r.False(ok)
+}
+
+func Test_Bundle_Errors(t *testing.T) {
+	r := require.New(t)
+	var buf bytes.Buffer
+	r.NoError(WriteBundle(&buf, bundleTemplates))
+	data := buf.Bytes()
+
+	_, err := LoadBundle(bytes.NewReader(data))
+	r.ErrorContains(err, "the template cache is not set up")
+
+	setupBundleCache(t)
+	_, err = LoadBundle(strings.NewReader("not a bundle"))
+	r.ErrorContains(err, "not a plush bytecode bundle")
+
+	_, err = LoadBundle(bytes.NewReader(data[:len(data)-3]))
+	r.Error(err)
+	_, ok := plush.CachedVMBytecodeForFilename("static.plush.html")
+	r.False(ok)
+
+	err = WriteBundle(&bytes.Buffer{}, []BundleTemplate{{Name: "a.plush", Source: "<%= if { %>"}})
+	r.ErrorContains(err, "a.plush:")
+
+	err = WriteBundle(&bytes.Buffer{}, []BundleTemplate{{Name: "a.txt", Source: "x"}})
+	r.ErrorContains(err, "only .plush and .html templates are cached")
+}
@@ -0,0 +1,22 @@
+package vm
+
+import "github.com/gobuffalo/plush/v5/vm/code"
+
+// traceCoverage records the branch the instruction at ip starts, if any,
+// and the line it belongs to when it starts a new line of frame. HTML
+// writes are left out, as lines holding only HTML are not tracked.
+func (vm *VM) traceCoverage(frame *Frame, ip int, op code.Opcode) {
+	fn := frame.cl.Fn
+	if b, ok := fn.Branches[ip]; ok {
+		vm.coverage.HitBranch(b.Line, b.Column, b.Branch)
+	}
+	if op == code.OpWriteHTML {
+		return
+	}
+	line := fn.LineNumbers[ip]
+	if line <= 0 || line == frame.coverLine {
+		return
+	}
+	frame.coverLine = line
+	vm.coverage.HitLine(line)
+}
@@ -0,0 +1,103 @@
+package vm
+
+import (
+	"testing"
+
+	"github.com/gobuffalo/plush/v5"
+	"github.com/gobuffalo/plush/v5/helpers/meta"
+	"github.com/stretchr/testify/require"
+)
+
+var coverageTemplates = map[string]string{
+	"users/index.plush.html": `<h1><%= title %></h1>
+<%= if (admin) { %>
+  <b><%= "admin" %></b>
+<% } else if (len(users) > 1) { %>
+  <i><%= len(users) %></i>
+<% } %>
+<% let shout = fn(s) { if (s == "paul") { return upcase(s) } return s } %>
+<%= for (i, u) in users { %>
+<li><%= partial("users/row.html", {who: u}) %> <%= shout(u) %></li><% } %>
+<%= if (false) { %><%= "never" %><% } %><%= for (x) in [] { %><%= x %><% } %>`,
+	"users/row.html": `<%= if (who == "mark") { %>
+<em><%= who %></em>
+<% } else { %>
+<%= who %>
+<% } %>`,
+}
+
+func coverageProfile(t *testing.T, render func(string, *plush.Context) (string, error), users ...string) *plush.CoverageProfile {
+	t.Helper()
+	cov := plush.NewCoverage()
+	ctx := plush.NewContextWith(map[string]interface{}{
+		"title": "Users",
+		"admin": false,
+		"users": users,
+	})
+	ctx.Set(meta.TemplateFileKey, "users/index.plush.html")
+	ctx.Set("partialFeeder", func(name string) (string, error) {
+		return coverageTemplates[name], nil
+	})
+	ctx.WithCoverage(cov)
+	_, err := render(coverageTemplates["users/index.plush.html"], ctx)
+	require.NoError(t, err)
+	return cov.Profile()
+}
+
+// covered reduces a profile to what ran at all, which both engines agree
+// on.
+func covered(p *plush.CoverageProfile) map[string]map[int]bool {
+	out := map[string]map[int]bool{}
+	for name, f := range p.Files {
+		out[name] = map[int]bool{}
+		for line, hits := range f.Lines {
+			out[name][line] = hits > 0
+		}
+	}
+	return out
+}
+
+func Test_Coverage_VM_Matches_Interpreter(t *testing.T) {
+	for _, users := range [][]string{{"mark", "paul"}, {"john"}, nil} {
+		r := require.New(t)
+		vmRender := func(input string, ctx *plush.Context) (string, error) { return Render(input, ctx) }
+		interpreterRender := func(input string, ctx *plush.Context) (string, error) { return plush.Render(input, ctx) }
+
+		got := coverageProfile(t, vmRender, users...)
+		want := coverageProfile(t, interpreterRender, users...)
+		r.Equal(covered(want), covered(got), "%v", users)
+		r.Equal(len(want.Files), len(got.Files))
+		for name, f := range want.Files {
+			r.Equal(f.Branches, got.Files[name].Branches, "%s %v", name, users)
+		}
+	}
+}
+
+func Test_Coverage_VM(t *testing.T) {
+	r := require.New(t)
+	p := coverageProfile(t, func(input string, ctx *plush.Context) (string, error) {
+		return Render(input, ctx)
+	}, "mark", "paul")
+
+	index := p.Files["users/index.plush.html"]
+	// The VM counts a line each time it enters it, so line 2 counts again
+	// when the else if body jumps back to it.
+	r.Equal(map[int]int{1: 1, 2: 2, 3: 0, 5: 1, 7: 3, 8: 3, 9: 2, 10: 1}, index.Lines)
+	r.Equal([]plush.BranchCoverage{
+		{Line: 2, Column: 5, Branch: 0, Kind: plush.BranchIf, Hits: 0},
+		{Line: 2, Column: 5, Branch: 1, Kind: plush.BranchElseIf, Hits: 1},
+		{Line: 2, Column: 5, Branch: 2, Kind: plush.BranchElse, Hits: 0},
+		{Line: 7, Column: 24, Branch: 0, Kind: plush.BranchIf, Hits: 1},
+		{Line: 7, Column: 24, Branch: 1, Kind: plush.BranchElse, Hits: 1},
+		{Line: 8, Column: 5, Branch: 0, Kind: plush.BranchFor, Hits: 2},
+		{Line: 10, Column: 5, Branch: 0, Kind: plush.BranchIf, Hits: 0},
+		{Line: 10, Column: 5, Branch: 1, Kind: plush.BranchElse, Hits: 1},
+		{Line: 10, Column: 45, Branch: 0, Kind: plush.BranchFor, Hits: 0},
+	}, index.Branches)
+
+	row := p.Files["users/row.html"]
+	r.Equal([]plush.BranchCoverage{
+		{Line: 1, Column: 5, Branch: 0, Kind: plush.BranchIf, Hits: 1},
+		{Line: 1, Column: 5, Branch: 1, Kind: plush.BranchElse, Hits: 1},
+	}, row.Branches)
+}
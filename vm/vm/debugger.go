@@ -0,0 +1,40 @@
+package vm
+
+import (
+	"github.com/gobuffalo/plush/v5"
+	"github.com/gobuffalo/plush/v5/vm/object"
+)
+
+// traceDebugger calls the debugger when the instruction at ip starts a
+// new line of frame.
+func (vm *VM) traceDebugger(frame *Frame, ip int) error {
+	line := frame.cl.Fn.LineNumbers[ip]
+	if line <= 0 || line == frame.debugLine {
+		return nil
+	}
+	frame.debugLine = line
+	return plush.DebugStep(vm.debugger, vm.ctx, line, vm.debugOutput.Len(), vm.debugVariables)
+}
+
+// debugVariables adds the let bindings and the locals of every frame, so
+// that inner loop variables shadow outer ones.
+func (vm *VM) debugVariables(vars map[string]interface{}) {
+	for index, name := range vm.globalNames {
+		if index < len(vm.globals) && vm.globals[index] != nil {
+			vars[name] = object.ToGo(vm.globals[index])
+		}
+	}
+	for i := 0; i < vm.framesIndex; i++ {
+		frame := vm.frames[i]
+		if frame == nil || frame.cl == nil || frame.cl.Fn == nil {
+			continue
+		}
+		for index, name := range frame.cl.Fn.LocalNames {
+			stackIndex := frame.basePointer + index
+			if stackIndex < 0 || stackIndex >= len(vm.stack) || vm.stack[stackIndex] == nil {
+				continue
+			}
+			vars[name] = object.ToGo(vm.stack[stackIndex])
+		}
+	}
+}
@@ -0,0 +1,91 @@
+package vm
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/gobuffalo/plush/v5"
+	"github.com/gobuffalo/plush/v5/helpers/meta"
+	"github.com/stretchr/testify/require"
+)
+
+type debugRecorder struct {
+	steps   []string
+	outputs []int
+	vars    []map[string]interface{}
+	err     error
+}
+
+func (d *debugRecorder) Step(s *plush.DebugState) error {
+	d.steps = append(d.steps, fmt.Sprintf("%s:%d", s.File, s.Line))
+	d.outputs = append(d.outputs, s.OutputLen)
+	d.vars = append(d.vars, s.Variables())
+	return d.err
+}
+
+func debugContext(d plush.Debugger) *plush.Context {
+	ctx := plush.NewContextWith(map[string]interface{}{
+		"title": "Users",
+		"users": []string{"mark", "paul"},
+	})
+	ctx.Set(meta.TemplateFileKey, "users/index.plush.html")
+	return ctx.WithDebugger(d)
+}
+
+const debugTemplate = `<h1><%= title %></h1>
+<% let n = 2 %>
+<%= for (i, u) in users { %>
+<li><%= u %></li><% } %>
+<%= n %>`
+
+func Test_Debugger_VM(t *testing.T) {
+	r := require.New(t)
+	want, err := Render(debugTemplate, plush.NewContextWith(map[string]interface{}{
+		"title": "Users",
+		"users": []string{"mark", "paul"},
+	}))
+	r.NoError(err)
+
+	rec := &debugRecorder{}
+	out, err := Render(debugTemplate, debugContext(rec))
+	r.NoError(err)
+	r.Equal(want, out)
+	r.Equal([]string{
+		"users/index.plush.html:1", "users/index.plush.html:2", "users/index.plush.html:3",
+		"users/index.plush.html:4", "users/index.plush.html:3", "users/index.plush.html:4",
+		"users/index.plush.html:3", "users/index.plush.html:5",
+	}, rec.steps)
+	r.Equal([]int{0, 15, 15, 16, 16, 16, 16, 44}, rec.outputs)
+
+	r.Equal("Users", rec.vars[0]["title"])
+	r.Equal(2, rec.vars[2]["n"])
+	r.Equal("mark", rec.vars[3]["u"])
+	r.Equal(0, rec.vars[3]["i"])
+	r.Equal("paul", rec.vars[5]["u"])
+	r.Equal(1, rec.vars[5]["i"])
+}
+
+func Test_Debugger_VM_Cached_Bytecode(t *testing.T) {
+	r := require.New(t)
+	setupBundleCache(t)
+	ctx := plush.NewContextWith(map[string]interface{}{"users": []string{"a"}, "title": "T"})
+	ctx.Set(meta.TemplateFileKey, "users/index.plush.html")
+	_, err := Render(debugTemplate, ctx)
+	r.NoError(err)
+
+	rec := &debugRecorder{}
+	_, err = Render(debugTemplate, debugContext(rec))
+	r.NoError(err)
+	r.NotEmpty(rec.steps)
+}
+
+func Test_Debugger_VM_Error_Stops_Render(t *testing.T) {
+	r := require.New(t)
+	stop := errors.New("stop")
+	rec := &debugRecorder{err: stop}
+	_, err := Render(debugTemplate, debugContext(rec))
+	r.True(errors.Is(err, stop))
+	r.Contains(err.Error(), "line 1")
+	r.Len(rec.steps, 1)
+}
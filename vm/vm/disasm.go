@@ -0,0 +1,548 @@
+package vm
+
+import (
+	"fmt"
+	"html/template"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/gobuffalo/plush/v5"
+	"github.com/gobuffalo/plush/v5/parser"
+	"github.com/gobuffalo/plush/v5/vm/code"
+	"github.com/gobuffalo/plush/v5/vm/compiler"
+	"github.com/gobuffalo/plush/v5/vm/object"
+)
+
+// FastPathReport says which path the VM renders a template with, using the
+// plush.RenderFastPath* names, and why it could not use the fast path.
+type FastPathReport struct {
+	Path string
+	// Reasons lists every construct that forced the generic or
+	// interpreter-fallback path, with its source line.
+	Reasons []compiler.FastRenderReject
+	// PunchHoles is true when the template has punch holes, which render
+	// through the punch-hole cache when a template file is set.
+	PunchHoles bool
+}
+
+// ExplainFastPath compiles input and reports the path the VM renders it
+// with.
+func ExplainFastPath(input string) (FastPathReport, error) {
+	bytecode, err := compileDisasm(input)
+	if err != nil {
+		return FastPathReport{}, err
+	}
+	return explainBytecode(bytecode), nil
+}
+
+// Disassemble compiles input and writes a listing of its bytecode
+// interleaved with the source lines it came from, its constants, and its
+// fast render plan, preceded by the FastPathReport.
+func Disassemble(w io.Writer, input string) error {
+	bytecode, err := compileDisasm(input)
+	if err != nil {
+		return err
+	}
+	d := &disassembler{lines: strings.Split(preprocessTrimTags(input), "\n")}
+	d.report(explainBytecode(bytecode))
+	d.printf("\nbytecode:\n")
+	d.bytecode(bytecode, "  ")
+	if bytecode.FastRenderPlan != nil {
+		d.printf("\nfast plan: %d segments, bindings: %s\n", len(bytecode.FastRenderPlan.Segments), bindingList(bytecode.FastRenderPlan.Bindings))
+		d.segments(bytecode.FastRenderPlan.Segments, "  ")
+	} else {
+		d.printf("\nfast plan: none\n")
+	}
+	_, err = io.WriteString(w, d.out.String())
+	return err
+}
+
+func compileDisasm(input string) (*compiler.Bytecode, error) {
+	program, err := parser.Parse(preprocessTrimTags(input))
+	if err != nil {
+		return nil, err
+	}
+	return compileProgramBytecode(program)
+}
+
+func explainBytecode(bytecode *compiler.Bytecode) FastPathReport {
+	report := FastPathReport{PunchHoles: bytecode.HasHoles}
+	switch {
+	case bytecode.Static:
+		report.Path = plush.RenderFastPathStatic
+		return report
+	case bytecode.FastRenderPlan == nil:
+		report.Path = plush.RenderFastPathGeneric
+		if shouldFallbackGenericBytecode(bytecode) {
+			report.Path = plush.RenderFastPathInterpreterFallback
+		}
+		reason := compiler.FastRenderReject{Line: bytecode.FastRejectLine, Reason: bytecode.FastReject}
+		if reason.Reason == "" {
+			reason.Reason = "the compiler built no fast render plan for this template"
+		}
+		report.Reasons = append(report.Reasons, reason)
+		return report
+	}
+	report.Path = renderFastPathForPlan(bytecode.FastRenderPlan)
+	report.Reasons = genericReasons(bytecode.FastRenderPlan.Segments, report.Reasons)
+	return report
+}
+
+// genericReasons collects the reasons of the generic segments in segments
+// and in the bodies of their block helpers.
+func genericReasons(segments []compiler.FastRenderSegment, reasons []compiler.FastRenderReject) []compiler.FastRenderReject {
+	for i := range segments {
+		s := &segments[i]
+		switch {
+		case s.Generic != nil:
+			reasons = append(reasons, compiler.FastRenderReject{Line: s.Generic.Line, Reason: s.Generic.Reason})
+		case s.Conditional != nil:
+			for _, branch := range s.Conditional.Branches {
+				reasons = genericReasons(branch.Segments, reasons)
+			}
+			reasons = genericReasons(s.Conditional.ElseSegments, reasons)
+		case s.BlockCall != nil && s.BlockCall.BlockBytecode != nil:
+			if plan := s.BlockCall.BlockBytecode.FastRenderPlan; plan != nil {
+				reasons = genericReasons(plan.Segments, reasons)
+			}
+		}
+	}
+	return reasons
+}
+
+type disassembler struct {
+	out   strings.Builder
+	lines []string
+}
+
+func (d *disassembler) printf(format string, args ...interface{}) {
+	fmt.Fprintf(&d.out, format, args...)
+}
+
+func (d *disassembler) report(r FastPathReport) {
+	d.printf("path: %s\n", r.Path)
+	for _, reason := range r.Reasons {
+		if reason.Line > 0 {
+			d.printf("  line %d: %s\n", reason.Line, reason.Reason)
+		} else {
+			d.printf("  %s\n", reason.Reason)
+		}
+	}
+	if r.PunchHoles {
+		d.printf("  punch holes render through the punch-hole cache when a template file is set\n")
+	}
+}
+
+// bytecode lists the instructions of b, then its constants. Compiled
+// functions are listed in place, below their constant.
+func (d *disassembler) bytecode(b *compiler.Bytecode, indent string) {
+	d.instructions(b.Instructions, disasmTables{
+		calls:      b.CallNames,
+		locals:     b.LocalNames,
+		globals:    b.GlobalNames,
+		lines:      b.LineNumbers,
+		properties: b.Properties,
+		constants:  b.Constants,
+	}, indent)
+
+	if len(b.Constants) == 0 {
+		return
+	}
+	d.printf("\n%sconstants:\n", strings.TrimSuffix(indent, "  "))
+	for i, c := range b.Constants {
+		fn, ok := c.(*object.CompiledFunction)
+		if !ok {
+			d.printf("%s%d %s\n", indent, i, describeConstant(c))
+			continue
+		}
+		d.printf("%s%d function: %d parameters, %d locals\n", indent, i, fn.NumParameters, fn.NumLocals)
+		d.instructions(fn.Instructions, disasmTables{
+			calls:      fn.CallNames,
+			locals:     fn.LocalNames,
+			globals:    b.GlobalNames,
+			lines:      fn.LineNumbers,
+			properties: fn.Properties,
+			constants:  b.Constants,
+		}, indent+"    ")
+	}
+}
+
+type disasmTables struct {
+	calls      map[int]string
+	locals     map[int]string
+	globals    map[int]string
+	lines      map[int]int
+	properties map[int]object.PropertyAccess
+	constants  []object.Object
+}
+
+// Operand roles, by opcode and operand position.
+const (
+	operandPlain = iota
+	operandConstant
+	operandLocal
+	operandGlobal
+	operandJump
+)
+
+var operandRoles = map[code.Opcode][]int{
+	code.OpConstant:             {operandConstant},
+	code.OpJumpNotTruthy:        {operandJump},
+	code.OpJump:                 {operandJump},
+	code.OpGetGlobal:            {operandGlobal},
+	code.OpSetGlobal:            {operandGlobal},
+	code.OpGetLocal:             {operandLocal},
+	code.OpSetLocal:             {operandLocal},
+	code.OpClosure:              {operandConstant},
+	code.OpGetName:              {operandConstant},
+	code.OpSetName:              {operandConstant},
+	code.OpAssignName:           {operandConstant},
+	code.OpGetProperty:          {operandConstant},
+	code.OpFor:                  {operandConstant, operandConstant, operandConstant},
+	code.OpCallBlock:            {operandPlain, operandConstant},
+	code.OpGetNameOrNull:        {operandConstant},
+	code.OpHole:                 {operandConstant},
+	code.OpWriteConstant:        {operandConstant},
+	code.OpWriteName:            {operandConstant},
+	code.OpWriteNameOrNull:      {operandConstant},
+	code.OpWriteLocal:           {operandLocal},
+	code.OpWriteGlobal:          {operandGlobal},
+	code.OpWriteString:          {operandConstant},
+	code.OpWriteHTML:            {operandConstant},
+	code.OpWriteLocalProperty:   {operandLocal, operandConstant},
+	code.OpWriteGlobalProperty:  {operandGlobal, operandConstant},
+	code.OpWriteNameProperty:    {operandConstant, operandConstant},
+	code.OpWriteNameCall:        {operandConstant},
+	code.OpGetNameOrJumpMissing: {operandConstant, operandJump},
+}
+
+// instructions lists ins, printing each source line before the first
+// instruction compiled from it.
+func (d *disassembler) instructions(ins code.Instructions, t disasmTables, indent string) {
+	line := 0
+	for i := 0; i < len(ins); {
+		def, err := code.Lookup(ins[i])
+		if err != nil {
+			d.printf("%s%04d ERROR: %s\n", indent, i, err)
+			i++
+			continue
+		}
+		if l, ok := t.lines[i]; ok && l != line && l > 0 && l <= len(d.lines) {
+			line = l
+			d.printf("%s%4d | %s\n", indent, l, d.lines[l-1])
+		}
+
+		operands, read := code.ReadOperands(def, ins[i+1:])
+		text := def.Name
+		var notes []string
+		for j, operand := range operands {
+			text += " " + strconv.Itoa(operand)
+			roles := operandRoles[code.Opcode(ins[i])]
+			if j >= len(roles) {
+				continue
+			}
+			switch roles[j] {
+			case operandConstant:
+				if operand < len(t.constants) {
+					notes = append(notes, describeConstant(t.constants[operand]))
+				}
+			case operandLocal:
+				if name, ok := t.locals[operand]; ok {
+					notes = append(notes, "local "+name)
+				}
+			case operandGlobal:
+				if name, ok := t.globals[operand]; ok {
+					notes = append(notes, "global "+name)
+				}
+			case operandJump:
+				notes = append(notes, fmt.Sprintf("-> %04d", operand))
+			}
+		}
+		if name, ok := t.calls[i]; ok {
+			notes = append(notes, "call "+name)
+		}
+		if p, ok := t.properties[i]; ok && p.Full != "" {
+			notes = append(notes, "property "+p.Full)
+		}
+
+		if len(notes) == 0 {
+			d.printf("%s%04d %s\n", indent, i, text)
+		} else {
+			d.printf("%s%04d %-32s ; %s\n", indent, i, text, strings.Join(notes, ", "))
+		}
+		i += 1 + read
+	}
+}
+
+func describeConstant(c object.Object) string {
+	switch c := c.(type) {
+	case *object.String:
+		return quoteShort(c.Value)
+	case *object.Native:
+		if html, ok := c.Value.(template.HTML); ok {
+			return "html " + quoteShort(string(html))
+		}
+		return fmt.Sprintf("native %T", c.Value)
+	case *object.CompiledFunction:
+		return fmt.Sprintf("function(%d)", c.NumParameters)
+	case nil:
+		return "nil"
+	}
+	return c.Inspect()
+}
+
+// quoteShort quotes s, eliding the middle of long strings.
+func quoteShort(s string) string {
+	const max = 48
+	if len(s) > max {
+		s = s[:max/2] + "…" + s[len(s)-max/2:]
+		s = strings.ToValidUTF8(s, "")
+	}
+	return strconv.Quote(s)
+}
+
+func bindingList(bindings []string) string {
+	if len(bindings) == 0 {
+		return "none"
+	}
+	sorted := append([]string(nil), bindings...)
+	sort.Strings(sorted)
+	return strings.Join(sorted, ", ")
+}
+
+func (d *disassembler) segments(segments []compiler.FastRenderSegment, indent string) {
+	for i := range segments {
+		s := &segments[i]
+		switch s.Kind {
+		case compiler.FastRenderSegmentStatic:
+			d.item(indent, s.Line, "static %s", quoteShort(s.Value))
+		case compiler.FastRenderSegmentName:
+			d.item(indent, s.Line, "write %s", s.Value)
+		case compiler.FastRenderSegmentProperty:
+			d.item(indent, s.Line, "write %s", s.Full)
+		case compiler.FastRenderSegmentValue:
+			d.item(indent, s.Line, "write %s", describeValue(&s.ValuePlan))
+		case compiler.FastRenderSegmentCall:
+			d.item(indent, s.Line, "%s %s", writeOrRun(s.Call.Silent), describeCall(s.Call))
+		case compiler.FastRenderSegmentBlockCall:
+			d.blockCall(s.BlockCall, indent)
+		case compiler.FastRenderSegmentConditional:
+			c := s.Conditional
+			for j, branch := range c.Branches {
+				keyword := "if"
+				if j > 0 {
+					keyword = "else if"
+				}
+				d.item(indent, branch.Line, "%s %s", keyword, describeValue(&branch.Condition))
+				d.segments(branch.Segments, indent+"  ")
+			}
+			if len(c.ElseSegments) > 0 {
+				d.printf("%selse\n", indent)
+				d.segments(c.ElseSegments, indent+"  ")
+			}
+		case compiler.FastRenderSegmentLoop:
+			d.loop(s.Loop, indent)
+		case compiler.FastRenderSegmentPartial:
+			d.item(indent, s.Line, "%s", describePartial(s.Partial))
+		case compiler.FastRenderSegmentLet:
+			d.item(indent, s.Line, "let %s = %s", s.Value, describeValue(&s.ValuePlan))
+		case compiler.FastRenderSegmentAssign:
+			d.item(indent, s.Line, "%s = %s", describeTarget(s.AssignTarget, s.Value), describeValue(&s.ValuePlan))
+		case compiler.FastRenderSegmentReturn:
+			d.item(indent, s.Line, "return %s", describeValue(&s.ValuePlan))
+		case compiler.FastRenderSegmentGeneric:
+			scope := "statement"
+			if s.Generic.WholeTemplate {
+				scope = "whole template"
+			}
+			d.item(indent, s.Generic.Line, "generic VM (%s): %s", scope, s.Generic.Reason)
+		default:
+			d.item(indent, s.Line, "segment kind %d", s.Kind)
+		}
+	}
+}
+
+func (d *disassembler) loop(l *compiler.FastLoopPlan, indent string) {
+	vars := l.ValueName
+	if l.KeyName != "" && l.KeyName != "_" {
+		vars = l.KeyName + ", " + l.ValueName
+	}
+	iterable := l.IterableName
+	if l.Iterable.Kind != compiler.FastValueInvalid {
+		iterable = describeValue(&l.Iterable)
+	}
+	d.item(indent, l.Line, "for (%s) in %s", vars, iterable)
+	d.parts(l.Parts, indent+"  ")
+}
+
+func (d *disassembler) parts(parts []compiler.FastLoopPart, indent string) {
+	for i := range parts {
+		p := &parts[i]
+		switch p.Kind {
+		case compiler.FastLoopPartStatic:
+			d.item(indent, p.Line, "static %s", quoteShort(p.Value))
+		case compiler.FastLoopPartKey, compiler.FastLoopPartValue:
+			d.item(indent, p.Line, "write %s", p.Value)
+		case compiler.FastLoopPartValueProperty:
+			d.item(indent, p.Line, "write %s", p.Full)
+		case compiler.FastLoopPartValuePath:
+			d.item(indent, p.Line, "write %s", describeValue(&p.ValuePlan))
+		case compiler.FastLoopPartCall:
+			d.item(indent, p.Line, "%s %s", writeOrRun(p.Call.Silent), describeCall(p.Call))
+		case compiler.FastLoopPartConditional:
+			c := p.Conditional
+			for j, branch := range c.Branches {
+				keyword := "if"
+				if j > 0 {
+					keyword = "else if"
+				}
+				d.item(indent, branch.Line, "%s %s", keyword, describeValue(&branch.Condition))
+				d.parts(branch.Parts, indent+"  ")
+			}
+			if len(c.ElseParts) > 0 {
+				d.printf("%selse\n", indent)
+				d.parts(c.ElseParts, indent+"  ")
+			}
+		case compiler.FastLoopPartLoop:
+			d.loop(p.Loop, indent)
+		case compiler.FastLoopPartBreak:
+			d.item(indent, p.Line, "break")
+		case compiler.FastLoopPartContinue:
+			d.item(indent, p.Line, "continue")
+		case compiler.FastLoopPartBlockCall:
+			d.blockCall(p.BlockCall, indent)
+		case compiler.FastLoopPartPartial:
+			d.item(indent, p.Line, "%s", describePartial(p.Partial))
+		case compiler.FastLoopPartLet:
+			d.item(indent, p.Line, "let %s = %s", p.Value, describeValue(&p.ValuePlan))
+		case compiler.FastLoopPartAssign:
+			d.item(indent, p.Line, "%s = %s", describeTarget(p.AssignTarget, p.Value), describeValue(&p.ValuePlan))
+		case compiler.FastLoopPartReturn:
+			d.item(indent, p.Line, "return %s", describeValue(&p.ValuePlan))
+		default:
+			d.item(indent, p.Line, "part kind %d", p.Kind)
+		}
+	}
+}
+
+func (d *disassembler) blockCall(c *compiler.FastBlockCallPlan, indent string) {
+	d.item(indent, c.Line, "%s %s with block", writeOrRun(c.Silent), describeArgs(c.Name, c.Args))
+	if c.BlockBytecode == nil || c.BlockBytecode.FastRenderPlan == nil {
+		d.printf("%s  generic VM\n", indent)
+		return
+	}
+	d.segments(c.BlockBytecode.FastRenderPlan.Segments, indent+"  ")
+}
+
+// item prints one fast-plan entry with its source line.
+func (d *disassembler) item(indent string, line int, format string, args ...interface{}) {
+	text := fmt.Sprintf(format, args...)
+	if line > 0 {
+		d.printf("%s%-*s line %d\n", indent, 48-len(indent), text, line)
+		return
+	}
+	d.printf("%s%s\n", indent, text)
+}
+
+func writeOrRun(silent bool) string {
+	if silent {
+		return "run"
+	}
+	return "write"
+}
+
+func describeCall(c *compiler.FastCallPlan) string {
+	if c == nil {
+		return "<nil>"
+	}
+	return describeArgs(c.Name, c.Args)
+}
+
+func describeArgs(name string, args []compiler.FastValuePlan) string {
+	parts := make([]string, len(args))
+	for i := range args {
+		parts[i] = describeValue(&args[i])
+	}
+	return name + "(" + strings.Join(parts, ", ") + ")"
+}
+
+func describePartial(p *compiler.FastPartialPlan) string {
+	s := "partial " + strconv.Quote(p.Name)
+	if len(p.Data) == 0 {
+		return s
+	}
+	pairs := make([]string, len(p.Data))
+	for i := range p.Data {
+		pairs[i] = p.Data[i].Key + ": " + describeValue(&p.Data[i].Value)
+	}
+	return s + " {" + strings.Join(pairs, ", ") + "}"
+}
+
+func describeTarget(t *compiler.FastAssignTarget, name string) string {
+	if t == nil || t.Kind == compiler.FastAssignTargetName {
+		return name
+	}
+	return describeValue(&t.Container) + "[" + describeValue(&t.Index) + "]"
+}
+
+// describeValue writes a value plan back as Plush-like source.
+func describeValue(v *compiler.FastValuePlan) string {
+	if v == nil {
+		return "<nil>"
+	}
+	var s string
+	switch v.Kind {
+	case compiler.FastValueName, compiler.FastValuePath, compiler.FastValueLoopKey:
+		s = v.Value
+	case compiler.FastValueString:
+		s = quoteShort(v.Value)
+	case compiler.FastValueInteger:
+		s = strconv.FormatInt(v.IntValue, 10)
+	case compiler.FastValueFloat:
+		s = strconv.FormatFloat(v.FloatValue, 'g', -1, 64)
+	case compiler.FastValueBool:
+		s = strconv.FormatBool(v.BoolValue)
+	case compiler.FastValueInfix, compiler.FastValueConcat:
+		s = "(" + describeValue(v.Left) + " " + v.Operator + " " + describeValue(v.Right) + ")"
+	case compiler.FastValuePrefix:
+		s = v.Operator + describeValue(v.Right)
+	case compiler.FastValueCall:
+		s = describeCall(v.Call)
+	case compiler.FastValueArray:
+		elements := make([]string, len(v.Elements))
+		for i := range v.Elements {
+			elements[i] = describeValue(&v.Elements[i])
+		}
+		s = "[" + strings.Join(elements, ", ") + "]"
+	case compiler.FastValueHash:
+		pairs := make([]string, len(v.Pairs))
+		for i := range v.Pairs {
+			key := v.Pairs[i].Key
+			if v.Pairs[i].KeyPlan != nil {
+				key = describeValue(v.Pairs[i].KeyPlan)
+			}
+			pairs[i] = key + ": " + describeValue(&v.Pairs[i].Value)
+		}
+		s = "{" + strings.Join(pairs, ", ") + "}"
+	case compiler.FastValueIndex:
+		s = describeValue(v.Left) + "[" + describeValue(v.Right) + "]"
+	default:
+		s = fmt.Sprintf("<value kind %d>", v.Kind)
+	}
+	for i := range v.Path {
+		step := &v.Path[i]
+		switch step.Kind {
+		case compiler.FastPathStepProperty:
+			s += "." + step.Value
+		case compiler.FastPathStepIndexInteger:
+			s += "[" + strconv.Itoa(step.Index) + "]"
+		case compiler.FastPathStepIndexString:
+			s += "[" + strconv.Quote(step.Value) + "]"
+		case compiler.FastPathStepCall:
+			s += "." + describeArgs(step.Value, step.Args)
+		}
+	}
+	return s
+}
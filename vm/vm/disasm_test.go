@@ -0,0 +1,114 @@
+package vm
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/gobuffalo/plush/v5"
+	"github.com/gobuffalo/plush/v5/vm/compiler"
+	"github.com/stretchr/testify/require"
+)
+
+func disassemble(t *testing.T, input string) string {
+	t.Helper()
+	var out strings.Builder
+	require.NoError(t, Disassemble(&out, input))
+	return out.String()
+}
+
+func Test_Disassemble_Fast(t *testing.T) {
+	r := require.New(t)
+	out := disassemble(t, "<h1><%= title %></h1>\n<%= for (i, u) in users { %><li><%= u.Name %> <%= upcase(u.Email) %></li><% } %>\n<%= if (x > 1) { %>big<% } else { %>small<% } %><%= partial(\"a.html\", {n: 1}) %>")
+
+	r.True(strings.HasPrefix(out, "path: fast\n\nbytecode:\n"), out)
+	r.Contains(out, "     1 | <h1><%= title %></h1>\n  0000 OpWriteHTML 0                    ; html \"<h1>\"\n")
+	r.Contains(out, "  0003 OpWriteName 1                    ; \"title\"\n")
+	r.Contains(out, "     2 | <%= for (i, u) in users { %>")
+	r.Contains(out, "OpFor 10 11 12 0                 ; function(2), \"i\", \"u\"")
+	r.Contains(out, "OpJumpNotTruthy 41               ; -> 0041")
+	r.Contains(out, "OpWriteNameCall 21 2             ; \"partial\", call partial")
+
+	r.Contains(out, "\nconstants:\n")
+	r.Contains(out, "  10 function: 2 parameters, 2 locals\n")
+	r.Contains(out, "      0003 OpWriteLocalProperty 1 5         ; local u, \"Name\", property u.Name\n")
+
+	r.Contains(out, "\nfast plan: 7 segments, bindings: title, upcase, users, x\n")
+	r.Contains(out, "  for (i, u) in users                            line 2\n")
+	r.Contains(out, "    write upcase(u.Email)                        line 2\n")
+	r.Contains(out, "  if (x > 1)                                     line 3\n    static \"big\"\n  else\n    static \"small\"\n")
+	r.Contains(out, "  partial \"a.html\" {n: 1}                        line 3\n")
+}
+
+func Test_Disassemble_Generic(t *testing.T) {
+	r := require.New(t)
+	out := disassemble(t, "<% let f = fn(x) { return x * 2 } %>\n<%= f(2) %>")
+
+	r.True(strings.HasPrefix(out, "path: generic\n  line 1: let value: function literals are not fast-planned\n"), out)
+	r.Contains(out, "OpSetGlobal 0                    ; global f")
+	r.Contains(out, "OpWriteCall 1                    ; call f")
+	r.Contains(out, "  generic VM (whole template): let value: function literals are not fast-planned line 1\n")
+}
+
+func Test_Disassemble_Static(t *testing.T) {
+	r := require.New(t)
+	out := disassemble(t, "<p>static</p>")
+	r.True(strings.HasPrefix(out, "path: static\n\nbytecode:\n"), out)
+}
+
+func Test_Disassemble_Parse_Error(t *testing.T) {
+	var out strings.Builder
+	require.Error(t, Disassemble(&out, "<%= if { %>"))
+	require.Empty(t, out.String())
+}
+
+func Test_Explain_Fast_Path(t *testing.T) {
+	r := require.New(t)
+
+	report, err := ExplainFastPath(`<%= name %>`)
+	r.NoError(err)
+	r.Equal(plush.RenderFastPathFast, report.Path)
+	r.Empty(report.Reasons)
+
+	report, err = ExplainFastPath("<p>\n<% let f = fn() { return 1 } %><%= f() %></p>")
+	r.NoError(err)
+	r.Equal(plush.RenderFastPathGeneric, report.Path)
+	r.Equal([]compiler.FastRenderReject{{Line: 2, Reason: "let value: function literals are not fast-planned"}}, report.Reasons)
+}
+
+func Test_Explain_Interpreter_Fallback(t *testing.T) {
+	r := require.New(t)
+	bytecode := &compiler.Bytecode{FastRejectLine: 4, FastReject: "unsupported statement"}
+
+	report := explainBytecode(bytecode)
+	r.Equal(plush.RenderFastPathGeneric, report.Path)
+	r.Equal([]compiler.FastRenderReject{{Line: 4, Reason: "unsupported statement"}}, report.Reasons)
+
+	previous := plush.SetVMGenericFallback(true)
+	defer plush.SetVMGenericFallback(previous)
+	report = explainBytecode(bytecode)
+	r.Equal(plush.RenderFastPathInterpreterFallback, report.Path)
+
+	report = explainBytecode(&compiler.Bytecode{})
+	r.Equal([]compiler.FastRenderReject{{Reason: "the compiler built no fast render plan for this template"}}, report.Reasons)
+}
+
+func Test_Describe_Value(t *testing.T) {
+	r := require.New(t)
+	name := compiler.FastValuePlan{Kind: compiler.FastValueName, Value: "user"}
+	for want, v := range map[string]compiler.FastValuePlan{
+		`user.Tags[0]["k"].Get(1)`: {Kind: compiler.FastValuePath, Value: "user", Path: []compiler.FastPathStep{
+			{Kind: compiler.FastPathStepProperty, Value: "Tags"},
+			{Kind: compiler.FastPathStepIndexInteger, Index: 0},
+			{Kind: compiler.FastPathStepIndexString, Value: "k"},
+			{Kind: compiler.FastPathStepCall, Value: "Get", Args: []compiler.FastValuePlan{{Kind: compiler.FastValueInteger, IntValue: 1}}},
+		}},
+		`!user`:            {Kind: compiler.FastValuePrefix, Operator: "!", Right: &name},
+		`[1.5, true, "a"]`: {Kind: compiler.FastValueArray, Elements: []compiler.FastValuePlan{{Kind: compiler.FastValueFloat, FloatValue: 1.5}, {Kind: compiler.FastValueBool, BoolValue: true}, {Kind: compiler.FastValueString, Value: "a"}}},
+		`{a: user}`:        {Kind: compiler.FastValueHash, Pairs: []compiler.FastValuePair{{Key: "a", Value: name}}},
+		`user[user]`:       {Kind: compiler.FastValueIndex, Left: &name, Right: &name},
+		`(user + user)`:    {Kind: compiler.FastValueConcat, Operator: "+", Left: &name, Right: &name},
+		`<value kind 0>`:   {},
+	} {
+		r.Equal(want, describeValue(&v))
+	}
+}
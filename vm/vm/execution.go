@@ -55,6 +55,7 @@ func (vm *VM) Run() error {
 	var ip int
 	var ins code.Instructions
 	var op code.Opcode
+	maxOutput := vm.budget().MaxOutput()
 
 	for !vm.halted && vm.currentFrame().ip < len(vm.currentFrame().Instructions())-1 {
 		vm.currentFrame().ip++
@@ -63,6 +64,17 @@ func (vm *VM) Run() error {
 		vm.lastIP = ip
 		ins = vm.currentFrame().Instructions()
 		op = code.Opcode(ins[ip])
+		if vm.sourceMap != nil {
+			vm.traceSourceMap(vm.currentFrame(), ip, op)
+		}
+		if vm.debugger != nil {
+			if err := vm.traceDebugger(vm.currentFrame(), ip); err != nil {
+				return err
+			}
+		}
+		if vm.coverage != nil {
+			vm.traceCoverage(vm.currentFrame(), ip, op)
+		}
 
 		switch op {
 		case code.OpConstant:
@@ -443,8 +455,14 @@ func (vm *VM) Run() error {
 				return err
 			}
 		}
+		if maxOutput > 0 && int64(vm.currentFrame().output.Len()) > maxOutput {
+			return plush.ErrOutputLimitExceeded
+		}
 	}
 
+	if vm.sourceMap != nil {
+		vm.flushSourceMap(vm.currentFrame())
+	}
 	return nil
 }
 
@@ -452,7 +470,7 @@ func (vm *VM) push(o object.Object) error {
 	if o == nil {
 		o = Null
 	}
-	if vm.sp >= StackSize {
+	if vm.sp >= len(vm.stack) && !vm.growStack(vm.sp+1) {
 		return fmt.Errorf("stack overflow")
 	}
 
@@ -462,6 +480,26 @@ func (vm *VM) push(o object.Object) error {
 	return nil
 }
 
+// growStack makes room for n values on the stack of vm and reports whether
+// it could. The stack only grows past StackSize when the budget allows more
+// calls than MaxFrames, as each call needs room on the stack.
+func (vm *VM) growStack(n int) bool {
+	if n <= len(vm.stack) {
+		return true
+	}
+	if n > StackSize && vm.budget().MaxCallDepth() < MaxFrames {
+		return false
+	}
+	size := 2 * len(vm.stack)
+	if size < n {
+		size = n
+	}
+	stack := make([]object.Object, size)
+	copy(stack, vm.stack[:vm.sp])
+	vm.stack = stack
+	return true
+}
+
 func (vm *VM) pop() object.Object {
 	o := vm.stack[vm.sp-1]
 	vm.sp--
@@ -627,7 +665,11 @@ func (vm *VM) frameOutputObject(frame *Frame) object.Object {
 	if frame == nil || !frame.hasOutput {
 		return Null
 	}
-	return &object.Native{Value: template.HTML(frame.output.String())}
+	value := &object.Native{Value: template.HTML(frame.output.String())}
+	if vm.sourceMap != nil {
+		vm.registerSourceMapOutput(frame, value)
+	}
+	return value
 }
 
 func (vm *VM) currentFrameOutputValues() []object.Object {
@@ -1112,6 +1154,15 @@ func (vm *VM) callClosure(cl *object.Closure, numArgs int, block *object.Closure
 	if numArgs != cl.Fn.NumParameters {
 		return fmt.Errorf("wrong number of arguments: want=%d, got=%d", cl.Fn.NumParameters, numArgs)
 	}
+	if vm.foreignClosure(cl) {
+		return vm.callForeignClosure(cl, numArgs, writeReturn, calleeOnStack)
+	}
+	if err := vm.checkCallDepth(); err != nil {
+		return err
+	}
+	if !vm.growStack(vm.sp - numArgs + cl.Fn.NumLocals) {
+		return fmt.Errorf("stack overflow")
+	}
 
 	frame := newFrame(cl, vm.sp-numArgs, vm.pooled)
 	frame.block = block
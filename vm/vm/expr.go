@@ -0,0 +1,50 @@
+package vm
+
+import (
+	"github.com/gobuffalo/plush/v5"
+	"github.com/gobuffalo/plush/v5/ast"
+	"github.com/gobuffalo/plush/v5/helpers/hctx"
+	"github.com/gobuffalo/plush/v5/token"
+	"github.com/gobuffalo/plush/v5/vm/compiler"
+)
+
+// Expr is a compiled Plush expression, safe for concurrent use.
+type Expr struct {
+	bytecode *compiler.Bytecode
+}
+
+// CompileExpr compiles a single Plush expression, parsed with
+// plush.ParseExpr, to bytecode that returns its value.
+func CompileExpr(expr string, opts plush.EvalOptions) (*Expr, error) {
+	node, err := plush.ParseExpr(expr, opts)
+	if err != nil {
+		return nil, err
+	}
+	ret := &ast.ReturnStatement{
+		Type:        token.RETURN,
+		TokenAble:   ast.TokenAble{Token: token.Token{Type: token.RETURN, Literal: "return", LineNumber: 1}},
+		ReturnValue: node,
+	}
+	bytecode, err := compileProgramBytecode(&ast.Program{Statements: []ast.Statement{ret}})
+	if err != nil {
+		return nil, err
+	}
+	return &Expr{bytecode: bytecode}, nil
+}
+
+// Eval runs the expression with ctx and returns its Go value. Budgets
+// attached to ctx are charged like for a render.
+func (e *Expr) Eval(ctx hctx.Context) (interface{}, error) {
+	if ctx == nil {
+		ctx = plush.NewContext()
+	}
+	if restorePartial := installVMPartialHelperForBytecode(e.bytecode, ctx); restorePartial != nil {
+		defer restorePartial()
+	}
+	machine := newPooledWithContext(e.bytecode, ctx)
+	defer machine.Release()
+	if err := machine.Run(); err != nil {
+		return nil, machine.wrapRuntimeError(err)
+	}
+	return machine.exportValue(machine.LastPoppedStackElem()), nil
+}
@@ -2,6 +2,7 @@ package vm
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/gobuffalo/plush/v5"
 	"github.com/gobuffalo/plush/v5/helpers/hctx"
@@ -283,8 +284,29 @@ func spendFastTraversal(ctx hctx.Context, line int) error {
 	return nil
 }
 
-func spendFastLoop(ctx hctx.Context, line int) error {
-	if err := fastBudget(ctx).SpendLoop(); err != nil {
+// spendFastLoop spends a loop iteration and checks the output written to
+// out so far against the output limit.
+func spendFastLoop(out *strings.Builder, ctx hctx.Context, line int) error {
+	if err := plush.CheckContext(ctx); err != nil {
+		return fastLineError(line, err)
+	}
+	budget := fastBudget(ctx)
+	if err := budget.SpendLoop(); err != nil {
+		return fastLineError(line, err)
+	}
+	if out != nil {
+		if err := budget.CheckOutput(out.Len()); err != nil {
+			return fastLineError(line, err)
+		}
+	}
+	return nil
+}
+
+func checkFastOutput(out *strings.Builder, ctx hctx.Context, line int) error {
+	if out == nil {
+		return nil
+	}
+	if err := fastBudget(ctx).CheckOutput(out.Len()); err != nil {
 		return fastLineError(line, err)
 	}
 	return nil
@@ -305,6 +327,9 @@ func spendFastAssignment(ctx hctx.Context, line int) error {
 }
 
 func spendFastFunctionCall(ctx hctx.Context, name string, line int) error {
+	if err := plush.CheckContext(ctx); err != nil {
+		return fastLineError(line, err)
+	}
 	if err := fastBudget(ctx).SpendFunctionCall(name); err != nil {
 		return fastLineError(line, err)
 	}
@@ -312,6 +337,9 @@ func spendFastFunctionCall(ctx hctx.Context, name string, line int) error {
 }
 
 func spendFastSubRender(ctx hctx.Context, line int) error {
+	if err := plush.CheckContext(ctx); err != nil {
+		return fastLineError(line, err)
+	}
 	if err := fastBudget(ctx).SpendSubRender(); err != nil {
 		return fastLineError(line, err)
 	}
@@ -231,9 +231,7 @@ func fastLoopBlockContext(ctx hctx.Context, bindings fastRenderBindings, loop *c
 }
 
 func writeFastBlockCallValue(out *strings.Builder, ctx hctx.Context, name string, raw interface{}, args *fastCallArgs, helperCtx plush.HelperContext, cacheSlot *object.InlineCacheSlot) error {
-	if obj, ok := raw.(object.Object); ok {
-		raw = object.ToGo(obj)
-	}
+	raw = callableValue(raw, ctx)
 	rv := reflect.ValueOf(raw)
 	if !rv.IsValid() {
 		return fmt.Errorf("%T is an invalid function", raw)
@@ -327,9 +325,7 @@ func writeFastDirectStringCallSegment(out *strings.Builder, ctx hctx.Context, bi
 	if out == nil || call == nil || len(call.Args) != 1 {
 		return false, nil
 	}
-	if obj, ok := raw.(object.Object); ok {
-		raw = object.ToGo(obj)
-	}
+	raw = callableValue(raw, ctx)
 	arg, ok, err := evalFastCallStringArg(&call.Args[0], ctx, bindings)
 	if err != nil {
 		return true, err
@@ -94,6 +94,9 @@ func writeRegisteredFastHelper(out *strings.Builder, ctx hctx.Context, helper Fa
 	if errors.Is(err, ErrFastUnsupported) {
 		return false, nil
 	}
+	if err == nil {
+		err = fastBudget(ctx).CheckOutput(out.Len())
+	}
 	return true, err
 }
 
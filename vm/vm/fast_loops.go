@@ -111,14 +111,14 @@ func renderFastPartialSegmentWithDataPlan(out *strings.Builder, ctx hctx.Context
 			if err != nil {
 				return err
 			}
-			return nil
+			return checkFastOutput(out, ctx, partial.Line)
 		}
 	}
 	if ok, err := renderFastNoDataPartialInto(out, partial.Name, ctx, partial.Line); ok || err != nil {
 		if err != nil {
 			return err
 		}
-		return nil
+		return checkFastOutput(out, ctx, partial.Line)
 	}
 	return nil
 }
@@ -284,7 +284,7 @@ func renderFastLoopIterationOrControl(out *strings.Builder, ctx hctx.Context, bi
 }
 
 func renderFastLoopIteration(out *strings.Builder, ctx hctx.Context, bindings fastRenderBindings, loop *compiler.FastLoopPlan, key, value interface{}) error {
-	if err := spendFastLoop(ctx, loop.Line); err != nil {
+	if err := spendFastLoop(out, ctx, loop.Line); err != nil {
 		return err
 	}
 
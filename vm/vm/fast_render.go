@@ -143,6 +143,9 @@ func renderFastPlanWithBindingPlanOptions(bytecode *compiler.Bytecode, plan *com
 		if !ok || err != nil {
 			return "", ok, err
 		}
+		if err := fastBudget(ctx).CheckOutput(out.Len()); err != nil {
+			return "", true, err
+		}
 		observeOutputBuilderSize(bytecode, ctx, options, &out, observation)
 		return out.String(), true, nil
 	}
@@ -153,6 +156,9 @@ func renderFastPlanWithBindingPlanOptions(bytecode *compiler.Bytecode, plan *com
 			return "", true, err
 		}
 		if ok {
+			if err := fastBudget(ctx).CheckOutput(out.Len()); err != nil {
+				return "", true, err
+			}
 			observeOutputBuilderSize(bytecode, ctx, options, &out, observation)
 			return out.String(), true, nil
 		}
@@ -165,6 +171,9 @@ func renderFastPlanWithBindingPlanOptions(bytecode *compiler.Bytecode, plan *com
 	if !ok || err != nil {
 		return "", ok, err
 	}
+	if err := fastBudget(ctx).CheckOutput(out.Len()); err != nil {
+		return "", true, err
+	}
 
 	observeOutputBuilderSize(bytecode, ctx, options, &out, observation)
 	return out.String(), true, nil
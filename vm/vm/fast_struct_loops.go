@@ -20,7 +20,7 @@ func renderFastStringKeyValueLoop(out *strings.Builder, ctx hctx.Context, loop *
 		return false, nil
 	}
 	for i, value := range iter {
-		if err := spendFastLoop(ctx, loop.Line); err != nil {
+		if err := spendFastLoop(out, ctx, loop.Line); err != nil {
 			return true, err
 		}
 		writeBuilderFastInt(out, int64(i))
@@ -56,7 +56,7 @@ func renderFastStructFieldLoop(out *strings.Builder, ctx hctx.Context, bindings
 
 	state := &fastStructLoopRenderState{}
 	for i := 0; i < iter.Len(); i++ {
-		if err := spendFastLoop(ctx, loop.Line); err != nil {
+		if err := spendFastLoop(out, ctx, loop.Line); err != nil {
 			return true, err
 		}
 
@@ -314,9 +314,7 @@ func resolveFastStructLoopCall(plan *fastStructLoopCallPlan, bindings fastRender
 	if !ok {
 		return nil, fmt.Errorf("%q: unknown identifier", call.Name)
 	}
-	if obj, ok := raw.(object.Object); ok {
-		raw = object.ToGo(obj)
-	}
+	raw = callableValue(raw, bindings.ctx)
 	rv := reflect.ValueOf(raw)
 	if !rv.IsValid() {
 		return nil, fmt.Errorf("%T is an invalid function", raw)
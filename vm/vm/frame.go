@@ -17,6 +17,9 @@ type Frame struct {
 	pooled        bool
 	writeReturn   bool
 	calleeOnStack bool
+	sourceMap     *frameSourceMap
+	debugLine     int
+	coverLine     int
 }
 
 func NewFrame(cl *object.Closure, basePointer int) *Frame {
@@ -36,6 +39,9 @@ func (f *Frame) reset(cl *object.Closure, basePointer int) {
 	f.block = nil
 	f.writeReturn = false
 	f.calleeOnStack = false
+	f.sourceMap = nil
+	f.debugLine = 0
+	f.coverLine = 0
 }
 
 func (f *Frame) Instructions() code.Instructions {
@@ -0,0 +1,152 @@
+package vm
+
+import (
+	"fmt"
+
+	"github.com/gobuffalo/plush/v5"
+	"github.com/gobuffalo/plush/v5/helpers/hctx"
+	"github.com/gobuffalo/plush/v5/vm/object"
+)
+
+// CallFunction calls the function a template bound to name with let
+// after it rendered with ctx, like plush.CallFunction, which it falls
+// back to for functions defined by the interpreter. The arguments and the
+// result are Go values. Each call runs in its own child of ctx, so calls
+// can run concurrently; budgets attached to ctx are charged like for a
+// render.
+func CallFunction(ctx hctx.Context, name string, args ...interface{}) (interface{}, error) {
+	if ctx == nil {
+		return nil, fmt.Errorf("%s: %w", name, plush.ErrNotFunction)
+	}
+	cl, ok := ctx.Value(name).(*object.Closure)
+	if !ok || cl.Origin == nil {
+		return plush.CallFunction(ctx, name, args...)
+	}
+	if err := plush.CheckContext(ctx); err != nil {
+		return nil, err
+	}
+	if err := contextBudget(ctx).SpendFunctionCall(name); err != nil {
+		return nil, err
+	}
+	v, err := closureFunc(cl, ctx)(args...)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", name, err)
+	}
+	return v, nil
+}
+
+// exportValue returns the Go value of value for the context. Closures
+// keep the program they were compiled in, so that they can be called
+// after it ran.
+func (vm *VM) exportValue(value object.Object) interface{} {
+	if cl, ok := value.(*object.Closure); ok && cl.Origin == nil {
+		cl.Origin = &object.Origin{
+			Constants:   vm.constants,
+			GlobalNames: vm.globalNames,
+			NumGlobals:  len(vm.globals),
+		}
+	}
+	return object.ToGo(value)
+}
+
+// callableValue returns the Go value of raw, a value of the context
+// about to be called through reflection. Closures become a Go function
+// running them in the program they were compiled in.
+func callableValue(raw interface{}, ctx hctx.Context) interface{} {
+	obj, ok := raw.(object.Object)
+	if !ok {
+		return raw
+	}
+	if cl, ok := obj.(*object.Closure); ok && cl.Origin != nil {
+		return closureFunc(cl, ctx)
+	}
+	return object.ToGo(obj)
+}
+
+// foreignClosure reports whether cl was compiled in another program than
+// the one vm runs, so that it can't run on the stack of vm.
+func (vm *VM) foreignClosure(cl *object.Closure) bool {
+	if cl.Origin == nil || len(cl.Origin.Constants) == 0 {
+		return false
+	}
+	return len(vm.constants) == 0 || &cl.Origin.Constants[0] != &vm.constants[0]
+}
+
+// callForeignClosure runs cl, which was compiled in another program, to
+// completion in a VM of its own, and then returns from it like
+// returnFromFrame does.
+func (vm *VM) callForeignClosure(cl *object.Closure, numArgs int, writeReturn, calleeOnStack bool) error {
+	args := make([]object.Object, numArgs)
+	copy(args, vm.stack[vm.sp-numArgs:vm.sp])
+	if err := vm.checkCallDepth(); err != nil {
+		return err
+	}
+	result, err := runClosure(cl, args, vm.ctx, vm.calls()+1)
+	if err != nil {
+		return err
+	}
+	vm.sp -= numArgs
+	if calleeOnStack {
+		vm.sp--
+	}
+	if writeReturn {
+		vm.writeFrameOutput(vm.currentFrame(), result)
+		return nil
+	}
+	return vm.push(result)
+}
+
+// closureFunc returns a Go function calling cl with Go values in a child
+// of ctx.
+func closureFunc(cl *object.Closure, ctx hctx.Context) func(args ...interface{}) (interface{}, error) {
+	return func(args ...interface{}) (interface{}, error) {
+		objs := make([]object.Object, len(args))
+		for i, arg := range args {
+			objs[i] = object.Wrap(arg)
+		}
+		result, err := runClosure(cl, objs, ctx.New(), 1)
+		if err != nil {
+			return nil, err
+		}
+		return object.ToGo(result), nil
+	}
+}
+
+// runClosure calls cl with args in a VM running the program cl was
+// compiled in, and returns the value it returns. depth is the number of
+// calls the call of cl makes, counting itself.
+func runClosure(cl *object.Closure, args []object.Object, ctx hctx.Context, depth int) (object.Object, error) {
+	if len(args) != cl.Fn.NumParameters {
+		return nil, fmt.Errorf("wrong number of arguments: want=%d, got=%d", cl.Fn.NumParameters, len(args))
+	}
+	frames := borrowFrames(true)
+	frames[0] = newFrame(cl, 0, true)
+	machine := &VM{
+		constants:   cl.Origin.Constants,
+		stack:       borrowStack(true),
+		globals:     borrowGlobals(cl.Origin.NumGlobals, true),
+		globalNames: cl.Origin.GlobalNames,
+		frames:      frames,
+		framesIndex: 1,
+		depth:       depth,
+		ctx:         ctx,
+		holes:       borrowHoles(true),
+		pooled:      true,
+		ownGlobals:  true,
+		ownHoles:    true,
+	}
+	defer machine.Release()
+	copy(machine.stack, args)
+	machine.sp = cl.Fn.NumLocals
+	if machine.sp < len(args) {
+		machine.sp = len(args)
+	}
+	machine.markStack()
+	if err := machine.Run(); err != nil {
+		return nil, machine.wrapRuntimeError(err)
+	}
+	if machine.lastPopped == nil {
+		return Null, nil
+	}
+	return machine.lastPopped, nil
+}
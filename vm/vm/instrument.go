@@ -0,0 +1,76 @@
+package vm
+
+import (
+	"github.com/gobuffalo/plush/v5"
+	"github.com/gobuffalo/plush/v5/helpers/hctx"
+	"github.com/gobuffalo/plush/v5/vm/compiler"
+	"github.com/gobuffalo/plush/v5/vm/object"
+)
+
+// renderInstrumented renders input through the generic instruction loop
+// for the source map recorder, the debugger and the coverage attached to
+// ctx, any of which may be combined. It skips fast render plans, static
+// output and the bytecode caches, which run without instructions, and
+// renders partials with plush.PartialHelper so that they are followed
+// too.
+func renderInstrumented(input string, ctx hctx.Context, filename string) (string, error) {
+	program, _, err := parseProgram(input, filename, ctx)
+	if err != nil {
+		return "", err
+	}
+	comp := compiler.New()
+	if err := comp.Compile(program); err != nil {
+		return "", err
+	}
+	bytecode := comp.Bytecode()
+	if shouldFallbackGenericBytecode(bytecode) {
+		return renderInterpreterFallback(input, ctx, filename)
+	}
+	if restorePartial := useInterpreterPartialHelper(ctx); restorePartial != nil {
+		defer restorePartial()
+	}
+
+	machine := NewWithContext(bytecode, ctx)
+	if rec := plush.SourceMapRecorderFrom(ctx); rec != nil {
+		machine.sourceMap = &vmSourceMap{
+			builder: rec.NewBuilder(ctx),
+			outputs: map[*object.Native][]plush.SourceSegment{},
+		}
+	}
+	if d := plush.DebuggerFrom(ctx); d != nil {
+		machine.debugger = d
+		machine.debugOutput = &machine.frames[0].output
+	}
+	machine.coverage = plush.TemplateCoverageFor(ctx, program)
+	if err := machine.Run(); err != nil {
+		return "", machine.wrapRuntimeError(err)
+	}
+	rendered := machine.Rendered()
+	var root *plush.SourceMapBuilder
+	if machine.sourceMap != nil {
+		root = machine.frameSourceMap(machine.frames[0]).builder
+	}
+
+	holes := machine.PunchHoles()
+	if len(holes) == 0 || !plush.IsPlushTemplateFile(filename) || plush.IsHoleRender(ctx) {
+		if root != nil {
+			root.Finish(rendered)
+		}
+		return rendered, nil
+	}
+
+	holes = plush.FinalizePunchHolePositions(rendered, holes)
+	if root != nil {
+		holes = root.MarkHoles(holes)
+	}
+	holes = plush.RenderPunchHolesConcurrentlyWith(holes, ctx, Render)
+	filled, err := plush.FillPunchHoles(rendered, holes)
+	if err != nil {
+		return "", err
+	}
+	if root != nil {
+		root.FillHoles(holes)
+		root.Finish(filled)
+	}
+	return filled, nil
+}
@@ -38,6 +38,8 @@ func (vm *VM) executeFor(iterable object.Object, block *object.Closure, keyName,
 	defer child.Release()
 	child.deferHolePositions = true
 	rawLoopValues := loopCanUseRawValues(block)
+	budget := vm.budget()
+	size := 0
 
 	run := func(key, value object.Object) (bool, error) {
 		if err := vm.spendLoop(); err != nil {
@@ -51,6 +53,12 @@ func (vm *VM) executeFor(iterable object.Object, block *object.Closure, keyName,
 		if err := child.Run(); err != nil {
 			return false, child.wrapRuntimeError(err)
 		}
+		// The output of the iterations is collected before it is written,
+		// so check its size as it grows.
+		size += child.frames[0].output.Len()
+		if err := budget.CheckOutput(size); err != nil {
+			return false, err
+		}
 		result := child.lastPopped
 		if result == nil {
 			result = child.frameOutputObject(child.frames[0])
@@ -577,7 +585,7 @@ func (vm *VM) updateNamedGlobal(globalIndex int, value object.Object) {
 	if !ok || vm.ctx == nil {
 		return
 	}
-	vm.ctx.Set(name, object.ToGo(value))
+	vm.ctx.Set(name, vm.exportValue(value))
 }
 
 func (vm *VM) globalFromContext(globalIndex int) object.Object {
@@ -13,9 +13,7 @@ import (
 )
 
 func writeFastCallValue(out *strings.Builder, ctx hctx.Context, name string, raw interface{}, args *fastCallArgs, cacheSlot *object.InlineCacheSlot) error {
-	if obj, ok := raw.(object.Object); ok {
-		raw = object.ToGo(obj)
-	}
+	raw = callableValue(raw, ctx)
 	rv := reflect.ValueOf(raw)
 	if !rv.IsValid() {
 		return fmt.Errorf("%T is an invalid function", raw)
@@ -51,9 +49,7 @@ func writeFastCallValueWithEntry(out *strings.Builder, ctx hctx.Context, name st
 }
 
 func fastCallValue(name string, raw interface{}, args *fastCallArgs, ctx hctx.Context, cacheSlot *object.InlineCacheSlot) (interface{}, error) {
-	if obj, ok := raw.(object.Object); ok {
-		raw = object.ToGo(obj)
-	}
+	raw = callableValue(raw, ctx)
 	rv := reflect.ValueOf(raw)
 	if !rv.IsValid() {
 		return nil, fmt.Errorf("%T is an invalid function", raw)
@@ -606,9 +602,13 @@ func (vm *VM) runBlock(block *object.Closure, ctx hctx.Context) (string, error)
 	vm.syncContextBindingsFromContext(ctx, blockCtx)
 	vm.syncFrameBindingsFromContext(blockCtx)
 	if !object.IsNull(child.lastPopped) && child.lastPopped != nil {
-		return child.renderObject(child.lastPopped), nil
+		rendered := child.renderObject(child.lastPopped)
+		child.finishSourceMap(rendered, child.lastPopped)
+		return rendered, nil
 	}
-	return child.Rendered(), nil
+	rendered := child.Rendered()
+	child.finishSourceMap(rendered, nil)
+	return rendered, nil
 }
 
 func (vm *VM) childVM(cl *object.Closure, args []object.Object, ctx hctx.Context) *VM {
@@ -622,9 +622,14 @@ func (vm *VM) childVM(cl *object.Closure, args []object.Object, ctx hctx.Context
 		globalNames: vm.globalNames,
 		frames:      frames,
 		framesIndex: 1,
+		depth:       vm.calls(),
 		ctx:         ctx,
 		holes:       vm.holes,
 		pooled:      true,
+		sourceMap:   vm.sourceMap,
+		debugger:    vm.debugger,
+		debugOutput: vm.debugOutput,
+		coverage:    vm.coverage,
 	}
 	child.resetChild(cl, args, ctx)
 	return child
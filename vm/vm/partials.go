@@ -502,6 +502,9 @@ func renderFastDataPartialInto(out *strings.Builder, partial *compiler.FastParti
 	} else {
 		setupPartialNesting(partialCtx, partial.Name)
 	}
+	if err := plush.EnterPartial(partialCtx, partial.Name, plush.TemplateFilenameForError(ctx), partial.Line); err != nil {
+		return true, err
+	}
 	needsJSEscape := partialNeedsJSEscape(partialCtx, partial.Name)
 	if useMetaIDs {
 		needsJSEscape = partialNeedsJSEscapeFast(partialCtx, partial.Name, metaIDs)
@@ -562,6 +565,11 @@ func renderFastDataPartialDirectInto(out *strings.Builder, partial *compiler.Fas
 	if !ok {
 		return false, nil
 	}
+	// Direct partials render no other partial, so they can't be part of a
+	// cycle, but they still count toward the nesting depth.
+	if err := plush.CheckPartialDepth(ctx); err != nil {
+		return true, plush.WrapPartialRenderError(plush.TemplateFilenameForError(ctx), partial.Line, filename, err)
+	}
 	bytecode := link.bytecode
 	var localStorage fastPartialLocalStorage
 	if bytecode.Static {
@@ -827,6 +835,9 @@ func renderFastNoDataPartialInto(out *strings.Builder, name string, ctx hctx.Con
 	} else {
 		setupPartialNesting(partialCtx, name)
 	}
+	if err := plush.EnterPartial(partialCtx, name, plush.TemplateFilenameForError(ctx), line); err != nil {
+		return true, err
+	}
 	needsJSEscape := partialNeedsJSEscape(partialCtx, name)
 	if useMetaIDs {
 		needsJSEscape = partialNeedsJSEscapeFast(partialCtx, name, metaIDs)
@@ -1162,6 +1173,9 @@ func renderFastNoDataPartialDirectInto(out *strings.Builder, name string, ctx hc
 	if !ok {
 		return false, nil
 	}
+	if err := plush.CheckPartialDepth(ctx); err != nil {
+		return true, plush.WrapPartialRenderError(plush.TemplateFilenameForError(ctx), line, filename, err)
+	}
 	bytecode := link.bytecode
 	if bytecode.Static {
 		observation := beginPartialOutputObservation(bytecode, filename, ctx)
@@ -1307,6 +1321,9 @@ func vmPartialHelper(name string, data map[string]interface{}, help plush.Helper
 	if help.Context == nil {
 		return "", fmt.Errorf("invalid context. abort")
 	}
+	if err := plush.CheckContext(help.Context); err != nil {
+		return "", err
+	}
 
 	if ctx, ok := help.Context.(*plush.Context); ok {
 		if err := ctx.Budget().SpendSubRender(); err != nil {
@@ -1349,6 +1366,9 @@ func vmPartialHelper(name string, data map[string]interface{}, help plush.Helper
 		help.Set(meta.TemplateFileKey, name)
 	}
 	childFile := plush.TemplateFilenameForError(help.Context)
+	if err := plush.EnterPartial(help.Context, name, parentFile, parentLine); err != nil {
+		return "", err
+	}
 
 	pf, ok := links.partialFeeder(help.Context)
 	if !ok {
@@ -1385,6 +1405,9 @@ func vmPartialHelper(name string, data map[string]interface{}, help plush.Helper
 			part = template.JSEscapeString(part)
 		}
 	}
+	if err := contextBudget(help.Context).CheckOutput(len(part)); err != nil {
+		return "", plush.WrapPartialRenderError(parentFile, parentLine, childFile, err)
+	}
 
 	if layout, ok := data["layout"].(string); ok {
 		return vmPartialHelper(layout, map[string]interface{}{"yield": template.HTML(part)}, help)
@@ -133,6 +133,13 @@ func (vm *VM) writeObject(out *strings.Builder, obj object.Object) {
 	if obj == nil || object.IsNull(obj) {
 		return
 	}
+	if vm.sourceMap != nil {
+		if native, ok := obj.(*object.Native); ok {
+			if segments, ok := vm.sourceMap.outputs[native]; ok {
+				defer vm.spliceSourceMapOutput(out, out.Len(), segments)
+			}
+		}
+	}
 
 	switch obj := obj.(type) {
 	case *object.Array:
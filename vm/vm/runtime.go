@@ -36,6 +36,7 @@ func newWithContext(bytecode *compiler.Bytecode, ctx hctx.Context, pooled bool)
 		LocalNames:     bytecode.LocalNames,
 		LineNumbers:    bytecode.LineNumbers,
 		Properties:     bytecode.Properties,
+		Branches:       bytecode.Branches,
 		PropertyCaches: bytecode.PropertyCaches,
 		CallCaches:     bytecode.CallCaches,
 		NumLocals:      bytecode.NumLocals,
@@ -82,6 +83,17 @@ func Compile(input string) (*Template, error) {
 	return templateFromBytecode(compileProgramBytecode(program))
 }
 
+// CompileAt compiles input like Compile, numbering its first line line so
+// errors report the lines of the template input was cut from.
+func CompileAt(input string, line int) (*Template, error) {
+	program, err := parser.ParseWithOptions(preprocessTrimTags(input), parser.Options{Line: line})
+	if err != nil {
+		return nil, err
+	}
+
+	return templateFromBytecode(compileProgramBytecode(program))
+}
+
 func compileProgramBytecode(program *ast.Program) (*compiler.Bytecode, error) {
 	comp := compiler.New()
 	if err := comp.Compile(program); err != nil {
@@ -143,7 +155,7 @@ func borrowStack(pooled bool) []object.Object {
 }
 
 func releaseStack(stack []object.Object, used int) {
-	if cap(stack) < StackSize {
+	if cap(stack) != StackSize {
 		return
 	}
 	stack = stack[:StackSize]
@@ -165,7 +177,7 @@ func borrowFrames(pooled bool) []*Frame {
 }
 
 func releaseFrames(frames []*Frame) {
-	if cap(frames) < MaxFrames {
+	if cap(frames) != MaxFrames {
 		return
 	}
 	frames = frames[:MaxFrames]
@@ -302,6 +314,10 @@ func Render(input string, ctx hctx.Context) (string, error) {
 		})
 	}()
 
+	if plush.SourceMapRecorderFrom(ctx) != nil || plush.DebuggerFrom(ctx) != nil || plush.CoverageFrom(ctx) != nil {
+		return renderInstrumented(cacheSource, ctx, filename)
+	}
+
 	if filename == "" {
 		if bytecode, ok := cachedSourceBytecode(cacheSource); ok {
 			return renderSourceCachedBytecode(cacheSource, ctx, bytecode)
@@ -0,0 +1,90 @@
+package vm
+
+import (
+	"strings"
+
+	"github.com/gobuffalo/plush/v5"
+	"github.com/gobuffalo/plush/v5/vm/code"
+	"github.com/gobuffalo/plush/v5/vm/object"
+)
+
+// vmSourceMap is shared by a VM and the child VMs running its blocks while
+// a source map is recorded.
+type vmSourceMap struct {
+	builder *plush.SourceMapBuilder
+	// outputs holds the segments of frame outputs turned into values, so
+	// they can be spliced in wherever the value is written.
+	outputs map[*object.Native][]plush.SourceSegment
+}
+
+// frameSourceMap tracks the output written by the instruction a frame is
+// executing.
+type frameSourceMap struct {
+	builder *plush.SourceMapBuilder
+	start   int
+	from    int
+	line    int
+	html    bool
+}
+
+func (vm *VM) frameSourceMap(frame *Frame) *frameSourceMap {
+	if frame.sourceMap == nil {
+		frame.sourceMap = &frameSourceMap{builder: vm.sourceMap.builder.Sub()}
+	}
+	return frame.sourceMap
+}
+
+// traceSourceMap maps the output written by the previous instruction of
+// frame and starts tracking the instruction at ip.
+func (vm *VM) traceSourceMap(frame *Frame, ip int, op code.Opcode) {
+	state := vm.flushSourceMap(frame)
+	if line := frame.cl.Fn.LineNumbers[ip]; line > 0 {
+		state.line = line
+	}
+	state.html = op == code.OpWriteHTML
+}
+
+func (vm *VM) flushSourceMap(frame *Frame) *frameSourceMap {
+	state := vm.frameSourceMap(frame)
+	n := frame.output.Len()
+	if n > state.start {
+		state.builder.Fill(frame.output.String(), state.start, n, state.from, state.line, state.html)
+	}
+	state.start = n
+	state.from = state.builder.Len()
+	return state
+}
+
+// registerSourceMapOutput remembers the segments of a frame output value.
+func (vm *VM) registerSourceMapOutput(frame *Frame, value *object.Native) {
+	state := vm.flushSourceMap(frame)
+	vm.sourceMap.outputs[value] = state.builder.Segments()
+}
+
+// spliceSourceMapOutput records the segments of a frame output value that
+// was written to out at offset.
+func (vm *VM) spliceSourceMapOutput(out *strings.Builder, offset int, segments []plush.SourceSegment) {
+	for i := vm.framesIndex - 1; i >= 0; i-- {
+		if frame := vm.frames[i]; frame != nil && &frame.output == out {
+			vm.frameSourceMap(frame).builder.Splice(offset, segments)
+			return
+		}
+	}
+}
+
+// finishSourceMap registers the output of a child VM run as a rendered
+// block. value is the object the output was rendered from, if any.
+func (vm *VM) finishSourceMap(rendered string, value object.Object) {
+	if vm.sourceMap == nil || vm.frames[0] == nil {
+		return
+	}
+	if native, ok := value.(*object.Native); ok {
+		if segments, ok := vm.sourceMap.outputs[native]; ok {
+			b := vm.sourceMap.builder.Sub()
+			b.Splice(0, segments)
+			b.FinishBlock(rendered)
+		}
+		return
+	}
+	vm.flushSourceMap(vm.frames[0]).builder.FinishBlock(rendered)
+}
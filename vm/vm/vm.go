@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"html/template"
 	"reflect"
+	"strings"
 	"sync"
 	"time"
 
@@ -43,6 +44,9 @@ type VM struct {
 
 	frames      []*Frame
 	framesIndex int
+	// depth is the number of calls the VM runs in besides those of its
+	// frames, such as those of the VM running the loop it runs the body of.
+	depth int
 
 	ctx hctx.Context
 
@@ -58,6 +62,13 @@ type VM struct {
 
 	lastHelperContext hctx.Context
 
+	sourceMap *vmSourceMap
+	debugger  plush.Debugger
+	// debugOutput is the output of the template being debugged, which
+	// child VMs running its blocks report too.
+	debugOutput *strings.Builder
+	coverage    *plush.TemplateCoverage
+
 	pooled     bool
 	ownGlobals bool
 	ownHoles   bool